@@ -0,0 +1,150 @@
+// Package breaker implements circuit breakers for calls to verbs.
+package breaker
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+// State of a circuit breaker.
+type State int
+
+const (
+	// Closed breakers allow all calls.
+	Closed State = iota
+	// Open breakers reject all calls.
+	Open
+	// HalfOpen breakers allow a single trial call, which determines whether
+	// the breaker closes or re-opens.
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	default:
+		panic("unknown circuit breaker state")
+	}
+}
+
+func (s State) ToProto() ftlv1.CircuitBreakerState {
+	switch s {
+	case Closed:
+		return ftlv1.CircuitBreakerState_CIRCUIT_BREAKER_CLOSED
+	case Open:
+		return ftlv1.CircuitBreakerState_CIRCUIT_BREAKER_OPEN
+	case HalfOpen:
+		return ftlv1.CircuitBreakerState_CIRCUIT_BREAKER_HALF_OPEN
+	default:
+		panic("unknown circuit breaker state")
+	}
+}
+
+type Config struct {
+	// Number of consecutive failed calls before a breaker opens. Breakers are
+	// disabled if this is zero.
+	FailureThreshold int
+	// How long a breaker stays open before allowing a trial call.
+	OpenTimeout time.Duration
+}
+
+// Status of the circuit breaker for a single verb.
+type Status struct {
+	Verb     schema.RefKey
+	State    State
+	Failures int
+}
+
+type breaker struct {
+	state    State
+	failures int
+	openedAt time.Time
+}
+
+// Breakers tracks a circuit breaker per destination verb.
+type Breakers struct {
+	config Config
+	clock  clock.Clock
+
+	lock     sync.Mutex
+	breakers map[schema.RefKey]*breaker
+}
+
+func New(config Config) *Breakers {
+	return NewForTesting(config, clock.New())
+}
+
+func NewForTesting(config Config, clock clock.Clock) *Breakers {
+	return &Breakers{config: config, clock: clock, breakers: map[schema.RefKey]*breaker{}}
+}
+
+// Allow returns true if a call to verb should proceed.
+//
+// If the call is allowed, the returned function must be called with whether
+// the call failed once it completes.
+func (b *Breakers) Allow(verb schema.RefKey) (done func(failed bool), ok bool) {
+	if b.config.FailureThreshold <= 0 {
+		return func(bool) {}, true
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	br, ok := b.breakers[verb]
+	if !ok {
+		br = &breaker{}
+		b.breakers[verb] = br
+	}
+	switch br.state {
+	case Closed:
+	case Open:
+		if b.clock.Since(br.openedAt) < b.config.OpenTimeout {
+			return nil, false
+		}
+		br.state = HalfOpen
+	case HalfOpen:
+		// A trial call is already in flight.
+		return nil, false
+	}
+	return func(failed bool) { b.record(br, failed) }, true
+}
+
+func (b *Breakers) record(br *breaker, failed bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if !failed {
+		br.state = Closed
+		br.failures = 0
+		return
+	}
+	br.failures++
+	if br.state == HalfOpen || br.failures >= b.config.FailureThreshold {
+		br.state = Open
+		br.openedAt = b.clock.Now()
+	}
+}
+
+// Status returns the state of every tracked breaker, ordered by verb.
+func (b *Breakers) Status() []Status {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	out := make([]Status, 0, len(b.breakers))
+	for verb, br := range b.breakers {
+		state := br.state
+		if state == Open && b.clock.Since(br.openedAt) >= b.config.OpenTimeout {
+			state = HalfOpen
+		}
+		out = append(out, Status{Verb: verb, State: state, Failures: br.failures})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Verb.String() < out[j].Verb.String() })
+	return out
+}
@@ -0,0 +1,55 @@
+package breaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/benbjohnson/clock"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestBreaker(t *testing.T) {
+	clk := clock.NewMock()
+	breakers := NewForTesting(Config{FailureThreshold: 2, OpenTimeout: time.Second * 10}, clk)
+	verb := schema.RefKey{Module: "echo", Name: "echo"}
+
+	call := func(failed bool) bool {
+		done, ok := breakers.Allow(verb)
+		if ok {
+			done(failed)
+		}
+		return ok
+	}
+
+	assert.True(t, call(true))
+	assert.True(t, call(false), "success should reset failures")
+	assert.True(t, call(true))
+	assert.True(t, call(true))
+	assert.Equal(t, []Status{{Verb: verb, State: Open, Failures: 2}}, breakers.Status())
+	assert.False(t, call(false), "open breaker should reject calls")
+
+	// After the timeout a single trial call is allowed.
+	clk.Add(time.Second * 10)
+	done, ok := breakers.Allow(verb)
+	assert.True(t, ok)
+	assert.False(t, call(false), "only one trial call should be allowed")
+	done(true)
+	assert.False(t, call(false), "failed trial call should re-open the breaker")
+
+	clk.Add(time.Second * 10)
+	assert.True(t, call(false))
+	assert.Equal(t, []Status{{Verb: verb, State: Closed}}, breakers.Status())
+}
+
+func TestBreakerDisabled(t *testing.T) {
+	breakers := New(Config{})
+	verb := schema.RefKey{Module: "echo", Name: "echo"}
+	for range 10 {
+		done, ok := breakers.Allow(verb)
+		assert.True(t, ok)
+		done(true)
+	}
+	assert.Equal(t, []Status{}, breakers.Status())
+}
@@ -12,12 +12,14 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/TBD54566975/ftl/backend/controller/breaker"
 	"github.com/TBD54566975/ftl/backend/controller/dal"
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
 	pbconsole "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/console"
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/console/pbconsoleconnect"
 	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
 	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/backend/schema/diagram"
 	"github.com/TBD54566975/ftl/buildengine"
 	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/TBD54566975/ftl/internal/model"
@@ -25,14 +27,16 @@ import (
 )
 
 type ConsoleService struct {
-	dal *dal.DAL
+	dal      *dal.DAL
+	breakers *breaker.Breakers
 }
 
 var _ pbconsoleconnect.ConsoleServiceHandler = (*ConsoleService)(nil)
 
-func NewService(dal *dal.DAL) *ConsoleService {
+func NewService(dal *dal.DAL, breakers *breaker.Breakers) *ConsoleService {
 	return &ConsoleService{
-		dal: dal,
+		dal:      dal,
+		breakers: breakers,
 	}
 }
 
@@ -85,6 +89,11 @@ func (c *ConsoleService) GetModules(ctx context.Context, req *connect.Request[pb
 	}
 	sch.Modules = append(sch.Modules, schema.Builtins())
 
+	breakerStates := map[schema.RefKey]breaker.State{}
+	for _, status := range c.breakers.Status() {
+		breakerStates[status.Verb] = status.State
+	}
+
 	var modules []*pbconsole.Module
 	for _, deployment := range deployments {
 		var verbs []*pbconsole.Verb
@@ -121,6 +130,7 @@ func (c *ConsoleService) GetModules(ctx context.Context, req *connect.Request[pb
 					Verb:              v,
 					Schema:            schemaString,
 					JsonRequestSchema: jsonRequestSchema,
+					CircuitBreaker:    breakerStates[schema.RefKey{Module: deployment.Module, Name: decl.Name}].ToProto(),
 				})
 
 			case *schema.Data:
@@ -181,6 +191,49 @@ func (c *ConsoleService) GetModules(ctx context.Context, req *connect.Request[pb
 	}), nil
 }
 
+func (c *ConsoleService) GetSchemaGraph(ctx context.Context, req *connect.Request[pbconsole.GetSchemaGraphRequest]) (*connect.Response[pbconsole.GetSchemaGraphResponse], error) {
+	deployments, err := c.dal.GetDeploymentsWithMinReplicas(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sch := &schema.Schema{
+		Modules: slices.Map(deployments, func(d dal.Deployment) *schema.Module {
+			return d.Schema
+		}),
+	}
+
+	var format diagram.Format
+	switch req.Msg.Format {
+	case pbconsole.GraphFormat_GRAPH_FORMAT_DOT:
+		format = diagram.FormatDOT
+	case pbconsole.GraphFormat_GRAPH_FORMAT_MERMAID:
+		format = diagram.FormatMermaid
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown graph format %s", req.Msg.Format))
+	}
+	kinds, err := slices.MapErr(req.Msg.Kinds, func(kind pbconsole.GraphKind) (diagram.Kind, error) {
+		switch kind {
+		case pbconsole.GraphKind_GRAPH_KIND_FSM:
+			return diagram.KindFSM, nil
+		case pbconsole.GraphKind_GRAPH_KIND_CALLS:
+			return diagram.KindCalls, nil
+		case pbconsole.GraphKind_GRAPH_KIND_PUBSUB:
+			return diagram.KindPubSub, nil
+		default:
+			return "", fmt.Errorf("unknown graph kind %s", kind)
+		}
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	out, err := diagram.Build(sch, kinds, req.Msg.Modules).Render(format)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pbconsole.GetSchemaGraphResponse{Graph: out}), nil
+}
+
 func (c *ConsoleService) GetEvents(ctx context.Context, req *connect.Request[pbconsole.EventsQuery]) (*connect.Response[pbconsole.GetEventsResponse], error) {
 	query, err := eventsQueryProtoToDAL(req.Msg)
 	if err != nil {
@@ -142,6 +142,18 @@ export data HttpRequest<Body> {
   query {String: [String]}
   headers {String: [String]}
   body Body
+  // The authenticated caller, if the verb declares +auth.
+  principal builtin.Principal?
+}
+
+// Principal is the authenticated caller of an HTTP ingress verb.
+export data Principal {
+  // The auth scheme that authenticated the caller: "jwt", "apikey" or "mtls".
+  scheme String
+  // The JWT "sub" claim, API key name or client certificate subject.
+  subject String
+  // The JWT claims, or client certificate details.
+  claims {String: Any}
 }
 
 data FooRequest {
@@ -25,6 +25,7 @@ import (
 	"github.com/alecthomas/kong"
 	"github.com/alecthomas/types/either"
 	"github.com/alecthomas/types/optional"
+	"github.com/gorilla/websocket"
 	"github.com/jackc/pgx/v5"
 	"github.com/jellydator/ttlcache/v3"
 	"github.com/jpillora/backoff"
@@ -36,13 +37,17 @@ import (
 
 	"github.com/TBD54566975/ftl"
 	"github.com/TBD54566975/ftl/backend/controller/admin"
+	"github.com/TBD54566975/ftl/backend/controller/breaker"
 	"github.com/TBD54566975/ftl/backend/controller/console"
 	"github.com/TBD54566975/ftl/backend/controller/cronjobs"
+	cronjobsdal "github.com/TBD54566975/ftl/backend/controller/cronjobs/dal"
 	"github.com/TBD54566975/ftl/backend/controller/dal"
 	"github.com/TBD54566975/ftl/backend/controller/ingress"
 	"github.com/TBD54566975/ftl/backend/controller/leases"
+	"github.com/TBD54566975/ftl/backend/controller/limiter"
 	"github.com/TBD54566975/ftl/backend/controller/observability"
 	"github.com/TBD54566975/ftl/backend/controller/pubsub"
+	"github.com/TBD54566975/ftl/backend/controller/routing"
 	"github.com/TBD54566975/ftl/backend/controller/scaling"
 	"github.com/TBD54566975/ftl/backend/controller/scaling/localscaling"
 	"github.com/TBD54566975/ftl/backend/controller/scheduledtask"
@@ -69,18 +74,41 @@ import (
 
 // CommonConfig between the production controller and development server.
 type CommonConfig struct {
-	AllowOrigins   []*url.URL    `help:"Allow CORS requests to ingress endpoints from these origins." env:"FTL_CONTROLLER_ALLOW_ORIGIN"`
-	AllowHeaders   []string      `help:"Allow these headers in CORS requests. (Requires AllowOrigins)" env:"FTL_CONTROLLER_ALLOW_HEADERS"`
-	NoConsole      bool          `help:"Disable the console."`
-	IdleRunners    int           `help:"Number of idle runners to keep around (not supported in production)." default:"3"`
-	WaitFor        []string      `help:"Wait for these modules to be deployed before becoming ready." placeholder:"MODULE"`
-	CronJobTimeout time.Duration `help:"Timeout for cron jobs." default:"5m"`
+	AllowOrigins      []*url.URL    `help:"Allow CORS requests to ingress endpoints from these origins." env:"FTL_CONTROLLER_ALLOW_ORIGIN"`
+	AllowHeaders      []string      `help:"Allow these headers in CORS requests. (Requires AllowOrigins)" env:"FTL_CONTROLLER_ALLOW_HEADERS"`
+	NoConsole         bool          `help:"Disable the console."`
+	IdleRunners       int           `help:"Number of idle runners to keep around (not supported in production)." default:"3"`
+	WaitFor           []string      `help:"Wait for these modules to be deployed before becoming ready." placeholder:"MODULE"`
+	CronJobTimeout    time.Duration `help:"Timeout for cron jobs." default:"5m"`
+	IngressTimeout    time.Duration `help:"Deadline for ingress requests, including all downstream verb calls (0 to disable)." default:"0s"`
+	IngressURL        *url.URL      `help:"Public URL of the ingress, listed as the server in the OpenAPI document served by the controller." env:"FTL_CONTROLLER_INGRESS_URL"`
+	IngressMaxBody    int64         `help:"Maximum size in bytes of ingress request bodies, including file uploads, and of WebSocket messages (0 to disable, which also rejects multipart forms)." default:"33554432"`
+	IngressFormMemory int64         `help:"Size in bytes of multipart form ingress request bodies buffered in memory while they are parsed, beyond which uploaded files are written to temporary files. Files are passed to verbs in full, so memory use is bounded by --ingress-max-body." default:"33554432"`
+	MaxCallDepth      int           `help:"Maximum depth of a chain of verb calls (0 to disable)." default:"64"`
+
+	RoutingStrategy        routing.Strategy            `help:"Default strategy for routing calls to runners." enum:"random,least-outstanding,power-of-two,consistent-hash" default:"random" env:"FTL_CONTROLLER_ROUTING_STRATEGY"`
+	ModuleRoutingStrategy  map[string]routing.Strategy `help:"Per-module routing strategy overrides." placeholder:"MODULE=STRATEGY" env:"FTL_CONTROLLER_MODULE_ROUTING_STRATEGY"`
+	RoutingOutlierFactor   float64                     `help:"Degrade runners whose average latency exceeds the module median by this factor (0 to disable)." default:"3"`
+	RoutingOutlierSamples  int                         `help:"Minimum number of successful calls to a runner before it is considered for latency outlier detection." default:"20"`
+	RoutingDegradedTimeout time.Duration               `help:"How long a latency outlier runner is deprioritised for." default:"30s"`
+
+	CircuitBreakerFailures int           `help:"Consecutive failed calls to a verb before its circuit breaker opens (0 to disable)." default:"5"`
+	CircuitBreakerTimeout  time.Duration `help:"How long a circuit breaker stays open before allowing a trial call." default:"30s"`
+
+	IdempotencyKeyTTL time.Duration `help:"How long responses to calls with an idempotency key are kept for replay." default:"24h"`
+
+	IngressClientCertHeader string `help:"Trust URL encoded PEM client certificates in this header for mTLS ingress auth. Only set this if ingress is behind a TLS terminating proxy that sets the header." env:"FTL_CONTROLLER_INGRESS_CLIENT_CERT_HEADER"`
 }
 
 func (c *CommonConfig) Validate() error {
 	if len(c.AllowHeaders) > 0 && len(c.AllowOrigins) == 0 {
 		return fmt.Errorf("AllowOrigins must be set when AllowHeaders is used")
 	}
+	for module, strategy := range c.ModuleRoutingStrategy {
+		if err := strategy.Validate(); err != nil {
+			return fmt.Errorf("module %q: %w", module, err)
+		}
+	}
 	return nil
 }
 
@@ -144,7 +172,7 @@ func Start(ctx context.Context, config Config, runnerScaling scaling.RunnerScali
 	sm := cf.SecretsFromContext(ctx)
 
 	admin := admin.NewAdminService(cm, sm, svc.dal)
-	console := console.NewService(svc.dal)
+	console := console.NewService(svc.dal, svc.breakers)
 
 	ingressHandler := http.Handler(svc)
 	if len(config.AllowOrigins) > 0 {
@@ -168,6 +196,7 @@ func Start(ctx context.Context, config Config, runnerScaling scaling.RunnerScali
 			rpc.GRPC(ftlv1connect.NewControllerServiceHandler, svc),
 			rpc.GRPC(ftlv1connect.NewAdminServiceHandler, admin),
 			rpc.GRPC(pbconsoleconnect.NewConsoleServiceHandler, console),
+			rpc.HTTP("/openapi.json", http.HandlerFunc(svc.serveOpenAPI)),
 			rpc.HTTP("/", consoleHandler),
 			rpc.PProf(),
 		)
@@ -208,6 +237,11 @@ type Service struct {
 	schema atomic.Value[*schema.Schema]
 
 	routes        atomic.Value[map[string][]dal.Route]
+	router        *routing.Router
+	limiter       *limiter.Limiter
+	breakers      *breaker.Breakers
+	authenticator *ingress.Authenticator
+	webSockets    *ingress.WebSockets
 	config        Config
 	runnerScaling scaling.RunnerScaling
 
@@ -244,6 +278,21 @@ func New(ctx context.Context, conn *sql.DB, config Config, runnerScaling scaling
 		config:                  config,
 		runnerScaling:           runnerScaling,
 		increaseReplicaFailures: map[string]int{},
+		router: routing.New(routing.Config{
+			Default:           config.RoutingStrategy,
+			Modules:           config.ModuleRoutingStrategy,
+			OutlierFactor:     config.RoutingOutlierFactor,
+			OutlierMinSamples: config.RoutingOutlierSamples,
+		}),
+		limiter: limiter.New(db),
+		breakers: breaker.New(breaker.Config{
+			FailureThreshold: config.CircuitBreakerFailures,
+			OpenTimeout:      config.CircuitBreakerTimeout,
+		}),
+		authenticator: ingress.NewAuthenticator(func(ctx context.Context, ref *schema.Ref, dest any) error {
+			return cf.SecretsFromContext(ctx).Get(ctx, cf.NewRef(ref.Module, ref.Name), dest)
+		}, config.IngressClientCertHeader),
+		webSockets: ingress.NewWebSockets(slices.Map(config.AllowOrigins, func(u *url.URL) string { return u.String() }), config.IngressMaxBody),
 	}
 	svc.routes.Store(map[string][]dal.Route{})
 	svc.schema.Store(&schema.Schema{})
@@ -256,6 +305,7 @@ func New(ctx context.Context, conn *sql.DB, config Config, runnerScaling scaling
 	svc.pubSub = pubSub
 
 	go svc.syncSchema(ctx)
+	go svc.deliverWebSocketMessages(ctx)
 
 	// Use min, max backoff if we are running in production, otherwise use
 	// (1s, 1s) (or develBackoff). Will also wrap the job such that it its next
@@ -294,6 +344,9 @@ func New(ctx context.Context, conn *sql.DB, config Config, runnerScaling scaling
 	svc.tasks.Singleton(maybeDevelTask(svc.reapStaleControllers, time.Second*2, time.Second*20, time.Second*20))
 	svc.tasks.Singleton(maybeDevelTask(svc.reapStaleRunners, time.Second*2, time.Second, time.Second*10))
 	svc.tasks.Singleton(maybeDevelTask(svc.reapCallEvents, time.Minute*5, time.Minute, time.Minute*30))
+	svc.tasks.Singleton(maybeDevelTask(svc.expireIdempotencyKeys, time.Minute, time.Minute, time.Minute*5))
+	svc.tasks.Singleton(maybeDevelTask(svc.expireLimits, time.Minute, time.Minute, time.Minute*5))
+	svc.tasks.Singleton(maybeDevelTask(svc.deleteStaleWebSocketMessages, time.Minute, time.Minute, time.Minute*5))
 	svc.tasks.Singleton(maybeDevelTask(svc.releaseExpiredReservations, time.Second*2, time.Second, time.Second*20))
 	svc.tasks.Singleton(maybeDevelTask(svc.reconcileDeployments, time.Second*2, time.Second, time.Second*5))
 	svc.tasks.Singleton(maybeDevelTask(svc.reconcileRunners, time.Second*2, time.Second, time.Second*5))
@@ -321,7 +374,36 @@ func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	requestKey := model.NewRequestKey(model.OriginIngress, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
-	ingress.Handle(start, sch, requestKey, routes, w, r, s.callWithRequest)
+	// WebSocket connections outlive the ingress timeout.
+	if s.config.IngressTimeout > 0 && !websocket.IsWebSocketUpgrade(r) {
+		ctx, cancel := context.WithTimeout(r.Context(), s.config.IngressTimeout)
+		defer cancel()
+		r = r.WithContext(ctx)
+	}
+	limits := ingress.BodyLimits{MaxBody: s.config.IngressMaxBody, MaxFormMemory: s.config.IngressFormMemory}
+	ingress.Handle(start, sch, requestKey, routes, s.authenticator, s.webSockets, limits, w, r, s.callWithRequest, s.callStreamWithRequest)
+}
+
+// serveOpenAPI serves an OpenAPI document describing all active HTTP ingress routes.
+func (s *Service) serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	sch, err := s.dal.GetActiveSchema(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	options := ingress.OpenAPIOptions{}
+	if s.config.IngressURL != nil {
+		options.Servers = []string{s.config.IngressURL.String()}
+	}
+	doc, err := ingress.GenerateOpenAPI(sch, options)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(doc); err != nil {
+		log.FromContext(r.Context()).Warnf("Failed to write OpenAPI document: %s", err)
+	}
 }
 
 func (s *Service) ProcessList(ctx context.Context, req *connect.Request[ftlv1.ProcessListRequest]) (*connect.Response[ftlv1.ProcessListResponse], error) {
@@ -437,6 +519,13 @@ func (s *Service) Status(ctx context.Context, req *connect.Request[ftlv1.StatusR
 			}
 		}),
 		Routes: routes,
+		CircuitBreakers: slices.Map(s.breakers.Status(), func(b breaker.Status) *ftlv1.StatusResponse_CircuitBreaker {
+			return &ftlv1.StatusResponse_CircuitBreaker{
+				Verb:                &schemapb.Ref{Module: b.Verb.Module, Name: b.Verb.Name},
+				State:               b.State.ToProto(),
+				ConsecutiveFailures: int32(b.Failures),
+			}
+		}),
 	}
 	return connect.NewResponse(resp), nil
 }
@@ -829,6 +918,10 @@ func (s *Service) Call(ctx context.Context, req *connect.Request[ftlv1.CallReque
 	return s.callWithRequest(ctx, req, optional.None[model.RequestKey](), optional.None[model.RequestKey](), "")
 }
 
+func (s *Service) CallStream(ctx context.Context, req *connect.Request[ftlv1.CallRequest], stream *connect.ServerStream[ftlv1.CallResponse]) error {
+	return s.callStreamWithRequest(ctx, req, optional.None[model.RequestKey](), optional.None[model.RequestKey](), "", stream.Send)
+}
+
 func (s *Service) SendFSMEvent(ctx context.Context, req *connect.Request[ftlv1.SendFSMEventRequest]) (resp *connect.Response[ftlv1.SendFSMEventResponse], err error) {
 	msg := req.Msg
 	sch := s.schema.Load()
@@ -854,6 +947,93 @@ func (s *Service) SendFSMEvent(ctx context.Context, req *connect.Request[ftlv1.S
 	}
 	defer instance.Release() //nolint:errcheck
 
+	if instance.Status == dal.FSMStatusCompensating {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("fsm instance %s is compensating", instance.Key))
+	}
+
+	if state, ok := instance.DestinationState.Get(); ok {
+		// A transition is already executing, so queue the event until it completes.
+		if !fsmHasTransition(sch, fsm, state, eventType) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no transition found from state %s for type %s", state, eventType))
+		}
+		depth, dedupe := fsm.QueueParams()
+		err = tx.QueueFSMEvent(ctx, instance.FSM, instance.Key, eventType, msg.Body, depth, dedupe)
+		if errors.Is(err, dal.ErrFSMQueueFull) {
+			return nil, connect.NewError(connect.CodeResourceExhausted, err)
+		} else if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("could not queue fsm event: %w", err))
+		}
+		return connect.NewResponse(&ftlv1.SendFSMEventResponse{}), nil
+	}
+
+	if err := s.startFSMTransition(ctx, tx, sch, fsm, instance, eventType, msg.Body); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&ftlv1.SendFSMEventResponse{}), nil
+}
+
+func (s *Service) SetNextFSMEvent(ctx context.Context, req *connect.Request[ftlv1.SetNextFSMEventRequest]) (*connect.Response[ftlv1.SetNextFSMEventResponse], error) {
+	msg := req.Msg
+	sch := s.schema.Load()
+	fsm := &schema.FSM{}
+	if err := sch.ResolveToType(schema.RefFromProto(msg.Fsm), fsm); err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("fsm not found: %w", err))
+	}
+	eventType := schema.TypeFromProto(msg.Event)
+	fsmKey := schema.RefFromProto(msg.Fsm).ToRefKey()
+
+	instance, err := s.dal.GetFSMInstance(ctx, fsmKey, msg.Instance)
+	if errors.Is(err, dalerrs.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("fsm instance not found: %w", err))
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("could not get fsm instance: %w", err))
+	}
+	state, ok := instance.DestinationState.Get()
+	if !ok {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("fsm instance %s is not executing a transition", msg.Instance))
+	}
+	// Only the verb of the state being transitioned to can set the next event.
+	callers, err := headers.GetCallers(req.Header())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if len(callers) == 0 || callers[len(callers)-1].ToRefKey() != state {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("the next event of fsm instance %s can only be set by %s", msg.Instance, state))
+	}
+	// Reject the event now rather than when the transition completes.
+	if !fsmHasTransition(sch, fsm, state, eventType) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no transition found from state %s for type %s", state, eventType))
+	}
+
+	err = s.dal.SetNextFSMEvent(ctx, fsmKey, msg.Instance, eventType, msg.Body)
+	if errors.Is(err, dal.ErrFSMNextEventSet) {
+		return nil, connect.NewError(connect.CodeAlreadyExists, err)
+	} else if errors.Is(err, dalerrs.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("could not set next fsm event: %w", err))
+	}
+	return connect.NewResponse(&ftlv1.SetNextFSMEventResponse{}), nil
+}
+
+// fsmHasTransition returns true if the FSM has a transition out of the given
+// state that accepts the event type.
+func fsmHasTransition(sch *schema.Schema, fsm *schema.FSM, from schema.RefKey, eventType schema.Type) bool {
+	for _, transition := range fsm.Transitions {
+		if _, isTimeout := transition.Timeout(); isTimeout || transition.From.ToRefKey() != from {
+			continue
+		}
+		verb := &schema.Verb{}
+		if err := sch.ResolveToType(transition.To, verb); err == nil && eventType.Equal(verb.Request) {
+			return true
+		}
+	}
+	return false
+}
+
+// startFSMTransition starts the transition from the current state of an idle
+// FSM instance that accepts the given event.
+func (s *Service) startFSMTransition(ctx context.Context, tx *dal.Tx, sch *schema.Schema, fsm *schema.FSM, instance *dal.FSMInstance, eventType schema.Type, body []byte) error {
 	// Populated if we find a matching transition.
 	var destinationRef *schema.Ref
 	var destinationVerb *schema.Verb
@@ -879,7 +1059,7 @@ func (s *Service) SendFSMEvent(ctx context.Context, req *connect.Request[ftlv1.S
 	if !instance.CurrentState.Ok() {
 		for _, start := range fsm.Start {
 			if brk, err := updateCandidates(start); err != nil {
-				return nil, err
+				return err
 			} else if brk {
 				break
 			}
@@ -891,8 +1071,12 @@ func (s *Service) SendFSMEvent(ctx context.Context, req *connect.Request[ftlv1.S
 			if transition.From.ToRefKey() != instanceState {
 				continue
 			}
+			if _, ok := transition.Timeout(); ok {
+				// Timeout transitions are never triggered by events.
+				continue
+			}
 			if brk, err := updateCandidates(transition.To); err != nil {
-				return nil, err
+				return err
 			} else if brk {
 				break
 			}
@@ -901,55 +1085,255 @@ func (s *Service) SendFSMEvent(ctx context.Context, req *connect.Request[ftlv1.S
 
 	if destinationRef == nil {
 		if len(candidates) > 0 {
-			return nil, connect.NewError(connect.CodeFailedPrecondition,
+			return connect.NewError(connect.CodeFailedPrecondition,
 				fmt.Errorf("no transition found from state %s for type %s, candidates are %s", instance.CurrentState, eventType, strings.Join(candidates, ", ")))
 		}
-		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no transition found from state %s for type %s", instance.CurrentState, eventType))
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no transition found from state %s for type %s", instance.CurrentState, eventType))
 	}
 
 	retryParams, err := schema.RetryParamsForFSMTransition(fsm, destinationVerb)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return connect.NewError(connect.CodeInternal, err)
 	}
 
-	err = tx.StartFSMTransition(ctx, instance.FSM, instance.Key, destinationRef.ToRefKey(), msg.Body, retryParams)
+	err = tx.StartFSMTransition(ctx, instance.FSM, instance.Key, destinationRef.ToRefKey(), body, retryParams)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("could not start fsm transition: %w", err))
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("could not start fsm transition: %w", err))
 	}
-	return connect.NewResponse(&ftlv1.SendFSMEventResponse{}), nil
+	return nil
 }
 
 func (s *Service) PublishEvent(ctx context.Context, req *connect.Request[ftlv1.PublishEventRequest]) (*connect.Response[ftlv1.PublishEventResponse], error) {
 	// Publish the event.
-	err := s.dal.PublishEventForTopic(ctx, req.Msg.Topic.Module, req.Msg.Topic.Name, req.Msg.Caller, req.Msg.Body)
+	err := s.dal.PublishEventForTopic(ctx, req.Msg.Topic.Module, req.Msg.Topic.Name, req.Msg.Caller, optional.Ptr(req.Msg.PartitionKey), req.Msg.Body)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to publish a event to topic %s:%s: %w", req.Msg.Topic.Module, req.Msg.Topic.Name, err))
 	}
 	return connect.NewResponse(&ftlv1.PublishEventResponse{}), nil
 }
 
-func (s *Service) callWithRequest(
+func (s *Service) SendWebSocketMessage(ctx context.Context, req *connect.Request[ftlv1.SendWebSocketMessageRequest]) (*connect.Response[ftlv1.SendWebSocketMessageResponse], error) {
+	key, err := model.ParseWebSocketConnectionKey(req.Msg.ConnectionId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid connection ID: %w", err))
+	}
+	message := dal.WebSocketMessage{Body: req.Msg.Body, Binary: req.Msg.Binary}
+	sent, err := s.webSockets.Send(key, message)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+	if !sent {
+		// The connection is held by another controller, or has closed.
+		if err := s.dal.SendWebSocketMessage(ctx, key, message); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	return connect.NewResponse(&ftlv1.SendWebSocketMessageResponse{}), nil
+}
+
+// webSocketMessageCheckInterval is how often all connections held by this
+// controller are checked for queued messages, in case notifications were
+// missed. It must be shorter than staleWebSocketMessageAge.
+const webSocketMessageCheckInterval = 10 * time.Second
+
+// deliverWebSocketMessages delivers messages queued by other controllers to
+// the WebSocket connections held by this controller.
+//
+// Each connection is delivered to by its own goroutine, so that a slow
+// connection doesn't delay delivery to the others.
+func (s *Service) deliverWebSocketMessages(ctx context.Context) {
+	notifications := make(chan model.WebSocketConnectionKey, 64)
+	s.dal.WebSocketMessages.Subscribe(notifications)
+	defer s.dal.WebSocketMessages.Unsubscribe(notifications)
+
+	listening := make(chan struct{}, 1)
+	go s.dal.ListenForWebSocketMessages(ctx, s.conn, func() {
+		select {
+		case listening <- struct{}{}:
+		default:
+		}
+	})
+	ticker := time.NewTicker(webSocketMessageCheckInterval)
+	defer ticker.Stop()
+
+	// Wakes the goroutine delivering to each connection, keyed by
+	// model.WebSocketConnectionKey.String().
+	deliveries := map[string]chan struct{}{}
+	done := make(chan model.WebSocketConnectionKey)
+	deliver := func(key model.WebSocketConnectionKey) {
+		if wake, ok := deliveries[key.String()]; ok {
+			select {
+			case wake <- struct{}{}:
+			default:
+			}
+			return
+		}
+		wake := make(chan struct{}, 1)
+		deliveries[key.String()] = wake
+		go func() {
+			s.deliverQueuedWebSocketMessages(ctx, key, wake)
+			select {
+			case done <- key:
+			case <-ctx.Done():
+			}
+		}()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case key := <-notifications:
+			if s.webSockets.Has(key) {
+				deliver(key)
+			}
+
+		case <-listening:
+			for _, key := range s.webSockets.Keys() {
+				deliver(key)
+			}
+
+		case <-ticker.C:
+			for _, key := range s.webSockets.Keys() {
+				deliver(key)
+			}
+
+		case key := <-done:
+			wake := deliveries[key.String()]
+			delete(deliveries, key.String())
+			// Messages may have been queued after the goroutine last checked.
+			if len(wake) > 0 {
+				deliver(key)
+			}
+		}
+	}
+}
+
+// deliverQueuedWebSocketMessages sends the messages queued for a connection,
+// taking them again if wake is signalled while they are being sent.
+func (s *Service) deliverQueuedWebSocketMessages(ctx context.Context, key model.WebSocketConnectionKey, wake chan struct{}) {
+	logger := log.FromContext(ctx)
+	for {
+		messages, err := s.dal.TakeWebSocketMessages(ctx, key)
+		if err != nil {
+			logger.Errorf(err, "Failed to take WebSocket messages for %s", key)
+			return
+		}
+		for _, message := range messages {
+			if _, err := s.webSockets.Send(key, message); err != nil {
+				logger.Debugf("Failed to deliver WebSocket message: %s", err)
+				break
+			}
+		}
+		select {
+		case <-wake:
+		default:
+			return
+		}
+	}
+}
+
+func (s *Service) ScheduleCall(ctx context.Context, req *connect.Request[ftlv1.ScheduleCallRequest]) (*connect.Response[ftlv1.ScheduleCallResponse], error) {
+	msg := req.Msg
+	if msg.Verb == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("verb is required"))
+	}
+	sch := s.schema.Load()
+	verbRef := schema.RefFromProto(msg.Verb)
+	verb := &schema.Verb{}
+	if err := sch.ResolveToType(verbRef, verb); err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("verb not found: %w", err))
+	}
+	if !verb.IsExported() {
+		callers, err := headers.GetCallers(req.Header())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		for _, caller := range callers {
+			if caller.Module != verbRef.Module {
+				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("verb %q is not exported", verbRef))
+			}
+		}
+	}
+	if err := ingress.ValidateCallBody(msg.Body, verb, sch); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	retryParams, err := schema.RetryParamsForVerb(verb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("could not get retry params for %s: %w", verbRef, err))
+	}
+	if retry := msg.Retry; retry != nil {
+		retryParams.Count = int(retry.Count)
+		retryParams.MinBackoff = retry.MinBackoff.AsDuration()
+		retryParams.MaxBackoff = retry.MaxBackoff.AsDuration()
+		if retryParams.Count < 0 || retryParams.MinBackoff < 0 || retryParams.MinBackoff > retryParams.MaxBackoff {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid retry policy"))
+		}
+	}
+
+	key := model.NewScheduledCallKey(verbRef.Module, verbRef.Name).String()
+	if msg.Key != nil {
+		if *msg.Key == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("key must not be empty"))
+		}
+		key = *msg.Key
+	}
+	scheduledAt := time.Now()
+	if msg.ScheduledAt != nil {
+		scheduledAt = msg.ScheduledAt.AsTime()
+	}
+
+	scheduled, err := s.dal.ScheduleCall(ctx, verbRef.ToRefKey(), key, msg.Body, scheduledAt, retryParams)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("could not schedule call to %s: %w", verbRef, err))
+	}
+	return connect.NewResponse(&ftlv1.ScheduleCallResponse{Key: key, Scheduled: scheduled}), nil
+}
+
+// preparedCall is a call to a Verb that has been validated and admitted, and
+// is ready to be sent to a runner.
+type preparedCall struct {
+	verbRef        *schema.Ref
+	route          dal.Route
+	requestKey     model.RequestKey
+	callers        []*schema.Ref
+	idempotencyKey optional.Option[string]
+	// replay is the stored response to an earlier call with the same
+	// idempotency key, which must be returned instead of calling the Verb.
+	replay optional.Option[*ftlv1.CallResponse]
+}
+
+// prepareCall validates and admits a call to a Verb, returning the context to
+// call it with.
+//
+// Idempotency keys are claimed before limits are applied, so that replayed
+// calls do not consume rate limit tokens or concurrency slots.
+//
+// The returned function must be called once the call has completed.
+func (s *Service) prepareCall(
 	ctx context.Context,
 	req *connect.Request[ftlv1.CallRequest],
 	key optional.Option[model.RequestKey],
 	parentKey optional.Option[model.RequestKey],
 	sourceAddress string,
-) (*connect.Response[ftlv1.CallResponse], error) {
-	start := time.Now()
-
+	start time.Time,
+	streaming bool,
+) (context.Context, *preparedCall, func(), error) {
 	if req.Msg.Verb == nil {
 		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("invalid request: missing verb"))
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("verb is required"))
+		return nil, nil, nil, connect.NewError(connect.CodeInvalidArgument, errors.New("verb is required"))
 	}
 	if req.Msg.Body == nil {
 		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("invalid request: missing body"))
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("body is required"))
+		return nil, nil, nil, connect.NewError(connect.CodeInvalidArgument, errors.New("body is required"))
 	}
 
 	sch, err := s.dal.GetActiveSchema(ctx)
 	if err != nil {
 		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("schema retrieval failed"))
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	verbRef := schema.RefFromProto(req.Msg.Verb)
@@ -958,42 +1342,117 @@ func (s *Service) callWithRequest(
 	if err = sch.ResolveToType(verbRef, verb); err != nil {
 		if errors.Is(err, schema.ErrNotFound) {
 			observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("verb not found"))
-			return nil, connect.NewError(connect.CodeNotFound, err)
+			return nil, nil, nil, connect.NewError(connect.CodeNotFound, err)
 		}
 		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("verb resolution failed"))
-		return nil, err
+		return nil, nil, nil, err
+	}
+
+	switch {
+	case streaming && !verb.Stream:
+		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("invalid request: not a streaming verb"))
+		return nil, nil, nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("verb %s is not a streaming verb", verbRef))
+	case !streaming && verb.Stream:
+		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("invalid request: streaming verb"))
+		return nil, nil, nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("verb %s is a streaming verb and must be called with CallStream", verbRef))
 	}
 
 	err = ingress.ValidateCallBody(req.Msg.Body, verb, sch)
 	if err != nil {
 		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("invalid request: invalid call body"))
-		return nil, err
+		return nil, nil, nil, err
+	}
+
+	// The verb's own timeout can only shorten the deadline of the call chain.
+	cancel := context.CancelFunc(func() {})
+	if md, ok := verb.GetMetadataTimeout().Get(); ok {
+		if timeout, err := md.Timeout(); err == nil {
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+		}
+	}
+	fail := func(err error) (context.Context, *preparedCall, func(), error) {
+		cancel()
+		return nil, nil, nil, err
 	}
 
 	module := verbRef.Module
 	routes, ok := s.routes.Load()[module]
 	if !ok {
 		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("no routes for module"))
-		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no routes for module %q", module))
+		return fail(connect.NewError(connect.CodeNotFound, fmt.Errorf("no routes for module %q", module)))
 	}
-	route := routes[rand.Intn(len(routes))] //nolint:gosec
-	client := s.clientsForRunner(route.Runner, route.Endpoint)
 
 	callers, err := headers.GetCallers(req.Header())
 	if err != nil {
 		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("failed to get callers"))
-		return nil, err
+		return fail(err)
 	}
 
 	if !verb.IsExported() {
 		for _, caller := range callers {
 			if caller.Module != module {
 				observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("invalid request: verb not exported"))
-				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("verb %q is not exported", verbRef))
+				return fail(connect.NewError(connect.CodePermissionDenied, fmt.Errorf("verb %q is not exported", verbRef)))
+			}
+		}
+	}
+
+	if err = checkCallChain(sch, callers, verbRef, s.config.MaxCallDepth); err != nil {
+		failure := "call cycle detected"
+		if s.config.MaxCallDepth > 0 && len(callers) >= s.config.MaxCallDepth {
+			failure = "max call depth exceeded"
+		}
+		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some(failure))
+		return fail(err)
+	}
+
+	idempotencyKey, hasIdempotencyKey := optional.Ptr(req.Msg.IdempotencyKey).Get()
+	if hasIdempotencyKey {
+		replay, err := s.dal.ClaimIdempotencyKey(ctx, verbRef.ToRefKey(), idempotencyKey, idempotencyClaimTimeout)
+		switch {
+		case errors.Is(err, dalerrs.ErrConflict):
+			observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("idempotent call in flight"))
+			return fail(connect.NewError(connect.CodeAborted, err))
+		case err != nil:
+			observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("failed to claim idempotency key"))
+			return fail(err)
+		}
+		if data, ok := replay.Get(); ok {
+			replayed := &ftlv1.CallResponse{}
+			if err := proto.Unmarshal(data, replayed); err != nil {
+				observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("invalid idempotent response"))
+				return fail(fmt.Errorf("failed to unmarshal idempotent response: %w", err))
 			}
+			return ctx, &preparedCall{verbRef: verbRef, replay: optional.Some(replayed)}, cancel, nil
+		}
+	}
+	// releaseKey releases the claimed idempotency key if the call is not made.
+	releaseKey := func() {
+		if hasIdempotencyKey {
+			s.finishIdempotentCall(context.WithoutCancel(ctx), verbRef, idempotencyKey, optional.None[*ftlv1.CallResponse]())
 		}
 	}
 
+	release, err := s.limiter.Acquire(ctx, verbRef, verb)
+	switch {
+	case errors.Is(err, limiter.ErrRateLimited):
+		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("rate limited"))
+		releaseKey()
+		return fail(connect.NewError(connect.CodeResourceExhausted, err))
+	case errors.Is(err, limiter.ErrConcurrencyLimited):
+		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("concurrency limited"))
+		releaseKey()
+		return fail(connect.NewError(connect.CodeResourceExhausted, err))
+	case err != nil:
+		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("failed to apply limits"))
+		releaseKey()
+		return fail(err)
+	}
+	done := func() {
+		release()
+		cancel()
+	}
+
 	var requestKey model.RequestKey
 	isNewRequestKey := false
 	if k, ok := key.Get(); ok {
@@ -1003,7 +1462,9 @@ func (s *Service) callWithRequest(
 		k, ok, err := headers.GetRequestKey(req.Header())
 		if err != nil {
 			observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("failed to get request key"))
-			return nil, err
+			releaseKey()
+			done()
+			return nil, nil, nil, err
 		} else if !ok {
 			requestKey = model.NewRequestKey(model.OriginIngress, "grpc")
 			sourceAddress = req.Peer().Addr
@@ -1016,7 +1477,9 @@ func (s *Service) callWithRequest(
 		headers.SetRequestKey(req.Header(), requestKey)
 		if err = s.dal.CreateRequest(ctx, requestKey, sourceAddress); err != nil {
 			observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("failed to create request"))
-			return nil, err
+			releaseKey()
+			done()
+			return nil, nil, nil, err
 		}
 	}
 
@@ -1027,23 +1490,84 @@ func (s *Service) callWithRequest(
 	ctx = rpc.WithVerbs(ctx, append(callers, verbRef))
 	headers.AddCaller(req.Header(), schema.RefFromProto(req.Msg.Verb))
 
-	response, err := client.verb.Call(ctx, req)
-	var resp *connect.Response[ftlv1.CallResponse]
-	var maybeResponse optional.Option[*ftlv1.CallResponse]
-	if err == nil {
-		resp = connect.NewResponse(response.Msg)
-		maybeResponse = optional.Some(resp.Msg)
+	return ctx, &preparedCall{
+		verbRef:        verbRef,
+		route:          s.router.Select(module, routes, requestKey.String()),
+		requestKey:     requestKey,
+		callers:        callers,
+		idempotencyKey: optional.Ptr(req.Msg.IdempotencyKey),
+	}, done, nil
+}
+
+func (s *Service) callWithRequest(
+	ctx context.Context,
+	req *connect.Request[ftlv1.CallRequest],
+	key optional.Option[model.RequestKey],
+	parentKey optional.Option[model.RequestKey],
+	sourceAddress string,
+) (*connect.Response[ftlv1.CallResponse], error) {
+	start := time.Now()
+
+	ctx, call, done, err := s.prepareCall(ctx, req, key, parentKey, sourceAddress, start, false)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	verbRef := call.verbRef
+
+	if replayed, ok := call.replay.Get(); ok {
 		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.None[string]())
-	} else {
-		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("verb call failed"))
+		return connect.NewResponse(replayed), nil
 	}
-	s.recordCall(ctx, &Call{
+	idempotencyKey, hasIdempotencyKey := call.idempotencyKey.Get()
+
+	route := call.route
+	var resp *connect.Response[ftlv1.CallResponse]
+	var maybeResponse optional.Option[*ftlv1.CallResponse]
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		err = connect.NewError(connect.CodeDeadlineExceeded, fmt.Errorf("deadline exceeded before calling %s", verbRef))
+		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("deadline exceeded"))
+	case ctx.Err() != nil:
+		err = connect.NewError(connect.CodeCanceled, fmt.Errorf("call to %s cancelled: %w", verbRef, ctx.Err()))
+		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("call cancelled"))
+	default:
+		breakerDone, ok := s.breakers.Allow(verbRef.ToRefKey())
+		if !ok {
+			err = connect.NewError(connect.CodeUnavailable, fmt.Errorf("circuit breaker open for %s", verbRef))
+			observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("circuit breaker open"))
+			break
+		}
+		client := s.clientsForRunner(route.Runner, route.Endpoint)
+		done := s.router.Track(route.Runner)
+		var response *connect.Response[ftlv1.CallResponse]
+		response, err = client.verb.Call(ctx, req)
+		done(err == nil)
+		// Failures caused by the caller giving up don't count against the destination.
+		breakerDone(err != nil && ctx.Err() == nil)
+		switch {
+		case err == nil:
+			resp = connect.NewResponse(response.Msg)
+			maybeResponse = optional.Some(resp.Msg)
+			observability.Calls.Request(ctx, req.Msg.Verb, start, optional.None[string]())
+		case errors.Is(ctx.Err(), context.DeadlineExceeded) || connect.CodeOf(err) == connect.CodeDeadlineExceeded:
+			err = connect.NewError(connect.CodeDeadlineExceeded, fmt.Errorf("deadline exceeded calling %s: %w", verbRef, err))
+			observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("deadline exceeded"))
+		default:
+			observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("verb call failed"))
+		}
+	}
+	if hasIdempotencyKey {
+		s.finishIdempotentCall(context.WithoutCancel(ctx), verbRef, idempotencyKey, maybeResponse)
+	}
+	// Record the call even if the caller's context has expired.
+	s.recordCall(context.WithoutCancel(ctx), &Call{
 		deploymentKey:    route.Deployment,
-		requestKey:       requestKey,
+		requestKey:       call.requestKey,
 		parentRequestKey: parentKey,
 		startTime:        start,
 		destVerb:         verbRef,
-		callers:          callers,
+		callers:          call.callers,
 		callError:        optional.Nil(err),
 		request:          req.Msg,
 		response:         maybeResponse,
@@ -1051,6 +1575,92 @@ func (s *Service) callWithRequest(
 	return resp, err
 }
 
+// callStreamWithRequest calls a streaming Verb, passing each response to send
+// as it is received.
+func (s *Service) callStreamWithRequest(
+	ctx context.Context,
+	req *connect.Request[ftlv1.CallRequest],
+	key optional.Option[model.RequestKey],
+	parentKey optional.Option[model.RequestKey],
+	sourceAddress string,
+	send func(*ftlv1.CallResponse) error,
+) error {
+	start := time.Now()
+
+	if req.Msg.IdempotencyKey != nil {
+		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("invalid request: idempotency key"))
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("idempotency keys are not supported for streaming verbs"))
+	}
+
+	ctx, call, done, err := s.prepareCall(ctx, req, key, parentKey, sourceAddress, start, true)
+	if err != nil {
+		return err
+	}
+	defer done()
+	verbRef := call.verbRef
+	route := call.route
+
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		err = connect.NewError(connect.CodeDeadlineExceeded, fmt.Errorf("deadline exceeded before calling %s", verbRef))
+		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("deadline exceeded"))
+	case ctx.Err() != nil:
+		err = connect.NewError(connect.CodeCanceled, fmt.Errorf("call to %s cancelled: %w", verbRef, ctx.Err()))
+		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("call cancelled"))
+	default:
+		breakerDone, ok := s.breakers.Allow(verbRef.ToRefKey())
+		if !ok {
+			err = connect.NewError(connect.CodeUnavailable, fmt.Errorf("circuit breaker open for %s", verbRef))
+			observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("circuit breaker open"))
+			break
+		}
+		client := s.clientsForRunner(route.Runner, route.Endpoint)
+		done := s.router.Track(route.Runner)
+		var sendErr error
+		err = func() error {
+			responses, err := client.verb.CallStream(ctx, req)
+			if err != nil {
+				return err
+			}
+			defer responses.Close()
+			for responses.Receive() {
+				if sendErr = send(responses.Msg()); sendErr != nil {
+					return sendErr
+				}
+			}
+			return responses.Err()
+		}()
+		done(err == nil)
+		// Failures caused by the caller giving up don't count against the destination.
+		breakerDone(err != nil && sendErr == nil && ctx.Err() == nil)
+		switch {
+		case err == nil:
+			observability.Calls.Request(ctx, req.Msg.Verb, start, optional.None[string]())
+		case sendErr != nil:
+			err = connect.NewError(connect.CodeCanceled, fmt.Errorf("stream from %s closed by caller: %w", verbRef, sendErr))
+			observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("stream closed by caller"))
+		case errors.Is(ctx.Err(), context.DeadlineExceeded) || connect.CodeOf(err) == connect.CodeDeadlineExceeded:
+			err = connect.NewError(connect.CodeDeadlineExceeded, fmt.Errorf("deadline exceeded calling %s: %w", verbRef, err))
+			observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("deadline exceeded"))
+		default:
+			observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("verb call failed"))
+		}
+	}
+	// Record the call even if the caller's context has expired. Streamed
+	// responses are not recorded.
+	s.recordCall(context.WithoutCancel(ctx), &Call{
+		deploymentKey:    route.Deployment,
+		requestKey:       call.requestKey,
+		parentRequestKey: parentKey,
+		startTime:        start,
+		destVerb:         verbRef,
+		callers:          call.callers,
+		callError:        optional.Nil(err),
+		request:          req.Msg,
+	})
+	return err
+}
+
 func (s *Service) GetArtefactDiffs(ctx context.Context, req *connect.Request[ftlv1.GetArtefactDiffsRequest]) (*connect.Response[ftlv1.GetArtefactDiffsResponse], error) {
 	byteDigests, err := slices.MapErr(req.Msg.ClientDigests, sha256.ParseSHA256)
 	if err != nil {
@@ -1135,21 +1745,492 @@ func (s *Service) ResetSubscription(ctx context.Context, req *connect.Request[ft
 	return connect.NewResponse(&ftlv1.ResetSubscriptionResponse{}), nil
 }
 
-// Load schemas for existing modules, combine with our new one, and validate the new module in the context
-// of the whole schema.
-func (s *Service) validateModuleSchema(ctx context.Context, module *schema.Module) (*schema.Module, error) {
-	existingModules, err := s.dal.GetActiveDeploymentSchemas(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("could not get existing schemas: %w", err)
+func (s *Service) SeekSubscription(ctx context.Context, req *connect.Request[ftlv1.SeekSubscriptionRequest]) (*connect.Response[ftlv1.SeekSubscriptionResponse], error) {
+	var target either.Either[model.TopicEventKey, time.Time]
+	switch t := req.Msg.Target.(type) {
+	case *ftlv1.SeekSubscriptionRequest_EventKey:
+		event, err := model.ParseTopicEventKey(t.EventKey)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid event key %q: %w", t.EventKey, err))
+		}
+		target = either.LeftOf[time.Time](event)
+
+	case *ftlv1.SeekSubscriptionRequest_Time:
+		target = either.RightOf[model.TopicEventKey](t.Time.AsTime())
+
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("an event key or time to seek to is required"))
 	}
-	schemaMap := ftlmaps.FromSlice(existingModules, func(el *schema.Module) (string, *schema.Module) { return el.Name, el })
-	schemaMap[module.Name] = module
-	fullSchema := &schema.Schema{Modules: maps.Values(schemaMap)}
-	schema, err := schema.ValidateModuleInSchema(fullSchema, optional.Some[*schema.Module](module))
+	result, err := s.dal.SeekSubscription(ctx, req.Msg.Subscription.Module, req.Msg.Subscription.Name, target, req.Msg.DryRun)
 	if err != nil {
-		return nil, fmt.Errorf("invalid schema: %w", err)
+		if errors.Is(err, dalerrs.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, fmt.Errorf("could not seek subscription: %w", err)
 	}
-	return schema.Module(module.Name).MustGet(), nil
+	return connect.NewResponse(&ftlv1.SeekSubscriptionResponse{
+		Redelivered: int64(result.Redelivered),
+		Skipped:     int64(result.Skipped),
+	}), nil
+}
+
+func (s *Service) ListDeadLetters(ctx context.Context, req *connect.Request[ftlv1.ListDeadLettersRequest]) (*connect.Response[ftlv1.ListDeadLettersResponse], error) {
+	event, err := parseDeadLetterEventKey(req.Msg.EventKey)
+	if err != nil {
+		return nil, err
+	}
+	limit := 100
+	if req.Msg.Limit != nil {
+		limit = int(*req.Msg.Limit)
+	}
+	deadLetters, err := s.dal.GetDeadLetters(ctx, req.Msg.Subscription.Module, req.Msg.Subscription.Name, event, limit)
+	if err != nil {
+		return nil, fmt.Errorf("could not list dead letters: %w", err)
+	}
+	return connect.NewResponse(&ftlv1.ListDeadLettersResponse{
+		DeadLetters: slices.Map(deadLetters, func(d dal.DeadLetter) *ftlv1.DeadLetter {
+			return &ftlv1.DeadLetter{
+				EventKey:     d.Event.String(),
+				Subscription: d.Subscription.ToProto(),
+				Topic:        d.Topic.ToProto(),
+				Error:        d.Error,
+				Attempts:     int32(d.Attempts),
+				CreatedAt:    timestamppb.New(d.CreatedAt),
+				Payload:      d.Payload,
+			}
+		}),
+	}), nil
+}
+
+func (s *Service) ReplayDeadLetters(ctx context.Context, req *connect.Request[ftlv1.ReplayDeadLettersRequest]) (*connect.Response[ftlv1.ReplayDeadLettersResponse], error) {
+	event, err := parseDeadLetterEventKey(req.Msg.EventKey)
+	if err != nil {
+		return nil, err
+	}
+	count, err := s.dal.ReplayDeadLetters(ctx, req.Msg.Subscription.Module, req.Msg.Subscription.Name, event)
+	if err != nil {
+		return nil, fmt.Errorf("could not replay dead letters: %w", err)
+	}
+	if count > 0 {
+		s.AsyncCallWasAdded(ctx)
+	}
+	return connect.NewResponse(&ftlv1.ReplayDeadLettersResponse{Count: int32(count)}), nil
+}
+
+func (s *Service) PurgeDeadLetters(ctx context.Context, req *connect.Request[ftlv1.PurgeDeadLettersRequest]) (*connect.Response[ftlv1.PurgeDeadLettersResponse], error) {
+	event, err := parseDeadLetterEventKey(req.Msg.EventKey)
+	if err != nil {
+		return nil, err
+	}
+	count, err := s.dal.PurgeDeadLetters(ctx, req.Msg.Subscription.Module, req.Msg.Subscription.Name, event)
+	if err != nil {
+		return nil, fmt.Errorf("could not purge dead letters: %w", err)
+	}
+	return connect.NewResponse(&ftlv1.PurgeDeadLettersResponse{Count: int32(count)}), nil
+}
+
+func (s *Service) ListFSMInstances(ctx context.Context, req *connect.Request[ftlv1.ListFSMInstancesRequest]) (*connect.Response[ftlv1.ListFSMInstancesResponse], error) {
+	status := optional.None[dal.FSMStatus]()
+	if req.Msg.Status != nil {
+		status = optional.Some(fsmStatusFromProto(*req.Msg.Status))
+	}
+	state := optional.None[schema.RefKey]()
+	if req.Msg.State != nil {
+		state = optional.Some(schema.RefFromProto(req.Msg.State).ToRefKey())
+	}
+	limit := 100
+	if req.Msg.Limit != nil {
+		limit = int(*req.Msg.Limit)
+	}
+	instances, err := s.dal.ListFSMInstances(ctx, schema.RefFromProto(req.Msg.Fsm).ToRefKey(), status, state, limit)
+	if err != nil {
+		return nil, fmt.Errorf("could not list fsm instances: %w", err)
+	}
+	return connect.NewResponse(&ftlv1.ListFSMInstancesResponse{
+		Instances: slices.Map(instances, fsmInstanceToProto),
+	}), nil
+}
+
+func (s *Service) GetFSMInstance(ctx context.Context, req *connect.Request[ftlv1.GetFSMInstanceRequest]) (*connect.Response[ftlv1.GetFSMInstanceResponse], error) {
+	fsm := schema.RefFromProto(req.Msg.Fsm).ToRefKey()
+	instance, err := s.dal.GetFSMInstance(ctx, fsm, req.Msg.Instance)
+	if errors.Is(err, dalerrs.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("fsm instance %s:%s not found", fsm, req.Msg.Instance))
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get fsm instance: %w", err)
+	}
+	history, err := s.dal.GetFSMTransitionHistory(ctx, fsm, req.Msg.Instance)
+	if err != nil {
+		return nil, fmt.Errorf("could not get fsm instance history: %w", err)
+	}
+	return connect.NewResponse(&ftlv1.GetFSMInstanceResponse{
+		Instance: fsmInstanceToProto(instance),
+		History: slices.Map(history, func(attempt dal.FSMTransitionAttempt) *ftlv1.FSMTransitionAttempt {
+			return &ftlv1.FSMTransitionAttempt{
+				State:     attempt.State.ToProto(),
+				Status:    string(attempt.Status),
+				Error:     attempt.Error.Ptr(),
+				CreatedAt: timestamppb.New(attempt.CreatedAt),
+				Event:     attempt.Event,
+				Catching:  attempt.Catching,
+			}
+		}),
+	}), nil
+}
+
+func (s *Service) ListScheduledCalls(ctx context.Context, req *connect.Request[ftlv1.ListScheduledCallsRequest]) (*connect.Response[ftlv1.ListScheduledCallsResponse], error) {
+	verb := optional.None[schema.RefKey]()
+	if req.Msg.Verb != nil {
+		verb = optional.Some(schema.RefFromProto(req.Msg.Verb).ToRefKey())
+	}
+	limit := 100
+	if req.Msg.Limit != nil {
+		limit = int(*req.Msg.Limit)
+	}
+	calls, err := s.dal.ListScheduledCalls(ctx, verb, limit)
+	if err != nil {
+		return nil, fmt.Errorf("could not list scheduled calls: %w", err)
+	}
+	return connect.NewResponse(&ftlv1.ListScheduledCallsResponse{
+		Calls: slices.Map(calls, func(call dal.ScheduledCall) *ftlv1.ScheduledCall {
+			return &ftlv1.ScheduledCall{
+				Verb:              call.Verb.ToProto(),
+				Key:               call.Key,
+				State:             string(call.State),
+				ScheduledAt:       timestamppb.New(call.ScheduledAt),
+				RemainingAttempts: call.RemainingAttempts,
+				Error:             call.Error.Ptr(),
+				CreatedAt:         timestamppb.New(call.CreatedAt),
+			}
+		}),
+	}), nil
+}
+
+func (s *Service) CancelScheduledCall(ctx context.Context, req *connect.Request[ftlv1.CancelScheduledCallRequest]) (*connect.Response[ftlv1.CancelScheduledCallResponse], error) {
+	if req.Msg.Verb == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("verb is required"))
+	}
+	err := s.dal.CancelScheduledCall(ctx, schema.RefFromProto(req.Msg.Verb).ToRefKey(), req.Msg.Key)
+	if errors.Is(err, dalerrs.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not cancel scheduled call: %w", err)
+	}
+	return connect.NewResponse(&ftlv1.CancelScheduledCallResponse{}), nil
+}
+
+func (s *Service) ListCronJobs(ctx context.Context, req *connect.Request[ftlv1.ListCronJobsRequest]) (*connect.Response[ftlv1.ListCronJobsResponse], error) {
+	jobs, err := s.cronJobs.ListJobs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list cron jobs: %w", err)
+	}
+	return connect.NewResponse(&ftlv1.ListCronJobsResponse{
+		Jobs: slices.Map(jobs, func(job cronjobsdal.CronJobStatus) *ftlv1.CronJob {
+			out := &ftlv1.CronJob{
+				Verb:          job.Verb.ToRefKey().ToProto(),
+				DeploymentKey: job.DeploymentKey.String(),
+				Schedule:      job.Schedule,
+				Timezone:      job.Timezone,
+				MissedRuns:    string(job.MissedRuns),
+				State:         string(job.State),
+				NextExecution: timestamppb.New(job.NextExecution),
+			}
+			if lastExecution, ok := job.LastExecution.Get(); ok {
+				out.LastExecution = timestamppb.New(lastExecution)
+			}
+			if pausedAt, ok := job.PausedAt.Get(); ok {
+				out.PausedAt = timestamppb.New(pausedAt)
+			}
+			return out
+		}),
+	}), nil
+}
+
+func (s *Service) TriggerCronJob(ctx context.Context, req *connect.Request[ftlv1.TriggerCronJobRequest]) (*connect.Response[ftlv1.TriggerCronJobResponse], error) {
+	if req.Msg.Verb == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("verb is required"))
+	}
+	err := s.cronJobs.TriggerJob(ctx, *schema.RefFromProto(req.Msg.Verb))
+	if err != nil {
+		return nil, cronJobError("trigger", err)
+	}
+	return connect.NewResponse(&ftlv1.TriggerCronJobResponse{}), nil
+}
+
+func (s *Service) PauseCronJob(ctx context.Context, req *connect.Request[ftlv1.PauseCronJobRequest]) (*connect.Response[ftlv1.PauseCronJobResponse], error) {
+	if req.Msg.Verb == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("verb is required"))
+	}
+	err := s.cronJobs.PauseJob(ctx, *schema.RefFromProto(req.Msg.Verb))
+	if err != nil {
+		return nil, cronJobError("pause", err)
+	}
+	return connect.NewResponse(&ftlv1.PauseCronJobResponse{}), nil
+}
+
+func (s *Service) ResumeCronJob(ctx context.Context, req *connect.Request[ftlv1.ResumeCronJobRequest]) (*connect.Response[ftlv1.ResumeCronJobResponse], error) {
+	if req.Msg.Verb == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("verb is required"))
+	}
+	err := s.cronJobs.ResumeJob(ctx, *schema.RefFromProto(req.Msg.Verb))
+	if err != nil {
+		return nil, cronJobError("resume", err)
+	}
+	return connect.NewResponse(&ftlv1.ResumeCronJobResponse{}), nil
+}
+
+func cronJobError(action string, err error) error {
+	switch {
+	case errors.Is(err, dalerrs.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, cronjobsdal.ErrCronJobNotIdle):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return fmt.Errorf("could not %s cron job: %w", action, err)
+	}
+}
+
+func fsmInstanceToProto(instance *dal.FSMInstance) *ftlv1.FSMInstance {
+	out := &ftlv1.FSMInstance{
+		Fsm:          instance.FSM.ToProto(),
+		Key:          instance.Key,
+		Status:       fsmStatusToProto(instance.Status),
+		CreatedAt:    timestamppb.New(instance.CreatedAt),
+		UpdatedAt:    timestamppb.New(instance.UpdatedAt),
+		QueuedEvents: instance.QueuedEvents,
+	}
+	if state, ok := instance.CurrentState.Get(); ok {
+		out.CurrentState = state.ToProto()
+	}
+	if state, ok := instance.DestinationState.Get(); ok {
+		out.DestinationState = state.ToProto()
+	}
+	return out
+}
+
+func fsmStatusToProto(status dal.FSMStatus) ftlv1.FSMStatus {
+	switch status {
+	case dal.FSMStatusCompleted:
+		return ftlv1.FSMStatus_FSM_STATUS_COMPLETED
+	case dal.FSMStatusFailed:
+		return ftlv1.FSMStatus_FSM_STATUS_FAILED
+	case dal.FSMStatusCompensating:
+		return ftlv1.FSMStatus_FSM_STATUS_COMPENSATING
+	case dal.FSMStatusCompensated:
+		return ftlv1.FSMStatus_FSM_STATUS_COMPENSATED
+	case dal.FSMStatusCompensationFailed:
+		return ftlv1.FSMStatus_FSM_STATUS_COMPENSATION_FAILED
+	default:
+		return ftlv1.FSMStatus_FSM_STATUS_RUNNING
+	}
+}
+
+func fsmStatusFromProto(status ftlv1.FSMStatus) dal.FSMStatus {
+	switch status {
+	case ftlv1.FSMStatus_FSM_STATUS_COMPLETED:
+		return dal.FSMStatusCompleted
+	case ftlv1.FSMStatus_FSM_STATUS_FAILED:
+		return dal.FSMStatusFailed
+	case ftlv1.FSMStatus_FSM_STATUS_COMPENSATING:
+		return dal.FSMStatusCompensating
+	case ftlv1.FSMStatus_FSM_STATUS_COMPENSATED:
+		return dal.FSMStatusCompensated
+	case ftlv1.FSMStatus_FSM_STATUS_COMPENSATION_FAILED:
+		return dal.FSMStatusCompensationFailed
+	default:
+		return dal.FSMStatusRunning
+	}
+}
+
+func parseDeadLetterEventKey(key *string) (optional.Option[model.TopicEventKey], error) {
+	if key == nil {
+		return optional.None[model.TopicEventKey](), nil
+	}
+	event, err := model.ParseTopicEventKey(*key)
+	if err != nil {
+		return optional.None[model.TopicEventKey](), connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid event key %q: %w", *key, err))
+	}
+	return optional.Some(event), nil
+}
+
+// idempotencyClaimTimeout is how long a duplicate of an in-flight call with an
+// idempotency key is rejected before the original call is assumed to be lost.
+const idempotencyClaimTimeout = time.Minute * 10
+
+// finishIdempotentCall stores the response to a call made with an idempotency
+// key, or releases the key if the call did not complete so that it can be retried.
+func (s *Service) finishIdempotentCall(ctx context.Context, verb *schema.Ref, key string, response optional.Option[*ftlv1.CallResponse]) {
+	logger := log.FromContext(ctx)
+	resp, ok := response.Get()
+	if !ok {
+		if err := s.dal.ReleaseIdempotencyKey(ctx, verb.ToRefKey(), key); err != nil {
+			logger.Warnf("Failed to release idempotency key %q for %s: %s", key, verb, err)
+		}
+		return
+	}
+	data, err := proto.Marshal(resp)
+	if err == nil {
+		err = s.dal.SetIdempotentResponse(ctx, verb.ToRefKey(), key, data, s.config.IdempotencyKeyTTL)
+	}
+	if err != nil {
+		logger.Warnf("Failed to store response for idempotency key %q for %s: %s", key, verb, err)
+	}
+}
+
+// checkCallChain rejects a call to verb if it would exceed maxDepth, or if it
+// forms a cycle containing a verb that is not marked "+recursive".
+func checkCallChain(sch *schema.Schema, callers []*schema.Ref, verb *schema.Ref, maxDepth int) error {
+	chain := func(refs []*schema.Ref) string {
+		return strings.Join(slices.Map(append(refs[:len(refs):len(refs)], verb), (*schema.Ref).String), " -> ")
+	}
+	if maxDepth > 0 && len(callers) >= maxDepth {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("max call depth of %d exceeded: %s", maxDepth, chain(callers)))
+	}
+	start := -1
+	for i, caller := range callers {
+		if caller.ToRefKey() == verb.ToRefKey() {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return nil
+	}
+	for _, ref := range callers[start:] {
+		decl := &schema.Verb{}
+		if err := sch.ResolveToType(ref, decl); err != nil || !decl.IsRecursive() {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("call cycle detected: %s", chain(callers[start:])))
+		}
+	}
+	return nil
+}
+
+// Load schemas for existing modules, combine with our new one, and validate the new module in the context
+// of the whole schema.
+func (s *Service) validateModuleSchema(ctx context.Context, module *schema.Module) (*schema.Module, error) {
+	existingModules, err := s.dal.GetActiveDeploymentSchemas(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get existing schemas: %w", err)
+	}
+	schemaMap := ftlmaps.FromSlice(existingModules, func(el *schema.Module) (string, *schema.Module) { return el.Name, el })
+	if existing, ok := schemaMap[module.Name]; ok {
+		if err := validateTopicPartitions(existing, module); err != nil {
+			return nil, err
+		}
+		if err := validateFSMMigrations(existing, module); err != nil {
+			return nil, err
+		}
+	}
+	schemaMap[module.Name] = module
+	fullSchema := &schema.Schema{Modules: maps.Values(schemaMap)}
+	warnings := schema.WarnCallCycles(fullSchema, optional.Some[*schema.Module](module))
+	schema, err := schema.ValidateModuleInSchema(fullSchema, optional.Some[*schema.Module](module))
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	module = schema.Module(module.Name).MustGet()
+	if err := s.validateFSMInstances(ctx, module); err != nil {
+		return nil, err
+	}
+	logger := log.FromContext(ctx)
+	for _, warning := range warnings {
+		logger.Warnf("%s: %s", module.Name, warning)
+	}
+	return module, nil
+}
+
+// validateTopicPartitions checks that the new schema of a module does not
+// change the number of partitions of its existing topics, as events would no
+// longer be consumed in order.
+func validateTopicPartitions(existing, module *schema.Module) error {
+	var merr []error
+	for _, decl := range module.Decls {
+		topic, ok := decl.(*schema.Topic)
+		if !ok {
+			continue
+		}
+		existingDecl := existing.Resolve(schema.Ref{Name: topic.Name})
+		if existingDecl == nil {
+			continue
+		}
+		existingTopic, ok := existingDecl.Symbol.(*schema.Topic)
+		if !ok || existingTopic.PartitionCount() == topic.PartitionCount() {
+			continue
+		}
+		merr = append(merr, fmt.Errorf("topic %s.%s: the number of partitions can not be changed from %d to %d",
+			module.Name, topic.Name, existingTopic.PartitionCount(), topic.PartitionCount()))
+	}
+	if len(merr) > 0 {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.Join(merr...))
+	}
+	return nil
+}
+
+// validateFSMMigrations checks that the state each removed FSM state is
+// migrated to accepts the same event type, as pending transitions into the
+// removed state are redirected to it.
+func validateFSMMigrations(existing, module *schema.Module) error {
+	var merr []error
+	for _, decl := range module.Decls {
+		fsm, ok := decl.(*schema.FSM)
+		if !ok {
+			continue
+		}
+		for _, md := range fsm.Metadata {
+			migrate, ok := md.(*schema.MetadataMigrate)
+			if !ok {
+				continue
+			}
+			from := existing.Resolve(*migrate.From)
+			to := module.Resolve(*migrate.To)
+			if from == nil || to == nil {
+				continue
+			}
+			fromVerb, ok := from.Symbol.(*schema.Verb)
+			if !ok {
+				continue
+			}
+			toVerb, ok := to.Symbol.(*schema.Verb)
+			if !ok || toVerb.Request.Equal(fromVerb.Request) {
+				continue
+			}
+			merr = append(merr, fmt.Errorf("fsm %s.%s: can not migrate %s to %s as it accepts %s rather than %s",
+				module.Name, fsm.Name, migrate.From, migrate.To, toVerb.Request, fromVerb.Request))
+		}
+	}
+	if len(merr) > 0 {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.Join(merr...))
+	}
+	return nil
+}
+
+// validateFSMInstances checks that the new schema of a module does not remove
+// states that running instances of its FSMs are in, unless the FSM declares a
+// +migrate mapping the removed state to a state that still exists.
+func (s *Service) validateFSMInstances(ctx context.Context, module *schema.Module) error {
+	var merr []error
+	for _, decl := range module.Decls {
+		fsm, ok := decl.(*schema.FSM)
+		if !ok {
+			continue
+		}
+		fsmKey := schema.RefKey{Module: module.Name, Name: fsm.Name}
+		states, err := s.dal.GetRunningFSMStates(ctx, fsmKey)
+		if err != nil {
+			return fmt.Errorf("could not get states of running FSM instances: %w", err)
+		}
+		for _, state := range states {
+			if fsm.HasState(state.State) || fsm.MigratedState(state.State).Ok() {
+				continue
+			}
+			merr = append(merr, fmt.Errorf("fsm %s: %d running instances are in removed state %s, add \"+migrate %s to <state>\" to the FSM",
+				fsmKey, state.Instances, state.State, state.State))
+		}
+	}
+	if len(merr) > 0 {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.Join(merr...))
+	}
+	return nil
 }
 
 func (s *Service) getDeployment(ctx context.Context, key string) (*model.Deployment, error) {
@@ -1382,12 +2463,15 @@ func (s *Service) executeAsyncCalls(ctx context.Context) (interval time.Duration
 		if returnErr == nil {
 			// Post-commit notification based on origin
 			switch origin := call.Origin.(type) {
-			case dal.AsyncOriginFSM:
+			case dal.AsyncOriginFSM, dal.AsyncOriginFSMTimeout, dal.AsyncOriginFSMCompensation:
 				break
 
 			case dal.AsyncOriginPubSub:
 				go s.pubSub.AsyncCallDidCommit(ctx, origin)
 
+			case dal.AsyncOriginDeadLetter, dal.AsyncOriginScheduled:
+				break
+
 			default:
 				break
 			}
@@ -1404,30 +2488,48 @@ func (s *Service) executeAsyncCalls(ctx context.Context) (interval time.Duration
 		return 0, s.catchAsyncCall(ctx, logger, call)
 	}
 
-	logger.Tracef("Executing async call")
-	req := &ftlv1.CallRequest{
-		Verb: call.Verb.ToProto(),
-		Body: call.Request,
-	}
-	resp, err := s.callWithRequest(ctx, connect.NewRequest(req), optional.None[model.RequestKey](), parentRequestKey, s.config.Advertise.String())
 	var callResult either.Either[[]byte, string]
-	if err != nil {
-		logger.Warnf("Async call could not be called: %v", err)
-		observability.AsyncCalls.Executed(ctx, call.Verb, call.CatchVerb, call.Origin.String(), call.ScheduledAt, false, optional.Some("async call could not be called"))
-		callResult = either.RightOf[[]byte](err.Error())
-	} else if perr := resp.Msg.GetError(); perr != nil {
-		logger.Warnf("Async call failed: %s", perr.Message)
-		observability.AsyncCalls.Executed(ctx, call.Verb, call.CatchVerb, call.Origin.String(), call.ScheduledAt, false, optional.Some("async call failed"))
-		callResult = either.RightOf[[]byte](perr.Message)
+	if _, ok := call.Origin.(dal.AsyncOriginFSMTimeout); ok {
+		// FSM timeouts don't call their verb directly, a transition to it is
+		// started when the call is finalised.
+		logger.Debugf("FSM timeout fired")
+		callResult = either.LeftOf[string]([]byte("{}"))
 	} else {
-		logger.Debugf("Async call succeeded")
-		callResult = either.LeftOf[string](resp.Msg.GetBody())
-		observability.AsyncCalls.Executed(ctx, call.Verb, call.CatchVerb, call.Origin.String(), call.ScheduledAt, false, optional.None[string]())
+		logger.Tracef("Executing async call")
+		req := &ftlv1.CallRequest{
+			Verb: call.Verb.ToProto(),
+			Body: call.Request,
+		}
+		if origin, ok := call.Origin.(dal.AsyncOriginFSM); ok {
+			// Let the state verb set the next event with FSMNext, dropping any
+			// set by a previous attempt.
+			if err := s.dal.ClearNextFSMEvent(ctx, origin.FSM, origin.Key); err != nil {
+				return 0, fmt.Errorf("failed to clear next FSM event: %w", err)
+			}
+			req.Metadata = &ftlv1.Metadata{Values: []*ftlv1.Metadata_Pair{
+				{Key: "fsm", Value: origin.FSM.String()},
+				{Key: "fsmInstance", Value: origin.Key},
+			}}
+		}
+		resp, err := s.callWithRequest(ctx, connect.NewRequest(req), optional.None[model.RequestKey](), parentRequestKey, s.config.Advertise.String())
+		if err != nil {
+			logger.Warnf("Async call could not be called: %v", err)
+			observability.AsyncCalls.Executed(ctx, call.Verb, call.CatchVerb, call.Origin.String(), call.ScheduledAt, false, optional.Some("async call could not be called"))
+			callResult = either.RightOf[[]byte](err.Error())
+		} else if perr := resp.Msg.GetError(); perr != nil {
+			logger.Warnf("Async call failed: %s", perr.Message)
+			observability.AsyncCalls.Executed(ctx, call.Verb, call.CatchVerb, call.Origin.String(), call.ScheduledAt, false, optional.Some("async call failed"))
+			callResult = either.RightOf[[]byte](perr.Message)
+		} else {
+			logger.Debugf("Async call succeeded")
+			callResult = either.LeftOf[string](resp.Msg.GetBody())
+			observability.AsyncCalls.Executed(ctx, call.Verb, call.CatchVerb, call.Origin.String(), call.ScheduledAt, false, optional.None[string]())
+		}
 	}
 
 	queueDepth := call.QueueDepth
 	didScheduleAnotherCall, err := s.dal.CompleteAsyncCall(ctx, call, callResult, func(tx *dal.Tx, isFinalResult bool) error {
-		return s.finaliseAsyncCall(ctx, tx, call, callResult, isFinalResult)
+		return s.finaliseAsyncCall(ctx, tx, call, callResult, isFinalResult, false)
 	})
 	if err != nil {
 		observability.AsyncCalls.Completed(ctx, call.Verb, call.CatchVerb, call.Origin.String(), call.ScheduledAt, false, queueDepth, err)
@@ -1486,7 +2588,11 @@ func (s *Service) catchAsyncCall(ctx context.Context, logger *log.Logger, call *
 	queueDepth := call.QueueDepth
 	didScheduleAnotherCall, err := s.dal.CompleteAsyncCall(ctx, call, catchResult, func(tx *dal.Tx, isFinalResult bool) error {
 		// Exposes the original error to external components such as PubSub and FSM
-		return s.finaliseAsyncCall(ctx, tx, call, originalResult, isFinalResult)
+		if catchError, failed := catchResult.(either.Right[[]byte, string]); failed {
+			result := either.RightOf[[]byte](fmt.Sprintf("%s (catch verb %s failed: %s)", originalError, catchVerb, catchError.Get()))
+			return s.finaliseAsyncCall(ctx, tx, call, result, isFinalResult, false)
+		}
+		return s.finaliseAsyncCall(ctx, tx, call, originalResult, isFinalResult, true)
 	})
 	if err != nil {
 		logger.Errorf(err, "Async call %s could not complete after catching (%s)", call.Verb, catchVerb)
@@ -1503,33 +2609,65 @@ func (s *Service) catchAsyncCall(ctx context.Context, logger *log.Logger, call *
 	return nil
 }
 
-func (s *Service) finaliseAsyncCall(ctx context.Context, tx *dal.Tx, call *dal.AsyncCall, callResult either.Either[[]byte, string], isFinalResult bool) error {
+// finaliseAsyncCall propagates the final result of an async call to its
+// origin. "caught" is true if a catch verb handled the failure of the call.
+func (s *Service) finaliseAsyncCall(ctx context.Context, tx *dal.Tx, call *dal.AsyncCall, callResult either.Either[[]byte, string], isFinalResult, caught bool) error {
 	if !isFinalResult {
 		// Will retry, do not propagate yet.
 		return nil
 	}
 
-	_, failed := callResult.(either.Right[[]byte, string])
+	callError, failed := callResult.(either.Right[[]byte, string])
+
+	failure := optional.None[string]()
+	if failed && !caught {
+		failure = optional.Some(callError.Get())
+	}
+	// Failures of the catch verb are not attempts of the verb itself.
+	attempts := int(call.Attempts)
+	if !call.Catching {
+		attempts++
+	}
 
 	// Allow for handling of completion based on origin
 	switch origin := call.Origin.(type) {
 	case dal.AsyncOriginFSM:
-		if err := s.onAsyncFSMCallCompletion(ctx, tx, origin, failed); err != nil {
+		if err := s.onAsyncFSMCallCompletion(ctx, tx, call, origin, failed); err != nil {
 			return fmt.Errorf("failed to finalize FSM async call: %w", err)
 		}
 
+	case dal.AsyncOriginFSMCompensation:
+		if err := s.onFSMCompensationCompletion(ctx, tx, origin, failed); err != nil {
+			return fmt.Errorf("failed to finalize FSM compensation: %w", err)
+		}
+
+	case dal.AsyncOriginFSMTimeout:
+		if err := s.onFSMTimeout(ctx, tx, call, origin); err != nil {
+			return fmt.Errorf("failed to finalize FSM timeout: %w", err)
+		}
+
 	case dal.AsyncOriginPubSub:
-		if err := s.pubSub.OnCallCompletion(ctx, tx, origin, failed); err != nil {
+		if err := s.pubSub.OnCallCompletion(ctx, tx, origin, call.Verb, failure, attempts); err != nil {
 			return fmt.Errorf("failed to finalize pubsub async call: %w", err)
 		}
 
+	case dal.AsyncOriginDeadLetter:
+		if err := s.pubSub.OnDeadLetterCallCompletion(ctx, tx, origin, call.Verb, failure, attempts); err != nil {
+			return fmt.Errorf("failed to finalize dead letter async call: %w", err)
+		}
+
+	case dal.AsyncOriginScheduled:
+		// Nothing to do, the result of a scheduled call is discarded.
+
 	default:
 		panic(fmt.Errorf("unsupported async call origin: %v", call.Origin))
 	}
 	return nil
 }
 
-func (s *Service) onAsyncFSMCallCompletion(ctx context.Context, tx *dal.Tx, origin dal.AsyncOriginFSM, failed bool) error {
+// onAsyncFSMCallCompletion finishes the transition of an FSM instance once its
+// destination verb has been called.
+func (s *Service) onAsyncFSMCallCompletion(ctx context.Context, tx *dal.Tx, call *dal.AsyncCall, origin dal.AsyncOriginFSM, failed bool) error {
 	logger := log.FromContext(ctx).Scope(origin.FSM.String())
 
 	instance, err := tx.AcquireFSMInstance(ctx, origin.FSM, origin.Key)
@@ -1540,11 +2678,17 @@ func (s *Service) onAsyncFSMCallCompletion(ctx context.Context, tx *dal.Tx, orig
 
 	if failed {
 		logger.Warnf("FSM %s failed async call", origin.FSM)
-		err := tx.FailFSMInstance(ctx, origin.FSM, origin.Key)
+		compensating, err := s.startFSMCompensation(ctx, tx, origin.FSM, origin.Key, call.ID)
 		if err != nil {
-			return fmt.Errorf("failed to fail FSM instance: %w", err)
+			return err
 		}
-		return nil
+		if !compensating {
+			err := tx.FailFSMInstance(ctx, origin.FSM, origin.Key)
+			if err != nil {
+				return fmt.Errorf("failed to fail FSM instance: %w", err)
+			}
+		}
+		return s.clearFSMQueue(ctx, tx, origin)
 	}
 
 	sch := s.schema.Load()
@@ -1564,18 +2708,240 @@ func (s *Service) onAsyncFSMCallCompletion(ctx context.Context, tx *dal.Tx, orig
 			if err != nil {
 				return fmt.Errorf("failed to succeed FSM instance: %w", err)
 			}
-			return nil
+			return s.clearFSMQueue(ctx, tx, origin)
 		}
 
 	}
 
-	err = tx.FinishFSMTransition(ctx, origin.FSM, origin.Key)
+	next, err := tx.FinishFSMTransition(ctx, origin.FSM, origin.Key)
 	if err != nil {
 		return fmt.Errorf("failed to complete FSM transition: %w", err)
 	}
+	instance.CurrentState = instance.DestinationState
+	instance.DestinationState = optional.None[schema.RefKey]()
+
+	// The event set by the state verb with FSMNext takes priority over queued events.
+	if event, ok := next.Get(); ok {
+		err = s.startFSMTransition(ctx, tx, sch, fsm, instance, event.Type, event.Request)
+		if connect.CodeOf(err) == connect.CodeFailedPrecondition {
+			logger.Warnf("Dropping next event for FSM instance %s: %s", origin.Key, err)
+		} else if err != nil {
+			return fmt.Errorf("failed to start next FSM transition: %w", err)
+		} else {
+			return nil
+		}
+	}
+
+	// Apply the next queued event, dropping any that are not valid from the new state.
+	for {
+		eventType, queuedRequest, err := tx.PopNextFSMEvent(ctx, origin.FSM, origin.Key)
+		if errors.Is(err, dalerrs.ErrNotFound) {
+			// Nothing else to do, so wait for an event or the timeout of the new state.
+			return s.scheduleFSMTimeout(ctx, tx, fsm, instance, call.Request)
+		} else if err != nil {
+			return fmt.Errorf("failed to pop queued FSM event: %w", err)
+		}
+		err = s.startFSMTransition(ctx, tx, sch, fsm, instance, eventType, queuedRequest)
+		if connect.CodeOf(err) == connect.CodeFailedPrecondition {
+			logger.Warnf("Dropping queued event for FSM instance %s: %s", origin.Key, err)
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to start queued FSM transition: %w", err)
+		}
+		return nil
+	}
+}
+
+// scheduleFSMTimeout schedules the timeout transition out of the current state
+// of an idle FSM instance, if the state has one.
+//
+// [request] is the event that moved the instance into its current state, and
+// is passed on to the destination of the timeout transition.
+func (s *Service) scheduleFSMTimeout(ctx context.Context, tx *dal.Tx, fsm *schema.FSM, instance *dal.FSMInstance, request []byte) error {
+	state, ok := instance.CurrentState.Get()
+	if !ok {
+		return nil
+	}
+	transition, timeout, ok := fsm.TimeoutTransition(state)
+	if !ok {
+		return nil
+	}
+	err := tx.ScheduleFSMTimeout(ctx, instance.FSM, instance.Key, transition.To.ToRefKey(), request, timeout)
+	if err != nil {
+		return fmt.Errorf("failed to schedule FSM timeout: %w", err)
+	}
 	return nil
 }
 
+// onFSMTimeout starts the timeout transition out of the current state of an
+// FSM instance, unless another transition has started since it was scheduled.
+func (s *Service) onFSMTimeout(ctx context.Context, tx *dal.Tx, call *dal.AsyncCall, origin dal.AsyncOriginFSMTimeout) error {
+	logger := log.FromContext(ctx).Scope(origin.FSM.String())
+
+	instance, err := tx.AcquireFSMInstance(ctx, origin.FSM, origin.Key)
+	if err != nil {
+		return fmt.Errorf("could not acquire lock on FSM instance: %w", err)
+	}
+	defer instance.Release() //nolint:errcheck
+
+	if id, ok := instance.TimeoutAsyncCallID.Get(); !ok || id != call.ID || instance.DestinationState.Ok() {
+		logger.Debugf("Ignoring superseded timeout of FSM instance %s", origin.Key)
+		return nil
+	}
+
+	sch := s.schema.Load()
+	fsm := &schema.FSM{}
+	if err := sch.ResolveToType(origin.FSM.ToRef(), fsm); err != nil {
+		return fmt.Errorf("could not resolve FSM: %w", err)
+	}
+	verb := &schema.Verb{}
+	if err := sch.ResolveToType(call.Verb.ToRef(), verb); err != nil {
+		return fmt.Errorf("fsm: destination verb %s not found: %w", call.Verb, err)
+	}
+	retryParams, err := schema.RetryParamsForFSMTransition(fsm, verb)
+	if err != nil {
+		return fmt.Errorf("could not get retry params for %s: %w", call.Verb, err)
+	}
+
+	logger.Debugf("FSM instance %s timed out in state %s", origin.Key, instance.CurrentState)
+	err = tx.StartFSMTransition(ctx, origin.FSM, origin.Key, call.Verb, call.Request, retryParams)
+	if err != nil {
+		return fmt.Errorf("could not start fsm timeout transition: %w", err)
+	}
+	return nil
+}
+
+// startFSMCompensation calls the compensation of the most recent state with one
+// that an FSM instance entered before the transition executed by the async
+// call [before].
+//
+// Returns false if there is nothing left to compensate.
+func (s *Service) startFSMCompensation(ctx context.Context, tx *dal.Tx, fsmKey schema.RefKey, instanceKey string, before int64) (bool, error) {
+	logger := log.FromContext(ctx).Scope(fsmKey.String())
+
+	sch := s.schema.Load()
+	fsm := &schema.FSM{}
+	if err := sch.ResolveToType(fsmKey.ToRef(), fsm); err != nil {
+		return false, fmt.Errorf("could not resolve FSM: %w", err)
+	}
+
+	transitions, err := tx.GetFSMCompletedTransitions(ctx, fsmKey, instanceKey, before)
+	if err != nil {
+		return false, err
+	}
+	for _, transition := range transitions {
+		compensation, ok := fsm.Compensation(transition.State).Get()
+		if !ok {
+			continue
+		}
+		verb := &schema.Verb{}
+		if err := sch.ResolveToType(compensation, verb); err != nil {
+			return false, fmt.Errorf("fsm: compensation verb %s not found: %w", compensation, err)
+		}
+		retryParams, err := schema.RetryParamsForFSMTransition(fsm, verb)
+		if err != nil {
+			return false, fmt.Errorf("could not get retry params for %s: %w", compensation, err)
+		}
+		// Compensations have no catch verb, a failed compensation fails the instance.
+		retryParams.Catch = optional.None[schema.RefKey]()
+
+		logger.Debugf("Compensating state %s of FSM instance %s with %s", transition.State, instanceKey, compensation)
+		err = tx.StartFSMCompensation(ctx, fsmKey, instanceKey, transition, compensation.ToRefKey(), retryParams)
+		if err != nil {
+			return false, fmt.Errorf("could not start fsm compensation: %w", err)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// onFSMCompensationCompletion calls the next compensation of a compensating
+// FSM instance, or finishes compensating it if there are none left.
+func (s *Service) onFSMCompensationCompletion(ctx context.Context, tx *dal.Tx, origin dal.AsyncOriginFSMCompensation, failed bool) error {
+	logger := log.FromContext(ctx).Scope(origin.FSM.String())
+
+	instance, err := tx.AcquireFSMInstance(ctx, origin.FSM, origin.Key)
+	if err != nil {
+		return fmt.Errorf("could not acquire lock on FSM instance: %w", err)
+	}
+	defer instance.Release() //nolint:errcheck
+
+	before, ok := instance.CompensatingAsyncCallID.Get()
+	if instance.Status != dal.FSMStatusCompensating || !ok {
+		logger.Debugf("Ignoring compensation of FSM instance %s that is not compensating", origin.Key)
+		return nil
+	}
+
+	if failed {
+		logger.Warnf("FSM instance %s failed to compensate", origin.Key)
+		if err := tx.FinishFSMCompensation(ctx, origin.FSM, origin.Key, dal.FSMStatusCompensationFailed); err != nil {
+			return fmt.Errorf("failed to fail FSM compensation: %w", err)
+		}
+		return nil
+	}
+
+	compensating, err := s.startFSMCompensation(ctx, tx, origin.FSM, origin.Key, before)
+	if err != nil {
+		return err
+	}
+	if compensating {
+		return nil
+	}
+	logger.Debugf("FSM instance %s compensated", origin.Key)
+	if err := tx.FinishFSMCompensation(ctx, origin.FSM, origin.Key, dal.FSMStatusCompensated); err != nil {
+		return fmt.Errorf("failed to finish FSM compensation: %w", err)
+	}
+	return nil
+}
+
+// clearFSMQueue drops any events still queued for an FSM instance that has
+// completed or failed.
+func (s *Service) clearFSMQueue(ctx context.Context, tx *dal.Tx, origin dal.AsyncOriginFSM) error {
+	dropped, err := tx.ClearFSMQueue(ctx, origin.FSM, origin.Key)
+	if err != nil {
+		return fmt.Errorf("failed to clear FSM queue: %w", err)
+	}
+	if dropped > 0 {
+		log.FromContext(ctx).Scope(origin.FSM.String()).Warnf("Dropped %d queued events for finished FSM instance %s", dropped, origin.Key)
+	}
+	return nil
+}
+
+func (s *Service) expireIdempotencyKeys(ctx context.Context) (time.Duration, error) {
+	err := s.dal.ExpireIdempotencyKeys(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire idempotency keys: %w", err)
+	}
+	return time.Minute, nil
+}
+
+// expireLimits deletes refilled rate limit buckets and concurrency slots that
+// were left behind by controllers that died.
+func (s *Service) expireLimits(ctx context.Context) (time.Duration, error) {
+	if err := s.dal.ExpireRateLimits(ctx); err != nil {
+		return 0, fmt.Errorf("failed to expire rate limits: %w", err)
+	}
+	if err := s.dal.ExpireConcurrencySlots(ctx); err != nil {
+		return 0, fmt.Errorf("failed to expire concurrency slots: %w", err)
+	}
+	return time.Minute, nil
+}
+
+// staleWebSocketMessageAge is how long a message queued for a WebSocket
+// connection held by another controller is kept, if it isn't delivered.
+const staleWebSocketMessageAge = time.Minute
+
+func (s *Service) deleteStaleWebSocketMessages(ctx context.Context) (time.Duration, error) {
+	deleted, err := s.dal.DeleteStaleWebSocketMessages(ctx, staleWebSocketMessageAge)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale WebSocket messages: %w", err)
+	}
+	if deleted > 0 {
+		log.FromContext(ctx).Debugf("Deleted %d undelivered WebSocket messages", deleted)
+	}
+	return time.Minute, nil
+}
+
 func (s *Service) expireStaleLeases(ctx context.Context) (time.Duration, error) {
 	err := s.dal.ExpireLeases(ctx)
 	if err != nil {
@@ -1815,9 +3181,33 @@ func (s *Service) syncRoutes(ctx context.Context) (time.Duration, error) {
 		return 0, err
 	}
 	s.routes.Store(routes)
+	s.degradeOutlierRunners(ctx, routes)
 	return time.Second, nil
 }
 
+// Mark runners with outlier latency as degraded so that they are deprioritised
+// by every controller's routing table.
+func (s *Service) degradeOutlierRunners(ctx context.Context, routes map[string][]dal.Route) {
+	logger := log.FromContext(ctx)
+	degraded := map[string]bool{}
+	for _, moduleRoutes := range routes {
+		for _, route := range moduleRoutes {
+			if route.Degraded {
+				degraded[route.Runner.String()] = true
+			}
+		}
+	}
+	for _, runner := range s.router.Outliers(routes) {
+		if degraded[runner.String()] {
+			continue
+		}
+		logger.Warnf("Runner %s is a latency outlier, degrading for %s", runner, s.config.RoutingDegradedTimeout)
+		if err := s.dal.DegradeRunner(ctx, runner, s.config.RoutingDegradedTimeout); err != nil {
+			logger.Errorf(err, "Could not degrade runner %s", runner)
+		}
+	}
+}
+
 // Synchronises Service.schema from the database.
 func (s *Service) syncSchema(ctx context.Context) {
 	logger := log.FromContext(ctx)
@@ -1885,9 +3275,14 @@ func extractIngressRoutingEntries(req *ftlv1.CreateDeploymentRequest) []dal.Ingr
 		if verb, ok := decl.Value.(*schemapb.Decl_Verb); ok {
 			for _, metadata := range verb.Verb.Metadata {
 				if ingress, ok := metadata.Value.(*schemapb.Metadata_Ingress); ok {
+					method := ingress.Ingress.Method
+					if ingress.Ingress.Type == "ws" {
+						// WebSocket connections are established with a GET request.
+						method = http.MethodGet
+					}
 					ingressRoutes = append(ingressRoutes, dal.IngressRoutingEntry{
 						Verb:   verb.Verb.Name,
-						Method: ingress.Ingress.Method,
+						Method: method,
 						Path:   ingressPathString(ingress.Ingress.Path),
 					})
 				}
@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestCheckCallChain(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module test {
+			verb a(Unit) Unit
+				+calls test.b
+			verb b(Unit) Unit
+				+calls test.a
+			verb c(Unit) Unit
+				+calls test.d
+				+recursive
+			verb d(Unit) Unit
+				+calls test.c
+				+recursive
+			verb e(Unit) Unit
+				+calls test.c
+		}
+	`)
+	assert.NoError(t, err)
+	refs := func(names ...string) []*schema.Ref {
+		out := make([]*schema.Ref, len(names))
+		for i, name := range names {
+			out[i] = &schema.Ref{Module: "test", Name: name}
+		}
+		return out
+	}
+
+	for _, test := range []struct {
+		name     string
+		callers  []*schema.Ref
+		verb     string
+		maxDepth int
+		err      string
+	}{
+		{name: "NoCycle", callers: refs("a"), verb: "b"},
+		{name: "DeclaredCycle", callers: refs("a", "b"), verb: "a",
+			err: "failed_precondition: call cycle detected: test.a -> test.b -> test.a"},
+		{name: "RecursiveCycle", callers: refs("c", "d"), verb: "c"},
+		{name: "PartiallyRecursiveCycle", callers: refs("e", "c", "e"), verb: "c",
+			err: "failed_precondition: call cycle detected: test.c -> test.e -> test.c"},
+		{name: "MaxDepth", callers: refs("c", "d", "c"), verb: "d", maxDepth: 3,
+			err: "failed_precondition: max call depth of 3 exceeded: test.c -> test.d -> test.c -> test.d"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkCallChain(sch, test.callers, &schema.Ref{Module: "test", Name: test.verb}, test.maxDepth)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateTopicPartitions(t *testing.T) {
+	existing, err := schema.ParseModuleString("", `
+		module test {
+			topic a test.event partitions 4
+			topic b test.event
+			data event {}
+		}
+	`)
+	assert.NoError(t, err)
+
+	module, err := schema.ParseModuleString("", `
+		module test {
+			topic a test.event partitions 4
+			topic b test.event
+			topic c test.event partitions 2
+			data event {}
+		}
+	`)
+	assert.NoError(t, err)
+	assert.NoError(t, validateTopicPartitions(existing, module))
+
+	module, err = schema.ParseModuleString("", `
+		module test {
+			topic a test.event partitions 8
+			topic b test.event partitions 2
+			data event {}
+		}
+	`)
+	assert.NoError(t, err)
+	err = validateTopicPartitions(existing, module)
+	assert.EqualError(t, err, "failed_precondition: topic test.a: the number of partitions can not be changed from 4 to 8\n"+
+		"topic test.b: the number of partitions can not be changed from 1 to 2")
+}
+
+func TestValidateFSMMigrations(t *testing.T) {
+	existing, err := schema.ParseModuleString("", `
+		module test {
+			data a {}
+			data b {}
+			verb start(test.a) Unit
+			verb removedA(test.a) Unit
+			verb removedB(test.b) Unit
+			fsm fsm {
+				start test.start
+				transition test.start to test.removedA
+				transition test.start to test.removedB
+			}
+		}
+	`)
+	assert.NoError(t, err)
+
+	module, err := schema.ParseModuleString("", `
+		module test {
+			data a {}
+			data b {}
+			verb start(test.a) Unit
+			verb next(test.a) Unit
+			fsm fsm
+				+migrate test.removedA to test.next
+				+migrate test.removedB to test.next
+			{
+				start test.start
+				transition test.start to test.next
+			}
+		}
+	`)
+	assert.NoError(t, err)
+	err = validateFSMMigrations(existing, module)
+	assert.EqualError(t, err, "failed_precondition: fsm test.fsm: can not migrate test.removedB to test.next as it accepts test.a rather than test.b")
+}
@@ -20,6 +20,7 @@ import (
 	parentdal "github.com/TBD54566975/ftl/backend/controller/dal"
 	"github.com/TBD54566975/ftl/backend/controller/observability"
 	"github.com/TBD54566975/ftl/backend/controller/scheduledtask"
+	dalerrs "github.com/TBD54566975/ftl/backend/dal"
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
 	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
 	"github.com/TBD54566975/ftl/backend/schema"
@@ -33,6 +34,10 @@ const (
 	controllersPerJob              = 2
 	jobResetInterval               = time.Minute
 	newJobHashRingOverrideInterval = time.Minute + time.Second*20
+	// Executions that start later than this after they were due are considered missed.
+	missedExecutionGracePeriod = time.Minute
+	// maxMissedExecutions is the number of missed executions that jobs catching up on all missed executions run at most.
+	maxMissedExecutions = 100
 )
 
 type Config struct {
@@ -73,6 +78,10 @@ type DAL interface {
 	StartCronJobs(ctx context.Context, jobs []model.CronJob) (attemptedJobs []dal.AttemptedCronJob, err error)
 	EndCronJob(ctx context.Context, job model.CronJob, next time.Time) (model.CronJob, error)
 	GetStaleCronJobs(ctx context.Context, duration time.Duration) ([]model.CronJob, error)
+	ListCronJobs(ctx context.Context) ([]dal.CronJobStatus, error)
+	TriggerCronJob(ctx context.Context, job model.CronJob) (model.CronJob, error)
+	PauseCronJob(ctx context.Context, verb schema.Ref) error
+	ResumeCronJob(ctx context.Context, job model.CronJob, next time.Time) error
 }
 
 type Scheduler interface {
@@ -137,11 +146,20 @@ func (s *Service) NewCronJobsForModule(ctx context.Context, module *schemapb.Mod
 				continue
 			}
 			cronStr := cronMetadata.CronJob.Cron
-			schedule, err := cron.Parse(cronStr)
+			timezone := cronMetadata.CronJob.Timezone
+			if timezone == "" {
+				timezone = "UTC"
+			}
+			schedule, err := cron.ParseInTimezone(cronStr, timezone)
 			if err != nil {
 				merr = append(merr, fmt.Errorf("failed to parse cron schedule %q: %w", cronStr, err))
 				continue
 			}
+			missedRuns, err := cron.ParseMissedRunPolicy(cronMetadata.CronJob.Missed)
+			if err != nil {
+				merr = append(merr, fmt.Errorf("cron job %v:%v: %w", module.Name, verb.Verb.Name, err))
+				continue
+			}
 			next, err := cron.NextAfter(schedule, start, false)
 			if err != nil {
 				merr = append(merr, fmt.Errorf("failed to calculate next execution for cron job %v:%v with schedule %q: %w", module.Name, verb.Verb.Name, schedule, err))
@@ -151,6 +169,8 @@ func (s *Service) NewCronJobsForModule(ctx context.Context, module *schemapb.Mod
 				Key:           model.NewCronJobKey(module.Name, verb.Verb.Name),
 				Verb:          schema.Ref{Module: module.Name, Name: verb.Verb.Name},
 				Schedule:      cronStr,
+				Timezone:      timezone,
+				MissedRuns:    missedRuns,
 				StartTime:     start,
 				NextExecution: next,
 				State:         model.CronJobStateIdle,
@@ -197,6 +217,79 @@ func (s *Service) syncJobsWithNewDeploymentKey(ctx context.Context, deploymentKe
 	return nil
 }
 
+// ListJobs returns the cron jobs of all active deployments, including paused jobs.
+func (s *Service) ListJobs(ctx context.Context) ([]dal.CronJobStatus, error) {
+	jobs, err := s.dal.ListCronJobs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cron jobs: %w", err)
+	}
+	return jobs, nil
+}
+
+// TriggerJob executes the cron job for a verb immediately, even if it is paused.
+//
+// The job is executed in the background and its next execution is rescheduled once it completes.
+func (s *Service) TriggerJob(ctx context.Context, verb schema.Ref) error {
+	job, err := s.jobForVerb(ctx, verb)
+	if err != nil {
+		return err
+	}
+	started, err := s.dal.TriggerCronJob(ctx, job.CronJob)
+	if err != nil {
+		return err
+	}
+	log.FromContext(ctx).Infof("triggered job %v", started.Key)
+	// The job outlives the request that triggered it.
+	go s.executeJob(context.WithoutCancel(ctx), started)
+	return nil
+}
+
+// PauseJob stops the cron job for a verb from being scheduled, including in future deployments of the module.
+func (s *Service) PauseJob(ctx context.Context, verb schema.Ref) error {
+	if _, err := s.jobForVerb(ctx, verb); err != nil {
+		return err
+	}
+	if err := s.dal.PauseCronJob(ctx, verb); err != nil {
+		return err
+	}
+	return s.syncJobsWithNewDeploymentKey(ctx, optional.None[model.DeploymentKey]())
+}
+
+// ResumeJob allows a paused cron job to be scheduled again.
+//
+// Executions missed while the job was paused are skipped.
+func (s *Service) ResumeJob(ctx context.Context, verb schema.Ref) error {
+	job, err := s.jobForVerb(ctx, verb)
+	if err != nil {
+		return err
+	}
+	pattern, err := job.Pattern()
+	if err != nil {
+		return fmt.Errorf("failed to parse cron schedule %q: %w", job.Schedule, err)
+	}
+	next, err := cron.NextAfter(pattern, s.clock.Now().UTC(), false)
+	if err != nil {
+		return fmt.Errorf("failed to calculate next execution for cron job %v with schedule %q: %w", job.Key, job.Schedule, err)
+	}
+	if err := s.dal.ResumeCronJob(ctx, job.CronJob, next); err != nil {
+		return err
+	}
+	return s.syncJobsWithNewDeploymentKey(ctx, optional.None[model.DeploymentKey]())
+}
+
+func (s *Service) jobForVerb(ctx context.Context, verb schema.Ref) (dal.CronJobStatus, error) {
+	jobs, err := s.ListJobs(ctx)
+	if err != nil {
+		return dal.CronJobStatus{}, err
+	}
+	for _, job := range jobs {
+		if job.Verb.Module == verb.Module && job.Verb.Name == verb.Name {
+			return job, nil
+		}
+	}
+	return dal.CronJobStatus{}, fmt.Errorf("no cron job for %s: %w", verb, dalerrs.ErrNotFound)
+}
+
 func (s *Service) executeJob(ctx context.Context, job model.CronJob) {
 	logger := log.FromContext(ctx)
 	requestBody := map[string]any{}
@@ -228,12 +321,24 @@ func (s *Service) executeJob(ctx context.Context, job model.CronJob) {
 		observability.Cron.JobSuccess(ctx, job)
 	}
 
-	schedule, err := cron.Parse(job.Schedule)
+	s.endJob(ctx, job)
+}
+
+// skipJob ends a job that was started for a missed execution without executing it.
+func (s *Service) skipJob(ctx context.Context, job model.CronJob) {
+	log.FromContext(ctx).Infof("skipping missed execution of job %v that was due at %v", job.Key, job.NextExecution)
+	s.endJob(ctx, job)
+}
+
+// endJob sets an executing job back to idle and schedules its next execution.
+func (s *Service) endJob(ctx context.Context, job model.CronJob) {
+	logger := log.FromContext(ctx)
+	pattern, err := job.Pattern()
 	if err != nil {
 		logger.Errorf(err, "failed to parse cron schedule %q", job.Schedule)
 		return
 	}
-	next, err := cron.NextAfter(schedule, s.clock.Now().UTC(), false)
+	next, err := s.nextExecution(job, pattern, s.clock.Now().UTC())
 	if err != nil {
 		logger.Errorf(err, "failed to calculate next execution for cron job %v with schedule %q", job.Key, job.Schedule)
 		return
@@ -249,6 +354,41 @@ func (s *Service) executeJob(ctx context.Context, job model.CronJob) {
 	}
 }
 
+// nextExecution calculates when a job should next execute after an execution that ended at the given time.
+//
+// Jobs that catch up on all missed executions continue from the execution that was due, rather than the current time,
+// skipping all but the last maxMissedExecutions missed executions.
+func (s *Service) nextExecution(job model.CronJob, pattern cron.Pattern, ended time.Time) (time.Time, error) {
+	if job.MissedRuns == cron.MissedRunsAll && !job.NextExecution.After(ended) {
+		missed := make([]time.Time, 0, maxMissedExecutions)
+		next := job.NextExecution
+		for {
+			var err error
+			next, err = cron.NextAfter(pattern, next, false)
+			if err != nil {
+				return time.Time{}, err
+			}
+			if next.After(ended) {
+				break
+			}
+			if len(missed) == maxMissedExecutions {
+				missed = append(missed[:0], missed[1:]...)
+			}
+			missed = append(missed, next)
+		}
+		if len(missed) == 0 {
+			return next, nil
+		}
+		return missed[0], nil
+	}
+	return cron.NextAfter(pattern, ended, false)
+}
+
+// isMissedExecution returns true if a job that is starting now is too late for the execution that was due.
+func (s *Service) isMissedExecution(job model.CronJob, now time.Time) bool {
+	return now.Sub(job.NextExecution) > missedExecutionGracePeriod
+}
+
 // killOldJobs looks for jobs that have been executing for too long.
 // A soft timeout should normally occur from the job's context timing out, but there are cases where this does not happen (eg: unresponsive or dead controller)
 // In these cases we need a hard timout after an additional grace period.
@@ -265,12 +405,12 @@ func (s *Service) killOldJobs(ctx context.Context) (time.Duration, error) {
 	updatedJobs := []model.CronJob{}
 	for _, stale := range staleJobs {
 		start := s.clock.Now().UTC()
-		pattern, err := cron.Parse(stale.Schedule)
+		pattern, err := stale.Pattern()
 		if err != nil {
 			logger.Errorf(err, "Could not kill stale cron job %q because schedule could not be parsed: %q", stale.Key, stale.Schedule)
 			continue
 		}
-		next, err := cron.NextAfter(pattern, start, false)
+		next, err := s.nextExecution(stale, pattern, start)
 		if err != nil {
 			logger.Errorf(err, "Could not kill stale cron job %q because next date could not be calculated: %q", stale.Key, stale.Schedule)
 			continue
@@ -373,8 +513,12 @@ func (s *Service) watchForUpdates(ctx context.Context) {
 					}
 					continue
 				}
-				logger.Infof("executing job %v", job.Key)
 				state.startedExecutingJob(job.CronJob)
+				if job.MissedRuns == cron.MissedRunsSkip && s.isMissedExecution(job.CronJob, s.clock.Now()) {
+					go s.skipJob(ctx, job.CronJob)
+					continue
+				}
+				logger.Infof("executing job %v", job.Key)
 				go s.executeJob(ctx, job.CronJob)
 			}
 
@@ -410,7 +554,7 @@ func (s *Service) nextAttemptForJob(job model.CronJob, state *state, currentTime
 		}
 		// We don't know when the other controller that is executing this job will finish it
 		// So we should optimistically attempt it when the next execution date is due assuming the job finishes
-		pattern, err := cron.Parse(job.Schedule)
+		pattern, err := job.Pattern()
 		if err != nil {
 			return s.clock.Now(), fmt.Errorf("failed to parse cron schedule %q", job.Schedule)
 		}
@@ -60,5 +60,48 @@ func TestCron(t *testing.T) {
 			_, err := os.Stat(tmpFile)
 			assert.NoError(t, err)
 		},
+		in.QueryRow("ftl", "SELECT timezone, missed_runs FROM cron_jobs WHERE verb = 'businessHours'", "Europe/Berlin", "once"),
+		in.QueryRow("ftl", "SELECT timezone, missed_runs FROM cron_jobs WHERE verb = 'cron'", "UTC", "skip"),
+	)
+}
+
+func TestCronManagement(t *testing.T) {
+	dir := t.TempDir()
+	tmpFile := filepath.Join(dir, "cron.txt")
+	t.Setenv("DEST_FILE", tmpFile)
+
+	t.Cleanup(func() { _ = os.Remove(tmpFile) })
+
+	removeFile := func(t testing.TB, ic in.TestContext) {
+		_ = os.Remove(tmpFile)
+	}
+
+	in.Run(t,
+		in.CopyModule("cron"),
+		in.Deploy("cron"),
+		in.FileExists(tmpFile),
+
+		// A paused job is not executed, and stays paused across deployments.
+		in.Exec("ftl", "cron", "pause", "cron.cron"),
+		in.QueryRow("ftl", "SELECT COUNT(*) FROM paused_cron_jobs WHERE module_name = 'cron' AND verb = 'cron'", int64(1)),
+		in.Sleep(2*time.Second),
+		removeFile,
+		in.Deploy("cron"),
+		in.Sleep(3*time.Second),
+		func(t testing.TB, ic in.TestContext) {
+			_, err := os.Stat(tmpFile)
+			assert.True(t, os.IsNotExist(err), "paused cron job should not have executed")
+		},
+
+		// Paused jobs can still be triggered manually.
+		in.Exec("ftl", "cron", "trigger", "cron.cron"),
+		in.Sleep(time.Second),
+		in.FileExists(tmpFile),
+
+		in.Exec("ftl", "cron", "resume", "cron.cron"),
+		in.QueryRow("ftl", "SELECT COUNT(*) FROM paused_cron_jobs", int64(0)),
+		removeFile,
+		in.Sleep(3*time.Second),
+		in.FileExists(tmpFile),
 	)
 }
@@ -14,8 +14,11 @@ import (
 
 	db "github.com/TBD54566975/ftl/backend/controller/dal"
 	"github.com/TBD54566975/ftl/backend/controller/sql/sqltest"
+	dalerrs "github.com/TBD54566975/ftl/backend/dal"
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
 	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/cron"
 	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/TBD54566975/ftl/internal/model"
 	"github.com/TBD54566975/ftl/internal/slices"
@@ -126,3 +129,132 @@ func TestHashRing(t *testing.T) {
 	}
 	assert.True(t, hasFoundNonMatchingKeys, "expected at least one verb to have different controllers assigned")
 }
+
+func TestPauseTriggerAndResume(t *testing.T) {
+	t.Parallel()
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	ctx, cancel := context.WithCancel(ctx)
+	t.Cleanup(cancel)
+
+	clk := clock.NewMock()
+	mockDal := &mockDAL{
+		clock:           clk,
+		lock:            sync.Mutex{},
+		attemptCountMap: map[string]int{},
+	}
+	moduleName := "initial"
+	jobsToCreate := newJobs(t, moduleName, "0 * * * * * *", clk, 2)
+	_, err := mockDal.CreateDeployment(ctx, "go", &schema.Module{Name: moduleName}, []db.DeploymentArtefact{}, []db.IngressRoutingEntry{}, jobsToCreate)
+	assert.NoError(t, err)
+
+	calls := make(chan string, 8)
+	key := model.NewControllerKey("localhost", "8080")
+	svc := NewForTesting(ctx, key, "test.com", Config{Timeout: time.Minute}, mockDal, &mockScheduler{}, func(ctx context.Context, r *connect.Request[ftlv1.CallRequest], o optional.Option[model.RequestKey], p optional.Option[model.RequestKey], s string) (*connect.Response[ftlv1.CallResponse], error) {
+		calls <- schema.RefFromProto(r.Msg.Verb).String()
+		return &connect.Response[ftlv1.CallResponse]{}, nil
+	}, clk)
+
+	verb := jobsToCreate[0].Verb
+
+	err = svc.PauseJob(ctx, verb)
+	assert.NoError(t, err)
+	jobs, err := mockDal.GetCronJobs(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(jobs), "paused jobs should not be scheduled")
+	statuses, err := svc.ListJobs(ctx)
+	assert.NoError(t, err)
+	assert.True(t, statuses[0].PausedAt.Ok())
+	assert.False(t, statuses[1].PausedAt.Ok())
+
+	// Paused jobs can still be triggered manually.
+	err = svc.TriggerJob(ctx, verb)
+	assert.NoError(t, err)
+	select {
+	case called := <-calls:
+		assert.Equal(t, verb.String(), called)
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out waiting for triggered job")
+	}
+
+	clk.Add(time.Minute * 3)
+	err = svc.ResumeJob(ctx, verb)
+	assert.NoError(t, err)
+	statuses, err = svc.ListJobs(ctx)
+	assert.NoError(t, err)
+	assert.False(t, statuses[0].PausedAt.Ok())
+	assert.True(t, statuses[0].LastExecution.Ok())
+	assert.True(t, statuses[0].NextExecution.After(clk.Now()), "executions missed while paused should be skipped")
+
+	err = svc.ResumeJob(ctx, verb)
+	assert.IsError(t, err, dalerrs.ErrNotFound)
+
+	err = svc.TriggerJob(ctx, schema.Ref{Module: moduleName, Name: "missing"})
+	assert.IsError(t, err, dalerrs.ErrNotFound)
+}
+
+func TestMissedRunPolicies(t *testing.T) {
+	svc := &Service{}
+	now := time.Date(2024, 1, 1, 12, 0, 30, 0, time.UTC)
+	due := now.Add(-10 * time.Minute)
+	pattern, err := cron.Parse("0 * * * * * *")
+	assert.NoError(t, err)
+
+	for _, tt := range []struct {
+		policy cron.MissedRunPolicy
+		next   time.Time
+	}{
+		{cron.MissedRunsSkip, time.Date(2024, 1, 1, 12, 1, 0, 0, time.UTC)},
+		{cron.MissedRunsOnce, time.Date(2024, 1, 1, 12, 1, 0, 0, time.UTC)},
+		// Catching up continues from the execution that was due.
+		{cron.MissedRunsAll, time.Date(2024, 1, 1, 11, 51, 0, 0, time.UTC)},
+	} {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			job := model.CronJob{Schedule: "0 * * * * * *", MissedRuns: tt.policy, NextExecution: due}
+			assert.True(t, svc.isMissedExecution(job, now))
+			next, err := svc.nextExecution(job, pattern, now)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.next, next)
+		})
+	}
+
+	// Catching up skips all but the most recent missed executions.
+	job := model.CronJob{Schedule: "0 * * * * * *", MissedRuns: cron.MissedRunsAll, NextExecution: now.Add(-200 * time.Minute)}
+	next, err := svc.nextExecution(job, pattern, now)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 21, 0, 0, time.UTC), next)
+
+	// Jobs that are triggered ahead of their next execution keep it.
+	job = model.CronJob{Schedule: "0 * * * * * *", MissedRuns: cron.MissedRunsAll, NextExecution: now.Add(30 * time.Second)}
+	next, err = svc.nextExecution(job, pattern, now)
+	assert.NoError(t, err)
+	assert.Equal(t, job.NextExecution, next)
+
+	job = model.CronJob{Schedule: "0 * * * * * *", NextExecution: now.Add(-30 * time.Second)}
+	assert.False(t, svc.isMissedExecution(job, now), "executions within the grace period are not missed")
+}
+
+func TestNewCronJobsForModuleWithTimezone(t *testing.T) {
+	clk := clock.NewMock()
+	clk.Set(time.Date(2024, 3, 30, 12, 0, 0, 0, time.UTC))
+	svc := &Service{clock: clk}
+	module := &schema.Module{Name: "test", Decls: []schema.Decl{
+		&schema.Verb{Name: "daily", Request: &schema.Unit{}, Response: &schema.Unit{}, Metadata: []schema.Metadata{
+			&schema.MetadataCronJob{Cron: "0 9 * * *", Timezone: "Europe/Berlin", Missed: "all"},
+		}},
+		&schema.Verb{Name: "utc", Request: &schema.Unit{}, Response: &schema.Unit{}, Metadata: []schema.Metadata{
+			&schema.MetadataCronJob{Cron: "0 9 * * *"},
+		}},
+	}}
+	jobs, err := svc.NewCronJobsForModule(context.Background(), module.ToProto().(*schemapb.Module)) //nolint:forcetypeassert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(jobs))
+
+	assert.Equal(t, "Europe/Berlin", jobs[0].Timezone)
+	assert.Equal(t, cron.MissedRunsAll, jobs[0].MissedRuns)
+	// Clocks go forward on the 31st, so 09:00 is 07:00 UTC.
+	assert.Equal(t, time.Date(2024, 3, 31, 7, 0, 0, 0, time.UTC), jobs[0].NextExecution)
+
+	assert.Equal(t, "UTC", jobs[1].Timezone)
+	assert.Equal(t, cron.MissedRunsOnce, jobs[1].MissedRuns)
+	assert.Equal(t, time.Date(2024, 3, 31, 9, 0, 0, 0, time.UTC), jobs[1].NextExecution)
+}
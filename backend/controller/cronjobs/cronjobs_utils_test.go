@@ -17,6 +17,7 @@ import (
 	cronjobsdb "github.com/TBD54566975/ftl/backend/controller/cronjobs/dal"
 	parentdb "github.com/TBD54566975/ftl/backend/controller/dal"
 	"github.com/TBD54566975/ftl/backend/controller/scheduledtask"
+	dalerrs "github.com/TBD54566975/ftl/backend/dal"
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
 	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/internal/cron"
@@ -34,6 +35,8 @@ type mockDAL struct {
 	clock           clock.Clock
 	jobs            []model.CronJob
 	attemptCountMap map[string]int
+	paused          map[string]time.Time
+	lastExecutions  map[string]time.Time
 }
 
 var _ ParentDAL = &mockDAL{}
@@ -57,7 +60,10 @@ func (d *mockDAL) GetCronJobs(ctx context.Context) ([]model.CronJob, error) {
 	d.lock.Lock()
 	defer d.lock.Unlock()
 
-	return d.jobs, nil
+	return slices.Filter(d.jobs, func(job model.CronJob) bool {
+		_, paused := d.paused[job.Verb.String()]
+		return !paused
+	}), nil
 }
 
 func (d *mockDAL) indexForJob(job model.CronJob) (int, error) {
@@ -82,7 +88,8 @@ func (d *mockDAL) StartCronJobs(ctx context.Context, jobs []model.CronJob) (atte
 			return nil, err
 		}
 		job := d.jobs[i]
-		if !job.NextExecution.After(now) && job.State == model.CronJobStateIdle {
+		_, paused := d.paused[job.Verb.String()]
+		if !job.NextExecution.After(now) && job.State == model.CronJobStateIdle && !paused {
 			job.State = model.CronJobStateExecuting
 			job.StartTime = d.clock.Now()
 			d.jobs[i] = job
@@ -133,6 +140,75 @@ func (d *mockDAL) GetStaleCronJobs(ctx context.Context, duration time.Duration)
 	}), nil
 }
 
+func (d *mockDAL) ListCronJobs(ctx context.Context) ([]cronjobsdb.CronJobStatus, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return slices.Map(d.jobs, func(job model.CronJob) cronjobsdb.CronJobStatus {
+		status := cronjobsdb.CronJobStatus{CronJob: job}
+		if lastExecution, ok := d.lastExecutions[job.Key.String()]; ok {
+			status.LastExecution = optional.Some(lastExecution)
+		}
+		if pausedAt, ok := d.paused[job.Verb.String()]; ok {
+			status.PausedAt = optional.Some(pausedAt)
+		}
+		return status
+	}), nil
+}
+
+func (d *mockDAL) TriggerCronJob(ctx context.Context, job model.CronJob) (model.CronJob, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	i, err := d.indexForJob(job)
+	if err != nil {
+		return model.CronJob{}, err
+	}
+	internalJob := d.jobs[i]
+	if internalJob.State != model.CronJobStateIdle {
+		return model.CronJob{}, fmt.Errorf("job can not be triggered, it is already running: %w", cronjobsdb.ErrCronJobNotIdle)
+	}
+	internalJob.State = model.CronJobStateExecuting
+	internalJob.StartTime = d.clock.Now()
+	d.jobs[i] = internalJob
+	if d.lastExecutions == nil {
+		d.lastExecutions = map[string]time.Time{}
+	}
+	d.lastExecutions[internalJob.Key.String()] = internalJob.StartTime
+	return internalJob, nil
+}
+
+func (d *mockDAL) PauseCronJob(ctx context.Context, verb schema.Ref) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.paused == nil {
+		d.paused = map[string]time.Time{}
+	}
+	if _, ok := d.paused[verb.String()]; !ok {
+		d.paused[verb.String()] = d.clock.Now()
+	}
+	return nil
+}
+
+func (d *mockDAL) ResumeCronJob(ctx context.Context, job model.CronJob, next time.Time) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	i, err := d.indexForJob(job)
+	if err != nil {
+		return err
+	}
+	if _, ok := d.paused[job.Verb.String()]; !ok {
+		return fmt.Errorf("job is not paused: %w", dalerrs.ErrNotFound)
+	}
+	delete(d.paused, job.Verb.String())
+	if d.jobs[i].State == model.CronJobStateIdle {
+		d.jobs[i].NextExecution = next
+	}
+	return nil
+}
+
 type mockScheduler struct {
 }
 
@@ -3,13 +3,18 @@ package dal
 
 import (
 	"context"
+	stdsql "database/sql"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/alecthomas/types/optional"
+
 	"github.com/TBD54566975/ftl/backend/controller/cronjobs/sql"
 	"github.com/TBD54566975/ftl/backend/controller/sql/sqltypes"
 	dalerrs "github.com/TBD54566975/ftl/backend/dal"
 	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/cron"
 	"github.com/TBD54566975/ftl/internal/model"
 	"github.com/TBD54566975/ftl/internal/slices"
 )
@@ -28,6 +33,8 @@ func cronJobFromRow(row sql.GetCronJobsRow) model.CronJob {
 		DeploymentKey: row.DeploymentKey,
 		Verb:          schema.Ref{Module: row.Module, Name: row.Verb},
 		Schedule:      row.Schedule,
+		Timezone:      row.Timezone,
+		MissedRuns:    cron.MissedRunPolicy(row.MissedRuns),
 		StartTime:     row.StartTime,
 		NextExecution: row.NextExecution,
 		State:         row.State,
@@ -67,6 +74,8 @@ func (d *DAL) StartCronJobs(ctx context.Context, jobs []model.CronJob) (attempte
 				DeploymentKey: row.DeploymentKey,
 				Verb:          schema.Ref{Module: row.Module, Name: row.Verb},
 				Schedule:      row.Schedule,
+				Timezone:      row.Timezone,
+				MissedRuns:    cron.MissedRunPolicy(row.MissedRuns),
 				StartTime:     row.StartTime,
 				NextExecution: row.NextExecution,
 				State:         row.State,
@@ -99,3 +108,83 @@ func (d *DAL) GetStaleCronJobs(ctx context.Context, duration time.Duration) ([]m
 		return cronJobFromRow(sql.GetCronJobsRow(row))
 	}), nil
 }
+
+// CronJobStatus is a cron job along with operator-facing details about it.
+type CronJobStatus struct {
+	model.CronJob
+	LastExecution optional.Option[time.Time]
+	PausedAt      optional.Option[time.Time]
+}
+
+// ListCronJobs returns all cron jobs for deployments with min replicas > 0, including paused jobs
+func (d *DAL) ListCronJobs(ctx context.Context) ([]CronJobStatus, error) {
+	rows, err := d.db.ListCronJobs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cron jobs: %w", dalerrs.TranslatePGError(err))
+	}
+	return slices.Map(rows, func(row sql.ListCronJobsRow) CronJobStatus {
+		return CronJobStatus{
+			CronJob: model.CronJob{
+				Key:           row.Key,
+				DeploymentKey: row.DeploymentKey,
+				Verb:          schema.Ref{Module: row.Module, Name: row.Verb},
+				Schedule:      row.Schedule,
+				Timezone:      row.Timezone,
+				MissedRuns:    cron.MissedRunPolicy(row.MissedRuns),
+				StartTime:     row.StartTime,
+				NextExecution: row.NextExecution,
+				State:         row.State,
+			},
+			LastExecution: row.LastExecution,
+			PausedAt:      row.PausedAt,
+		}
+	}), nil
+}
+
+// ErrCronJobNotIdle is returned by TriggerCronJob when the job is already
+// executing.
+var ErrCronJobNotIdle = errors.New("cron job is not idle")
+
+// TriggerCronJob sets the status of an idle job to executing, regardless of its next execution time.
+//
+// ErrCronJobNotIdle is returned if the job is not idle.
+func (d *DAL) TriggerCronJob(ctx context.Context, job model.CronJob) (model.CronJob, error) {
+	row, err := d.db.TriggerCronJob(ctx, job.Key)
+	if errors.Is(err, stdsql.ErrNoRows) {
+		return model.CronJob{}, fmt.Errorf("cron job %s is already executing: %w", job.Verb, ErrCronJobNotIdle)
+	} else if err != nil {
+		return model.CronJob{}, fmt.Errorf("failed to trigger cron job: %w", dalerrs.TranslatePGError(err))
+	}
+	return cronJobFromRow(sql.GetCronJobsRow(row)), nil
+}
+
+// PauseCronJob stops the cron job for a verb from being scheduled until it is resumed.
+//
+// Pausing applies to the verb rather than a single deployment's job, so it persists across deployments.
+func (d *DAL) PauseCronJob(ctx context.Context, verb schema.Ref) error {
+	err := d.db.PauseCronJob(ctx, verb.Module, verb.Name)
+	if err != nil {
+		return fmt.Errorf("failed to pause cron job: %w", dalerrs.TranslatePGError(err))
+	}
+	return nil
+}
+
+// ResumeCronJob allows a paused cron job to be scheduled again, starting at the next execution time.
+//
+// ErrNotFound is returned if the job is not paused.
+func (d *DAL) ResumeCronJob(ctx context.Context, job model.CronJob, next time.Time) error {
+	// Executions missed while paused are skipped by moving the next execution forward.
+	count, err := d.db.ResumeCronJob(ctx, sql.ResumeCronJobParams{
+		ModuleName:    job.Verb.Module,
+		Verb:          job.Verb.Name,
+		NextExecution: next,
+		Key:           job.Key,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to resume cron job: %w", dalerrs.TranslatePGError(err))
+	}
+	if count == 0 {
+		return fmt.Errorf("cron job %s is not paused: %w", job.Verb, dalerrs.ErrNotFound)
+	}
+	return nil
+}
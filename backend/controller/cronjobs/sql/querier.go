@@ -17,7 +17,15 @@ type Querier interface {
 	EndCronJob(ctx context.Context, nextExecution time.Time, key model.CronJobKey, startTime time.Time) (EndCronJobRow, error)
 	GetCronJobs(ctx context.Context) ([]GetCronJobsRow, error)
 	GetStaleCronJobs(ctx context.Context, dollar_1 sqltypes.Duration) ([]GetStaleCronJobsRow, error)
+	ListCronJobs(ctx context.Context) ([]ListCronJobsRow, error)
+	PauseCronJob(ctx context.Context, moduleName string, verb string) error
+	// Resumes a paused cron job, moving its next execution forward so that
+	// executions missed while paused are skipped. Returns the number of paused
+	// jobs that were resumed.
+	ResumeCronJob(ctx context.Context, arg ResumeCronJobParams) (int64, error)
 	StartCronJobs(ctx context.Context, keys []string) ([]StartCronJobsRow, error)
+	// Starts a cron job immediately, regardless of its next execution time or whether it is paused.
+	TriggerCronJob(ctx context.Context, key model.CronJobKey) (TriggerCronJobRow, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -1,17 +1,20 @@
 -- name: GetCronJobs :many
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.timezone, j.missed_runs, j.start_time, j.next_execution, j.state
 FROM cron_jobs j
   INNER JOIN deployments d on j.deployment_id = d.id
-WHERE d.min_replicas > 0;
+WHERE d.min_replicas > 0
+  AND NOT EXISTS (SELECT 1 FROM paused_cron_jobs p WHERE p.module_name = j.module_name AND p.verb = j.verb);
 
 -- name: CreateCronJob :exec
-INSERT INTO cron_jobs (key, deployment_id, module_name, verb, schedule, start_time, next_execution)
+INSERT INTO cron_jobs (key, deployment_id, module_name, verb, schedule, timezone, missed_runs, start_time, next_execution)
   VALUES (
     sqlc.arg('key')::cron_job_key,
     (SELECT id FROM deployments WHERE key = sqlc.arg('deployment_key')::deployment_key LIMIT 1),
     sqlc.arg('module_name')::TEXT,
     sqlc.arg('verb')::TEXT,
     sqlc.arg('schedule')::TEXT,
+    sqlc.arg('timezone')::TEXT,
+    sqlc.arg('missed_runs')::TEXT,
     sqlc.arg('start_time')::TIMESTAMPTZ,
     sqlc.arg('next_execution')::TIMESTAMPTZ);
 
@@ -19,13 +22,15 @@ INSERT INTO cron_jobs (key, deployment_id, module_name, verb, schedule, start_ti
 WITH updates AS (
   UPDATE cron_jobs
   SET state = 'executing',
-    start_time = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
+    start_time = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ,
+    last_execution = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
   WHERE key = ANY (sqlc.arg('keys'))
     AND state = 'idle'
     AND start_time < next_execution
     AND (next_execution AT TIME ZONE 'utc') < (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
+    AND NOT EXISTS (SELECT 1 FROM paused_cron_jobs p WHERE p.module_name = cron_jobs.module_name AND p.verb = cron_jobs.verb)
   RETURNING id, key, state, start_time, next_execution)
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule,
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.timezone, j.missed_runs,
   COALESCE(u.start_time, j.start_time) as start_time,
   COALESCE(u.next_execution, j.next_execution) as next_execution,
   COALESCE(u.state, j.state) as state,
@@ -46,14 +51,62 @@ UPDATE cron_jobs
     AND start_time = sqlc.arg('start_time')::TIMESTAMPTZ
   RETURNING *
 )
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.timezone, j.missed_runs, j.start_time, j.next_execution, j.state
   FROM j
   INNER JOIN deployments d on j.deployment_id = d.id
   LIMIT 1;
 
 -- name: GetStaleCronJobs :many
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.timezone, j.missed_runs, j.start_time, j.next_execution, j.state
 FROM cron_jobs j
   INNER JOIN deployments d on j.deployment_id = d.id
 WHERE state = 'executing'
   AND start_time < (NOW() AT TIME ZONE 'utc') - $1::INTERVAL;
+
+-- name: ListCronJobs :many
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.timezone, j.missed_runs, j.start_time, j.next_execution, j.state,
+  j.last_execution, p.paused_at
+FROM cron_jobs j
+  INNER JOIN deployments d on j.deployment_id = d.id
+  LEFT JOIN paused_cron_jobs p on p.module_name = j.module_name AND p.verb = j.verb
+WHERE d.min_replicas > 0
+ORDER BY j.module_name, j.verb;
+
+-- name: TriggerCronJob :one
+-- Starts a cron job immediately, regardless of its next execution time or whether it is paused.
+WITH j AS (
+UPDATE cron_jobs
+  SET state = 'executing',
+    start_time = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ,
+    last_execution = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
+  WHERE key = sqlc.arg('key')::cron_job_key
+    AND state = 'idle'
+  RETURNING *
+)
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.timezone, j.missed_runs, j.start_time, j.next_execution, j.state
+  FROM j
+  INNER JOIN deployments d on j.deployment_id = d.id
+  LIMIT 1;
+
+-- name: PauseCronJob :exec
+INSERT INTO paused_cron_jobs (module_name, verb)
+VALUES (sqlc.arg('module_name')::TEXT, sqlc.arg('verb')::TEXT)
+ON CONFLICT (module_name, verb) DO NOTHING;
+
+-- name: ResumeCronJob :one
+-- Resumes a paused cron job, moving its next execution forward so that
+-- executions missed while paused are skipped. Returns the number of paused
+-- jobs that were resumed.
+WITH resumed AS (
+  DELETE FROM paused_cron_jobs
+  WHERE module_name = sqlc.arg('module_name')::TEXT
+    AND verb = sqlc.arg('verb')::TEXT
+  RETURNING module_name
+), rescheduled AS (
+  UPDATE cron_jobs
+  SET next_execution = sqlc.arg('next_execution')::TIMESTAMPTZ
+  WHERE key = sqlc.arg('key')::cron_job_key
+    AND state = 'idle'
+    AND EXISTS (SELECT 1 FROM resumed)
+)
+SELECT COUNT(*) FROM resumed;
@@ -11,19 +11,22 @@ import (
 
 	"github.com/TBD54566975/ftl/backend/controller/sql/sqltypes"
 	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/alecthomas/types/optional"
 	"github.com/lib/pq"
 )
 
 const createCronJob = `-- name: CreateCronJob :exec
-INSERT INTO cron_jobs (key, deployment_id, module_name, verb, schedule, start_time, next_execution)
+INSERT INTO cron_jobs (key, deployment_id, module_name, verb, schedule, timezone, missed_runs, start_time, next_execution)
   VALUES (
     $1::cron_job_key,
     (SELECT id FROM deployments WHERE key = $2::deployment_key LIMIT 1),
     $3::TEXT,
     $4::TEXT,
     $5::TEXT,
-    $6::TIMESTAMPTZ,
-    $7::TIMESTAMPTZ)
+    $6::TEXT,
+    $7::TEXT,
+    $8::TIMESTAMPTZ,
+    $9::TIMESTAMPTZ)
 `
 
 type CreateCronJobParams struct {
@@ -32,6 +35,8 @@ type CreateCronJobParams struct {
 	ModuleName    string
 	Verb          string
 	Schedule      string
+	Timezone      string
+	MissedRuns    string
 	StartTime     time.Time
 	NextExecution time.Time
 }
@@ -43,6 +48,8 @@ func (q *Queries) CreateCronJob(ctx context.Context, arg CreateCronJobParams) er
 		arg.ModuleName,
 		arg.Verb,
 		arg.Schedule,
+		arg.Timezone,
+		arg.MissedRuns,
 		arg.StartTime,
 		arg.NextExecution,
 	)
@@ -57,9 +64,9 @@ UPDATE cron_jobs
   WHERE key = $2::cron_job_key
     AND state = 'executing'
     AND start_time = $3::TIMESTAMPTZ
-  RETURNING id, key, deployment_id, verb, schedule, start_time, next_execution, state, module_name
+  RETURNING id, key, deployment_id, verb, schedule, start_time, next_execution, state, module_name, last_execution, timezone, missed_runs
 )
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.timezone, j.missed_runs, j.start_time, j.next_execution, j.state
   FROM j
   INNER JOIN deployments d on j.deployment_id = d.id
   LIMIT 1
@@ -71,6 +78,8 @@ type EndCronJobRow struct {
 	Module        string
 	Verb          string
 	Schedule      string
+	Timezone      string
+	MissedRuns    string
 	StartTime     time.Time
 	NextExecution time.Time
 	State         model.CronJobState
@@ -85,6 +94,8 @@ func (q *Queries) EndCronJob(ctx context.Context, nextExecution time.Time, key m
 		&i.Module,
 		&i.Verb,
 		&i.Schedule,
+		&i.Timezone,
+		&i.MissedRuns,
 		&i.StartTime,
 		&i.NextExecution,
 		&i.State,
@@ -93,10 +104,11 @@ func (q *Queries) EndCronJob(ctx context.Context, nextExecution time.Time, key m
 }
 
 const getCronJobs = `-- name: GetCronJobs :many
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.timezone, j.missed_runs, j.start_time, j.next_execution, j.state
 FROM cron_jobs j
   INNER JOIN deployments d on j.deployment_id = d.id
 WHERE d.min_replicas > 0
+  AND NOT EXISTS (SELECT 1 FROM paused_cron_jobs p WHERE p.module_name = j.module_name AND p.verb = j.verb)
 `
 
 type GetCronJobsRow struct {
@@ -105,6 +117,8 @@ type GetCronJobsRow struct {
 	Module        string
 	Verb          string
 	Schedule      string
+	Timezone      string
+	MissedRuns    string
 	StartTime     time.Time
 	NextExecution time.Time
 	State         model.CronJobState
@@ -125,6 +139,8 @@ func (q *Queries) GetCronJobs(ctx context.Context) ([]GetCronJobsRow, error) {
 			&i.Module,
 			&i.Verb,
 			&i.Schedule,
+			&i.Timezone,
+			&i.MissedRuns,
 			&i.StartTime,
 			&i.NextExecution,
 			&i.State,
@@ -143,7 +159,7 @@ func (q *Queries) GetCronJobs(ctx context.Context) ([]GetCronJobsRow, error) {
 }
 
 const getStaleCronJobs = `-- name: GetStaleCronJobs :many
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.timezone, j.missed_runs, j.start_time, j.next_execution, j.state
 FROM cron_jobs j
   INNER JOIN deployments d on j.deployment_id = d.id
 WHERE state = 'executing'
@@ -156,6 +172,8 @@ type GetStaleCronJobsRow struct {
 	Module        string
 	Verb          string
 	Schedule      string
+	Timezone      string
+	MissedRuns    string
 	StartTime     time.Time
 	NextExecution time.Time
 	State         model.CronJobState
@@ -176,6 +194,8 @@ func (q *Queries) GetStaleCronJobs(ctx context.Context, dollar_1 sqltypes.Durati
 			&i.Module,
 			&i.Verb,
 			&i.Schedule,
+			&i.Timezone,
+			&i.MissedRuns,
 			&i.StartTime,
 			&i.NextExecution,
 			&i.State,
@@ -193,17 +213,129 @@ func (q *Queries) GetStaleCronJobs(ctx context.Context, dollar_1 sqltypes.Durati
 	return items, nil
 }
 
+const listCronJobs = `-- name: ListCronJobs :many
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.timezone, j.missed_runs, j.start_time, j.next_execution, j.state,
+  j.last_execution, p.paused_at
+FROM cron_jobs j
+  INNER JOIN deployments d on j.deployment_id = d.id
+  LEFT JOIN paused_cron_jobs p on p.module_name = j.module_name AND p.verb = j.verb
+WHERE d.min_replicas > 0
+ORDER BY j.module_name, j.verb
+`
+
+type ListCronJobsRow struct {
+	Key           model.CronJobKey
+	DeploymentKey model.DeploymentKey
+	Module        string
+	Verb          string
+	Schedule      string
+	Timezone      string
+	MissedRuns    string
+	StartTime     time.Time
+	NextExecution time.Time
+	State         model.CronJobState
+	LastExecution optional.Option[time.Time]
+	PausedAt      optional.Option[time.Time]
+}
+
+func (q *Queries) ListCronJobs(ctx context.Context) ([]ListCronJobsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCronJobs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCronJobsRow
+	for rows.Next() {
+		var i ListCronJobsRow
+		if err := rows.Scan(
+			&i.Key,
+			&i.DeploymentKey,
+			&i.Module,
+			&i.Verb,
+			&i.Schedule,
+			&i.Timezone,
+			&i.MissedRuns,
+			&i.StartTime,
+			&i.NextExecution,
+			&i.State,
+			&i.LastExecution,
+			&i.PausedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const pauseCronJob = `-- name: PauseCronJob :exec
+INSERT INTO paused_cron_jobs (module_name, verb)
+VALUES ($1::TEXT, $2::TEXT)
+ON CONFLICT (module_name, verb) DO NOTHING
+`
+
+func (q *Queries) PauseCronJob(ctx context.Context, moduleName string, verb string) error {
+	_, err := q.db.ExecContext(ctx, pauseCronJob, moduleName, verb)
+	return err
+}
+
+const resumeCronJob = `-- name: ResumeCronJob :one
+WITH resumed AS (
+  DELETE FROM paused_cron_jobs
+  WHERE module_name = $1::TEXT
+    AND verb = $2::TEXT
+  RETURNING module_name
+), rescheduled AS (
+  UPDATE cron_jobs
+  SET next_execution = $3::TIMESTAMPTZ
+  WHERE key = $4::cron_job_key
+    AND state = 'idle'
+    AND EXISTS (SELECT 1 FROM resumed)
+)
+SELECT COUNT(*) FROM resumed
+`
+
+type ResumeCronJobParams struct {
+	ModuleName    string
+	Verb          string
+	NextExecution time.Time
+	Key           model.CronJobKey
+}
+
+// Resumes a paused cron job, moving its next execution forward so that
+// executions missed while paused are skipped. Returns the number of paused
+// jobs that were resumed.
+func (q *Queries) ResumeCronJob(ctx context.Context, arg ResumeCronJobParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, resumeCronJob,
+		arg.ModuleName,
+		arg.Verb,
+		arg.NextExecution,
+		arg.Key,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const startCronJobs = `-- name: StartCronJobs :many
 WITH updates AS (
   UPDATE cron_jobs
   SET state = 'executing',
-    start_time = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
+    start_time = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ,
+    last_execution = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
   WHERE key = ANY ($1)
     AND state = 'idle'
     AND start_time < next_execution
     AND (next_execution AT TIME ZONE 'utc') < (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
+    AND NOT EXISTS (SELECT 1 FROM paused_cron_jobs p WHERE p.module_name = cron_jobs.module_name AND p.verb = cron_jobs.verb)
   RETURNING id, key, state, start_time, next_execution)
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule,
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.timezone, j.missed_runs,
   COALESCE(u.start_time, j.start_time) as start_time,
   COALESCE(u.next_execution, j.next_execution) as next_execution,
   COALESCE(u.state, j.state) as state,
@@ -221,6 +353,8 @@ type StartCronJobsRow struct {
 	Module         string
 	Verb           string
 	Schedule       string
+	Timezone       string
+	MissedRuns     string
 	StartTime      time.Time
 	NextExecution  time.Time
 	State          model.CronJobState
@@ -243,6 +377,8 @@ func (q *Queries) StartCronJobs(ctx context.Context, keys []string) ([]StartCron
 			&i.Module,
 			&i.Verb,
 			&i.Schedule,
+			&i.Timezone,
+			&i.MissedRuns,
 			&i.StartTime,
 			&i.NextExecution,
 			&i.State,
@@ -261,3 +397,51 @@ func (q *Queries) StartCronJobs(ctx context.Context, keys []string) ([]StartCron
 	}
 	return items, nil
 }
+
+const triggerCronJob = `-- name: TriggerCronJob :one
+WITH j AS (
+UPDATE cron_jobs
+  SET state = 'executing',
+    start_time = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ,
+    last_execution = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
+  WHERE key = $1::cron_job_key
+    AND state = 'idle'
+  RETURNING id, key, deployment_id, verb, schedule, start_time, next_execution, state, module_name, last_execution, timezone, missed_runs
+)
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.timezone, j.missed_runs, j.start_time, j.next_execution, j.state
+  FROM j
+  INNER JOIN deployments d on j.deployment_id = d.id
+  LIMIT 1
+`
+
+type TriggerCronJobRow struct {
+	Key           model.CronJobKey
+	DeploymentKey model.DeploymentKey
+	Module        string
+	Verb          string
+	Schedule      string
+	Timezone      string
+	MissedRuns    string
+	StartTime     time.Time
+	NextExecution time.Time
+	State         model.CronJobState
+}
+
+// Starts a cron job immediately, regardless of its next execution time or whether it is paused.
+func (q *Queries) TriggerCronJob(ctx context.Context, key model.CronJobKey) (TriggerCronJobRow, error) {
+	row := q.db.QueryRowContext(ctx, triggerCronJob, key)
+	var i TriggerCronJobRow
+	err := row.Scan(
+		&i.Key,
+		&i.DeploymentKey,
+		&i.Module,
+		&i.Verb,
+		&i.Schedule,
+		&i.Timezone,
+		&i.MissedRuns,
+		&i.StartTime,
+		&i.NextExecution,
+		&i.State,
+	)
+	return i, err
+}
@@ -29,3 +29,8 @@ func FiveMinutes(ctx context.Context) error {
 func Saturday(ctx context.Context) error {
 	return nil
 }
+
+//ftl:cron 0 9 * * 1-5 tz Europe/Berlin missed once
+func BusinessHours(ctx context.Context) error {
+	return nil
+}
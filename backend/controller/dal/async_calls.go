@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
 	"github.com/alecthomas/types/either"
 	"github.com/alecthomas/types/optional"
 
@@ -21,8 +22,18 @@ type asyncOriginParseRoot struct {
 	Key AsyncOrigin `parser:"@@"`
 }
 
+// Keys in origins are arbitrary strings, so anything that isn't part of the
+// origin syntax is lexed as-is rather than as Go tokens.
+var asyncOriginLexer = lexer.MustSimple([]lexer.SimpleRule{
+	{Name: "Ident", Pattern: `[a-zA-Z_][a-zA-Z0-9_]*`},
+	{Name: "Int", Pattern: `[0-9]+`},
+	{Name: "Punct", Pattern: `[:.]`},
+	{Name: "Other", Pattern: `[^a-zA-Z0-9_:.]+`},
+})
+
 var asyncOriginParser = participle.MustBuild[asyncOriginParseRoot](
-	participle.Union[AsyncOrigin](AsyncOriginFSM{}, AsyncOriginPubSub{}),
+	participle.Lexer(asyncOriginLexer),
+	participle.Union[AsyncOrigin](AsyncOriginFSM{}, AsyncOriginFSMTimeout{}, AsyncOriginFSMCompensation{}, AsyncOriginPubSub{}, AsyncOriginDeadLetter{}, AsyncOriginScheduled{}),
 )
 
 // AsyncOrigin is a sum type representing the originator of an async call.
@@ -49,18 +60,95 @@ func (AsyncOriginFSM) asyncOrigin()     {}
 func (a AsyncOriginFSM) Origin() string { return "fsm" }
 func (a AsyncOriginFSM) String() string { return fmt.Sprintf("fsm:%s:%s", a.FSM, a.Key) }
 
+// AsyncOriginFSMTimeout represents the context for the originator of an FSM
+// state timeout.
+//
+// When executed, a timeout async call starts the timeout transition to its
+// verb rather than calling it directly.
+//
+// It is in the form fsmtimeout:<module>.<name>:<key>
+type AsyncOriginFSMTimeout struct {
+	FSM schema.RefKey `parser:"'fsmtimeout' ':' @@"`
+	Key string        `parser:"':' @(~EOF)+"`
+}
+
+var _ AsyncOrigin = AsyncOriginFSMTimeout{}
+
+func (AsyncOriginFSMTimeout) asyncOrigin()     {}
+func (a AsyncOriginFSMTimeout) Origin() string { return "fsmtimeout" }
+func (a AsyncOriginFSMTimeout) String() string {
+	return fmt.Sprintf("fsmtimeout:%s:%s", a.FSM, a.Key)
+}
+
+// AsyncOriginFSMCompensation represents the context for the originator of a
+// call to the compensation of an FSM state.
+//
+// It is in the form fsmcompensation:<module>.<name>:<key>
+type AsyncOriginFSMCompensation struct {
+	FSM schema.RefKey `parser:"'fsmcompensation' ':' @@"`
+	Key string        `parser:"':' @(~EOF)+"`
+}
+
+var _ AsyncOrigin = AsyncOriginFSMCompensation{}
+
+func (AsyncOriginFSMCompensation) asyncOrigin()     {}
+func (a AsyncOriginFSMCompensation) Origin() string { return "fsmcompensation" }
+func (a AsyncOriginFSMCompensation) String() string {
+	return fmt.Sprintf("fsmcompensation:%s:%s", a.FSM, a.Key)
+}
+
 // AsyncOriginPubSub represents the context for the originator of an PubSub async call.
 //
-// It is in the form fsm:<module>.<subscription_name>
+// It is in the form sub:<module>.<subscription_name>[:<partition>], where the
+// partition is omitted for the first partition.
 type AsyncOriginPubSub struct {
 	Subscription schema.RefKey `parser:"'sub' ':' @@"`
+	Partition    int           `parser:"(':' @Int)?"`
 }
 
 var _ AsyncOrigin = AsyncOriginPubSub{}
 
 func (AsyncOriginPubSub) asyncOrigin()     {}
 func (a AsyncOriginPubSub) Origin() string { return "sub" }
-func (a AsyncOriginPubSub) String() string { return fmt.Sprintf("sub:%s", a.Subscription) }
+func (a AsyncOriginPubSub) String() string {
+	if a.Partition == 0 {
+		return fmt.Sprintf("sub:%s", a.Subscription)
+	}
+	return fmt.Sprintf("sub:%s:%d", a.Subscription, a.Partition)
+}
+
+// AsyncOriginDeadLetter represents the context for the originator of a replayed dead letter event.
+//
+// It is in the form dlq:<module>.<subscription_name>:<event_key>
+type AsyncOriginDeadLetter struct {
+	Subscription schema.RefKey `parser:"'dlq' ':' @@"`
+	Event        string        `parser:"':' @(~EOF)+"`
+}
+
+var _ AsyncOrigin = AsyncOriginDeadLetter{}
+
+func (AsyncOriginDeadLetter) asyncOrigin()     {}
+func (a AsyncOriginDeadLetter) Origin() string { return "dlq" }
+func (a AsyncOriginDeadLetter) String() string {
+	return fmt.Sprintf("dlq:%s:%s", a.Subscription, a.Event)
+}
+
+// AsyncOriginScheduled represents the context for the originator of a call
+// scheduled with ScheduleCall.
+//
+// It is in the form scheduled:<module>.<verb>:<key>
+type AsyncOriginScheduled struct {
+	Verb schema.RefKey `parser:"'scheduled' ':' @@"`
+	Key  string        `parser:"':' @(~EOF)+"`
+}
+
+var _ AsyncOrigin = AsyncOriginScheduled{}
+
+func (AsyncOriginScheduled) asyncOrigin()     {}
+func (a AsyncOriginScheduled) Origin() string { return "scheduled" }
+func (a AsyncOriginScheduled) String() string {
+	return fmt.Sprintf("scheduled:%s:%s", a.Verb, a.Key)
+}
 
 // ParseAsyncOrigin parses an async origin key.
 func ParseAsyncOrigin(origin string) (AsyncOrigin, error) {
@@ -86,9 +174,11 @@ type AsyncCall struct {
 	Error optional.Option[string]
 
 	RemainingAttempts int32
-	Backoff           time.Duration
-	MaxBackoff        time.Duration
-	Catching          bool
+	// Attempts is the number of times the verb has already failed.
+	Attempts   int32
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	Catching   bool
 }
 
 // AcquireAsyncCall acquires a pending async call to execute.
@@ -133,6 +223,7 @@ func (d *DAL) AcquireAsyncCall(ctx context.Context) (call *AsyncCall, err error)
 		ParentRequestKey:  row.ParentRequestKey,
 		TraceContext:      row.TraceContext.RawMessage,
 		RemainingAttempts: row.RemainingAttempts,
+		Attempts:          row.Attempts,
 		Error:             row.Error,
 		Backoff:           time.Duration(row.Backoff),
 		MaxBackoff:        time.Duration(row.MaxBackoff),
@@ -168,6 +259,16 @@ func (d *DAL) CompleteAsyncCall(ctx context.Context,
 		}
 
 	case either.Right[[]byte, string]: // Failure message.
+		catch := call.CatchVerb.Ok()
+		if catch && call.Catching {
+			// Rather than catching again, a failed catch of an event is final if
+			// the event can be dead lettered.
+			deadLetter, err := tx.hasDeadLetterTopic(ctx, call.Origin)
+			if err != nil {
+				return false, err
+			}
+			catch = !deadLetter
+		}
 		if call.RemainingAttempts > 0 {
 			_, err = d.db.FailAsyncCallWithRetry(ctx, sql.FailAsyncCallWithRetryParams{
 				ID:                call.ID,
@@ -182,7 +283,7 @@ func (d *DAL) CompleteAsyncCall(ctx context.Context,
 			}
 			isFinalResult = false
 			didScheduleAnotherCall = true
-		} else if call.RemainingAttempts == 0 && call.CatchVerb.Ok() {
+		} else if call.RemainingAttempts == 0 && catch {
 			// original error is the last error that occurred before we started to catch
 			originalError := call.Error.Default(result.Get())
 			// scheduledAt should be immediate if this is our first catch attempt, otherwise we should use backoff
@@ -218,6 +319,25 @@ func (d *DAL) CompleteAsyncCall(ctx context.Context,
 	return didScheduleAnotherCall, nil
 }
 
+// hasDeadLetterTopic returns true if origin is an event consumed by a
+// subscription that has a dead letter topic.
+func (d *DAL) hasDeadLetterTopic(ctx context.Context, origin AsyncOrigin) (bool, error) {
+	var subscription schema.RefKey
+	switch origin := origin.(type) {
+	case AsyncOriginPubSub:
+		subscription = origin.Subscription
+	case AsyncOriginDeadLetter:
+		subscription = origin.Subscription
+	default:
+		return false, nil
+	}
+	row, err := d.db.GetSubscription(ctx, subscription.Name, subscription.Module)
+	if err != nil {
+		return false, fmt.Errorf("could not fetch subscription: %w", dalerrs.TranslatePGError(err))
+	}
+	return row.DeadLetterTopicID.Ok(), nil
+}
+
 func (d *DAL) LoadAsyncCall(ctx context.Context, id int64) (*AsyncCall, error) {
 	row, err := d.db.LoadAsyncCall(ctx, id)
 	if err != nil {
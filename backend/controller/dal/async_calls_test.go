@@ -8,6 +8,7 @@ import (
 
 	"github.com/TBD54566975/ftl/backend/controller/sql/sqltest"
 	dalerrs "github.com/TBD54566975/ftl/backend/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/alecthomas/assert/v2"
 )
@@ -22,3 +23,56 @@ func TestNoCallToAcquire(t *testing.T) {
 	assert.IsError(t, err, dalerrs.ErrNotFound)
 	assert.EqualError(t, err, "no pending async calls: not found")
 }
+
+func TestParseAsyncOriginPubSub(t *testing.T) {
+	for _, test := range []struct {
+		origin   string
+		expected AsyncOriginPubSub
+	}{
+		{"sub:module.sub", AsyncOriginPubSub{Subscription: schema.RefKey{Module: "module", Name: "sub"}}},
+		{"sub:module.sub:3", AsyncOriginPubSub{Subscription: schema.RefKey{Module: "module", Name: "sub"}, Partition: 3}},
+	} {
+		t.Run(test.origin, func(t *testing.T) {
+			origin, err := ParseAsyncOrigin(test.origin)
+			assert.NoError(t, err)
+			assert.Equal(t, AsyncOrigin(test.expected), origin)
+			assert.Equal(t, test.origin, origin.String())
+		})
+	}
+}
+
+func TestParseAsyncOriginFSM(t *testing.T) {
+	for _, test := range []struct {
+		origin   string
+		expected AsyncOrigin
+	}{
+		{"fsm:module.payment:invoice-1", AsyncOriginFSM{FSM: schema.RefKey{Module: "module", Name: "payment"}, Key: "invoice-1"}},
+		{"fsmtimeout:module.payment:invoice-1", AsyncOriginFSMTimeout{FSM: schema.RefKey{Module: "module", Name: "payment"}, Key: "invoice-1"}},
+		{"fsmcompensation:module.payment:invoice-1", AsyncOriginFSMCompensation{FSM: schema.RefKey{Module: "module", Name: "payment"}, Key: "invoice-1"}},
+	} {
+		t.Run(test.origin, func(t *testing.T) {
+			origin, err := ParseAsyncOrigin(test.origin)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, origin)
+			assert.Equal(t, test.origin, origin.String())
+		})
+	}
+}
+
+func TestParseAsyncOriginKeys(t *testing.T) {
+	for _, test := range []struct {
+		origin   string
+		expected AsyncOrigin
+	}{
+		{"dlq:module.sub:evt-module-topic-1ez3", AsyncOriginDeadLetter{Subscription: schema.RefKey{Module: "module", Name: "sub"}, Event: "evt-module-topic-1ez3"}},
+		{"scheduled:module.remind:scl-module-remind-1ez3", AsyncOriginScheduled{Verb: schema.RefKey{Module: "module", Name: "remind"}, Key: "scl-module-remind-1ez3"}},
+		{"scheduled:module.remind:order 1's reminder", AsyncOriginScheduled{Verb: schema.RefKey{Module: "module", Name: "remind"}, Key: "order 1's reminder"}},
+	} {
+		t.Run(test.origin, func(t *testing.T) {
+			origin, err := ParseAsyncOrigin(test.origin)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, origin)
+			assert.Equal(t, test.origin, origin.String())
+		})
+	}
+}
@@ -87,11 +87,12 @@ func runnerFromDB(row sql.GetRunnerRow) Runner {
 	}
 
 	return Runner{
-		Key:        row.RunnerKey,
-		Endpoint:   row.Endpoint,
-		State:      RunnerState(row.State),
-		Deployment: deployment,
-		Labels:     attrs,
+		Key:           row.RunnerKey,
+		Endpoint:      row.Endpoint,
+		State:         RunnerState(row.State),
+		Deployment:    deployment,
+		Labels:        attrs,
+		DegradedUntil: row.DegradedUntil,
 	}
 }
 
@@ -104,6 +105,8 @@ type Runner struct {
 	// Assigned deployment key, if any.
 	Deployment optional.Option[model.DeploymentKey]
 	Labels     model.Labels
+	// Set when the controller has detected the runner as a latency outlier.
+	DegradedUntil optional.Option[time.Time]
 }
 
 func (r Runner) notification() {}
@@ -192,6 +195,8 @@ type Route struct {
 	Runner     model.RunnerKey
 	Deployment model.DeploymentKey
 	Endpoint   string
+	// Degraded routes are only used when no healthy route is available.
+	Degraded bool
 }
 
 func (r Route) String() string {
@@ -214,6 +219,7 @@ func New(ctx context.Context, conn *stdsql.DB, kmsURL optional.Option[string]) (
 	d := &DAL{
 		db:                sql.NewDB(conn),
 		DeploymentChanges: pubsub.New[DeploymentNotification](),
+		WebSocketMessages: pubsub.New[model.WebSocketConnectionKey](),
 		kmsURL:            kmsURL,
 	}
 
@@ -232,6 +238,9 @@ type DAL struct {
 
 	// DeploymentChanges is a Topic that receives changes to the deployments table.
 	DeploymentChanges *pubsub.Topic[DeploymentNotification]
+	// WebSocketMessages is a Topic that receives the key of the connection of
+	// each message queued with [DAL.SendWebSocketMessage].
+	WebSocketMessages *pubsub.Topic[model.WebSocketConnectionKey]
 }
 
 // Tx is DAL within a transaction.
@@ -352,11 +361,12 @@ func (d *DAL) GetStatus(ctx context.Context) (Status, error) {
 		}
 
 		return Runner{
-			Key:        in.RunnerKey,
-			Endpoint:   in.Endpoint,
-			State:      RunnerState(in.State),
-			Deployment: deployment,
-			Labels:     attrs,
+			Key:           in.RunnerKey,
+			Endpoint:      in.Endpoint,
+			State:         RunnerState(in.State),
+			Deployment:    deployment,
+			Labels:        attrs,
+			DegradedUntil: in.DegradedUntil,
 		}, nil
 	})
 	if err != nil {
@@ -381,6 +391,7 @@ func (d *DAL) GetStatus(ctx context.Context) (Status, error) {
 				Runner:     row.RunnerKey,
 				Deployment: row.DeploymentKey.MustGet(),
 				Endpoint:   row.Endpoint,
+				Degraded:   row.Degraded,
 			}
 		}),
 	}, nil
@@ -482,15 +493,20 @@ func (d *DAL) CreateDeployment(ctx context.Context, language string, moduleSchem
 		if !ok {
 			continue
 		}
-		err := tx.UpsertTopic(ctx, sql.UpsertTopicParams{
-			Topic:     model.NewTopicKey(moduleSchema.Name, t.Name),
-			Module:    moduleSchema.Name,
-			Name:      t.Name,
-			EventType: t.Event.String(),
+		topicKey := model.NewTopicKey(moduleSchema.Name, t.Name)
+		rows, err := tx.UpsertTopic(ctx, sql.UpsertTopicParams{
+			Topic:      topicKey,
+			Module:     moduleSchema.Name,
+			Name:       t.Name,
+			EventType:  t.Event.String(),
+			Partitions: int32(t.PartitionCount()),
 		})
 		if err != nil {
 			return model.DeploymentKey{}, fmt.Errorf("could not insert topic: %w", dalerrs.TranslatePGError(err))
 		}
+		if rows == 0 {
+			return model.DeploymentKey{}, fmt.Errorf("topic %s.%s: the number of partitions of an existing topic can not be changed", moduleSchema.Name, t.Name)
+		}
 	}
 
 	deploymentKey := model.NewDeploymentKey(moduleSchema.Name)
@@ -548,6 +564,8 @@ func (d *DAL) CreateDeployment(ctx context.Context, language string, moduleSchem
 			Verb:          job.Verb.Name,
 			StartTime:     job.StartTime,
 			Schedule:      job.Schedule,
+			Timezone:      job.Timezone,
+			MissedRuns:    string(job.MissedRuns),
 			NextExecution: job.NextExecution,
 		})
 		if err != nil {
@@ -603,6 +621,91 @@ func (d *DAL) KillStaleControllers(ctx context.Context, age time.Duration) (int6
 	return count, err
 }
 
+// DegradeRunner marks a runner as degraded for the given duration.
+//
+// Degraded runners remain assigned but are deprioritised by the routing table.
+func (d *DAL) DegradeRunner(ctx context.Context, key model.RunnerKey, ttl time.Duration) error {
+	err := d.db.DegradeRunner(ctx, sqltypes.Duration(ttl), key)
+	return dalerrs.TranslatePGError(err)
+}
+
+// TakeRateLimitToken takes a token from the cluster-wide token bucket for key,
+// which refills at limit tokens per period.
+//
+// Returns false if the bucket is empty.
+func (d *DAL) TakeRateLimitToken(ctx context.Context, key string, limit int, period time.Duration) (bool, error) {
+	_, err := d.db.TakeRateLimitToken(ctx, key, int64(limit), sqltypes.Duration(period))
+	if err != nil {
+		err = dalerrs.TranslatePGError(err)
+		if errors.Is(err, dalerrs.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// ExpireRateLimits deletes the token buckets that have refilled.
+func (d *DAL) ExpireRateLimits(ctx context.Context) error {
+	count, err := d.db.ExpireRateLimits(ctx)
+	if count > 0 {
+		log.FromContext(ctx).Debugf("Expired %d rate limit buckets", count)
+	}
+	return dalerrs.TranslatePGError(err)
+}
+
+// AcquireConcurrencySlot takes one of limit cluster-wide slots for key, which
+// is held for ttl unless renewed.
+//
+// Returns false if all slots are held.
+func (d *DAL) AcquireConcurrencySlot(ctx context.Context, key string, limit int, ttl time.Duration) (id int64, ok bool, err error) {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return 0, false, dalerrs.TranslatePGError(err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+	err = tx.LockConcurrencyLimit(ctx, key)
+	if err != nil {
+		return 0, false, dalerrs.TranslatePGError(err)
+	}
+	id, err = tx.AcquireConcurrencySlot(ctx, key, sqltypes.Duration(ttl), int64(limit))
+	if err != nil {
+		err = dalerrs.TranslatePGError(err)
+		if errors.Is(err, dalerrs.ErrNotFound) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	return id, true, nil
+}
+
+// RenewConcurrencySlot extends a slot taken with [DAL.AcquireConcurrencySlot]
+// for another ttl.
+func (d *DAL) RenewConcurrencySlot(ctx context.Context, id int64, ttl time.Duration) error {
+	count, err := d.db.RenewConcurrencySlot(ctx, sqltypes.Duration(ttl), id)
+	if err != nil {
+		return dalerrs.TranslatePGError(err)
+	}
+	if count == 0 {
+		return dalerrs.ErrNotFound
+	}
+	return nil
+}
+
+// ReleaseConcurrencySlot releases a slot taken with [DAL.AcquireConcurrencySlot].
+func (d *DAL) ReleaseConcurrencySlot(ctx context.Context, id int64) error {
+	return dalerrs.TranslatePGError(d.db.ReleaseConcurrencySlot(ctx, id))
+}
+
+// ExpireConcurrencySlots deletes slots that were not released or renewed.
+func (d *DAL) ExpireConcurrencySlots(ctx context.Context) error {
+	count, err := d.db.ExpireConcurrencySlots(ctx)
+	if count > 0 {
+		log.FromContext(ctx).Debugf("Expired %d concurrency slots", count)
+	}
+	return dalerrs.TranslatePGError(err)
+}
+
 // DeregisterRunner deregisters the given runner.
 func (d *DAL) DeregisterRunner(ctx context.Context, key model.RunnerKey) error {
 	count, err := d.db.DeregisterRunner(ctx, key)
@@ -806,7 +909,7 @@ func (d *DAL) ReplaceDeployment(ctx context.Context, newDeploymentKey model.Depl
 // deploymentWillActivate is called whenever a deployment goes from min_replicas=0 to min_replicas>0.
 //
 // when replacing a deployment, this should be called first before calling deploymentWillDeactivate on the old deployment.
-// This allows the new deployment to migrate from the old deployment (such as subscriptions).
+// This allows the new deployment to migrate from the old deployment (such as subscriptions and FSM instances).
 func (d *DAL) deploymentWillActivate(ctx context.Context, tx *sql.Tx, key model.DeploymentKey) error {
 	module, err := tx.GetSchemaForDeployment(ctx, key)
 	if err != nil {
@@ -816,7 +919,11 @@ func (d *DAL) deploymentWillActivate(ctx context.Context, tx *sql.Tx, key model.
 	if err != nil {
 		return err
 	}
-	return d.createSubscribers(ctx, tx, key, module)
+	err = d.createSubscribers(ctx, tx, key, module)
+	if err != nil {
+		return err
+	}
+	return d.migrateFSMInstances(ctx, tx, module)
 }
 
 // deploymentWillDeactivate is called whenever a deployment goes to min_replicas=0.
@@ -1019,6 +1126,7 @@ func (d *DAL) GetRoutingTable(ctx context.Context, modules []string) (map[string
 			Deployment: deploymentKey,
 			Runner:     route.RunnerKey,
 			Endpoint:   route.Endpoint,
+			Degraded:   route.Degraded,
 		})
 	}
 	return out, nil
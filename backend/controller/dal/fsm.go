@@ -2,6 +2,7 @@ package dal
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -16,6 +17,7 @@ import (
 	dalerrs "github.com/TBD54566975/ftl/backend/dal"
 	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/internal/encryption"
+	"github.com/TBD54566975/ftl/internal/log"
 )
 
 // StartFSMTransition sends an event to an executing instance of an FSM.
@@ -30,6 +32,8 @@ import (
 // Note: this does not actually call the FSM, it just enqueues an async call for
 // future execution.
 //
+// Any pending timeout of the current state is cancelled.
+//
 // Note: no validation of the FSM is performed.
 func (d *DAL) StartFSMTransition(ctx context.Context, fsm schema.RefKey, executionKey string, destinationState schema.RefKey, request json.RawMessage, retryParams schema.RetryParams) (err error) {
 	encryptedRequest, err := d.encryptJSON(encryption.AsyncSubKey, request)
@@ -37,6 +41,10 @@ func (d *DAL) StartFSMTransition(ctx context.Context, fsm schema.RefKey, executi
 		return fmt.Errorf("failed to encrypt FSM request: %w", err)
 	}
 
+	if _, err := d.db.CancelFSMTimeout(ctx, fsm, executionKey); err != nil {
+		return fmt.Errorf("failed to cancel FSM timeout: %w", dalerrs.TranslatePGError(err))
+	}
+
 	// Create an async call for the event.
 	origin := AsyncOriginFSM{FSM: fsm, Key: executionKey}
 	asyncCallID, err := d.db.CreateAsyncCall(ctx, sql.CreateAsyncCallParams{
@@ -80,11 +88,170 @@ func (d *DAL) StartFSMTransition(ctx context.Context, fsm schema.RefKey, executi
 	return nil
 }
 
-func (d *DAL) FinishFSMTransition(ctx context.Context, fsm schema.RefKey, instanceKey string) error {
-	_, err := d.db.FinishFSMTransition(ctx, fsm, instanceKey)
-	observability.FSM.TransitionCompleted(ctx, fsm)
+// ScheduleFSMTimeout schedules the timeout transition out of the current state
+// of an idle FSM instance.
+//
+// After [timeout], an async call will start a transition to [destinationState]
+// with [request], unless another transition has started by then.
+func (d *DAL) ScheduleFSMTimeout(ctx context.Context, fsm schema.RefKey, instanceKey string, destinationState schema.RefKey, request json.RawMessage, timeout time.Duration) error {
+	encryptedRequest, err := d.encryptJSON(encryption.AsyncSubKey, request)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt FSM request: %w", err)
+	}
+	origin := AsyncOriginFSMTimeout{FSM: fsm, Key: instanceKey}
+	asyncCallID, err := d.db.CreateAsyncCall(ctx, sql.CreateAsyncCallParams{
+		Verb:        destinationState,
+		Origin:      origin.String(),
+		Request:     encryptedRequest,
+		ScheduledAt: optional.Some(time.Now().Add(timeout)),
+	})
+	observability.AsyncCalls.Created(ctx, destinationState, optional.None[schema.RefKey](), origin.String(), 0, err)
+	if err != nil {
+		return fmt.Errorf("failed to create FSM timeout async call: %w", dalerrs.TranslatePGError(err))
+	}
+	if err := d.db.SetFSMTimeout(ctx, asyncCallID, fsm, instanceKey); err != nil {
+		return fmt.Errorf("failed to set FSM timeout: %w", dalerrs.TranslatePGError(err))
+	}
+	return nil
+}
 
-	return dalerrs.TranslatePGError(err)
+// ErrFSMQueueFull is returned by QueueFSMEvent when the FSM instance already
+// has the maximum number of events queued.
+var ErrFSMQueueFull = errors.New("FSM event queue is full")
+
+// QueueFSMEvent queues an event for an FSM instance that is executing a
+// transition. Queued events are applied in order by PopNextFSMEvent once the
+// active transition completes.
+//
+// If [dedupe] is true, an event identical to one that is already queued is
+// dropped. Returns ErrFSMQueueFull if [depth] events are already queued.
+func (d *DAL) QueueFSMEvent(ctx context.Context, fsm schema.RefKey, instanceKey string, eventType schema.Type, request json.RawMessage, depth int, dedupe bool) error {
+	queued, err := d.db.GetFSMQueueDepth(ctx, fsm, instanceKey)
+	if err != nil {
+		return fmt.Errorf("failed to get FSM queue depth: %w", dalerrs.TranslatePGError(err))
+	}
+	if queued >= int64(depth) {
+		return fmt.Errorf("%s:%s has %d queued events: %w", fsm, instanceKey, queued, ErrFSMQueueFull)
+	}
+	encryptedRequest, err := d.encryptJSON(encryption.AsyncSubKey, request)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt FSM request: %w", err)
+	}
+	hash := sha256.New()
+	hash.Write([]byte(eventType.String()))
+	hash.Write(request)
+	rows, err := d.db.QueueFSMEvent(ctx, sql.QueueFSMEventParams{
+		Fsm:         fsm,
+		Key:         instanceKey,
+		EventType:   eventType.String(),
+		Request:     encryptedRequest,
+		RequestHash: hash.Sum(nil),
+		Dedupe:      dedupe,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to queue FSM event: %w", dalerrs.TranslatePGError(err))
+	}
+	if rows == 0 {
+		log.FromContext(ctx).Debugf("Dropped duplicate event %s for FSM instance %s:%s", eventType, fsm, instanceKey)
+	}
+	return nil
+}
+
+// PopNextFSMEvent removes the oldest queued event for an FSM instance and
+// returns its type and request.
+//
+// Returns ErrNotFound if no events are queued.
+func (d *DAL) PopNextFSMEvent(ctx context.Context, fsm schema.RefKey, instanceKey string) (schema.Type, json.RawMessage, error) {
+	row, err := d.db.PopNextFSMEvent(ctx, fsm, instanceKey)
+	if err != nil {
+		return nil, nil, dalerrs.TranslatePGError(err)
+	}
+	eventType, err := schema.ParseType("", row.EventType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse queued FSM event type %q: %w", row.EventType, err)
+	}
+	request, err := d.decrypt(encryption.AsyncSubKey, row.Request)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decrypt FSM request: %w", err)
+	}
+	return eventType, request, nil
+}
+
+// ClearFSMQueue drops all events queued for an FSM instance, returning the
+// number of events dropped.
+func (d *DAL) ClearFSMQueue(ctx context.Context, fsm schema.RefKey, instanceKey string) (int64, error) {
+	dropped, err := d.db.ClearFSMQueue(ctx, fsm, instanceKey)
+	return dropped, dalerrs.TranslatePGError(err)
+}
+
+// FSMEvent is an event sent to an FSM instance.
+type FSMEvent struct {
+	Type    schema.Type
+	Request json.RawMessage
+}
+
+// ErrFSMNextEventSet is returned by SetNextFSMEvent when the next event of the
+// executing transition has already been set.
+var ErrFSMNextEventSet = errors.New("next FSM event already set")
+
+// SetNextFSMEvent sets the event that an FSM instance is sent when the
+// transition it is executing completes.
+//
+// Returns ErrNotFound if the instance is not executing a transition, or
+// ErrFSMNextEventSet if the next event has already been set.
+func (d *DAL) SetNextFSMEvent(ctx context.Context, fsm schema.RefKey, instanceKey string, eventType schema.Type, request json.RawMessage) error {
+	instance, err := d.db.GetFSMInstance(ctx, fsm, instanceKey)
+	if err != nil {
+		return fmt.Errorf("failed to get FSM instance: %w", dalerrs.TranslatePGError(err))
+	}
+	if !instance.DestinationState.Ok() {
+		return fmt.Errorf("%s:%s is not executing a transition: %w", fsm, instanceKey, dalerrs.ErrNotFound)
+	}
+	encryptedRequest, err := d.encryptJSON(encryption.AsyncSubKey, request)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt FSM request: %w", err)
+	}
+	rows, err := d.db.SetNextFSMEvent(ctx, sql.SetNextFSMEventParams{
+		Fsm:       fsm,
+		Key:       instanceKey,
+		EventType: eventType.String(),
+		Request:   encryptedRequest,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set next FSM event: %w", dalerrs.TranslatePGError(err))
+	}
+	if rows == 0 {
+		return fmt.Errorf("%s:%s: %w", fsm, instanceKey, ErrFSMNextEventSet)
+	}
+	return nil
+}
+
+// ClearNextFSMEvent drops the next event set for an FSM instance, if any.
+func (d *DAL) ClearNextFSMEvent(ctx context.Context, fsm schema.RefKey, instanceKey string) error {
+	return dalerrs.TranslatePGError(d.db.ClearNextFSMEvent(ctx, fsm, instanceKey))
+}
+
+// FinishFSMTransition completes the executing transition of an FSM instance,
+// returning the next event set by the state verb, if any.
+func (d *DAL) FinishFSMTransition(ctx context.Context, fsm schema.RefKey, instanceKey string) (optional.Option[FSMEvent], error) {
+	row, err := d.db.FinishFSMTransition(ctx, fsm, instanceKey)
+	observability.FSM.TransitionCompleted(ctx, fsm)
+	if err != nil {
+		return optional.None[FSMEvent](), dalerrs.TranslatePGError(err)
+	}
+	nextEventType, ok := row.NextEventType.Get()
+	if !ok {
+		return optional.None[FSMEvent](), nil
+	}
+	eventType, err := schema.ParseType("", nextEventType)
+	if err != nil {
+		return optional.None[FSMEvent](), fmt.Errorf("failed to parse next FSM event type %q: %w", nextEventType, err)
+	}
+	request, err := d.decrypt(encryption.AsyncSubKey, row.NextEventRequest)
+	if err != nil {
+		return optional.None[FSMEvent](), fmt.Errorf("failed to decrypt FSM request: %w", err)
+	}
+	return optional.Some(FSMEvent{Type: eventType, Request: request}), nil
 }
 
 func (d *DAL) FailFSMInstance(ctx context.Context, fsm schema.RefKey, instanceKey string) error {
@@ -105,6 +272,11 @@ const (
 	FSMStatusRunning   = sql.FsmStatusRunning
 	FSMStatusCompleted = sql.FsmStatusCompleted
 	FSMStatusFailed    = sql.FsmStatusFailed
+	// The instance failed and is calling the compensations of the states it
+	// completed, most recent first.
+	FSMStatusCompensating       = sql.FsmStatusCompensating
+	FSMStatusCompensated        = sql.FsmStatusCompensated
+	FSMStatusCompensationFailed = sql.FsmStatusCompensationFailed
 )
 
 type FSMInstance struct {
@@ -116,6 +288,18 @@ type FSMInstance struct {
 	Status           FSMStatus
 	CurrentState     optional.Option[schema.RefKey]
 	DestinationState optional.Option[schema.RefKey]
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	// The async call that will take the timeout transition out of the current
+	// state, if one is scheduled.
+	TimeoutAsyncCallID optional.Option[int64]
+	// The transition whose destination state is being compensated, if the
+	// instance is compensating.
+	CompensatingAsyncCallID optional.Option[int64]
+	// Number of events waiting for the executing transition to complete.
+	//
+	// Only populated by ListFSMInstances and GetFSMInstance.
+	QueuedEvents int64
 }
 
 // AcquireFSMInstance returns an FSM instance, also acquiring a lease on it.
@@ -135,11 +319,249 @@ func (d *DAL) AcquireFSMInstance(ctx context.Context, fsm schema.RefKey, instanc
 		row.Status = sql.FsmStatusRunning
 	}
 	return &FSMInstance{
-		Lease:            lease,
-		FSM:              fsm,
-		Key:              instanceKey,
-		Status:           row.Status,
-		CurrentState:     row.CurrentState,
-		DestinationState: row.DestinationState,
+		Lease:                   lease,
+		FSM:                     fsm,
+		Key:                     instanceKey,
+		Status:                  row.Status,
+		CurrentState:            row.CurrentState,
+		DestinationState:        row.DestinationState,
+		CreatedAt:               row.CreatedAt,
+		UpdatedAt:               row.UpdatedAt,
+		TimeoutAsyncCallID:      row.TimeoutAsyncCallID,
+		CompensatingAsyncCallID: row.CompensatingAsyncCallID,
 	}, nil
 }
+
+// ListFSMInstances returns instances of an FSM, most recently updated first.
+//
+// The returned instances are not leased.
+func (d *DAL) ListFSMInstances(ctx context.Context, fsm schema.RefKey, status optional.Option[FSMStatus], state optional.Option[schema.RefKey], limit int) ([]*FSMInstance, error) {
+	statusFilter := sql.NullFsmStatus{}
+	if s, ok := status.Get(); ok {
+		statusFilter = sql.NullFsmStatus{FsmStatus: s, Valid: true}
+	}
+	rows, err := d.db.ListFSMInstances(ctx, sql.ListFSMInstancesParams{
+		Fsm:    fsm,
+		Status: statusFilter,
+		State:  state,
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list FSM instances: %w", dalerrs.TranslatePGError(err))
+	}
+	out := make([]*FSMInstance, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &FSMInstance{
+			FSM:                     row.Fsm,
+			Key:                     row.Key,
+			Status:                  row.Status,
+			CurrentState:            row.CurrentState,
+			DestinationState:        row.DestinationState,
+			CreatedAt:               row.CreatedAt,
+			UpdatedAt:               row.UpdatedAt,
+			QueuedEvents:            row.QueuedEvents,
+			CompensatingAsyncCallID: row.CompensatingAsyncCallID,
+		})
+	}
+	return out, nil
+}
+
+// GetFSMInstance returns an FSM instance without leasing it.
+//
+// Returns ErrNotFound if the instance does not exist.
+func (d *DAL) GetFSMInstance(ctx context.Context, fsm schema.RefKey, instanceKey string) (*FSMInstance, error) {
+	row, err := d.db.GetFSMInstance(ctx, fsm, instanceKey)
+	if err != nil {
+		return nil, dalerrs.TranslatePGError(err)
+	}
+	queued, err := d.db.GetFSMQueueDepth(ctx, fsm, instanceKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get FSM queue depth: %w", dalerrs.TranslatePGError(err))
+	}
+	return &FSMInstance{
+		FSM:                     fsm,
+		Key:                     instanceKey,
+		Status:                  row.Status,
+		CurrentState:            row.CurrentState,
+		DestinationState:        row.DestinationState,
+		CreatedAt:               row.CreatedAt,
+		UpdatedAt:               row.UpdatedAt,
+		QueuedEvents:            queued,
+		CompensatingAsyncCallID: row.CompensatingAsyncCallID,
+	}, nil
+}
+
+// FSMCompletedTransition is a transition successfully completed by an FSM
+// instance.
+type FSMCompletedTransition struct {
+	// The async call that executed the transition.
+	AsyncCallID int64
+	// The state entered by the transition.
+	State schema.RefKey
+	// The event that triggered the transition.
+	Request json.RawMessage
+}
+
+// GetFSMCompletedTransitions returns the transitions completed by an FSM
+// instance before the transition executed by the async call [before], most
+// recent first.
+func (d *DAL) GetFSMCompletedTransitions(ctx context.Context, fsm schema.RefKey, instanceKey string, before int64) ([]FSMCompletedTransition, error) {
+	origin := AsyncOriginFSM{FSM: fsm, Key: instanceKey}
+	rows, err := d.db.GetFSMCompletedTransitions(ctx, origin.String(), before)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get FSM completed transitions: %w", dalerrs.TranslatePGError(err))
+	}
+	out := make([]FSMCompletedTransition, 0, len(rows))
+	for _, row := range rows {
+		request, err := d.decrypt(encryption.AsyncSubKey, row.Request)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt FSM request: %w", err)
+		}
+		out = append(out, FSMCompletedTransition{
+			AsyncCallID: row.ID,
+			State:       row.Verb,
+			Request:     request,
+		})
+	}
+	return out, nil
+}
+
+// StartFSMCompensation calls the compensation verb for the state entered by a
+// completed transition, marking the FSM instance as compensating.
+//
+// The compensation is called with the event that triggered the transition.
+func (d *DAL) StartFSMCompensation(ctx context.Context, fsm schema.RefKey, instanceKey string, transition FSMCompletedTransition, compensation schema.RefKey, retryParams schema.RetryParams) error {
+	encryptedRequest, err := d.encryptJSON(encryption.AsyncSubKey, transition.Request)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt FSM request: %w", err)
+	}
+	origin := AsyncOriginFSMCompensation{FSM: fsm, Key: instanceKey}
+	_, err = d.db.CreateAsyncCall(ctx, sql.CreateAsyncCallParams{
+		Verb:              compensation,
+		Origin:            origin.String(),
+		Request:           encryptedRequest,
+		RemainingAttempts: int32(retryParams.Count),
+		Backoff:           sqltypes.Duration(retryParams.MinBackoff),
+		MaxBackoff:        sqltypes.Duration(retryParams.MaxBackoff),
+	})
+	observability.AsyncCalls.Created(ctx, compensation, optional.None[schema.RefKey](), origin.String(), int64(retryParams.Count), err)
+	if err != nil {
+		return fmt.Errorf("failed to create FSM compensation async call: %w", dalerrs.TranslatePGError(err))
+	}
+	_, err = d.db.CompensateFSMInstance(ctx, transition.AsyncCallID, fsm, instanceKey)
+	if err != nil {
+		return fmt.Errorf("failed to start FSM compensation: %w", dalerrs.TranslatePGError(err))
+	}
+	return nil
+}
+
+// FinishFSMCompensation marks a compensating FSM instance as either
+// compensated or as having failed to compensate.
+func (d *DAL) FinishFSMCompensation(ctx context.Context, fsm schema.RefKey, instanceKey string, status FSMStatus) error {
+	_, err := d.db.FinishFSMCompensation(ctx, status, fsm, instanceKey)
+	observability.FSM.InstanceCompleted(ctx, fsm)
+	return dalerrs.TranslatePGError(err)
+}
+
+// FSMTransitionAttempt is an attempt to execute a transition of an FSM instance.
+type FSMTransitionAttempt struct {
+	// The destination state of the transition.
+	State     schema.RefKey
+	Status    sql.AsyncCallState
+	Error     optional.Option[string]
+	CreatedAt time.Time
+	Event     json.RawMessage
+	// True if the attempt is executing the catch verb of the transition.
+	Catching bool
+}
+
+// GetFSMTransitionHistory returns every attempt to execute a transition of an
+// FSM instance, oldest first.
+func (d *DAL) GetFSMTransitionHistory(ctx context.Context, fsm schema.RefKey, instanceKey string) ([]FSMTransitionAttempt, error) {
+	origin := AsyncOriginFSM{FSM: fsm, Key: instanceKey}
+	rows, err := d.db.GetFSMTransitionHistory(ctx, origin.String())
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch FSM transition history: %w", dalerrs.TranslatePGError(err))
+	}
+	out := make([]FSMTransitionAttempt, 0, len(rows))
+	for _, row := range rows {
+		event, err := d.decrypt(encryption.AsyncSubKey, row.Request)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt FSM event: %w", err)
+		}
+		out = append(out, FSMTransitionAttempt{
+			State:     row.Verb,
+			Status:    row.State,
+			Error:     row.Error,
+			CreatedAt: row.CreatedAt,
+			Event:     event,
+			Catching:  row.Catching,
+		})
+	}
+	return out, nil
+}
+
+// FSMStateInstances is the number of running instances of an FSM that are in,
+// or transitioning to, a state.
+type FSMStateInstances struct {
+	State     schema.RefKey
+	Instances int64
+}
+
+// GetRunningFSMStates returns every state that running instances of an FSM are
+// in or transitioning to.
+func (d *DAL) GetRunningFSMStates(ctx context.Context, fsm schema.RefKey) ([]FSMStateInstances, error) {
+	rows, err := d.db.GetRunningFSMStates(ctx, fsm)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch FSM states: %w", dalerrs.TranslatePGError(err))
+	}
+	out := make([]FSMStateInstances, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, FSMStateInstances{State: row.State, Instances: row.Instances})
+	}
+	return out, nil
+}
+
+// migrateFSMInstances moves running instances of the FSMs in a module out of
+// states that have been removed, as declared by the +migrate metadata of each
+// FSM.
+//
+// Pending transitions and timeouts into a removed state are redirected too.
+func (d *DAL) migrateFSMInstances(ctx context.Context, tx *sql.Tx, module *schema.Module) error {
+	logger := log.FromContext(ctx)
+	for _, decl := range module.Decls {
+		fsm, ok := decl.(*schema.FSM)
+		if !ok {
+			continue
+		}
+		fsmKey := schema.RefKey{Module: module.Name, Name: fsm.Name}
+		for _, md := range fsm.Metadata {
+			migrate, ok := md.(*schema.MetadataMigrate)
+			if !ok {
+				continue
+			}
+			from, to := migrate.From.ToRefKey(), migrate.To.ToRefKey()
+			if _, err := tx.MigrateFSMAsyncCalls(ctx, to, from, fsmKey); err != nil {
+				return fmt.Errorf("could not migrate pending calls of FSM %s from %s to %s: %w", fsmKey, from, to, dalerrs.TranslatePGError(err))
+			}
+			migrated, err := tx.MigrateFSMState(ctx, from, to, fsmKey)
+			if err != nil {
+				return fmt.Errorf("could not migrate instances of FSM %s from %s to %s: %w", fsmKey, from, to, dalerrs.TranslatePGError(err))
+			}
+			if migrated > 0 {
+				logger.Infof("Migrated %d instances of FSM %s from %s to %s", migrated, fsmKey, from, to)
+			}
+		}
+		// Instances may have entered a removed state since the schema was validated.
+		states, err := tx.GetRunningFSMStates(ctx, fsmKey)
+		if err != nil {
+			return fmt.Errorf("could not fetch FSM states: %w", dalerrs.TranslatePGError(err))
+		}
+		for _, state := range states {
+			if !fsm.HasState(state.State) {
+				return fmt.Errorf("fsm %s: %d running instances are in removed state %s", fsmKey, state.Instances, state.State)
+			}
+		}
+	}
+	return nil
+}
@@ -45,6 +45,20 @@ func TestFSM(t *testing.T) {
 		in.Fail(in.Call[in.Obj, in.Obj]("fsm", "sendOne", in.Obj{"instance": "1"}, nil),
 			"FSM instance 1 is already in state fsm.end"),
 
+		// Inspect the completed instance
+		in.ExecWithOutput("ftl", []string{"fsm", "get", "fsm.fsm", "1"}, func(output string) {
+			assert.Contains(t, output, `"status": "completed"`)
+			assert.Contains(t, output, `"state": "fsm.end"`)
+		}),
+		in.ExecWithOutput("ftl", []string{"fsm", "history", "fsm.fsm", "1"}, func(output string) {
+			assert.Contains(t, output, "fsm.start")
+			assert.Contains(t, output, "fsm.middle")
+			assert.Contains(t, output, "fsm.end")
+		}),
+		in.ExecWithOutput("ftl", []string{"fsm", "list", "fsm.fsm", "--status=running"}, func(output string) {
+			assert.NotContains(t, output, "completed")
+		}),
+
 		// Invalid state transition
 		in.Fail(in.Call[in.Obj, in.Obj]("fsm", "sendTwo", in.Obj{"instance": "2"}, nil),
 			"invalid state transition"),
@@ -56,6 +70,116 @@ func TestFSM(t *testing.T) {
 		// Invalid state transition
 		in.Fail(in.Call[in.Obj, in.Obj]("fsm", "sendTwo", in.Obj{"instance": "2"}, nil),
 			"invalid state transition"),
+
+		// Send an event from the CLI
+		in.Exec("ftl", "fsm", "send", "fsm.fsm", "3", "fsm.One", `{instance: "3"}`),
+		in.FileContains(logFilePath, "start 3"),
+		fsmInState("3", "running", "fsm.start"),
+	)
+}
+
+func TestFSMQueue(t *testing.T) {
+	logFilePath := filepath.Join(t.TempDir(), "fsm.log")
+	t.Setenv("FSM_LOG_FILE", logFilePath)
+	in.Run(t,
+		in.CopyModule("fsm"),
+		in.Deploy("fsm"),
+
+		// Events sent while a transition is executing are queued and applied in order.
+		in.Call[in.Obj, in.Obj]("fsm", "sendOne", in.Obj{"instance": "1"}, nil),
+		in.Call[in.Obj, in.Obj]("fsm", "sendOne", in.Obj{"instance": "1"}, nil),
+		in.Call[in.Obj, in.Obj]("fsm", "sendOne", in.Obj{"instance": "1"}, nil),
+		in.FileContains(logFilePath, "start 1"),
+		in.FileContains(logFilePath, "middle 1"),
+		in.FileContains(logFilePath, "end 1"),
+		in.QueryRow("ftl", `
+			SELECT status, current_state
+			FROM fsm_instances
+			WHERE fsm = 'fsm.fsm' AND key = '1'
+		`, "completed", "fsm.end"),
+		in.QueryRow("ftl", "SELECT COUNT(*) FROM fsm_queued_events", int64(0)),
+	)
+}
+
+func TestFSMNext(t *testing.T) {
+	logFilePath := filepath.Join(t.TempDir(), "fsm.log")
+	t.Setenv("FSM_LOG_FILE", logFilePath)
+	in.Run(t,
+		in.CopyModule("fsm"),
+		in.Deploy("fsm"),
+
+		// Each state verb sets the next event itself, so one event runs the FSM to completion.
+		in.Call[in.Obj, in.Obj]("fsm", "sendOne", in.Obj{"instance": "1", "chain": true}, nil),
+		in.FileContains(logFilePath, "start 1"),
+		in.FileContains(logFilePath, "middle 1"),
+		in.FileContains(logFilePath, "end 1"),
+		in.QueryRow("ftl", `
+			SELECT status, current_state
+			FROM fsm_instances
+			WHERE fsm = 'fsm.fsm' AND key = '1'
+		`, "completed", "fsm.end"),
+	)
+}
+
+func TestFSMTimeout(t *testing.T) {
+	logFilePath := filepath.Join(t.TempDir(), "fsm.log")
+	t.Setenv("FSM_LOG_FILE", logFilePath)
+	paymentInState := func(instance, status, state string) in.Action {
+		return in.QueryRow("ftl", fmt.Sprintf(`
+			SELECT status, current_state
+			FROM fsm_instances
+			WHERE fsm = 'fsm.payment' AND key = '%s'
+		`, instance), status, state)
+	}
+	in.Run(t,
+		in.CopyModule("fsm"),
+		in.Deploy("fsm"),
+
+		in.Call[in.Obj, in.Obj]("fsm", "sendInvoice", in.Obj{"instance": "1"}, nil),
+		in.Call[in.Obj, in.Obj]("fsm", "sendInvoice", in.Obj{"instance": "2"}, nil),
+		in.FileContains(logFilePath, "invoiced 1"),
+		in.FileContains(logFilePath, "invoiced 2"),
+
+		// Paying cancels the timeout.
+		in.Call[in.Obj, in.Obj]("fsm", "sendPayment", in.Obj{"instance": "2"}, nil),
+		in.FileContains(logFilePath, "paid 2"),
+
+		// Without a payment the invoice expires.
+		in.FileContains(logFilePath, "expired 1"),
+		paymentInState("1", "completed", "fsm.expired"),
+
+		in.Sleep(time.Second*3),
+		paymentInState("2", "completed", "fsm.paid"),
+		in.QueryRow("ftl", "SELECT COUNT(*) FROM async_calls WHERE origin = 'fsmtimeout:fsm.payment:2'", int64(0)),
+	)
+}
+
+func TestFSMCompensation(t *testing.T) {
+	logFilePath := filepath.Join(t.TempDir(), "fsm.log")
+	t.Setenv("FSM_LOG_FILE", logFilePath)
+	in.Run(t,
+		in.CopyModule("fsm"),
+		in.Deploy("fsm"),
+
+		in.Call[in.Obj, in.Obj]("fsm", "sendOrder", in.Obj{"instance": "1"}, nil),
+		in.FileContains(logFilePath, "reserved 1"),
+		in.Call[in.Obj, in.Obj]("fsm", "sendOrder", in.Obj{"instance": "1"}, nil),
+		in.FileContains(logFilePath, "charged 1"),
+
+		// Shipping fails, so the completed states are compensated most recent first.
+		in.Call[in.Obj, in.Obj]("fsm", "sendOrder", in.Obj{"instance": "1", "failShipping": true}, nil),
+		in.FileContains(logFilePath, "refunded 1"),
+		in.FileContains(logFilePath, "released 1"),
+		in.QueryRow("ftl", `
+			SELECT status
+			FROM fsm_instances
+			WHERE fsm = 'fsm.order' AND key = '1'
+		`, "compensated"),
+		in.QueryRow("ftl", `
+			SELECT STRING_AGG(verb::TEXT, ',' ORDER BY id)
+			FROM async_calls
+			WHERE origin = 'fsmcompensation:fsm.order:1'
+		`, "fsm.refunded,fsm.released"),
 	)
 }
 
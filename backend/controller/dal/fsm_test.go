@@ -60,3 +60,90 @@ func TestSendFSMEvent(t *testing.T) {
 	assert.Equal(t, call, actual, assert.Exclude[*Lease](), assert.Exclude[time.Time](), assert.Exclude[int64]())
 	assert.Equal(t, call.ID, actual.ID)
 }
+
+func TestQueueFSMEvent(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn, optional.None[string]())
+	assert.NoError(t, err)
+
+	fsm := schema.RefKey{Module: "test", Name: "test"}
+	eventType := &schema.Ref{Module: "test", Name: "Event"}
+	err = dal.StartFSMTransition(ctx, fsm, "invoiceID", schema.RefKey{Module: "test", Name: "verb"}, []byte(`{}`), schema.RetryParams{})
+	assert.NoError(t, err)
+
+	err = dal.QueueFSMEvent(ctx, fsm, "invoiceID", eventType, []byte(`{"n":1}`), 2, true)
+	assert.NoError(t, err)
+	// Duplicate is dropped.
+	err = dal.QueueFSMEvent(ctx, fsm, "invoiceID", eventType, []byte(`{"n":1}`), 2, true)
+	assert.NoError(t, err)
+	err = dal.QueueFSMEvent(ctx, fsm, "invoiceID", eventType, []byte(`{"n":2}`), 2, true)
+	assert.NoError(t, err)
+	err = dal.QueueFSMEvent(ctx, fsm, "invoiceID", eventType, []byte(`{"n":3}`), 2, true)
+	assert.IsError(t, err, ErrFSMQueueFull)
+
+	for _, expected := range []string{`{"n":1}`, `{"n":2}`} {
+		actualType, request, err := dal.PopNextFSMEvent(ctx, fsm, "invoiceID")
+		assert.NoError(t, err)
+		assert.True(t, eventType.Equal(actualType))
+		assert.Equal(t, expected, string(request))
+	}
+	_, _, err = dal.PopNextFSMEvent(ctx, fsm, "invoiceID")
+	assert.IsError(t, err, dalerrs.ErrNotFound)
+
+	err = dal.QueueFSMEvent(ctx, fsm, "invoiceID", eventType, []byte(`{"n":1}`), 2, false)
+	assert.NoError(t, err)
+	dropped, err := dal.ClearFSMQueue(ctx, fsm, "invoiceID")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), dropped)
+}
+
+func TestMigrateFSMInstances(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn, optional.None[string]())
+	assert.NoError(t, err)
+
+	fsm := schema.RefKey{Module: "test", Name: "test"}
+	created := schema.RefKey{Module: "test", Name: "created"}
+	invoiced := schema.RefKey{Module: "test", Name: "invoiced"}
+	for _, key := range []string{"a", "b"} {
+		err = dal.StartFSMTransition(ctx, fsm, key, created, []byte(`{}`), schema.RetryParams{})
+		assert.NoError(t, err)
+	}
+	states, err := dal.GetRunningFSMStates(ctx, fsm)
+	assert.NoError(t, err)
+	assert.Equal(t, []FSMStateInstances{{State: created, Instances: 2}}, states)
+
+	module, err := schema.ParseModuleString("", `
+	module test {
+	  fsm test
+	    +migrate test.created to test.invoiced
+	  {
+	    start test.invoiced
+	  }
+
+	  verb invoiced(Unit) Unit
+	}
+	`)
+	assert.NoError(t, err)
+	tx, err := dal.db.Begin(ctx)
+	assert.NoError(t, err)
+	err = dal.migrateFSMInstances(ctx, tx, module)
+	assert.NoError(t, err)
+	err = tx.Commit(ctx)
+	assert.NoError(t, err)
+
+	states, err = dal.GetRunningFSMStates(ctx, fsm)
+	assert.NoError(t, err)
+	assert.Equal(t, []FSMStateInstances{{State: invoiced, Instances: 2}}, states)
+
+	// The pending transitions now execute the new state.
+	call, err := dal.AcquireAsyncCall(ctx)
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		err := call.Lease.Release()
+		assert.NoError(t, err)
+	})
+	assert.Equal(t, invoiced, call.Verb)
+}
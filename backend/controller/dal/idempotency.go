@@ -0,0 +1,82 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	"github.com/TBD54566975/ftl/backend/controller/sql/sqltypes"
+	dalerrs "github.com/TBD54566975/ftl/backend/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/encryption"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// ClaimIdempotencyKey claims an idempotency key for a call to verb.
+//
+// If the key is claimed the claim expires after ttl unless a response is
+// stored with [DAL.SetIdempotentResponse]. If a previous call with the same
+// key has completed its stored response is returned. Returns ErrConflict if a
+// previous call with the same key is still in flight.
+func (d *DAL) ClaimIdempotencyKey(ctx context.Context, verb schema.RefKey, key string, ttl time.Duration) (optional.Option[[]byte], error) {
+	claimed, err := d.db.ClaimIdempotencyKey(ctx, verb, key, sqltypes.Duration(ttl))
+	if err != nil {
+		return optional.None[[]byte](), dalerrs.TranslatePGError(err)
+	}
+	if claimed > 0 {
+		return optional.None[[]byte](), nil
+	}
+	response, err := d.db.GetIdempotentResponse(ctx, verb, key)
+	if err != nil {
+		err = dalerrs.TranslatePGError(err)
+		if errors.Is(err, dalerrs.ErrNotFound) {
+			// The previous claim expired between claiming and reading it.
+			return optional.None[[]byte](), fmt.Errorf("idempotency key %q for %s: %w", key, verb, dalerrs.ErrConflict)
+		}
+		return optional.None[[]byte](), err
+	}
+	if response == nil {
+		return optional.None[[]byte](), fmt.Errorf("call with idempotency key %q to %s is in flight: %w", key, verb, dalerrs.ErrConflict)
+	}
+	decrypted, err := d.decrypt(encryption.IdempotencySubKey, response)
+	if err != nil {
+		return optional.None[[]byte](), fmt.Errorf("failed to decrypt idempotent response: %w", err)
+	}
+	return optional.Some(decrypted), nil
+}
+
+// SetIdempotentResponse stores the response to a call made with a claimed
+// idempotency key, to be replayed for duplicate calls until ttl expires.
+func (d *DAL) SetIdempotentResponse(ctx context.Context, verb schema.RefKey, key string, response []byte, ttl time.Duration) error {
+	encrypted, err := d.encrypt(encryption.IdempotencySubKey, response)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt idempotent response: %w", err)
+	}
+	err = d.db.SetIdempotentResponse(ctx, sql.SetIdempotentResponseParams{
+		Response: encrypted,
+		Ttl:      sqltypes.Duration(ttl),
+		Verb:     verb,
+		Key:      key,
+	})
+	return dalerrs.TranslatePGError(err)
+}
+
+// ReleaseIdempotencyKey releases the claim on an idempotency key for a call
+// that did not complete, so that it can be retried.
+func (d *DAL) ReleaseIdempotencyKey(ctx context.Context, verb schema.RefKey, key string) error {
+	err := d.db.ReleaseIdempotencyKey(ctx, verb, key)
+	return dalerrs.TranslatePGError(err)
+}
+
+// ExpireIdempotencyKeys deletes all expired idempotency keys and their responses.
+func (d *DAL) ExpireIdempotencyKeys(ctx context.Context) error {
+	count, err := d.db.ExpireIdempotencyKeys(ctx)
+	if count > 0 {
+		log.FromContext(ctx).Debugf("Expired %d idempotency keys", count)
+	}
+	return dalerrs.TranslatePGError(err)
+}
@@ -0,0 +1,55 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql/sqltest"
+	dalerrs "github.com/TBD54566975/ftl/backend/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn, optional.None[string]())
+	assert.NoError(t, err)
+
+	verb := schema.RefKey{Module: "payments", Name: "charge"}
+
+	replay, err := dal.ClaimIdempotencyKey(ctx, verb, "abc", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, optional.None[[]byte](), replay)
+
+	_, err = dal.ClaimIdempotencyKey(ctx, verb, "abc", time.Minute)
+	assert.True(t, errors.Is(err, dalerrs.ErrConflict), "expected conflict for in-flight call but got %v", err)
+
+	// Keys are scoped to a verb.
+	replay, err = dal.ClaimIdempotencyKey(ctx, schema.RefKey{Module: "payments", Name: "refund"}, "abc", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, optional.None[[]byte](), replay)
+
+	err = dal.SetIdempotentResponse(ctx, verb, "abc", []byte("response"), time.Minute)
+	assert.NoError(t, err)
+	replay, err = dal.ClaimIdempotencyKey(ctx, verb, "abc", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, optional.Some([]byte("response")), replay)
+
+	// Released keys can be claimed again.
+	_, err = dal.ClaimIdempotencyKey(ctx, verb, "def", time.Minute)
+	assert.NoError(t, err)
+	err = dal.ReleaseIdempotencyKey(ctx, verb, "def")
+	assert.NoError(t, err)
+	replay, err = dal.ClaimIdempotencyKey(ctx, verb, "def", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, optional.None[[]byte](), replay)
+
+	err = dal.ExpireIdempotencyKeys(ctx)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,71 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql/sqltest"
+	dalerrs "github.com/TBD54566975/ftl/backend/dal"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func TestConcurrencySlots(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn, optional.None[string]())
+	assert.NoError(t, err)
+
+	id1, ok, err := dal.AcquireConcurrencySlot(ctx, "echo.echo", 2, time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	_, ok, err = dal.AcquireConcurrencySlot(ctx, "echo.echo", 2, time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	_, ok, err = dal.AcquireConcurrencySlot(ctx, "echo.echo", 2, time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	// Limits are per key.
+	_, ok, err = dal.AcquireConcurrencySlot(ctx, "echo.other", 2, time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	err = dal.RenewConcurrencySlot(ctx, id1, time.Minute)
+	assert.NoError(t, err)
+	err = dal.ReleaseConcurrencySlot(ctx, id1)
+	assert.NoError(t, err)
+	err = dal.RenewConcurrencySlot(ctx, id1, time.Minute)
+	assert.True(t, errors.Is(err, dalerrs.ErrNotFound), "expected not found but got %v", err)
+
+	_, ok, err = dal.AcquireConcurrencySlot(ctx, "echo.echo", 2, time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	err = dal.ExpireConcurrencySlots(ctx)
+	assert.NoError(t, err)
+}
+
+func TestExpireRateLimits(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn, optional.None[string]())
+	assert.NoError(t, err)
+
+	ok, err := dal.TakeRateLimitToken(ctx, "echo.echo", 1, time.Millisecond*10)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	time.Sleep(time.Millisecond * 20)
+	err = dal.ExpireRateLimits(ctx)
+	assert.NoError(t, err)
+
+	var count int
+	err = conn.QueryRowContext(ctx, "SELECT COUNT(*) FROM rate_limits").Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
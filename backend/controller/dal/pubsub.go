@@ -2,10 +2,15 @@ package dal
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand"
 	"strings"
 	"time"
 
+	"github.com/alecthomas/types/either"
 	"github.com/alecthomas/types/optional"
 
 	"github.com/TBD54566975/ftl/backend/controller/observability"
@@ -20,7 +25,12 @@ import (
 	"github.com/TBD54566975/ftl/internal/slices"
 )
 
-func (d *DAL) PublishEventForTopic(ctx context.Context, module, topic, caller string, payload []byte) error {
+// PublishEventForTopic publishes an event to a topic.
+//
+// Events published with the same partition key are always published to the
+// same partition of the topic, and are therefore consumed in order. Events
+// without a partition key are distributed randomly across partitions.
+func (d *DAL) PublishEventForTopic(ctx context.Context, module, topic, caller string, partitionKey optional.Option[string], payload []byte) error {
 	encryptedPayload, err := d.encrypt(encryption.AsyncSubKey, payload)
 	if err != nil {
 		return fmt.Errorf("failed to encrypt payload: %w", err)
@@ -44,13 +54,15 @@ func (d *DAL) PublishEventForTopic(ctx context.Context, module, topic, caller st
 	}
 
 	err = d.db.PublishEventForTopic(ctx, sql.PublishEventForTopicParams{
-		Key:          model.NewTopicEventKey(module, topic),
-		Module:       module,
-		Topic:        topic,
-		Caller:       caller,
-		Payload:      encryptedPayload,
-		RequestKey:   requestKey,
-		TraceContext: jsonOc,
+		Key:           model.NewTopicEventKey(module, topic),
+		Module:        module,
+		Topic:         topic,
+		Caller:        caller,
+		Payload:       encryptedPayload,
+		RequestKey:    requestKey,
+		TraceContext:  jsonOc,
+		PartitionHash: partitionHash(partitionKey),
+		PartitionKey:  partitionKey,
 	})
 	observability.PubSub.Published(ctx, module, topic, caller, err)
 	if err != nil {
@@ -59,6 +71,18 @@ func (d *DAL) PublishEventForTopic(ctx context.Context, module, topic, caller st
 	return nil
 }
 
+// partitionHash returns a non-negative hash used to select the partition an
+// event is published to.
+func partitionHash(key optional.Option[string]) int64 {
+	k, ok := key.Get()
+	if !ok {
+		return rand.Int63() //nolint:gosec
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(k)) //nolint:errcheck
+	return int64(h.Sum64() & math.MaxInt64)
+}
+
 func (d *DAL) GetSubscriptionsNeedingUpdate(ctx context.Context) ([]model.Subscription, error) {
 	rows, err := d.db.GetSubscriptionsNeedingUpdate(ctx)
 	if err != nil {
@@ -66,10 +90,11 @@ func (d *DAL) GetSubscriptionsNeedingUpdate(ctx context.Context) ([]model.Subscr
 	}
 	return slices.Map(rows, func(row sql.GetSubscriptionsNeedingUpdateRow) model.Subscription {
 		return model.Subscription{
-			Name:   row.Name,
-			Key:    row.Key,
-			Topic:  row.Topic,
-			Cursor: row.Cursor,
+			Name:      row.Name,
+			Key:       row.Key,
+			Topic:     row.Topic,
+			Partition: int(row.Partition),
+			Cursor:    row.Cursor,
 		}
 	}), nil
 }
@@ -92,30 +117,66 @@ func (d *DAL) ProgressSubscriptions(ctx context.Context, eventConsumptionDelay t
 
 	successful := 0
 	for _, subscription := range subs {
-		nextCursor, err := tx.db.GetNextEventForSubscription(ctx, sqltypes.Duration(eventConsumptionDelay), subscription.Topic, subscription.Cursor)
+		subscriber, err := tx.db.GetRandomSubscriber(ctx, subscription.Key)
+		if err != nil {
+			logger.Tracef("no subscriber for subscription %s", subscription.Key)
+			continue
+		}
+
+		// Subscribers that don't consume batches receive one event at a time.
+		batchSize := subscriber.BatchSize.Default(1)
+		events, err := tx.db.GetNextEventsForSubscription(ctx, sql.GetNextEventsForSubscriptionParams{
+			ConsumptionDelay: sqltypes.Duration(eventConsumptionDelay),
+			BatchWait:        subscriber.BatchWait,
+			Topic:            subscription.Topic,
+			Partition:        subscription.Partition,
+			MaxEvents:        batchSize,
+			Cursor:           subscription.Cursor,
+		})
 		if err != nil {
-			observability.PubSub.PropagationFailed(ctx, "GetNextEventForSubscription", subscription.Topic.Payload, nextCursor.Caller, subscriptionRef(subscription), optional.None[schema.RefKey]())
+			observability.PubSub.PropagationFailed(ctx, "GetNextEventsForSubscription", subscription.Topic.Payload, optional.None[string](), subscriptionRef(subscription), optional.Some(subscriber.Sink))
 			return 0, fmt.Errorf("failed to get next cursor: %w", dalerrs.TranslatePGError(err))
 		}
-		nextCursorKey, ok := nextCursor.Event.Get()
-		if !ok {
-			observability.PubSub.PropagationFailed(ctx, "GetNextEventForSubscription-->Event.Get", subscription.Topic.Payload, nextCursor.Caller, subscriptionRef(subscription), optional.None[schema.RefKey]())
-			return 0, fmt.Errorf("could not find event to progress subscription: %w", dalerrs.TranslatePGError(err))
+		if len(events) == 0 {
+			observability.PubSub.PropagationFailed(ctx, "GetNextEventsForSubscription-->Event.Get", subscription.Topic.Payload, optional.None[string](), subscriptionRef(subscription), optional.Some(subscriber.Sink))
+			return 0, fmt.Errorf("could not find event to progress subscription %s", subscription.Key)
+		}
+		// Only events that are old enough to be consumed are included in the batch.
+		for i, event := range events {
+			if !event.Ready {
+				events = events[:i]
+				break
+			}
 		}
-		if !nextCursor.Ready {
-			logger.Tracef("Skipping subscription %s because event is too new", subscription.Key)
+		if len(events) == 0 {
+			logger.Tracef("Skipping partition %d of subscription %s because event is too new", subscription.Partition, subscription.Key)
 			continue
 		}
-
-		subscriber, err := tx.db.GetRandomSubscriber(ctx, subscription.Key)
-		if err != nil {
-			logger.Tracef("no subscriber for subscription %s", subscription.Key)
+		first, last := events[0], events[len(events)-1]
+		if len(events) < int(batchSize) && !first.BatchWaited {
+			logger.Tracef("Skipping partition %d of subscription %s while waiting for batch to fill", subscription.Partition, subscription.Key)
 			continue
 		}
+		firstKey, ok := first.Event.Get()
+		if !ok {
+			return 0, fmt.Errorf("could not find event to progress subscription %s", subscription.Key)
+		}
+		lastKey, ok := last.Event.Get()
+		if !ok {
+			return 0, fmt.Errorf("could not find event to progress subscription %s", subscription.Key)
+		}
 
-		err = tx.db.BeginConsumingTopicEvent(ctx, subscription.Key, nextCursorKey)
+		request := first.Payload // already encrypted
+		if subscriber.BatchSize.Ok() {
+			request, err = tx.encryptedBatch(slices.Map(events, func(e sql.GetNextEventsForSubscriptionRow) []byte { return e.Payload }))
+			if err != nil {
+				return 0, fmt.Errorf("failed to create batch for subscription %s: %w", subscription.Key, err)
+			}
+		}
+
+		err = tx.db.BeginConsumingTopicEvent(ctx, firstKey, subscription.Key, lastKey)
 		if err != nil {
-			observability.PubSub.PropagationFailed(ctx, "BeginConsumingTopicEvent", subscription.Topic.Payload, nextCursor.Caller, subscriptionRef(subscription), optional.Some(subscriber.Sink))
+			observability.PubSub.PropagationFailed(ctx, "BeginConsumingTopicEvent", subscription.Topic.Payload, first.Caller, subscriptionRef(subscription), optional.Some(subscriber.Sink))
 			return 0, fmt.Errorf("failed to progress subscription: %w", dalerrs.TranslatePGError(err))
 		}
 
@@ -124,26 +185,27 @@ func (d *DAL) ProgressSubscriptions(ctx context.Context, eventConsumptionDelay t
 				Module: subscription.Key.Payload.Module,
 				Name:   subscription.Key.Payload.Name,
 			},
+			Partition: int(subscription.Partition),
 		}
 
 		_, err = tx.db.CreateAsyncCall(ctx, sql.CreateAsyncCallParams{
 			Verb:              subscriber.Sink,
 			Origin:            origin.String(),
-			Request:           nextCursor.Payload, // already encrypted
+			Request:           request,
 			RemainingAttempts: subscriber.RetryAttempts,
 			Backoff:           subscriber.Backoff,
 			MaxBackoff:        subscriber.MaxBackoff,
-			ParentRequestKey:  nextCursor.RequestKey,
-			TraceContext:      nextCursor.TraceContext.RawMessage,
+			ParentRequestKey:  first.RequestKey,
+			TraceContext:      first.TraceContext.RawMessage,
 			CatchVerb:         subscriber.CatchVerb,
 		})
 		observability.AsyncCalls.Created(ctx, subscriber.Sink, subscriber.CatchVerb, origin.String(), int64(subscriber.RetryAttempts), err)
 		if err != nil {
-			observability.PubSub.PropagationFailed(ctx, "CreateAsyncCall", subscription.Topic.Payload, nextCursor.Caller, subscriptionRef(subscription), optional.Some(subscriber.Sink))
+			observability.PubSub.PropagationFailed(ctx, "CreateAsyncCall", subscription.Topic.Payload, first.Caller, subscriptionRef(subscription), optional.Some(subscriber.Sink))
 			return 0, fmt.Errorf("failed to schedule async task for subscription: %w", dalerrs.TranslatePGError(err))
 		}
 
-		observability.PubSub.SinkCalled(ctx, subscription.Topic.Payload, nextCursor.Caller, subscriptionRef(subscription), subscriber.Sink)
+		observability.PubSub.SinkCalled(ctx, subscription.Topic.Payload, first.Caller, subscriptionRef(subscription), subscriber.Sink)
 		successful++
 	}
 
@@ -161,19 +223,37 @@ func (d *DAL) ProgressSubscriptions(ctx context.Context, eventConsumptionDelay t
 	return successful, nil
 }
 
+// encryptedBatch combines encrypted event payloads into a single encrypted JSON
+// array, which is the request for a subscriber that consumes batches.
+func (d *DAL) encryptedBatch(payloads [][]byte) ([]byte, error) {
+	batch := make([]json.RawMessage, 0, len(payloads))
+	for _, payload := range payloads {
+		decrypted, err := d.decrypt(encryption.AsyncSubKey, payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt payload: %w", err)
+		}
+		batch = append(batch, decrypted)
+	}
+	encrypted, err := d.encryptJSON(encryption.AsyncSubKey, batch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt batch: %w", err)
+	}
+	return encrypted, nil
+}
+
 func subscriptionRef(subscription sql.GetSubscriptionsNeedingUpdateRow) schema.RefKey {
 	return schema.RefKey{Module: subscription.Key.Payload.Module, Name: subscription.Name}
 }
 
-func (d *DAL) CompleteEventForSubscription(ctx context.Context, module, name string) error {
-	err := d.db.CompleteEventForSubscription(ctx, name, module)
+func (d *DAL) CompleteEventForSubscription(ctx context.Context, module, name string, partition int) error {
+	err := d.db.CompleteEventForSubscription(ctx, name, int32(partition), module)
 	if err != nil {
 		return fmt.Errorf("failed to complete event for subscription: %w", dalerrs.TranslatePGError(err))
 	}
 	return nil
 }
 
-// ResetSubscription resets the subscription cursor to the topic's head.
+// ResetSubscription resets the subscription cursors to the head of each partition of the topic.
 func (d *DAL) ResetSubscription(ctx context.Context, module, name string) (err error) {
 	tx, err := d.db.Begin(ctx)
 	if err != nil {
@@ -194,24 +274,349 @@ func (d *DAL) ResetSubscription(ctx context.Context, module, name string) (err e
 		return fmt.Errorf("could not fetch topic: %w", dalerrs.TranslatePGError(err))
 	}
 
-	headEventID, ok := topic.Head.Get()
-	if !ok {
+	if !topic.Head.Ok() {
 		return fmt.Errorf("no events published to topic %s", topic.Name)
 	}
 
-	headEvent, err := tx.GetTopicEvent(ctx, headEventID)
+	err = tx.ResetSubscriptionCursors(ctx, subscription.Key)
 	if err != nil {
-		return fmt.Errorf("could not fetch topic head: %w", dalerrs.TranslatePGError(err))
+		return fmt.Errorf("failed to reset subscription: %w", dalerrs.TranslatePGError(err))
 	}
 
-	err = tx.SetSubscriptionCursor(ctx, subscription.Key, headEvent.Key)
+	return nil
+}
+
+// SubscriptionSeekResult describes the effect of moving a subscription's cursor.
+type SubscriptionSeekResult struct {
+	// Redelivered is the number of previously consumed events that will be consumed again.
+	Redelivered int
+	// Skipped is the number of unconsumed events that will not be consumed.
+	Skipped int
+}
+
+// SeekSubscription moves a subscription's cursor so that the next event it
+// consumes is either the given event, or the first event published at or after
+// the given time.
+//
+// If dryRun is true the cursor is not moved.
+func (d *DAL) SeekSubscription(ctx context.Context, module, name string, target either.Either[model.TopicEventKey, time.Time], dryRun bool) (result SubscriptionSeekResult, err error) {
+	tx, err := d.db.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to reset subscription: %w", dalerrs.TranslatePGError(err))
+		return result, fmt.Errorf("could not start transaction: %w", err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+
+	subscription, err := tx.GetSubscription(ctx, name, module)
+	if err != nil {
+		if dalerrs.IsNotFound(err) {
+			return result, fmt.Errorf("subscription %s.%s not found: %w", module, name, dalerrs.ErrNotFound)
+		}
+		return result, fmt.Errorf("could not fetch subscription: %w", dalerrs.TranslatePGError(err))
+	}
+
+	cursors, err := tx.GetSubscriptionCursors(ctx, subscription.ID)
+	if err != nil {
+		return result, fmt.Errorf("could not fetch subscription cursors: %w", dalerrs.TranslatePGError(err))
+	}
+
+	// The position in the topic to seek to. Every partition is moved to the
+	// last event ordered before this position.
+	var position struct {
+		createdAt time.Time
+		id        int64
+	}
+	switch target := target.(type) {
+	case either.Left[model.TopicEventKey, time.Time]:
+		event, err := tx.GetTopicEventByKey(ctx, target.Get())
+		if err != nil {
+			if dalerrs.IsNotFound(err) {
+				return result, fmt.Errorf("event %s not found: %w", target.Get(), dalerrs.ErrNotFound)
+			}
+			return result, fmt.Errorf("could not fetch event: %w", dalerrs.TranslatePGError(err))
+		}
+		if event.TopicID != subscription.TopicID {
+			return result, fmt.Errorf("event %s is not in the topic for subscription %s.%s", target.Get(), module, name)
+		}
+		position.createdAt, position.id = event.CreatedAt, event.ID
+
+	case either.Right[model.TopicEventKey, time.Time]:
+		// Event IDs are always positive, so this is every event published before the given time.
+		position.createdAt = target.Get()
+	}
+
+	for _, partition := range cursors {
+		before, err := tx.GetPreviousTopicEvent(ctx, sql.GetPreviousTopicEventParams{
+			TopicID:   subscription.TopicID,
+			Partition: partition.Partition,
+			CreatedAt: position.createdAt,
+			EventID:   position.id,
+		})
+		if err != nil && !dalerrs.IsNotFound(err) {
+			return result, fmt.Errorf("could not fetch previous event: %w", dalerrs.TranslatePGError(err))
+		}
+
+		// A missing cursor represents the start of the partition.
+		cursor := optional.None[int64]()
+		if before.ID != 0 {
+			cursor = optional.Some(before.ID)
+		}
+
+		redelivered, err := tx.CountTopicEventsBetween(ctx, sql.CountTopicEventsBetweenParams{
+			TopicID:   subscription.TopicID,
+			Partition: partition.Partition,
+			FromID:    cursor,
+			ToID:      partition.Cursor,
+		})
+		if err != nil {
+			return result, fmt.Errorf("could not count redelivered events: %w", dalerrs.TranslatePGError(err))
+		}
+		skipped, err := tx.CountTopicEventsBetween(ctx, sql.CountTopicEventsBetweenParams{
+			TopicID:   subscription.TopicID,
+			Partition: partition.Partition,
+			FromID:    partition.Cursor,
+			ToID:      cursor,
+		})
+		if err != nil {
+			return result, fmt.Errorf("could not count skipped events: %w", dalerrs.TranslatePGError(err))
+		}
+		result.Redelivered += int(redelivered)
+		result.Skipped += int(skipped)
+		if dryRun {
+			continue
+		}
+
+		err = tx.SetSubscriptionCursorByID(ctx, cursor, partition.ID)
+		if err != nil {
+			return result, fmt.Errorf("failed to seek subscription: %w", dalerrs.TranslatePGError(err))
+		}
 	}
+	return result, nil
+}
+
+// DeadLetter is an event that a subscription permanently failed to consume.
+type DeadLetter struct {
+	Event        model.TopicEventKey
+	Subscription schema.RefKey
+	// Topic is the dead letter topic the event was published to.
+	Topic     schema.RefKey
+	Error     string
+	Attempts  int
+	CreatedAt time.Time
+	Payload   []byte
+}
+
+// failedEvent is the payload of events published to a dead letter topic,
+// matching builtin.FailedEvent.
+type failedEvent struct {
+	Event    json.RawMessage `json:"event"`
+	Error    string          `json:"error"`
+	Attempts int             `json:"attempts"`
+}
 
+// DeadLetterEventForSubscription publishes the events currently being consumed
+// from a partition by a subscription to the subscription's dead letter topic,
+// if it has one, wrapped in a builtin.FailedEvent with the error of the last of
+// the given number of attempts.
+//
+// Subscribers that consume batches dead letter every event in the batch.
+func (d *DAL) DeadLetterEventForSubscription(ctx context.Context, subscription schema.RefKey, partition int, callError string, attempts int) error {
+	rows, err := d.db.GetDeadLetterEventsForSubscription(ctx, subscription.Module, subscription.Name, int32(partition))
+	if err != nil {
+		return fmt.Errorf("could not fetch dead letter topic: %w", dalerrs.TranslatePGError(err))
+	}
+
+	for _, row := range rows {
+		event, err := d.decrypt(encryption.AsyncSubKey, row.Payload)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt event %s: %w", row.Event, err)
+		}
+		payload, err := d.encryptJSON(encryption.AsyncSubKey, failedEvent{Event: event, Error: callError, Attempts: attempts})
+		if err != nil {
+			return fmt.Errorf("failed to encrypt dead letter event: %w", err)
+		}
+		eventKey := model.NewTopicEventKey(row.Module, row.Topic)
+		err = d.db.PublishEventForTopic(ctx, sql.PublishEventForTopicParams{
+			Key:           eventKey,
+			Module:        row.Module,
+			Topic:         row.Topic,
+			Caller:        row.Caller.Default(""),
+			Payload:       payload,
+			RequestKey:    row.RequestKey.Default(""),
+			TraceContext:  row.TraceContext.RawMessage,
+			PartitionHash: partitionHash(row.PartitionKey),
+			PartitionKey:  row.PartitionKey,
+		})
+		observability.PubSub.Published(ctx, row.Module, row.Topic, row.Caller.Default(""), err)
+		if err != nil {
+			return fmt.Errorf("could not publish to dead letter topic: %w", dalerrs.TranslatePGError(err))
+		}
+		log.FromContext(ctx).Warnf("Published event %s to dead letter topic %s.%s after %d attempts: %s", row.Event, row.Module, row.Topic, attempts, callError)
+		if err := d.insertDeadLetter(ctx, row.Subscription, eventKey, callError, attempts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeadLetterReplayedEvent records that a replayed dead letter event failed
+// again after the given number of attempts.
+func (d *DAL) DeadLetterReplayedEvent(ctx context.Context, origin AsyncOriginDeadLetter, callError string, attempts int) error {
+	subscription, err := d.db.GetSubscription(ctx, origin.Subscription.Name, origin.Subscription.Module)
+	if err != nil {
+		return fmt.Errorf("could not fetch subscription: %w", dalerrs.TranslatePGError(err))
+	}
+	eventKey, err := model.ParseTopicEventKey(origin.Event)
+	if err != nil {
+		return fmt.Errorf("invalid dead letter event key: %w", err)
+	}
+	return d.insertDeadLetter(ctx, subscription.Key, eventKey, callError, attempts)
+}
+
+func (d *DAL) insertDeadLetter(ctx context.Context, subscription model.SubscriptionKey, event model.TopicEventKey, callError string, attempts int) error {
+	err := d.db.InsertTopicDeadLetter(ctx, sql.InsertTopicDeadLetterParams{
+		Event:        event,
+		Subscription: subscription,
+		Error:        callError,
+		Attempts:     int32(attempts),
+	})
+	if err != nil {
+		return fmt.Errorf("could not record dead letter: %w", dalerrs.TranslatePGError(err))
+	}
 	return nil
 }
 
+// failedEventPayload returns the original event wrapped in an encrypted
+// dead letter event.
+func (d *DAL) failedEventPayload(payload []byte) (json.RawMessage, error) {
+	decrypted, err := d.decrypt(encryption.AsyncSubKey, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt dead letter payload: %w", err)
+	}
+	var failed failedEvent
+	if err := json.Unmarshal(decrypted, &failed); err != nil {
+		return nil, fmt.Errorf("invalid dead letter payload: %w", err)
+	}
+	return failed.Event, nil
+}
+
+// GetDeadLetters returns the dead lettered events for a subscription, oldest first.
+//
+// If event is provided only that event is returned.
+func (d *DAL) GetDeadLetters(ctx context.Context, module, name string, event optional.Option[model.TopicEventKey], limit int) ([]DeadLetter, error) {
+	rows, err := d.db.GetTopicDeadLetters(ctx, sql.GetTopicDeadLettersParams{
+		Module:     module,
+		Name:       name,
+		Event:      event,
+		MaxResults: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch dead letters: %w", dalerrs.TranslatePGError(err))
+	}
+	out := make([]DeadLetter, 0, len(rows))
+	for _, row := range rows {
+		payload, err := d.failedEventPayload(row.Payload)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, DeadLetter{
+			Event:        row.Event,
+			Subscription: schema.RefKey{Module: module, Name: name},
+			Topic:        schema.RefKey{Module: row.Topic.Payload.Module, Name: row.Topic.Payload.Name},
+			Error:        row.Error,
+			Attempts:     int(row.Attempts),
+			CreatedAt:    row.CreatedAt,
+			Payload:      payload,
+		})
+	}
+	return out, nil
+}
+
+// ReplayDeadLetters schedules dead lettered events to be consumed by the
+// subscription again, removing them from the dead letters.
+//
+// If event is provided only that event is replayed.
+func (d *DAL) ReplayDeadLetters(ctx context.Context, module, name string, event optional.Option[model.TopicEventKey]) (count int, err error) {
+	tx, err := d.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+
+	rows, err := tx.db.GetTopicDeadLetters(ctx, sql.GetTopicDeadLettersParams{
+		Module:     module,
+		Name:       name,
+		Event:      event,
+		MaxResults: math.MaxInt32,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not fetch dead letters: %w", dalerrs.TranslatePGError(err))
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	subscriber, err := tx.db.GetRandomSubscriber(ctx, rows[0].Subscription)
+	if err != nil {
+		if dalerrs.IsNotFound(err) {
+			return 0, fmt.Errorf("no subscribers for subscription %s.%s", module, name)
+		}
+		return 0, fmt.Errorf("could not fetch subscriber: %w", dalerrs.TranslatePGError(err))
+	}
+
+	for _, row := range rows {
+		origin := AsyncOriginDeadLetter{
+			Subscription: schema.RefKey{Module: module, Name: name},
+			Event:        row.Event.String(),
+		}
+		event, err := tx.failedEventPayload(row.Payload)
+		if err != nil {
+			return 0, err
+		}
+		var request []byte
+		if subscriber.BatchSize.Ok() {
+			// Replayed events are delivered to subscribers that consume batches one at a time.
+			request, err = tx.encryptJSON(encryption.AsyncSubKey, []json.RawMessage{event})
+		} else {
+			request, err = tx.encrypt(encryption.AsyncSubKey, event)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to encrypt replay of %s: %w", row.Event, err)
+		}
+		_, err = tx.db.CreateAsyncCall(ctx, sql.CreateAsyncCallParams{
+			Verb:              subscriber.Sink,
+			Origin:            origin.String(),
+			Request:           request,
+			RemainingAttempts: subscriber.RetryAttempts,
+			Backoff:           subscriber.Backoff,
+			MaxBackoff:        subscriber.MaxBackoff,
+			ParentRequestKey:  row.RequestKey,
+			TraceContext:      row.TraceContext.RawMessage,
+			CatchVerb:         subscriber.CatchVerb,
+		})
+		observability.AsyncCalls.Created(ctx, subscriber.Sink, subscriber.CatchVerb, origin.String(), int64(subscriber.RetryAttempts), err)
+		if err != nil {
+			return 0, fmt.Errorf("failed to schedule replay of %s: %w", row.Event, dalerrs.TranslatePGError(err))
+		}
+	}
+
+	if _, err = tx.db.DeleteTopicDeadLetters(ctx, module, name, event); err != nil {
+		return 0, fmt.Errorf("could not remove replayed dead letters: %w", dalerrs.TranslatePGError(err))
+	}
+	return len(rows), nil
+}
+
+// PurgeDeadLetters discards dead lettered events for a subscription.
+//
+// If event is provided only that event is purged. The events themselves remain
+// in the dead letter topic.
+func (d *DAL) PurgeDeadLetters(ctx context.Context, module, name string, event optional.Option[model.TopicEventKey]) (int, error) {
+	count, err := d.db.DeleteTopicDeadLetters(ctx, module, name, event)
+	if err != nil {
+		return 0, fmt.Errorf("could not purge dead letters: %w", dalerrs.TranslatePGError(err))
+	}
+	return int(count), nil
+}
+
 func (d *DAL) createSubscriptions(ctx context.Context, tx *sql.Tx, key model.DeploymentKey, module *schema.Module) error {
 	logger := log.FromContext(ctx)
 
@@ -230,17 +635,33 @@ func (d *DAL) createSubscriptions(ctx context.Context, tx *sql.Tx, key model.Dep
 			continue
 		}
 		subscriptionKey := model.NewSubscriptionKey(module.Name, s.Name)
-		result, err := tx.UpsertSubscription(ctx, sql.UpsertSubscriptionParams{
+		params := sql.UpsertSubscriptionParams{
 			Key:         subscriptionKey,
 			Module:      module.Name,
 			Deployment:  key,
 			TopicModule: s.Topic.Module,
 			TopicName:   s.Topic.Name,
 			Name:        s.Name,
-		})
+		}
+		if s.DeadLetter != nil {
+			params.DeadLetterModule = optional.Some(s.DeadLetter.Module)
+			if s.DeadLetter.Module == "" {
+				params.DeadLetterModule = optional.Some(module.Name)
+			}
+			params.DeadLetterName = optional.Some(s.DeadLetter.Name)
+		}
+		result, err := tx.UpsertSubscription(ctx, params)
 		if err != nil {
 			return fmt.Errorf("could not insert subscription: %w", dalerrs.TranslatePGError(err))
 		}
+		if s.DeadLetter != nil && !result.DeadLetterTopicID.Ok() {
+			// Otherwise failed events would be silently dropped.
+			return fmt.Errorf("dead letter topic %s of subscription %s does not exist", s.DeadLetter, subscriptionKey)
+		}
+		err = tx.UpsertSubscriptionCursors(ctx, result.ID)
+		if err != nil {
+			return fmt.Errorf("could not insert subscription cursors: %w", dalerrs.TranslatePGError(err))
+		}
 		if result.Inserted {
 			logger.Debugf("Inserted subscription %s for %s", subscriptionKey, key)
 		} else {
@@ -293,6 +714,14 @@ func (d *DAL) createSubscribers(ctx context.Context, tx *sql.Tx, key model.Deplo
 					return fmt.Errorf("could not parse retry parameters for %q: %w", v.Name, err)
 				}
 			}
+			batchSize := optional.None[int32]()
+			size, wait, err := s.BatchParams()
+			if err != nil {
+				return fmt.Errorf("could not parse batch parameters for %q: %w", v.Name, err)
+			}
+			if s.IsBatch() {
+				batchSize = optional.Some(int32(size))
+			}
 			subscriberKey := model.NewSubscriberKey(module.Name, s.Name, v.Name)
 			err = tx.InsertSubscriber(ctx, sql.InsertSubscriberParams{
 				Key:              subscriberKey,
@@ -304,6 +733,8 @@ func (d *DAL) createSubscribers(ctx context.Context, tx *sql.Tx, key model.Deplo
 				Backoff:          sqltypes.Duration(retryParams.MinBackoff),
 				MaxBackoff:       sqltypes.Duration(retryParams.MaxBackoff),
 				CatchVerb:        retryParams.Catch,
+				BatchSize:        batchSize,
+				BatchWait:        sqltypes.Duration(wait),
 			})
 			if err != nil {
 				return fmt.Errorf("could not insert subscriber: %w", dalerrs.TranslatePGError(err))
@@ -0,0 +1,154 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/either"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql/sqltest"
+	dalerrs "github.com/TBD54566975/ftl/backend/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestProgressSubscriptionsSkipsWaitingBatches(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn, optional.None[string]())
+	assert.NoError(t, err)
+
+	// More subscriptions are waiting for batches to fill than are progressed at
+	// a time, which must not prevent the other subscription from progressing.
+	module, err := schema.ParseModuleString("", `
+		module test {
+			topic events test.event
+			subscription batchA test.events
+			subscription batchB test.events
+			subscription batchC test.events
+			subscription batchD test.events
+			subscription single test.events
+
+			data event {}
+
+			verb consumeA([test.event]) Unit
+				+subscribe batchA batch 10 1m
+			verb consumeB([test.event]) Unit
+				+subscribe batchB batch 10 1m
+			verb consumeC([test.event]) Unit
+				+subscribe batchC batch 10 1m
+			verb consumeD([test.event]) Unit
+				+subscribe batchD batch 10 1m
+			verb consumeSingle(test.event) Unit
+				+subscribe single
+		}
+	`)
+	assert.NoError(t, err)
+
+	digest, err := dal.CreateArtefact(ctx, []byte("artefact"))
+	assert.NoError(t, err)
+	deploymentKey, err := dal.CreateDeployment(ctx, "go", module, []DeploymentArtefact{{
+		Digest:     digest,
+		Executable: true,
+		Path:       "main",
+	}}, nil, nil)
+	assert.NoError(t, err)
+	err = dal.ReplaceDeployment(ctx, deploymentKey, 1)
+	assert.NoError(t, err)
+
+	err = dal.PublishEventForTopic(ctx, "test", "events", "", optional.None[string](), []byte(`{}`))
+	assert.NoError(t, err)
+
+	count, err := dal.ProgressSubscriptions(ctx, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestFailedCatchIsDeadLettered(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn, optional.None[string]())
+	assert.NoError(t, err)
+
+	module, err := schema.ParseModuleString("", `
+		module test {
+			topic events test.event
+			topic failed builtin.FailedEvent<test.event>
+			subscription consume test.events deadletter test.failed
+
+			data event {}
+
+			verb consume(test.event) Unit
+				+subscribe consume
+				+retry 1 1s catch test.recover
+			verb recover(builtin.CatchRequest<test.event>) Unit
+		}
+	`)
+	assert.NoError(t, err)
+
+	digest, err := dal.CreateArtefact(ctx, []byte("artefact"))
+	assert.NoError(t, err)
+	deploymentKey, err := dal.CreateDeployment(ctx, "go", module, []DeploymentArtefact{{
+		Digest:     digest,
+		Executable: true,
+		Path:       "main",
+	}}, nil, nil)
+	assert.NoError(t, err)
+	err = dal.ReplaceDeployment(ctx, deploymentKey, 1)
+	assert.NoError(t, err)
+
+	err = dal.PublishEventForTopic(ctx, "test", "events", "", optional.None[string](), []byte(`{}`))
+	assert.NoError(t, err)
+	count, err := dal.ProgressSubscriptions(ctx, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	complete := func(callError string) (didScheduleAnotherCall, isFinalResult bool) {
+		t.Helper()
+		// Retries are scheduled after a backoff.
+		var call *AsyncCall
+		var err error
+		for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(100 * time.Millisecond) {
+			call, err = dal.AcquireAsyncCall(ctx)
+			if !errors.Is(err, dalerrs.ErrNotFound) || time.Now().After(deadline) {
+				break
+			}
+		}
+		assert.NoError(t, err)
+		defer call.Release() //nolint:errcheck
+		origin, ok := call.Origin.(AsyncOriginPubSub)
+		assert.True(t, ok, "unexpected origin %s", call.Origin)
+		didScheduleAnotherCall, err = dal.CompleteAsyncCall(ctx, call, either.RightOf[[]byte](callError), func(tx *Tx, final bool) error {
+			isFinalResult = final
+			if !final {
+				return nil
+			}
+			return tx.DeadLetterEventForSubscription(ctx, origin.Subscription, origin.Partition, callError, 1)
+		})
+		assert.NoError(t, err)
+		return didScheduleAnotherCall, isFinalResult
+	}
+
+	// The failed event is retried, and then caught.
+	for range 2 {
+		didScheduleAnotherCall, isFinalResult := complete("consume failed")
+		assert.True(t, didScheduleAnotherCall)
+		assert.False(t, isFinalResult)
+	}
+
+	// The catch verb fails, so the event is dead lettered rather than caught again.
+	didScheduleAnotherCall, isFinalResult := complete("recover failed")
+	assert.False(t, didScheduleAnotherCall)
+	assert.True(t, isFinalResult)
+
+	deadLetters, err := dal.GetDeadLetters(ctx, "test", "consume", optional.None[model.TopicEventKey](), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(deadLetters))
+	assert.Equal(t, "recover failed", deadLetters[0].Error)
+	assert.Equal(t, `{}`, string(deadLetters[0].Payload))
+}
@@ -0,0 +1,106 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/observability"
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	"github.com/TBD54566975/ftl/backend/controller/sql/sqltypes"
+	dalerrs "github.com/TBD54566975/ftl/backend/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/encryption"
+)
+
+// ScheduledCall is a call to a verb scheduled with [DAL.ScheduleCall] that has
+// not yet completed.
+type ScheduledCall struct {
+	Key         string
+	Verb        schema.RefKey
+	State       sql.AsyncCallState
+	ScheduledAt time.Time
+	// Attempts remaining after the scheduled one.
+	RemainingAttempts int32
+	// The error of the previous attempt, if it failed.
+	Error     optional.Option[string]
+	CreatedAt time.Time
+}
+
+// ScheduleCall durably schedules a call to a verb at the given time.
+//
+// Returns false if a call to the verb with the same key has not yet completed,
+// in which case no call is scheduled.
+func (d *DAL) ScheduleCall(ctx context.Context, verb schema.RefKey, key string, request []byte, scheduledAt time.Time, retryParams schema.RetryParams) (bool, error) {
+	origin := AsyncOriginScheduled{Verb: verb, Key: key}
+	encryptedRequest, err := d.encrypt(encryption.AsyncSubKey, request)
+	if err != nil {
+		return false, fmt.Errorf("failed to encrypt scheduled call request: %w", err)
+	}
+	scheduled, err := d.db.ScheduleAsyncCall(ctx, sql.ScheduleAsyncCallParams{
+		Verb:              verb,
+		Origin:            origin.String(),
+		Request:           encryptedRequest,
+		RemainingAttempts: int32(retryParams.Count),
+		Backoff:           sqltypes.Duration(retryParams.MinBackoff),
+		MaxBackoff:        sqltypes.Duration(retryParams.MaxBackoff),
+		CatchVerb:         retryParams.Catch,
+		ScheduledAt:       scheduledAt,
+	})
+	if err != nil {
+		observability.AsyncCalls.Created(ctx, verb, retryParams.Catch, origin.String(), int64(retryParams.Count), err)
+		return false, fmt.Errorf("failed to create scheduled async call: %w", dalerrs.TranslatePGError(err))
+	}
+	if scheduled == 0 {
+		return false, nil
+	}
+	observability.AsyncCalls.Created(ctx, verb, retryParams.Catch, origin.String(), int64(retryParams.Count), nil)
+	return true, nil
+}
+
+// ListScheduledCalls returns scheduled calls that have not yet completed,
+// soonest first, optionally only those to the given verb.
+func (d *DAL) ListScheduledCalls(ctx context.Context, verb optional.Option[schema.RefKey], limit int) ([]ScheduledCall, error) {
+	rows, err := d.db.ListScheduledCalls(ctx, verb, int32(limit))
+	if err != nil {
+		return nil, fmt.Errorf("could not list scheduled calls: %w", dalerrs.TranslatePGError(err))
+	}
+	out := make([]ScheduledCall, 0, len(rows))
+	for _, row := range rows {
+		origin, err := ParseAsyncOrigin(row.Origin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse origin of scheduled call %d: %w", row.ID, err)
+		}
+		scheduled, ok := origin.(AsyncOriginScheduled)
+		if !ok {
+			return nil, fmt.Errorf("unexpected origin %q for scheduled call %d", row.Origin, row.ID)
+		}
+		out = append(out, ScheduledCall{
+			Key:               scheduled.Key,
+			Verb:              row.Verb,
+			State:             row.State,
+			ScheduledAt:       row.ScheduledAt,
+			RemainingAttempts: row.RemainingAttempts,
+			Error:             row.Error,
+			CreatedAt:         row.CreatedAt,
+		})
+	}
+	return out, nil
+}
+
+// CancelScheduledCall cancels a scheduled call that is not yet executing.
+//
+// Returns ErrNotFound if there is no such call.
+func (d *DAL) CancelScheduledCall(ctx context.Context, verb schema.RefKey, key string) error {
+	origin := AsyncOriginScheduled{Verb: verb, Key: key}
+	count, err := d.db.CancelScheduledCall(ctx, origin.String())
+	if err != nil {
+		return fmt.Errorf("failed to cancel scheduled call: %w", dalerrs.TranslatePGError(err))
+	}
+	if count == 0 {
+		return fmt.Errorf("no pending call to %s with key %q: %w", verb, key, dalerrs.ErrNotFound)
+	}
+	return nil
+}
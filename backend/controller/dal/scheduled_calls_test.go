@@ -0,0 +1,50 @@
+package dal
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql/sqltest"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func TestScheduleCallIsUniquePerKey(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn, optional.None[string]())
+	assert.NoError(t, err)
+
+	verb := schema.RefKey{Module: "test", Name: "verb"}
+	scheduledAt := time.Now().Add(time.Hour)
+
+	var wg sync.WaitGroup
+	results := make(chan bool, 10)
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			scheduled, err := dal.ScheduleCall(ctx, verb, "key", []byte(`{}`), scheduledAt, schema.RetryParams{})
+			assert.NoError(t, err)
+			results <- scheduled
+		}()
+	}
+	wg.Wait()
+	close(results)
+	count := 0
+	for scheduled := range results {
+		if scheduled {
+			count++
+		}
+	}
+	assert.Equal(t, 1, count)
+
+	scheduled, err := dal.ScheduleCall(ctx, verb, "other", []byte(`{}`), scheduledAt, schema.RetryParams{})
+	assert.NoError(t, err)
+	assert.True(t, scheduled)
+}
@@ -0,0 +1,30 @@
+//go:build integration
+
+package dal_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	in "github.com/TBD54566975/ftl/integration"
+)
+
+func TestScheduledCalls(t *testing.T) {
+	logFilePath := filepath.Join(t.TempDir(), "scheduled.log")
+	t.Setenv("SCHEDULED_LOG_FILE", logFilePath)
+	in.Run(t,
+		in.CopyModule("scheduled"),
+		in.Deploy("scheduled"),
+
+		// Scheduling twice with the same key only schedules one call.
+		in.Call[in.Obj, in.Obj]("scheduled", "scheduleReminder", in.Obj{"instance": "1", "delaySeconds": 2}, nil),
+		in.Call[in.Obj, in.Obj]("scheduled", "scheduleReminder", in.Obj{"instance": "1", "delaySeconds": 2}, nil),
+		in.Call[in.Obj, in.Obj]("scheduled", "scheduleReminder", in.Obj{"instance": "2", "delaySeconds": 600}, nil),
+		in.QueryRow("ftl", "SELECT COUNT(*) FROM async_calls WHERE origin = 'scheduled:scheduled.remind:1'", int64(1)),
+
+		in.Exec("ftl", "scheduled", "cancel", "scheduled.remind", "2"),
+		in.QueryRow("ftl", "SELECT COUNT(*) FROM async_calls WHERE origin = 'scheduled:scheduled.remind:2'", int64(0)),
+
+		in.FileContains(logFilePath, "remind 1"),
+	)
+}
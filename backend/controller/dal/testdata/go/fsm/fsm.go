@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/TBD54566975/ftl/go-runtime/ftl"
 )
 
 type One struct {
 	Instance string
+	// If true, each state moves straight on to the next with FSMNext.
+	Chain bool
 }
 
 type Two struct {
@@ -25,12 +28,18 @@ var fsm = ftl.FSM("fsm",
 //ftl:verb
 func Start(ctx context.Context, in One) error {
 	appendLog("start %s", in.Instance)
+	if in.Chain {
+		return ftl.FSMNext(ctx, in)
+	}
 	return nil
 }
 
 //ftl:verb
 func Middle(ctx context.Context, in One) error {
 	appendLog("middle %s", in.Instance)
+	if in.Chain {
+		return ftl.FSMNext(ctx, in)
+	}
 	return nil
 }
 
@@ -40,6 +49,100 @@ func End(ctx context.Context, in One) error {
 	return nil
 }
 
+type Invoice struct {
+	Instance string
+}
+
+type Payment struct {
+	Instance string
+}
+
+var payment = ftl.FSM("payment",
+	ftl.Start(Invoiced),
+	ftl.Transition(Invoiced, Paid),
+	ftl.Timeout(Invoiced, Expired, 2*time.Second),
+)
+
+//ftl:verb
+func Invoiced(ctx context.Context, in Invoice) error {
+	appendLog("invoiced %s", in.Instance)
+	return nil
+}
+
+//ftl:verb
+func Paid(ctx context.Context, in Payment) error {
+	appendLog("paid %s", in.Instance)
+	return nil
+}
+
+//ftl:verb
+func Expired(ctx context.Context, in Invoice) error {
+	appendLog("expired %s", in.Instance)
+	return nil
+}
+
+//ftl:verb
+func SendInvoice(ctx context.Context, in Invoice) error {
+	return payment.Send(ctx, in.Instance, in)
+}
+
+//ftl:verb
+func SendPayment(ctx context.Context, in Payment) error {
+	return payment.Send(ctx, in.Instance, in)
+}
+
+type Order struct {
+	Instance string
+	// If true, shipping the order fails.
+	FailShipping bool
+}
+
+var order = ftl.FSM("order",
+	ftl.Start(Reserved),
+	ftl.Transition(Reserved, Charged),
+	ftl.Transition(Charged, Shipped),
+	ftl.Compensate(Reserved, Released),
+	ftl.Compensate(Charged, Refunded),
+)
+
+//ftl:verb
+func Reserved(ctx context.Context, in Order) error {
+	appendLog("reserved %s", in.Instance)
+	return nil
+}
+
+//ftl:verb
+func Charged(ctx context.Context, in Order) error {
+	appendLog("charged %s", in.Instance)
+	return nil
+}
+
+//ftl:verb
+func Shipped(ctx context.Context, in Order) error {
+	if in.FailShipping {
+		return fmt.Errorf("could not ship %s", in.Instance)
+	}
+	appendLog("shipped %s", in.Instance)
+	return nil
+}
+
+//ftl:verb
+func Released(ctx context.Context, in Order) error {
+	appendLog("released %s", in.Instance)
+	return nil
+}
+
+//ftl:verb
+func Refunded(ctx context.Context, in Order) error {
+	appendLog("refunded %s", in.Instance)
+	return nil
+}
+
+//ftl:verb
+func SendOrder(ctx context.Context, in Order) error {
+	return order.Send(ctx, in.Instance, in)
+}
+
 //ftl:verb
 func SendOne(ctx context.Context, in One) error {
 	return fsm.Send(ctx, in.Instance, in)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/alecthomas/assert/v2"
 
+	"github.com/TBD54566975/ftl/go-runtime/ftl"
 	"github.com/TBD54566975/ftl/go-runtime/ftl/ftltest"
 )
 
@@ -24,3 +25,41 @@ func TestFSM(t *testing.T) {
 	err = fsm.Send(ctx, "one", One{Instance: "one"}) // Invalid, terminated
 	assert.Error(t, err)
 }
+
+func TestFSMTimeout(t *testing.T) {
+	ctx := ftltest.Context()
+
+	err := payment.Send(ctx, "one", Invoice{Instance: "one"}) // -> Invoiced
+	assert.NoError(t, err)
+	err = payment.Send(ctx, "one", Invoice{Instance: "one"}) // Invalid, only the timeout leads to Expired
+	assert.Error(t, err)
+	err = payment.Send(ctx, "one", Payment{Instance: "one"}) // -> Paid
+	assert.NoError(t, err)
+}
+
+func TestFSMNext(t *testing.T) {
+	ctx := ftltest.Context()
+
+	err := fsm.Send(ctx, "one", One{Instance: "one", Chain: true}) // -> Start -> Middle -> End
+	assert.NoError(t, err)
+
+	err = fsm.Send(ctx, "one", One{Instance: "one"}) // Invalid, terminated
+	assert.Error(t, err)
+
+	err = ftl.FSMNext(ctx, One{Instance: "one"}) // Invalid, not in a state verb
+	assert.Error(t, err)
+}
+
+func TestFSMCompensation(t *testing.T) {
+	ctx := ftltest.Context()
+
+	err := order.Send(ctx, "one", Order{Instance: "one"}) // -> Reserved
+	assert.NoError(t, err)
+	err = order.Send(ctx, "one", Order{Instance: "one"}) // -> Charged
+	assert.NoError(t, err)
+	err = order.Send(ctx, "one", Order{Instance: "one", FailShipping: true}) // Fails, -> Refunded -> Released
+	assert.EqualError(t, err, "could not ship one")
+
+	err = order.Send(ctx, "one", Order{Instance: "one"}) // Invalid, failed
+	assert.Error(t, err)
+}
@@ -0,0 +1,47 @@
+package scheduled
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/TBD54566975/ftl/go-runtime/ftl"
+)
+
+type Reminder struct {
+	Instance string
+}
+
+type ScheduleRequest struct {
+	Instance     string
+	DelaySeconds int
+}
+
+//ftl:verb
+func Remind(ctx context.Context, in Reminder) error {
+	appendLog("remind %s", in.Instance)
+	return nil
+}
+
+//ftl:verb
+func ScheduleReminder(ctx context.Context, in ScheduleRequest) error {
+	_, err := ftl.CallAfter(ctx, Remind, Reminder{Instance: in.Instance}, time.Duration(in.DelaySeconds)*time.Second, ftl.WithCallKey(in.Instance))
+	return err
+}
+
+func appendLog(msg string, args ...interface{}) {
+	dest, ok := os.LookupEnv("SCHEDULED_LOG_FILE")
+	if !ok {
+		panic("SCHEDULED_LOG_FILE not set")
+	}
+	w, err := os.OpenFile(dest, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Fprintf(w, msg+"\n", args...)
+	err = w.Close()
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,25 @@
+package scheduled
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/go-runtime/ftl/ftltest"
+)
+
+func TestScheduleReminder(t *testing.T) {
+	logFilePath := filepath.Join(t.TempDir(), "scheduled.log")
+	t.Setenv("SCHEDULED_LOG_FILE", logFilePath)
+	ctx := ftltest.Context(ftltest.WithCallsAllowedWithinModule())
+
+	// Scheduled calls are made immediately in tests.
+	err := ScheduleReminder(ctx, ScheduleRequest{Instance: "1", DelaySeconds: 60})
+	assert.NoError(t, err)
+
+	log, err := os.ReadFile(logFilePath)
+	assert.NoError(t, err)
+	assert.Equal(t, "remind 1\n", string(log))
+}
@@ -0,0 +1,129 @@
+package dal
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/jpillora/backoff"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	"github.com/TBD54566975/ftl/backend/controller/sql/sqltypes"
+	dalerrs "github.com/TBD54566975/ftl/backend/dal"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// WebSocketMessage is a message to send to a WebSocket connection.
+type WebSocketMessage struct {
+	Body []byte
+	// Sent as a binary message if true, otherwise as a text message.
+	Binary bool
+}
+
+// SendWebSocketMessage queues a message for a WebSocket connection held by
+// another controller.
+//
+// Controllers are notified of the message through [DAL.WebSocketMessages],
+// and the controller holding the connection takes it with
+// [DAL.TakeWebSocketMessages]. Messages for connections that have closed are
+// never taken, and are removed by [DAL.DeleteStaleWebSocketMessages].
+func (d *DAL) SendWebSocketMessage(ctx context.Context, connection model.WebSocketConnectionKey, message WebSocketMessage) error {
+	err := d.db.InsertWebSocketMessage(ctx, connection.String(), message.Body, message.Binary)
+	if err != nil {
+		return fmt.Errorf("failed to queue WebSocket message: %w", dalerrs.TranslatePGError(err))
+	}
+	return nil
+}
+
+// TakeWebSocketMessages removes and returns the messages queued for a
+// connection, oldest first.
+func (d *DAL) TakeWebSocketMessages(ctx context.Context, connection model.WebSocketConnectionKey) ([]WebSocketMessage, error) {
+	rows, err := d.db.TakeWebSocketMessages(ctx, connection.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to take WebSocket messages: %w", dalerrs.TranslatePGError(err))
+	}
+	return slices.Map(rows, func(row sql.TakeWebSocketMessagesRow) WebSocketMessage {
+		return WebSocketMessage{Body: row.Body, Binary: row.IsBinary}
+	}), nil
+}
+
+// DeleteStaleWebSocketMessages deletes queued messages older than maxAge,
+// returning the number deleted.
+func (d *DAL) DeleteStaleWebSocketMessages(ctx context.Context, maxAge time.Duration) (int64, error) {
+	count, err := d.db.DeleteStaleWebSocketMessages(ctx, sqltypes.Duration(maxAge))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale WebSocket messages: %w", dalerrs.TranslatePGError(err))
+	}
+	return count, nil
+}
+
+// ListenForWebSocketMessages publishes the key of the connection of each
+// message queued with [DAL.SendWebSocketMessage] to [DAL.WebSocketMessages],
+// until ctx is cancelled.
+//
+// Notifications are received over a dedicated connection from conn. As
+// messages queued while the connection isn't listening are never notified,
+// listening is called each time it starts listening.
+func (d *DAL) ListenForWebSocketMessages(ctx context.Context, conn *stdsql.DB, listening func()) {
+	logger := log.FromContext(ctx)
+	retry := backoff.Backoff{}
+	for {
+		err := d.listenForWebSocketMessages(ctx, conn, func() {
+			retry.Reset()
+			listening()
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Errorf(err, "WebSocket message listener failed")
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-time.After(retry.Duration()):
+		}
+	}
+}
+
+func (d *DAL) listenForWebSocketMessages(ctx context.Context, db *stdsql.DB, listening func()) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Close()
+	return conn.Raw(func(driverConn any) error {
+		stdlibConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("unsupported database driver %T", driverConn)
+		}
+		return d.receiveWebSocketMessages(ctx, stdlibConn.Conn(), listening)
+	})
+}
+
+func (d *DAL) receiveWebSocketMessages(ctx context.Context, conn *pgx.Conn, listening func()) error {
+	logger := log.FromContext(ctx)
+	if _, err := conn.Exec(ctx, "LISTEN websocket_messages_events"); err != nil {
+		return fmt.Errorf("failed to LISTEN to websocket_messages_events: %w", err)
+	}
+	// Don't leave the pooled connection listening.
+	defer func() { _, _ = conn.Exec(context.Background(), "UNLISTEN *") }()
+	logger.Debugf("Listening for WebSocket messages")
+	listening()
+	for {
+		notification, err := waitForNotification(ctx, conn)
+		if err != nil {
+			return fmt.Errorf("failed to receive notification: %w", err)
+		}
+		key, err := model.ParseWebSocketConnectionKey(notification.New)
+		if err != nil {
+			logger.Warnf("Invalid WebSocket connection key %q in notification: %s", notification.New, err)
+			continue
+		}
+		d.WebSocketMessages.Publish(key)
+	}
+}
@@ -0,0 +1,69 @@
+package dal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql/sqltest"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestWebSocketMessages(t *testing.T) {
+	ctx, cancel := context.WithCancel(log.ContextWithNewDefaultLogger(context.Background()))
+	t.Cleanup(cancel)
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn, optional.None[string]())
+	assert.NoError(t, err)
+
+	notifications := make(chan model.WebSocketConnectionKey, 8)
+	dal.WebSocketMessages.Subscribe(notifications)
+	defer dal.WebSocketMessages.Unsubscribe(notifications)
+	go dal.ListenForWebSocketMessages(ctx, conn, func() {})
+
+	connection := model.NewWebSocketConnectionKey("chat", "messages")
+	other := model.NewWebSocketConnectionKey("chat", "messages")
+
+	// Notifications sent before the listener starts are lost, so send messages
+	// to another connection until one is received.
+	for listening := false; !listening; {
+		assert.NoError(t, dal.SendWebSocketMessage(ctx, other, WebSocketMessage{Body: []byte("ping")}))
+		select {
+		case key := <-notifications:
+			assert.Equal(t, other, key)
+			listening = true
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	assert.NoError(t, dal.SendWebSocketMessage(ctx, connection, WebSocketMessage{Body: []byte("hello")}))
+	assert.NoError(t, dal.SendWebSocketMessage(ctx, connection, WebSocketMessage{Body: []byte{0, 1}, Binary: true}))
+	for received := false; !received; {
+		select {
+		case key := <-notifications:
+			received = key.String() == connection.String()
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for notification")
+		}
+	}
+
+	messages, err := dal.TakeWebSocketMessages(ctx, connection)
+	assert.NoError(t, err)
+	assert.Equal(t, []WebSocketMessage{
+		{Body: []byte("hello")},
+		{Body: []byte{0, 1}, Binary: true},
+	}, messages)
+
+	// Messages are only taken once.
+	messages, err = dal.TakeWebSocketMessages(ctx, connection)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(messages))
+
+	deleted, err := dal.DeleteStaleWebSocketMessages(ctx, 0)
+	assert.NoError(t, err)
+	assert.True(t, deleted > 0, "expected messages for the other connection to be deleted")
+}
@@ -0,0 +1,341 @@
+package ingress
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/jwt"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// ErrUnauthenticated is returned by [Authenticator.Authenticate] when a
+// request does not satisfy any of the auth requirements of a verb.
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+const (
+	jwksTTL             = 5 * time.Minute
+	jwksRefreshInterval = 10 * time.Second
+	jwksMaxSize         = 1 << 20
+	jwtLeeway           = 30 * time.Second
+)
+
+// Principal is the authenticated caller of an ingress verb, passed to the
+// verb as builtin.HttpRequest.principal.
+type Principal struct {
+	Scheme  string
+	Subject string
+	Claims  map[string]any
+}
+
+func (p Principal) toMap() map[string]any {
+	claims := p.Claims
+	if claims == nil {
+		claims = map[string]any{}
+	}
+	return map[string]any{
+		"scheme":  p.Scheme,
+		"subject": p.Subject,
+		"claims":  claims,
+	}
+}
+
+// SecretGetter retrieves the value of a secret, decoding it into dest.
+type SecretGetter func(ctx context.Context, ref *schema.Ref, dest any) error
+
+// Authenticator verifies the +auth requirements of ingress verbs.
+type Authenticator struct {
+	secrets          SecretGetter
+	clientCertHeader string
+	client           *http.Client
+	now              func() time.Time
+
+	jwksFetches singleflight.Group
+	lock        sync.Mutex
+	jwks        map[string]*cachedJWKS
+}
+
+type cachedJWKS struct {
+	keys    []jwt.Key
+	fetched time.Time
+	// attempted is when the keys were last fetched or failed to be refreshed.
+	attempted time.Time
+}
+
+// NewAuthenticator creates a new Authenticator.
+//
+// If clientCertHeader is set, a URL encoded PEM client certificate in that
+// header is trusted for mTLS auth, as forwarded by a TLS terminating proxy.
+func NewAuthenticator(secrets SecretGetter, clientCertHeader string) *Authenticator {
+	return &Authenticator{
+		secrets:          secrets,
+		clientCertHeader: clientCertHeader,
+		client:           &http.Client{Timeout: 10 * time.Second},
+		now:              time.Now,
+		jwks:             map[string]*cachedJWKS{},
+	}
+}
+
+// Authenticate a request against the auth requirements of a verb.
+//
+// Returns None if the verb has no auth requirements, or an error wrapping
+// [ErrUnauthenticated] if the request doesn't satisfy any of them.
+func (a *Authenticator) Authenticate(ctx context.Context, r *http.Request, verb *schema.Verb) (optional.Option[Principal], error) {
+	requirements := verb.GetMetadataAuth()
+	if len(requirements) == 0 {
+		return optional.None[Principal](), nil
+	}
+	var internalErr error
+	var reasons []string
+	for _, md := range requirements {
+		var principal optional.Option[Principal]
+		var err error
+		switch md.Scheme {
+		case schema.AuthSchemeJWT:
+			principal, err = a.authenticateJWT(ctx, r, md)
+		case schema.AuthSchemeAPIKey:
+			principal, err = a.authenticateAPIKey(ctx, r, md)
+		case schema.AuthSchemeMTLS:
+			principal, err = a.authenticateMTLS(r)
+		default:
+			err = fmt.Errorf("unsupported auth scheme %q", md.Scheme)
+		}
+		if p, ok := principal.Get(); ok {
+			return optional.Some(p), nil
+		}
+		var authErr unauthenticatedError
+		switch {
+		case errors.As(err, &authErr):
+			reasons = append(reasons, authErr.reason)
+		case err != nil:
+			internalErr = errors.Join(internalErr, fmt.Errorf("%s auth: %w", md.Scheme, err))
+		}
+	}
+	if internalErr != nil {
+		return optional.None[Principal](), internalErr
+	}
+	return optional.None[Principal](), fmt.Errorf("%w: %s", ErrUnauthenticated, strings.Join(reasons, "; "))
+}
+
+// Challenges returns the WWW-Authenticate challenges for a verb.
+func Challenges(verb *schema.Verb) []string {
+	var challenges []string
+	for _, md := range verb.GetMetadataAuth() {
+		if md.Scheme == schema.AuthSchemeJWT {
+			return append(challenges, "Bearer")
+		}
+	}
+	return challenges
+}
+
+type unauthenticatedError struct{ reason string }
+
+func (e unauthenticatedError) Error() string        { return ErrUnauthenticated.Error() + ": " + e.reason }
+func (e unauthenticatedError) Is(target error) bool { return target == ErrUnauthenticated }
+
+func unauthenticated(format string, args ...any) error {
+	return unauthenticatedError{reason: fmt.Sprintf(format, args...)}
+}
+
+func (a *Authenticator) authenticateJWT(ctx context.Context, r *http.Request, md *schema.MetadataAuth) (optional.Option[Principal], error) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return optional.None[Principal](), unauthenticated("missing bearer token")
+	}
+	keys, err := a.jwtKeys(ctx, md, false)
+	if err != nil {
+		return optional.None[Principal](), err
+	}
+	validation := jwt.Validation{Issuer: md.Issuer, Audience: md.Audience, Now: a.now(), Leeway: jwtLeeway}
+	claims, err := jwt.Verify(token, keys, validation)
+	if errors.Is(err, jwt.ErrUnknownKey) && md.JWKS != "" {
+		// The signing keys may have been rotated since they were fetched.
+		if keys, err = a.jwtKeys(ctx, md, true); err != nil {
+			return optional.None[Principal](), err
+		}
+		claims, err = jwt.Verify(token, keys, validation)
+	}
+	if err != nil {
+		return optional.None[Principal](), unauthenticated("invalid bearer token: %s", err)
+	}
+	return optional.Some(Principal{Scheme: schema.AuthSchemeJWT, Subject: claims.Subject(), Claims: claims}), nil
+}
+
+func (a *Authenticator) jwtKeys(ctx context.Context, md *schema.MetadataAuth, refresh bool) ([]jwt.Key, error) {
+	if md.Secret != nil {
+		var value string
+		if err := a.secrets(ctx, md.Secret, &value); err != nil {
+			return nil, fmt.Errorf("failed to get secret %s: %w", md.Secret, err)
+		}
+		keys, err := jwt.ParseKeys([]byte(value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid keys in secret %s: %w", md.Secret, err)
+		}
+		return keys, nil
+	}
+	return a.fetchJWKS(ctx, md.JWKS, refresh)
+}
+
+// fetchJWKS returns the keys of a JWKS endpoint, fetching them if they aren't cached.
+//
+// Concurrent fetches of the same endpoint are coalesced, and previously fetched
+// keys continue to be used if they can't be refreshed.
+func (a *Authenticator) fetchJWKS(ctx context.Context, jwksURL string, refresh bool) ([]jwt.Key, error) {
+	a.lock.Lock()
+	cached, ok := a.jwks[jwksURL]
+	a.lock.Unlock()
+	if ok {
+		now := a.now()
+		age := now.Sub(cached.fetched)
+		// Limit refreshes so that tokens with unknown key IDs can't be used to flood the JWKS endpoint.
+		if (age < jwksTTL && (!refresh || age < jwksRefreshInterval)) || now.Sub(cached.attempted) < jwksRefreshInterval {
+			return cached.keys, nil
+		}
+	}
+	keys, err, _ := a.jwksFetches.Do(jwksURL, func() (any, error) {
+		// The fetch is shared with other requests, so it must not be cancelled along with this one.
+		keys, err := a.requestJWKS(context.WithoutCancel(ctx), jwksURL)
+		now := a.now()
+		a.lock.Lock()
+		defer a.lock.Unlock()
+		if err != nil {
+			if cached, ok := a.jwks[jwksURL]; ok {
+				a.jwks[jwksURL] = &cachedJWKS{keys: cached.keys, fetched: cached.fetched, attempted: now}
+			}
+			return nil, err
+		}
+		a.jwks[jwksURL] = &cachedJWKS{keys: keys, fetched: now, attempted: now}
+		return keys, nil
+	})
+	if err != nil {
+		if ok {
+			log.FromContext(ctx).Warnf("Failed to refresh JWKS from %s, using previously fetched keys: %s", jwksURL, err)
+			return cached.keys, nil
+		}
+		return nil, err
+	}
+	return keys.([]jwt.Key), nil //nolint:forcetypeassert
+}
+
+func (a *Authenticator) requestJWKS(ctx context.Context, jwksURL string) ([]jwt.Key, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWKS URL: %w", err)
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch JWKS from %s: %s", jwksURL, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, jwksMaxSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS: %w", err)
+	}
+	return jwt.ParseJWKS(data)
+}
+
+func (a *Authenticator) authenticateAPIKey(ctx context.Context, r *http.Request, md *schema.MetadataAuth) (optional.Option[Principal], error) {
+	header := md.APIKeyHeader()
+	key := r.Header.Get(header)
+	if key == "" {
+		return optional.None[Principal](), unauthenticated("missing API key in %s header", header)
+	}
+	var value any
+	if err := a.secrets(ctx, md.Secret, &value); err != nil {
+		return optional.None[Principal](), fmt.Errorf("failed to get secret %s: %w", md.Secret, err)
+	}
+	// Compare against every key so that timing doesn't reveal which one matched.
+	subject := optional.None[string]()
+	match := func(candidate any, candidateSubject string) {
+		if s, ok := candidate.(string); ok && s != "" && subtle.ConstantTimeCompare([]byte(s), []byte(key)) == 1 {
+			subject = optional.Some(candidateSubject)
+		}
+	}
+	switch value := value.(type) {
+	case string:
+		match(value, "0")
+	case []any:
+		for i, candidate := range value {
+			match(candidate, strconv.Itoa(i))
+		}
+	case map[string]any:
+		for candidate, candidateSubject := range value {
+			if s, ok := candidateSubject.(string); ok {
+				match(candidate, s)
+			}
+		}
+	default:
+		return optional.None[Principal](), fmt.Errorf("secret %s must be a string, list of strings or map of strings, not %T", md.Secret, value)
+	}
+	s, ok := subject.Get()
+	if !ok {
+		return optional.None[Principal](), unauthenticated("invalid API key")
+	}
+	return optional.Some(Principal{Scheme: schema.AuthSchemeAPIKey, Subject: s}), nil
+}
+
+func (a *Authenticator) authenticateMTLS(r *http.Request) (optional.Option[Principal], error) {
+	var cert *x509.Certificate
+	switch {
+	case r.TLS != nil && len(r.TLS.VerifiedChains) > 0:
+		cert = r.TLS.VerifiedChains[0][0]
+
+	case a.clientCertHeader != "" && r.Header.Get(a.clientCertHeader) != "":
+		data, err := url.QueryUnescape(r.Header.Get(a.clientCertHeader))
+		if err != nil {
+			return optional.None[Principal](), unauthenticated("invalid client certificate header: %s", err)
+		}
+		block, _ := pem.Decode([]byte(data))
+		if block == nil || block.Type != "CERTIFICATE" {
+			return optional.None[Principal](), unauthenticated("invalid client certificate header: expected a PEM encoded certificate")
+		}
+		cert, err = x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return optional.None[Principal](), unauthenticated("invalid client certificate: %s", err)
+		}
+
+	default:
+		return optional.None[Principal](), unauthenticated("missing client certificate")
+	}
+	now := a.now()
+	if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return optional.None[Principal](), unauthenticated("client certificate is not valid at %s", now.Format(time.RFC3339))
+	}
+	return optional.Some(Principal{
+		Scheme:  schema.AuthSchemeMTLS,
+		Subject: cert.Subject.String(),
+		Claims: map[string]any{
+			"issuer":         cert.Issuer.String(),
+			"serialNumber":   cert.SerialNumber.String(),
+			"notBefore":      cert.NotBefore.UTC().Format(time.RFC3339),
+			"notAfter":       cert.NotAfter.UTC().Format(time.RFC3339),
+			"dnsNames":       stringsToAny(cert.DNSNames),
+			"emailAddresses": stringsToAny(cert.EmailAddresses),
+		},
+	}), nil
+}
+
+func stringsToAny(values []string) []any {
+	out := make([]any, len(values))
+	for i, v := range values {
+		out[i] = v
+	}
+	return out
+}
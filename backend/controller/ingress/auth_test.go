@@ -0,0 +1,302 @@
+package ingress
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/jwt"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestAuthenticate(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	rotatedKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	var jwks atomic.Value
+	jwks.Store(jwksFor(map[string]*rsa.PublicKey{"a": &rsaKey.PublicKey}))
+	var fetches atomic.Int32
+	var unavailable atomic.Bool
+	jwksServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		fetches.Add(1)
+		if unavailable.Load() {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write(jwks.Load().([]byte)) //nolint:forcetypeassert
+	}))
+	t.Cleanup(jwksServer.Close)
+
+	sch, err := schema.ParseString("test", fmt.Sprintf(`
+		module test {
+			secret jwtKey String
+			secret apiKeys {String: String}
+
+			export verb public(HttpRequest<Unit>) HttpResponse<Unit, Unit>
+				+ingress http GET /public
+
+			export verb jwks(HttpRequest<Unit>) HttpResponse<Unit, Unit>
+				+ingress http GET /jwks
+				+auth jwt issuer "issuer" audience "api" jwks %q
+
+			export verb secret(HttpRequest<Unit>) HttpResponse<Unit, Unit>
+				+ingress http GET /secret
+				+auth jwt secret test.jwtKey
+				+auth apikey header "X-Token" secret test.apiKeys
+
+			export verb mtls(HttpRequest<Unit>) HttpResponse<Unit, Unit>
+				+ingress http GET /mtls
+				+auth mtls
+		}
+	`, jwksServer.URL+"/"))
+	assert.NoError(t, err)
+
+	secrets := map[string]any{
+		"jwtKey":  fmt.Sprintf(`{"keys": [{"kty": "oct", "k": %q}]}`, base64.RawURLEncoding.EncodeToString([]byte("hmac-secret-hmac-secret-hmac-sec"))),
+		"apiKeys": map[string]any{"key-1": "alice", "key-2": "bob"},
+	}
+	authenticator := NewAuthenticator(func(ctx context.Context, ref *schema.Ref, dest any) error {
+		value, ok := secrets[ref.Name]
+		if !ok || ref.Module != "test" {
+			return fmt.Errorf("unknown secret %s", ref)
+		}
+		data, err := json.Marshal(value)
+		assert.NoError(t, err)
+		return json.Unmarshal(data, dest)
+	}, "X-Client-Cert")
+	authenticator.now = func() time.Time { return now }
+
+	sign := func(key any, alg, kid string, claims jwt.Claims) string {
+		token, err := jwt.Sign(claims, alg, kid, key)
+		assert.NoError(t, err)
+		return token
+	}
+	validClaims := jwt.Claims{"sub": "alice", "iss": "issuer", "aud": "api", "exp": float64(now.Add(time.Hour).Unix())}
+	clientCert := selfSignedCert(t, now)
+
+	for _, test := range []struct {
+		name      string
+		verb      string
+		headers   map[string]string
+		principal optional.Option[Principal]
+		err       string
+	}{
+		{name: "NoAuth", verb: "public"},
+		{name: "JWKS",
+			verb:      "jwks",
+			headers:   map[string]string{"Authorization": "Bearer " + sign(rsaKey, "RS256", "a", validClaims)},
+			principal: optional.Some(Principal{Scheme: "jwt", Subject: "alice", Claims: validClaims})},
+		{name: "MissingBearerToken",
+			verb: "jwks",
+			err:  "unauthenticated: missing bearer token"},
+		{name: "WrongAudience",
+			verb:    "jwks",
+			headers: map[string]string{"Authorization": "Bearer " + sign(rsaKey, "RS256", "a", jwt.Claims{"iss": "issuer", "aud": "other"})},
+			err:     "unauthenticated: invalid bearer token: invalid token: go-jose/go-jose/jwt: validation failed, invalid audience claim (aud)"},
+		{name: "Expired",
+			verb:    "jwks",
+			headers: map[string]string{"Authorization": "Bearer " + sign(rsaKey, "RS256", "a", jwt.Claims{"iss": "issuer", "aud": "api", "exp": float64(now.Add(-time.Hour).Unix())})},
+			err:     "unauthenticated: invalid bearer token: invalid token: go-jose/go-jose/jwt: validation failed, token is expired (exp)"},
+		{name: "JWTSecret",
+			verb:      "secret",
+			headers:   map[string]string{"Authorization": "bearer " + sign([]byte("hmac-secret-hmac-secret-hmac-sec"), "HS256", "", jwt.Claims{"sub": "bob"})},
+			principal: optional.Some(Principal{Scheme: "jwt", Subject: "bob", Claims: jwt.Claims{"sub": "bob"}})},
+		{name: "APIKey",
+			verb:      "secret",
+			headers:   map[string]string{"X-Token": "key-2"},
+			principal: optional.Some(Principal{Scheme: "apikey", Subject: "bob"})},
+		{name: "InvalidAPIKeyAndToken",
+			verb:    "secret",
+			headers: map[string]string{"X-Token": "key-3", "Authorization": "Bearer " + sign([]byte("other-secret-other-secret-other-"), "HS256", "", jwt.Claims{})},
+			err:     "unauthenticated: invalid bearer token: no key matching token; invalid API key"},
+		{name: "ClientCert",
+			verb:    "mtls",
+			headers: map[string]string{"X-Client-Cert": url.QueryEscape(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: clientCert.Raw})))},
+			principal: optional.Some(Principal{Scheme: "mtls", Subject: "CN=client", Claims: map[string]any{
+				"issuer":         "CN=client",
+				"serialNumber":   "1",
+				"notBefore":      "2024-09-01T11:00:00Z",
+				"notAfter":       "2024-09-01T13:00:00Z",
+				"dnsNames":       []any{"client.example.com"},
+				"emailAddresses": []any{},
+			}})},
+		{name: "MissingClientCert",
+			verb: "mtls",
+			err:  "unauthenticated: missing client certificate"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			verb := &schema.Verb{}
+			assert.NoError(t, sch.ResolveToType(&schema.Ref{Module: "test", Name: test.verb}, verb))
+			r := httptest.NewRequest(http.MethodGet, "/"+test.verb, nil)
+			for key, value := range test.headers {
+				r.Header.Set(key, value)
+			}
+			principal, err := authenticator.Authenticate(ctx, r, verb)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				assert.IsError(t, err, ErrUnauthenticated)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.principal, principal)
+		})
+	}
+
+	t.Run("KeyRotation", func(t *testing.T) {
+		verb := &schema.Verb{}
+		assert.NoError(t, sch.ResolveToType(&schema.Ref{Module: "test", Name: "jwks"}, verb))
+		jwks.Store(jwksFor(map[string]*rsa.PublicKey{"a": &rsaKey.PublicKey, "b": &rotatedKey.PublicKey}))
+		r := httptest.NewRequest(http.MethodGet, "/jwks", nil)
+		r.Header.Set("Authorization", "Bearer "+sign(rotatedKey, "RS256", "b", validClaims))
+
+		// Cached keys are only refreshed once per interval.
+		before := fetches.Load()
+		_, err := authenticator.Authenticate(ctx, r, verb)
+		assert.IsError(t, err, ErrUnauthenticated)
+		assert.Equal(t, before, fetches.Load())
+
+		now = now.Add(jwksRefreshInterval)
+		principal, err := authenticator.Authenticate(ctx, r, verb)
+		assert.NoError(t, err)
+		assert.Equal(t, "alice", principal.MustGet().Subject)
+		assert.Equal(t, before+1, fetches.Load())
+	})
+
+	t.Run("StaleKeys", func(t *testing.T) {
+		verb := &schema.Verb{}
+		assert.NoError(t, sch.ResolveToType(&schema.Ref{Module: "test", Name: "jwks"}, verb))
+		r := httptest.NewRequest(http.MethodGet, "/jwks", nil)
+		r.Header.Set("Authorization", "Bearer "+sign(rsaKey, "RS256", "a", validClaims))
+
+		// Keys that can't be refreshed continue to be used, and refreshing is retried once per interval.
+		unavailable.Store(true)
+		t.Cleanup(func() { unavailable.Store(false) })
+		now = now.Add(jwksTTL)
+		before := fetches.Load()
+		for range 2 {
+			principal, err := authenticator.Authenticate(ctx, r, verb)
+			assert.NoError(t, err)
+			assert.Equal(t, "alice", principal.MustGet().Subject)
+		}
+		assert.Equal(t, before+1, fetches.Load())
+	})
+
+	t.Run("JWKSUnavailable", func(t *testing.T) {
+		verb := &schema.Verb{Metadata: []schema.Metadata{&schema.MetadataAuth{Scheme: "jwt", JWKS: jwksServer.URL + "/missing"}}}
+		r := httptest.NewRequest(http.MethodGet, "/jwks", nil)
+		r.Header.Set("Authorization", "Bearer "+sign(rsaKey, "RS256", "a", validClaims))
+		_, err := authenticator.Authenticate(ctx, r, verb)
+		assert.Error(t, err)
+		assert.NotIsError(t, err, ErrUnauthenticated)
+	})
+}
+
+func TestHandleUnauthenticated(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	sch, err := schema.ParseString("test", `
+		module test {
+			secret apiKeys [String]
+
+			export verb protected(HttpRequest<Unit>) HttpResponse<Unit, Unit>
+				+ingress http GET /protected
+				+auth jwt jwks "https://auth.example.com/jwks.json"
+				+auth apikey secret test.apiKeys
+		}
+	`)
+	assert.NoError(t, err)
+	routes := []dal.IngressRoute{{Path: "/protected", Module: "test", Verb: "protected"}}
+	authenticator := NewAuthenticator(func(ctx context.Context, ref *schema.Ref, dest any) error {
+		return json.Unmarshal([]byte(`["key"]`), dest)
+	}, "")
+
+	var called *ftlv1.CallRequest
+	call := func(ctx context.Context, req *connect.Request[ftlv1.CallRequest], requestKey optional.Option[model.RequestKey], parentRequestKey optional.Option[model.RequestKey], requestSource string) (*connect.Response[ftlv1.CallResponse], error) {
+		called = req.Msg
+		return connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: []byte(`{"body": {}}`)}}), nil
+	}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil).WithContext(ctx)
+	Handle(time.Now(), sch, model.NewRequestKey(model.OriginIngress, "test"), routes, authenticator, nil, DefaultBodyLimits, rec, req, call, nil)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, []string{"Bearer"}, rec.Result().Header.Values("WWW-Authenticate"))
+	assert.Zero(t, called)
+
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/protected", nil).WithContext(ctx)
+	req.Header.Set("X-API-Key", "key")
+	Handle(time.Now(), sch, model.NewRequestKey(model.OriginIngress, "test"), routes, authenticator, nil, DefaultBodyLimits, rec, req, call, nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotZero(t, called)
+	var request map[string]any
+	assert.NoError(t, json.Unmarshal(called.Body, &request))
+	assert.Equal[any](t, map[string]any{"scheme": "apikey", "subject": "0", "claims": map[string]any{}}, request["principal"])
+}
+
+func jwksFor(keys map[string]*rsa.PublicKey) []byte {
+	var set struct {
+		Keys []map[string]string `json:"keys"`
+	}
+	for kid, key := range keys {
+		set.Keys = append(set.Keys, map[string]string{
+			"kty": "RSA",
+			"kid": kid,
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	data, err := json.Marshal(set)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+func selfSignedCert(t *testing.T, now time.Time) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		DNSNames:     []string{"client.example.com"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	return cert
+}
@@ -0,0 +1,284 @@
+package ingress
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+// Content types of form request bodies.
+const (
+	FormContentType          = "application/x-www-form-urlencoded"
+	MultipartFormContentType = "multipart/form-data"
+)
+
+// BodyLimits bound the size of ingress request bodies.
+type BodyLimits struct {
+	// MaxBody is the maximum size in bytes of a request body, or 0 for no
+	// limit.
+	//
+	// Uploaded files are passed to verbs in full, so this is what bounds the
+	// memory used by a multipart/form-data request. Multipart forms are
+	// rejected if it is 0.
+	MaxBody int64
+	// MaxFormMemory is the amount of a multipart/form-data request body that
+	// is buffered in memory while it is parsed, beyond which file parts are
+	// written to temporary files.
+	MaxFormMemory int64
+}
+
+// DefaultBodyLimits are the default limits of the controller.
+var DefaultBodyLimits = BodyLimits{MaxBody: 32 << 20, MaxFormMemory: 32 << 20}
+
+// defaultMaxFormSize limits application/x-www-form-urlencoded request bodies
+// when BodyLimits.MaxBody is 0, as http.Request.ParseForm does for bodies
+// that aren't otherwise limited.
+const defaultMaxFormSize = 10 << 20
+
+// errFormTooLarge is returned when the non-file parts of a multipart form
+// exceed the limits of http.Request.ParseMultipartForm.
+var errFormTooLarge = errors.New("form request body too large")
+
+// isFileType returns true if typ is builtin.File.
+func isFileType(typ schema.Type) bool {
+	ref, ok := typ.(*schema.Ref)
+	return ok && ref.Module == "builtin" && ref.Name == "File"
+}
+
+// requestMediaType returns the media type of the request body, if any.
+func requestMediaType(r *http.Request) string {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return ""
+	}
+	return mediaType
+}
+
+// decodeForm decodes an "application/x-www-form-urlencoded" or
+// "multipart/form-data" request body into a map of the fields of the data
+// structure ref.
+//
+// Form values are converted to the type of the corresponding field, and file
+// parts are mapped to builtin.File fields. Form values that don't correspond
+// to a field are ignored.
+func decodeForm(r *http.Request, mediaType string, ref *schema.Ref, sch *schema.Schema, limits BodyLimits) (map[string]any, error) {
+	data, err := sch.ResolveMonomorphised(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	var values url.Values
+	var files map[string][]*multipart.FileHeader
+	if mediaType == MultipartFormContentType {
+		if limits.MaxBody <= 0 {
+			return nil, fmt.Errorf("multipart/form-data request bodies are not accepted without a request body limit")
+		}
+		r.Body = http.MaxBytesReader(nil, r.Body, limits.MaxBody)
+		if err := r.ParseMultipartForm(limits.MaxFormMemory); err != nil {
+			if errors.Is(err, multipart.ErrMessageTooLarge) {
+				return nil, fmt.Errorf("%w: %w", errFormTooLarge, err)
+			}
+			return nil, fmt.Errorf("HTTP request body is not a valid multipart form: %w", err)
+		}
+		defer r.MultipartForm.RemoveAll() //nolint:errcheck
+		values = r.MultipartForm.Value
+		files = r.MultipartForm.File
+	} else {
+		maxSize := limits.MaxBody
+		if maxSize <= 0 {
+			maxSize = defaultMaxFormSize
+		}
+		r.Body = http.MaxBytesReader(nil, r.Body, maxSize)
+		if err := r.ParseForm(); err != nil {
+			return nil, fmt.Errorf("HTTP request body is not a valid form: %w", err)
+		}
+		values = r.PostForm
+	}
+
+	bodyMap := map[string]any{}
+	for _, field := range data.Fields {
+		key := field.Name
+		if alias, ok := field.Alias(schema.AliasKindJSON).Get(); ok {
+			key = alias
+		}
+		var value optional.Option[any]
+		if fileHeaders, ok := files[key]; ok {
+			value, err = formFileValue(field.Type, fileHeaders)
+		} else if formValues, ok := values[key]; ok {
+			value, err = formValue(field.Type, formValues)
+		} else {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse form field %q: %w", key, err)
+		}
+		if v, ok := value.Get(); ok {
+			bodyMap[key] = v
+		}
+	}
+	return bodyMap, nil
+}
+
+// formValue converts the values of a form field to the field's type.
+func formValue(typ schema.Type, values []string) (optional.Option[any], error) {
+	switch t := typ.(type) {
+	case *schema.Optional:
+		// Empty inputs in HTML forms are submitted as empty strings.
+		if _, ok := t.Type.(*schema.String); !ok && len(values) == 1 && values[0] == "" {
+			return optional.None[any](), nil
+		}
+		return formValue(t.Type, values)
+
+	case *schema.Array:
+		elements := make([]any, 0, len(values))
+		for _, value := range values {
+			element, err := formValue(t.Element, []string{value})
+			if err != nil {
+				return optional.None[any](), err
+			}
+			if e, ok := element.Get(); ok {
+				elements = append(elements, e)
+			}
+		}
+		return optional.Some[any](elements), nil
+
+	case *schema.Any:
+		if len(values) == 1 {
+			return optional.Some[any](values[0]), nil
+		}
+		elements := make([]any, len(values))
+		for i, value := range values {
+			elements[i] = value
+		}
+		return optional.Some[any](elements), nil
+	}
+
+	if len(values) > 1 {
+		return optional.None[any](), fmt.Errorf("multiple values are not supported")
+	}
+	value := values[0]
+	switch typ.(type) {
+	case *schema.String:
+		return optional.Some[any](value), nil
+
+	case *schema.Int:
+		intVal, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return optional.None[any](), fmt.Errorf("failed to parse integer: %w", err)
+		}
+		return optional.Some[any](intVal), nil
+
+	case *schema.Float:
+		floatVal, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return optional.None[any](), fmt.Errorf("failed to parse float: %w", err)
+		}
+		return optional.Some[any](floatVal), nil
+
+	case *schema.Bool:
+		boolVal, err := strconv.ParseBool(value)
+		if err != nil {
+			return optional.None[any](), fmt.Errorf("failed to parse boolean: %w", err)
+		}
+		return optional.Some[any](boolVal), nil
+
+	case *schema.Bytes:
+		return optional.Some[any]([]byte(value)), nil
+
+	default:
+		if isFileType(typ) {
+			return optional.None[any](), fmt.Errorf("expected a file")
+		}
+		return optional.None[any](), fmt.Errorf("unsupported form field type %s", typ)
+	}
+}
+
+// formFileValue converts the file parts of a form field to the field's type.
+func formFileValue(typ schema.Type, fileHeaders []*multipart.FileHeader) (optional.Option[any], error) {
+	switch t := typ.(type) {
+	case *schema.Optional:
+		return formFileValue(t.Type, fileHeaders)
+
+	case *schema.Array:
+		elements := make([]any, len(fileHeaders))
+		for i, fileHeader := range fileHeaders {
+			element, err := formFileValue(t.Element, []*multipart.FileHeader{fileHeader})
+			if err != nil {
+				return optional.None[any](), err
+			}
+			elements[i], _ = element.Get()
+		}
+		return optional.Some[any](elements), nil
+
+	case *schema.Bytes:
+		if len(fileHeaders) > 1 {
+			return optional.None[any](), fmt.Errorf("multiple files are not supported")
+		}
+		content, err := readFormFile(fileHeaders[0])
+		if err != nil {
+			return optional.None[any](), err
+		}
+		return optional.Some[any](content), nil
+
+	default:
+		if !isFileType(typ) {
+			return optional.None[any](), fmt.Errorf("unexpected file for field of type %s", typ)
+		}
+		if len(fileHeaders) > 1 {
+			return optional.None[any](), fmt.Errorf("multiple files are not supported")
+		}
+		content, err := readFormFile(fileHeaders[0])
+		if err != nil {
+			return optional.None[any](), err
+		}
+		return optional.Some[any](fileValue(fileHeaders[0].Filename, fileHeaders[0].Header.Get("Content-Type"), content)), nil
+	}
+}
+
+func readFormFile(fileHeader *multipart.FileHeader) ([]byte, error) {
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file %q: %w", fileHeader.Filename, err)
+	}
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %q: %w", fileHeader.Filename, err)
+	}
+	return content, nil
+}
+
+// fileFromRequestBody maps a raw request body to a builtin.File.
+//
+// The file name is taken from the "Content-Disposition" header, if present.
+func fileFromRequestBody(r *http.Request) (map[string]any, error) {
+	content, err := readRequestBody(r)
+	if err != nil {
+		return nil, err
+	}
+	var name string
+	if _, params, err := mime.ParseMediaType(r.Header.Get("Content-Disposition")); err == nil {
+		name = params["filename"]
+	}
+	return fileValue(name, r.Header.Get("Content-Type"), content), nil
+}
+
+func fileValue(name, contentType string, content []byte) map[string]any {
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	return map[string]any{
+		"name":        name,
+		"contentType": contentType,
+		"content":     content,
+	}
+}
@@ -0,0 +1,234 @@
+package ingress
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/go-runtime/encoding"
+	"github.com/TBD54566975/ftl/go-runtime/ftl"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+// File mirrors builtin.File.
+type File struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
+
+type FormPayload struct {
+	Name      string
+	Age       ftl.Option[int] `json:"age,omitempty"`
+	Tags      []string
+	Subscribe bool             `json:"subscribe"`
+	Avatar    ftl.Option[File] `json:"avatar,omitempty"`
+	Docs      []File           `json:"docs,omitempty"`
+}
+
+func TestBuildRequestBodyForm(t *testing.T) {
+	sch, err := schema.ParseString("test", `
+		module test {
+			data FormPayload {
+				name String
+				age Int?
+				tags [String]
+				subscribe Bool +alias json "subscribe"
+				avatar builtin.File?
+				docs [builtin.File]
+			}
+
+			export verb postForm(HttpRequest<test.FormPayload>) HttpResponse<Empty, Empty>
+				+ingress http POST /form
+
+			export verb upload(HttpRequest<builtin.File>) HttpResponse<Empty, Empty>
+				+ingress http POST /upload
+		}
+	`)
+	assert.NoError(t, err)
+
+	multipartBody := func() (string, []byte) {
+		buf := &bytes.Buffer{}
+		w := multipart.NewWriter(buf)
+		assert.NoError(t, w.WriteField("name", "Alice"))
+		assert.NoError(t, w.WriteField("age", "42"))
+		assert.NoError(t, w.WriteField("tags", "a"))
+		assert.NoError(t, w.WriteField("tags", "b"))
+		assert.NoError(t, w.WriteField("subscribe", "true"))
+		assert.NoError(t, w.WriteField("ignored", "value"))
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", `form-data; name="avatar"; filename="avatar.png"`)
+		header.Set("Content-Type", "image/png")
+		part, err := w.CreatePart(header)
+		assert.NoError(t, err)
+		_, err = part.Write([]byte("PNG"))
+		assert.NoError(t, err)
+		for _, name := range []string{"a.txt", "b.txt"} {
+			part, err := w.CreateFormFile("docs", name)
+			assert.NoError(t, err)
+			_, err = part.Write([]byte(name))
+			assert.NoError(t, err)
+		}
+		assert.NoError(t, w.Close())
+		return w.FormDataContentType(), buf.Bytes()
+	}
+
+	for _, test := range []struct {
+		name        string
+		path        string
+		contentType string
+		body        []byte
+		expected    any
+		err         string
+	}{
+		{name: "URLEncoded",
+			path:        "/form",
+			contentType: FormContentType,
+			body:        []byte(url.Values{"name": {"Alice"}, "age": {""}, "tags": {"a", "b"}, "subscribe": {"false"}}.Encode()),
+			expected: FormPayload{
+				Name: "Alice",
+				Tags: []string{"a", "b"},
+			}},
+		{name: "URLEncodedInvalidBool",
+			path:        "/form",
+			contentType: FormContentType,
+			body:        []byte(url.Values{"name": {"Alice"}, "subscribe": {"on"}}.Encode()),
+			err:         `failed to parse form field "subscribe": failed to parse boolean: strconv.ParseBool: parsing "on": invalid syntax`},
+		{name: "URLEncodedInvalidInt",
+			path:        "/form",
+			contentType: FormContentType,
+			body:        []byte(url.Values{"name": {"Alice"}, "age": {"old"}}.Encode()),
+			err:         `failed to parse form field "age": failed to parse integer: strconv.ParseInt: parsing "old": invalid syntax`},
+		{name: "URLEncodedMultipleValues",
+			path:        "/form",
+			contentType: FormContentType,
+			body:        []byte(url.Values{"name": {"Alice", "Bob"}}.Encode()),
+			err:         `failed to parse form field "name": multiple values are not supported`},
+		{name: "URLEncodedFileField",
+			path:        "/form",
+			contentType: FormContentType,
+			body:        []byte(url.Values{"name": {"Alice"}, "avatar": {"avatar.png"}}.Encode()),
+			err:         `failed to parse form field "avatar": expected a file`},
+		{name: "Multipart",
+			path: "/form",
+			expected: FormPayload{
+				Name:      "Alice",
+				Age:       ftl.Some(42),
+				Tags:      []string{"a", "b"},
+				Subscribe: true,
+				Avatar:    ftl.Some(File{Name: "avatar.png", ContentType: "image/png", Content: []byte("PNG")}),
+				Docs: []File{
+					{Name: "a.txt", ContentType: "application/octet-stream", Content: []byte("a.txt")},
+					{Name: "b.txt", ContentType: "application/octet-stream", Content: []byte("b.txt")},
+				},
+			}},
+		{name: "MultipartInvalid",
+			path:        "/form",
+			contentType: MultipartFormContentType + "; boundary=missing",
+			body:        []byte("not a multipart body"),
+			err:         "HTTP request body is not a valid multipart form: multipart: NextPart: EOF"},
+		{name: "RawFile",
+			path:        "/upload",
+			contentType: "text/csv",
+			body:        []byte("a,b\n1,2\n"),
+			expected:    File{ContentType: "text/csv", Content: []byte("a,b\n1,2\n")}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if test.name == "Multipart" {
+				test.contentType, test.body = multipartBody()
+			}
+			r, err := http.NewRequest(http.MethodPost, "http://127.0.0.1"+test.path, bytes.NewReader(test.body)) //nolint:noctx
+			assert.NoError(t, err)
+			r.Header.Set("Content-Type", test.contentType)
+			route := &dal.IngressRoute{Path: test.path, Module: "test", Verb: "postForm"}
+			if test.path == "/upload" {
+				route.Verb = "upload"
+			}
+			requestBody, err := BuildRequestBody(route, r, sch, optional.None[Principal](), DefaultBodyLimits)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			assert.NoError(t, err)
+			switch expected := test.expected.(type) {
+			case FormPayload:
+				var actual HTTPRequest[FormPayload]
+				assert.NoError(t, encoding.Unmarshal(requestBody, &actual))
+				assert.Equal(t, expected, actual.Body)
+			case File:
+				var actual HTTPRequest[File]
+				assert.NoError(t, encoding.Unmarshal(requestBody, &actual))
+				assert.Equal(t, expected, actual.Body)
+			}
+		})
+	}
+
+	t.Run("TooLarge", func(t *testing.T) {
+		ctx := log.ContextWithNewDefaultLogger(context.Background())
+		routes := []dal.IngressRoute{{Path: "/form", Module: "test", Verb: "postForm"}}
+		contentType, body := multipartBody()
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/form", bytes.NewReader(body)).WithContext(ctx)
+		req.Header.Set("Content-Type", contentType)
+		req.Body = http.MaxBytesReader(rec, req.Body, int64(len(body)-1))
+		Handle(time.Now(), sch, model.NewRequestKey(model.OriginIngress, "test"), routes, NewAuthenticator(nil, ""), nil, DefaultBodyLimits, rec, req, nil, nil)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+		assert.True(t, strings.HasPrefix(rec.Body.String(), http.StatusText(http.StatusRequestEntityTooLarge)))
+	})
+
+	t.Run("URLEncodedTooLarge", func(t *testing.T) {
+		ctx := log.ContextWithNewDefaultLogger(context.Background())
+		routes := []dal.IngressRoute{{Path: "/form", Module: "test", Verb: "postForm"}}
+		for _, test := range []struct {
+			limits BodyLimits
+			size   int
+		}{
+			{BodyLimits{MaxBody: 1 << 10}, 1 << 10},
+			// Without a body limit, forms are limited to 10MB as by ParseForm.
+			{BodyLimits{}, 10 << 20},
+		} {
+			body := "name=" + strings.Repeat("a", test.size)
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/form", strings.NewReader(body)).WithContext(ctx)
+			req.Header.Set("Content-Type", FormContentType)
+			Handle(time.Now(), sch, model.NewRequestKey(model.OriginIngress, "test"), routes, NewAuthenticator(nil, ""), nil, test.limits, rec, req, nil, nil)
+			assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+		}
+	})
+
+	t.Run("MultipartWithoutBodyLimit", func(t *testing.T) {
+		contentType, body := multipartBody()
+		r, err := http.NewRequest(http.MethodPost, "http://127.0.0.1/form", bytes.NewReader(body)) //nolint:noctx
+		assert.NoError(t, err)
+		r.Header.Set("Content-Type", contentType)
+		_, err = BuildRequestBody(&dal.IngressRoute{Path: "/form", Module: "test", Verb: "postForm"}, r, sch, optional.None[Principal](), BodyLimits{MaxFormMemory: DefaultBodyLimits.MaxFormMemory})
+		assert.EqualError(t, err, "multipart/form-data request bodies are not accepted without a request body limit")
+	})
+
+	t.Run("MaxFormMemory", func(t *testing.T) {
+		// Files that don't fit in memory are read back from disk.
+		contentType, body := multipartBody()
+		r, err := http.NewRequest(http.MethodPost, "http://127.0.0.1/form", bytes.NewReader(body)) //nolint:noctx
+		assert.NoError(t, err)
+		r.Header.Set("Content-Type", contentType)
+		requestBody, err := BuildRequestBody(&dal.IngressRoute{Path: "/form", Module: "test", Verb: "postForm"}, r, sch, optional.None[Principal](), BodyLimits{MaxBody: DefaultBodyLimits.MaxBody, MaxFormMemory: 1})
+		assert.NoError(t, err)
+		var actual HTTPRequest[FormPayload]
+		assert.NoError(t, encoding.Unmarshal(requestBody, &actual))
+		assert.Equal(t, ftl.Some(File{Name: "avatar.png", ContentType: "image/png", Content: []byte("PNG")}), actual.Body.Avatar)
+		assert.Equal(t, 2, len(actual.Body.Docs))
+	})
+}
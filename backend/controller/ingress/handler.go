@@ -21,14 +21,21 @@ import (
 )
 
 // Handle HTTP ingress routes.
+//
+// Requests to "+ingress ws" verbs are upgraded to WebSocket connections held
+// by sockets. Other request bodies are bounded by limits.
 func Handle(
 	startTime time.Time,
 	sch *schema.Schema,
 	requestKey model.RequestKey,
 	routes []dal.IngressRoute,
+	authenticator *Authenticator,
+	sockets *WebSockets,
+	limits BodyLimits,
 	w http.ResponseWriter,
 	r *http.Request,
 	call func(context.Context, *connect.Request[ftlv1.CallRequest], optional.Option[model.RequestKey], optional.Option[model.RequestKey], string) (*connect.Response[ftlv1.CallResponse], error),
+	callStream func(context.Context, *connect.Request[ftlv1.CallRequest], optional.Option[model.RequestKey], optional.Option[model.RequestKey], string, func(*ftlv1.CallResponse) error) error,
 ) {
 	logger := log.FromContext(r.Context())
 	logger.Debugf("%s %s", r.Method, r.URL.Path)
@@ -47,9 +54,50 @@ func Handle(
 
 	verbRef := &schemapb.Ref{Module: route.Module, Name: route.Verb}
 
-	body, err := BuildRequestBody(route, r, sch)
+	verb := &schema.Verb{}
+	err = sch.ResolveToType(&schema.Ref{Name: route.Verb, Module: route.Module}, verb)
+	if err != nil {
+		logger.Errorf(err, "could not resolve schema type for verb %s", route.Verb)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		observability.Ingress.Request(r.Context(), r.Method, r.URL.Path, optional.Some(verbRef), startTime, optional.Some("could not resolve schema type for verb"))
+		return
+	}
+
+	principal, err := authenticator.Authenticate(r.Context(), r, verb)
+	if err != nil {
+		if errors.Is(err, ErrUnauthenticated) {
+			// Only log at debug, as this is a client side error
+			logger.Debugf("%s %s: %s", r.Method, r.URL.Path, err)
+			for _, challenge := range Challenges(verb) {
+				w.Header().Add("WWW-Authenticate", challenge)
+			}
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			observability.Ingress.Request(r.Context(), r.Method, r.URL.Path, optional.Some(verbRef), startTime, optional.Some("unauthenticated"))
+			return
+		}
+		logger.Errorf(err, "failed to authenticate request to verb %s", route.Verb)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		observability.Ingress.Request(r.Context(), r.Method, r.URL.Path, optional.Some(verbRef), startTime, optional.Some("failed to authenticate"))
+		return
+	}
+
+	if metadata, ok := verb.GetMetadataIngress().Get(); ok && metadata.Type == "ws" {
+		sockets.serve(startTime, sch, route, verb, w, r, call)
+		return
+	}
+
+	if limits.MaxBody > 0 {
+		r.Body = http.MaxBytesReader(w, r.Body, limits.MaxBody)
+	}
+	body, err := BuildRequestBody(route, r, sch, principal, limits)
 	if err != nil {
 		// Only log at debug, as this is a client side error
+		if maxBytesErr := new(http.MaxBytesError); errors.As(err, &maxBytesErr) || errors.Is(err, errFormTooLarge) {
+			logger.Debugf("request body too large: %s", err.Error())
+			http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+			observability.Ingress.Request(r.Context(), r.Method, r.URL.Path, optional.Some(verbRef), startTime, optional.Some("request body too large"))
+			return
+		}
 		logger.Debugf("bad request: %s", err.Error())
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		observability.Ingress.Request(r.Context(), r.Method, r.URL.Path, optional.Some(verbRef), startTime, optional.Some("bad request"))
@@ -61,6 +109,13 @@ func Handle(
 		Verb:     verbRef,
 		Body:     body,
 	})
+	if verb.Stream {
+		handleStream(startTime, requestKey, verb, w, r, creq, callStream)
+		return
+	}
+	if key := r.Header.Get("Idempotency-Key"); key != "" {
+		creq.Msg.IdempotencyKey = &key
+	}
 
 	resp, err := call(r.Context(), creq, optional.Some(requestKey), optional.None[model.RequestKey](), r.RemoteAddr)
 	if err != nil {
@@ -77,14 +132,6 @@ func Handle(
 	}
 	switch msg := resp.Msg.Response.(type) {
 	case *ftlv1.CallResponse_Body:
-		verb := &schema.Verb{}
-		err = sch.ResolveToType(&schema.Ref{Name: route.Verb, Module: route.Module}, verb)
-		if err != nil {
-			logger.Errorf(err, "could not resolve schema type for verb %s", route.Verb)
-			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-			observability.Ingress.Request(r.Context(), r.Method, r.URL.Path, optional.Some(verbRef), startTime, optional.Some("could not resolve schema type for verb"))
-			return
-		}
 		var responseBody []byte
 
 		if metadata, ok := verb.GetMetadataIngress().Get(); ok && metadata.Type == "http" {
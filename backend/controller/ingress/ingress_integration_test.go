@@ -3,14 +3,19 @@
 package ingress_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/alecthomas/assert/v2"
 	"github.com/alecthomas/repr"
+	"github.com/gorilla/websocket"
 
+	"github.com/TBD54566975/ftl/backend/controller/ingress"
 	in "github.com/TBD54566975/ftl/integration"
+	"github.com/TBD54566975/ftl/internal/slices"
 )
 
 func TestHttpIngress(t *testing.T) {
@@ -187,3 +192,77 @@ func TestHttpIngressWithCors(t *testing.T) {
 		}),
 	)
 }
+
+func TestOpenAPI(t *testing.T) {
+	in.Run(t,
+		in.CopyModule("httpingress"),
+		in.Deploy("httpingress"),
+		in.ExecWithOutput("ftl", []string{"schema", "openapi"}, func(output string) {
+			var doc ingress.OpenAPI
+			assert.NoError(t, json.Unmarshal([]byte(output), &doc))
+			get, ok := doc.Paths["/users/{userId}/posts/{postId}"]["get"]
+			assert.True(t, ok, "missing GET /users/{userId}/posts/{postId}: %s", output)
+			assert.Equal(t, "httpingress.get", get.OperationID)
+			assert.Equal(t, []string{"userId", "postId"}, slices.Map(get.Parameters, func(p ingress.OpenAPIParameter) string { return p.Name }))
+			_, ok = doc.Paths["/users"]["post"]
+			assert.True(t, ok, "missing POST /users: %s", output)
+		}),
+	)
+}
+
+func TestHttpIngressAuth(t *testing.T) {
+	secretsPath, err := filepath.Abs("testdata/secrets.json")
+	assert.NoError(t, err)
+	in.Run(t,
+		in.CopyModule("httpingress"),
+		in.Deploy("httpingress"),
+		in.Exec("ftl", "secret", "import", "--inline", secretsPath),
+		in.HttpCall(http.MethodGet, "/whoami", nil, nil, func(t testing.TB, resp *in.HTTPResponse) {
+			assert.Equal(t, 401, resp.Status)
+		}),
+		in.HttpCall(http.MethodGet, "/whoami", map[string][]string{"X-API-Key": {"key-2"}}, nil, func(t testing.TB, resp *in.HTTPResponse) {
+			assert.Equal(t, 401, resp.Status)
+		}),
+		in.HttpCall(http.MethodGet, "/whoami", map[string][]string{"X-API-Key": {"key-1"}}, nil, func(t testing.TB, resp *in.HTTPResponse) {
+			assert.Equal(t, 200, resp.Status)
+			assert.Equal(t, []byte("apikey:alice"), resp.BodyBytes)
+		}),
+	)
+}
+
+func TestHttpIngressStream(t *testing.T) {
+	in.Run(t,
+		in.CopyModule("httpingress"),
+		in.Deploy("httpingress"),
+		in.HttpCall(http.MethodGet, "/progress", nil, nil, func(t testing.TB, resp *in.HTTPResponse) {
+			assert.Equal(t, 200, resp.Status)
+			assert.Equal(t, []string{"application/x-ndjson"}, resp.Headers["Content-Type"])
+			assert.Equal(t, "{\"percent\":25}\n{\"percent\":50}\n{\"percent\":75}\n{\"percent\":100}\n", string(resp.BodyBytes))
+		}),
+		in.HttpCall(http.MethodGet, "/progress", map[string][]string{"Accept": {"text/event-stream"}}, nil, func(t testing.TB, resp *in.HTTPResponse) {
+			assert.Equal(t, 200, resp.Status)
+			assert.Equal(t, []string{"text/event-stream"}, resp.Headers["Content-Type"])
+			assert.Equal(t, "data: {\"percent\":25}\n\ndata: {\"percent\":50}\n\ndata: {\"percent\":75}\n\ndata: {\"percent\":100}\n\n", string(resp.BodyBytes))
+		}),
+	)
+}
+
+func TestHttpIngressWebSocket(t *testing.T) {
+	in.Run(t,
+		in.CopyModule("httpingress"),
+		in.Deploy("httpingress"),
+		func(t testing.TB, ic in.TestContext) {
+			in.Infof("WebSocket /chat")
+			conn, resp, err := websocket.DefaultDialer.DialContext(ic, "ws://localhost:8891/chat", nil)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+			defer conn.Close()
+
+			assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"text":"hello"}`)))
+			messageType, data, err := conn.ReadMessage()
+			assert.NoError(t, err)
+			assert.Equal(t, websocket.TextMessage, messageType)
+			assert.Equal(t, `{"text":"echo: hello"}`, string(data))
+		},
+	)
+}
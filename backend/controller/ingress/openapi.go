@@ -0,0 +1,386 @@
+package ingress
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/alecthomas/types/optional"
+	"github.com/swaggest/jsonschema-go"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+const openAPIComponentsPrefix = "#/components/schemas/"
+
+// OpenAPIOptions controls the top-level metadata of a generated OpenAPI document.
+type OpenAPIOptions struct {
+	// Title of the API, defaults to "FTL".
+	Title string
+	// Version of the API, defaults to "1.0.0".
+	Version string
+	// Servers are the base URLs the ingress routes are served from.
+	Servers []string
+}
+
+// OpenAPI is an OpenAPI 3.1 document.
+//
+// Only the subset of the specification generated by FTL is modelled.
+type OpenAPI struct {
+	OpenAPI    string                     `json:"openapi"`
+	Info       OpenAPIInfo                `json:"info"`
+	Servers    []OpenAPIServer            `json:"servers,omitempty"`
+	Paths      map[string]OpenAPIPathItem `json:"paths"`
+	Components OpenAPIComponents          `json:"components,omitempty"`
+}
+
+type OpenAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type OpenAPIServer struct {
+	URL string `json:"url"`
+}
+
+// OpenAPIPathItem maps lower-case HTTP methods to operations.
+type OpenAPIPathItem map[string]*OpenAPIOperation
+
+type OpenAPIOperation struct {
+	OperationID string                     `json:"operationId"`
+	Summary     string                     `json:"summary,omitempty"`
+	Description string                     `json:"description,omitempty"`
+	Tags        []string                   `json:"tags,omitempty"`
+	Parameters  []OpenAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *OpenAPIRequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]OpenAPIResponse `json:"responses"`
+	Security    []map[string][]string      `json:"security,omitempty"`
+}
+
+type OpenAPIParameter struct {
+	Name        string                      `json:"name"`
+	In          string                      `json:"in"`
+	Description string                      `json:"description,omitempty"`
+	Required    bool                        `json:"required,omitempty"`
+	Schema      *jsonschema.Schema          `json:"schema,omitempty"`
+	Content     map[string]OpenAPIMediaType `json:"content,omitempty"`
+}
+
+type OpenAPIRequestBody struct {
+	Required bool                        `json:"required,omitempty"`
+	Content  map[string]OpenAPIMediaType `json:"content"`
+}
+
+type OpenAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]OpenAPIMediaType `json:"content,omitempty"`
+}
+
+type OpenAPIMediaType struct {
+	Schema *jsonschema.Schema `json:"schema,omitempty"`
+}
+
+type OpenAPIComponents struct {
+	Schemas         map[string]jsonschema.SchemaOrBool `json:"schemas,omitempty"`
+	SecuritySchemes map[string]OpenAPISecurityScheme   `json:"securitySchemes,omitempty"`
+}
+
+type OpenAPISecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	In           string `json:"in,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+// GenerateOpenAPI generates an OpenAPI 3.1 document describing all HTTP
+// ingress routes in the schema.
+//
+// Request parameters and bodies follow the same rules used to decode
+// incoming requests in BuildRequestBody.
+func GenerateOpenAPI(sch *schema.Schema, options OpenAPIOptions) (*OpenAPI, error) {
+	if options.Title == "" {
+		options.Title = "FTL"
+	}
+	if options.Version == "" {
+		options.Version = "1.0.0"
+	}
+	doc := &OpenAPI{
+		OpenAPI: "3.1.0",
+		Info:    OpenAPIInfo{Title: options.Title, Version: options.Version},
+		Paths:   map[string]OpenAPIPathItem{},
+	}
+	for _, server := range options.Servers {
+		doc.Servers = append(doc.Servers, OpenAPIServer{URL: server})
+	}
+	components := schema.NewJSONSchemaComponents(sch, openAPIComponentsPrefix)
+	for _, module := range sch.Modules {
+		for _, decl := range module.Decls {
+			verb, ok := decl.(*schema.Verb)
+			if !ok {
+				continue
+			}
+			ingress, ok := verb.GetMetadataIngress().Get()
+			if !ok || ingress.Type != "http" {
+				continue
+			}
+			op, err := openAPIOperation(sch, components, module.Name, verb, ingress)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", module.Name, verb.Name, err)
+			}
+			path := openAPIPath(ingress)
+			item, ok := doc.Paths[path]
+			if !ok {
+				item = OpenAPIPathItem{}
+				doc.Paths[path] = item
+			}
+			item[strings.ToLower(ingress.Method)] = op
+
+			// Any one of the verb's auth requirements is sufficient.
+			for _, auth := range verb.GetMetadataAuth() {
+				name, scheme := openAPISecurityScheme(auth)
+				if doc.Components.SecuritySchemes == nil {
+					doc.Components.SecuritySchemes = map[string]OpenAPISecurityScheme{}
+				}
+				doc.Components.SecuritySchemes[name] = scheme
+				op.Security = append(op.Security, map[string][]string{name: {}})
+			}
+		}
+	}
+	definitions, err := components.Definitions()
+	if err != nil {
+		return nil, err
+	}
+	doc.Components.Schemas = definitions
+	return doc, nil
+}
+
+func openAPISecurityScheme(auth *schema.MetadataAuth) (string, OpenAPISecurityScheme) {
+	switch auth.Scheme {
+	case schema.AuthSchemeJWT:
+		return "jwt", OpenAPISecurityScheme{Type: "http", Scheme: "bearer", BearerFormat: "JWT"}
+	case schema.AuthSchemeAPIKey:
+		header := auth.APIKeyHeader()
+		return "apikey-" + http.CanonicalHeaderKey(header), OpenAPISecurityScheme{Type: "apiKey", In: "header", Name: header}
+	default:
+		return "mtls", OpenAPISecurityScheme{Type: "mutualTLS"}
+	}
+}
+
+func openAPIPath(ingress *schema.MetadataIngress) string {
+	path := make([]string, len(ingress.Path))
+	for i, p := range ingress.Path {
+		switch v := p.(type) {
+		case *schema.IngressPathLiteral:
+			path[i] = v.Text
+		case *schema.IngressPathParameter:
+			path[i] = "{" + v.Name + "}"
+		}
+	}
+	return "/" + strings.Join(path, "/")
+}
+
+func openAPIOperation(sch *schema.Schema, components *schema.JSONSchemaComponents, module string, verb *schema.Verb, ingress *schema.MetadataIngress) (*OpenAPIOperation, error) {
+	op := &OpenAPIOperation{
+		OperationID: module + "." + verb.Name,
+		Description: strings.Join(verb.Comments, "\n"),
+		Tags:        []string{module},
+		Responses:   map[string]OpenAPIResponse{},
+	}
+	if len(verb.Comments) > 0 {
+		op.Summary = verb.Comments[0]
+	}
+
+	request, ok := verb.Request.(*schema.Ref)
+	if !ok {
+		return nil, fmt.Errorf("verb %s input must be a data structure", verb.Name)
+	}
+	bodyField, err := getBodyField(request, sch)
+	if err != nil {
+		return nil, err
+	}
+	var bodyData *schema.Data
+	if ref, ok := bodyField.Type.(*schema.Ref); ok {
+		if err := sch.ResolveToType(ref, &schema.Data{}); err == nil {
+			bodyData, err = sch.ResolveMonomorphised(ref)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Path parameters take the type of the matching body field, if any.
+	pathParameters := map[string]bool{}
+	for _, component := range ingress.Path {
+		param, ok := component.(*schema.IngressPathParameter)
+		if !ok {
+			continue
+		}
+		pathParameters[param.Name] = true
+		parameter := OpenAPIParameter{Name: param.Name, In: "path", Required: true}
+		if field, ok := bodyFieldForParameter(bodyData, param.Name).Get(); ok {
+			parameter.Description = strings.Join(field.Comments, "\n")
+			parameter.Schema = components.Schema(unwrapOptional(field.Type))
+		} else {
+			parameter.Schema = components.Schema(&schema.String{})
+		}
+		op.Parameters = append(op.Parameters, parameter)
+	}
+
+	switch {
+	case bodyData != nil && (ingress.Method == http.MethodPost || ingress.Method == http.MethodPut):
+		op.RequestBody = &OpenAPIRequestBody{
+			Required: true,
+			Content:  map[string]OpenAPIMediaType{"application/json": {Schema: components.Schema(bodyField.Type)}},
+		}
+
+	case bodyData != nil:
+		op.Parameters = append(op.Parameters, openAPIQueryParameters(components, bodyField.Type, bodyData, pathParameters)...)
+
+	default:
+		if content, ok := openAPIContent(components, bodyField.Type); ok {
+			_, isOptional := bodyField.Type.(*schema.Optional)
+			op.RequestBody = &OpenAPIRequestBody{Required: !isOptional, Content: content}
+		}
+	}
+
+	if verb.Stream {
+		op.Responses["200"] = openAPIStreamResponse(components, verb.Response)
+		return op, nil
+	}
+
+	response, ok := verb.Response.(*schema.Ref)
+	if !ok {
+		op.Responses["200"] = OpenAPIResponse{Description: "Successful response."}
+		return op, nil
+	}
+	responseData, err := sch.ResolveMonomorphised(response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve response data type: %w", err)
+	}
+	success := OpenAPIResponse{Description: "Successful response."}
+	if field := responseData.FieldByName("body"); field != nil {
+		success.Content, _ = openAPIContent(components, unwrapOptional(field.Type))
+	}
+	op.Responses["200"] = success
+	if field := responseData.FieldByName("error"); field != nil {
+		failure := OpenAPIResponse{Description: "Error response."}
+		failure.Content, _ = openAPIContent(components, unwrapOptional(field.Type))
+		op.Responses["default"] = failure
+	}
+	return op, nil
+}
+
+// openAPIQueryParameters describes the query parameters accepted for a
+// request body that is decoded by buildRequestMap from the query string.
+//
+// Fields that cannot be represented as plain query parameters can only be
+// supplied by encoding the entire body as JSON in the "@json" parameter.
+func openAPIQueryParameters(components *schema.JSONSchemaComponents, bodyType schema.Type, data *schema.Data, pathParameters map[string]bool) []OpenAPIParameter {
+	var fields []*schema.Field
+	required := false
+	simple := true
+	for _, field := range data.Fields {
+		if pathParameters[parameterName(field)] || pathParameters[field.Name] {
+			continue
+		}
+		fields = append(fields, field)
+		if _, ok := field.Type.(*schema.Optional); !ok {
+			required = true
+		}
+		if !isQueryParameterType(unwrapOptional(field.Type)) {
+			simple = false
+		}
+	}
+	if !simple {
+		return []OpenAPIParameter{{
+			Name:        "@json",
+			In:          "query",
+			Description: "The request encoded as JSON.",
+			Required:    required,
+			Content:     map[string]OpenAPIMediaType{"application/json": {Schema: components.Schema(bodyType)}},
+		}}
+	}
+	out := make([]OpenAPIParameter, 0, len(fields))
+	for _, field := range fields {
+		_, isOptional := field.Type.(*schema.Optional)
+		out = append(out, OpenAPIParameter{
+			Name:        parameterName(field),
+			In:          "query",
+			Description: strings.Join(field.Comments, "\n"),
+			Required:    !isOptional,
+			Schema:      components.Schema(unwrapOptional(field.Type)),
+		})
+	}
+	return out
+}
+
+// isQueryParameterType returns true if valueForField can decode the type from query values.
+func isQueryParameterType(typ schema.Type) bool {
+	switch t := typ.(type) {
+	case *schema.Int, *schema.Float, *schema.String, *schema.Bool:
+		return true
+	case *schema.Array:
+		return isQueryParameterType(t.Element)
+	default:
+		return false
+	}
+}
+
+// openAPIContent returns the content of a body of the given type, using the
+// same default content types as ResponseForVerb.
+func openAPIContent(components *schema.JSONSchemaComponents, typ schema.Type) (map[string]OpenAPIMediaType, bool) {
+	contentType, _, _ := strings.Cut(getDefaultContentType(unwrapOptional(typ)), ";")
+	if contentType == "" {
+		return nil, false
+	}
+	media := OpenAPIMediaType{}
+	if _, ok := unwrapOptional(typ).(*schema.Bytes); !ok {
+		media.Schema = components.Schema(typ)
+	}
+	return map[string]OpenAPIMediaType{contentType: media}, true
+}
+
+// openAPIStreamResponse describes the response of a streaming verb, which is
+// either a stream of server-sent events or a chunked stream of elements.
+func openAPIStreamResponse(components *schema.JSONSchemaComponents, element schema.Type) OpenAPIResponse {
+	contentType, _, _ := strings.Cut(streamContentType(element), ";")
+	media := OpenAPIMediaType{}
+	if _, ok := element.(*schema.Bytes); !ok {
+		media.Schema = components.Schema(element)
+	}
+	return OpenAPIResponse{
+		Description: "Stream of responses.",
+		Content: map[string]OpenAPIMediaType{
+			contentType:            media,
+			EventStreamContentType: {},
+		},
+	}
+}
+
+func bodyFieldForParameter(data *schema.Data, name string) optional.Option[*schema.Field] {
+	if data == nil {
+		return optional.None[*schema.Field]()
+	}
+	for _, field := range data.Fields {
+		if parameterName(field) == name || field.Name == name {
+			return optional.Some(field)
+		}
+	}
+	return optional.None[*schema.Field]()
+}
+
+// parameterName returns the JSON alias of a field, or its name if it has none.
+func parameterName(field *schema.Field) string {
+	if alias, ok := field.Alias(schema.AliasKindJSON).Get(); ok {
+		return alias
+	}
+	return field.Name
+}
+
+func unwrapOptional(typ schema.Type) schema.Type {
+	if opt, ok := typ.(*schema.Optional); ok {
+		return opt.Type
+	}
+	return typ
+}
@@ -0,0 +1,148 @@
+package ingress
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestGenerateOpenAPI(t *testing.T) {
+	sch, err := schema.ParseString("test", `
+		module test {
+			// A user.
+			data User {
+				id Int
+				// The user's name.
+				name String +alias json "userName"
+			}
+
+			data GetUserRequest {
+				id Int
+				// Include deleted users.
+				deleted Bool?
+				tags [String]
+			}
+
+			data SearchRequest {
+				filter {String: String}
+			}
+
+			data UserError {
+				message String
+			}
+
+			secret apiKeys [String]
+
+			// Get a user.
+			// Returns the user with the given ID.
+			export verb getUser(HttpRequest<test.GetUserRequest>) HttpResponse<test.User, test.UserError>
+				+ingress http GET /users/{id}
+
+			export verb createUser(HttpRequest<test.User>) HttpResponse<test.User, String>
+				+ingress http POST /users
+				+auth jwt jwks "https://auth.example.com/jwks.json"
+				+auth apikey secret test.apiKeys
+
+			export verb search(HttpRequest<test.SearchRequest>) HttpResponse<[test.User], Unit>
+				+ingress http GET /users/search
+
+			export verb upload(HttpRequest<Bytes>) HttpResponse<Unit, String>
+				+ingress http PUT /avatar
+
+			export verb exportUsers(HttpRequest<Unit>) stream test.User
+				+ingress http GET /users/export
+		}
+	`)
+	assert.NoError(t, err)
+
+	doc, err := GenerateOpenAPI(sch, OpenAPIOptions{Servers: []string{"http://127.0.0.1:8891"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "3.1.0", doc.OpenAPI)
+	assert.Equal(t, OpenAPIInfo{Title: "FTL", Version: "1.0.0"}, doc.Info)
+	assert.Equal(t, []OpenAPIServer{{URL: "http://127.0.0.1:8891"}}, doc.Servers)
+	assert.Equal(t, []string{"/avatar", "/users", "/users/export", "/users/search", "/users/{id}"}, sortedKeys(doc.Paths))
+
+	getUser := doc.Paths["/users/{id}"]["get"]
+	assert.Equal(t, "test.getUser", getUser.OperationID)
+	assert.Equal(t, "Get a user.", getUser.Summary)
+	assert.Equal(t, "Get a user.\nReturns the user with the given ID.", getUser.Description)
+	assert.Equal(t, []string{"test"}, getUser.Tags)
+	assert.Zero(t, getUser.RequestBody)
+	assertJSON(t, `[
+		{"name": "id", "in": "path", "required": true, "schema": {"type": "integer"}},
+		{"name": "deleted", "in": "query", "description": "Include deleted users.", "schema": {"type": "boolean"}},
+		{"name": "tags", "in": "query", "required": true, "schema": {"type": "array", "items": {"type": "string"}}}
+	]`, getUser.Parameters)
+	assertJSON(t, `{
+		"200": {"description": "Successful response.", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/test.User"}}}},
+		"default": {"description": "Error response.", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/test.UserError"}}}}
+	}`, getUser.Responses)
+
+	createUser := doc.Paths["/users"]["post"]
+	assert.Zero(t, createUser.Parameters)
+	assertJSON(t, `{"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/test.User"}}}}`, createUser.RequestBody)
+	assertJSON(t, `{"description": "Error response.", "content": {"text/plain": {"schema": {"type": "string"}}}}`, createUser.Responses["default"])
+	assertJSON(t, `[{"jwt": []}, {"apikey-X-Api-Key": []}]`, createUser.Security)
+	assert.Zero(t, getUser.Security)
+	assertJSON(t, `{
+		"jwt": {"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+		"apikey-X-Api-Key": {"type": "apiKey", "in": "header", "name": "X-API-Key"}
+	}`, doc.Components.SecuritySchemes)
+
+	search := doc.Paths["/users/search"]["get"]
+	assertJSON(t, `[
+		{"name": "@json", "in": "query", "description": "The request encoded as JSON.", "required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/test.SearchRequest"}}}}
+	]`, search.Parameters)
+	assertJSON(t, `{
+		"200": {"description": "Successful response.", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/test.User"}}}}},
+		"default": {"description": "Error response."}
+	}`, search.Responses)
+
+	upload := doc.Paths["/avatar"]["put"]
+	assert.Zero(t, upload.Parameters)
+	assertJSON(t, `{"required": true, "content": {"application/octet-stream": {}}}`, upload.RequestBody)
+
+	exportUsers := doc.Paths["/users/export"]["get"]
+	assert.Zero(t, exportUsers.RequestBody)
+	assertJSON(t, `{
+		"200": {"description": "Stream of responses.", "content": {
+			"application/x-ndjson": {"schema": {"$ref": "#/components/schemas/test.User"}},
+			"text/event-stream": {}
+		}}
+	}`, exportUsers.Responses)
+
+	assertJSON(t, `{
+		"description": "A user.",
+		"type": "object",
+		"required": ["id", "userName"],
+		"additionalProperties": false,
+		"properties": {
+			"id": {"type": "integer"},
+			"userName": {"type": "string", "description": "The user's name."}
+		}
+	}`, doc.Components.Schemas["test.User"])
+	assert.Equal(t, []string{"test.SearchRequest", "test.User", "test.UserError"}, sortedKeys(doc.Components.Schemas))
+}
+
+func assertJSON(t *testing.T, expected string, actual any) {
+	t.Helper()
+	var expectedValue, actualValue any
+	assert.NoError(t, json.Unmarshal([]byte(expected), &expectedValue))
+	data, err := json.Marshal(actual)
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(data, &actualValue))
+	assert.Equal(t, expectedValue, actualValue)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -16,7 +16,9 @@ import (
 )
 
 // BuildRequestBody extracts the HttpRequest body from an HTTP request.
-func BuildRequestBody(route *dal.IngressRoute, r *http.Request, sch *schema.Schema) ([]byte, error) {
+//
+// The principal, if any, is the caller authenticated by [Authenticator].
+func BuildRequestBody(route *dal.IngressRoute, r *http.Request, sch *schema.Schema, principal optional.Option[Principal], limits BodyLimits) ([]byte, error) {
 	verb := &schema.Verb{}
 	err := sch.ResolveToType(&schema.Ref{Name: route.Verb, Module: route.Module}, verb)
 	if err != nil {
@@ -38,7 +40,7 @@ func BuildRequestBody(route *dal.IngressRoute, r *http.Request, sch *schema.Sche
 			pathParameters[segment] = value
 		})
 
-		httpRequestBody, err := extractHTTPRequestBody(route, r, request, sch)
+		httpRequestBody, err := extractHTTPRequestBody(route, r, request, sch, limits)
 		if err != nil {
 			return nil, err
 		}
@@ -71,9 +73,12 @@ func BuildRequestBody(route *dal.IngressRoute, r *http.Request, sch *schema.Sche
 		requestMap["query"] = queryMap
 		requestMap["headers"] = headerMap
 		requestMap["body"] = httpRequestBody
+		if p, ok := principal.Get(); ok {
+			requestMap["principal"] = p.toMap()
+		}
 	} else {
 		var err error
-		requestMap, err = buildRequestMap(route, r, request, sch)
+		requestMap, err = buildRequestMap(route, r, request, sch, limits)
 		if err != nil {
 			return nil, err
 		}
@@ -97,15 +102,19 @@ func BuildRequestBody(route *dal.IngressRoute, r *http.Request, sch *schema.Sche
 	return body, nil
 }
 
-func extractHTTPRequestBody(route *dal.IngressRoute, r *http.Request, ref *schema.Ref, sch *schema.Schema) (any, error) {
+func extractHTTPRequestBody(route *dal.IngressRoute, r *http.Request, ref *schema.Ref, sch *schema.Schema, limits BodyLimits) (any, error) {
 	bodyField, err := getBodyField(ref, sch)
 	if err != nil {
 		return nil, err
 	}
 
+	if isFileType(bodyField.Type) {
+		return fileFromRequestBody(r)
+	}
+
 	if ref, ok := bodyField.Type.(*schema.Ref); ok {
 		if err := sch.ResolveToType(ref, &schema.Data{}); err == nil {
-			return buildRequestMap(route, r, ref, sch)
+			return buildRequestMap(route, r, ref, sch, limits)
 		}
 	}
 
@@ -198,7 +207,7 @@ func readRequestBody(r *http.Request) ([]byte, error) {
 	return bodyData, nil
 }
 
-func buildRequestMap(route *dal.IngressRoute, r *http.Request, ref *schema.Ref, sch *schema.Schema) (map[string]any, error) {
+func buildRequestMap(route *dal.IngressRoute, r *http.Request, ref *schema.Ref, sch *schema.Schema, limits BodyLimits) (map[string]any, error) {
 	requestMap := map[string]any{}
 	matchSegments(route.Path, r.URL.Path, func(segment, value string) {
 		requestMap[segment] = value
@@ -207,9 +216,19 @@ func buildRequestMap(route *dal.IngressRoute, r *http.Request, ref *schema.Ref,
 	switch r.Method {
 	case http.MethodPost, http.MethodPut:
 		var bodyMap map[string]any
-		err := json.NewDecoder(r.Body).Decode(&bodyMap)
-		if err != nil {
-			return nil, fmt.Errorf("HTTP request body is not valid JSON: %w", err)
+		switch mediaType := requestMediaType(r); mediaType {
+		case FormContentType, MultipartFormContentType:
+			var err error
+			bodyMap, err = decodeForm(r, mediaType, ref, sch, limits)
+			if err != nil {
+				return nil, err
+			}
+
+		default:
+			err := json.NewDecoder(r.Body).Decode(&bodyMap)
+			if err != nil {
+				return nil, fmt.Errorf("HTTP request body is not valid JSON: %w", err)
+			}
 		}
 
 		// Merge bodyMap into params
@@ -0,0 +1,234 @@
+package ingress
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/observability"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+// Content types of streamed ingress responses.
+const (
+	EventStreamContentType = "text/event-stream"
+	NDJSONContentType      = "application/x-ndjson"
+)
+
+// handleStream calls a streaming verb and writes each response to the client
+// as soon as it is received.
+//
+// If the client accepts "text/event-stream" each response is written as a
+// server-sent event. Otherwise responses are written with chunked transfer
+// encoding: String and Bytes responses as-is, and anything else as newline
+// delimited JSON.
+func handleStream(
+	startTime time.Time,
+	requestKey model.RequestKey,
+	verb *schema.Verb,
+	w http.ResponseWriter,
+	r *http.Request,
+	creq *connect.Request[ftlv1.CallRequest],
+	callStream func(context.Context, *connect.Request[ftlv1.CallRequest], optional.Option[model.RequestKey], optional.Option[model.RequestKey], string, func(*ftlv1.CallResponse) error) error,
+) {
+	logger := log.FromContext(r.Context())
+	verbRef := creq.Msg.Verb
+	sw := newStreamWriter(w, verb, acceptsEventStream(r))
+	err := callStream(r.Context(), creq, optional.Some(requestKey), optional.None[model.RequestKey](), r.RemoteAddr, sw.write)
+
+	var failure string
+	switch {
+	case err != nil && !sw.started:
+		logger.Errorf(err, "failed to call verb %s", verb.Name)
+		httpCode := http.StatusInternalServerError
+		if connectErr := new(connect.Error); errors.As(err, &connectErr) {
+			httpCode = connectCodeToHTTP(connectErr.Code())
+		}
+		http.Error(w, http.StatusText(httpCode), httpCode)
+		observability.Ingress.Request(r.Context(), r.Method, r.URL.Path, optional.Some(verbRef), startTime, optional.Some("failed to call verb"))
+		return
+
+	case sw.verbErr != "" && !sw.started:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		observability.Ingress.Request(r.Context(), r.Method, r.URL.Path, optional.Some(verbRef), startTime, optional.Some("call response: internal server error"))
+		return
+
+	case err != nil:
+		logger.Errorf(err, "stream from verb %s failed", verb.Name)
+		failure = "stream failed"
+
+	case sw.verbErr != "":
+		logger.Debugf("stream from verb %s failed: %s", verb.Name, sw.verbErr)
+		failure = "stream response: internal server error"
+
+	default:
+		sw.start()
+		observability.Ingress.Request(r.Context(), r.Method, r.URL.Path, optional.Some(verbRef), startTime, optional.None[string]())
+		return
+	}
+
+	observability.Ingress.Request(r.Context(), r.Method, r.URL.Path, optional.Some(verbRef), startTime, optional.Some(failure))
+	if sw.events {
+		if err := sw.writeEvent("error", []string{http.StatusText(http.StatusInternalServerError)}); err != nil {
+			logger.Debugf("could not write error event: %s", err)
+		}
+		return
+	}
+	// The status has already been sent, so abort the response to signal to the
+	// client that it is incomplete.
+	panic(http.ErrAbortHandler)
+}
+
+type streamWriter struct {
+	w       http.ResponseWriter
+	rc      *http.ResponseController
+	element schema.Type
+	events  bool
+	started bool
+	// verbErr is the error returned by the verb, if any.
+	verbErr string
+}
+
+func newStreamWriter(w http.ResponseWriter, verb *schema.Verb, events bool) *streamWriter {
+	return &streamWriter{
+		w:       w,
+		rc:      http.NewResponseController(w),
+		element: verb.Response,
+		events:  events,
+	}
+}
+
+// start writes the response headers, if they haven't been written yet.
+func (s *streamWriter) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+	header := s.w.Header()
+	switch {
+	case s.events:
+		header.Set("Content-Type", EventStreamContentType)
+		header.Set("Cache-Control", "no-cache")
+	default:
+		header.Set("Content-Type", streamContentType(s.element))
+	}
+	s.w.WriteHeader(http.StatusOK)
+}
+
+// write a single response from the verb to the client.
+func (s *streamWriter) write(resp *ftlv1.CallResponse) error {
+	switch resp := resp.Response.(type) {
+	case *ftlv1.CallResponse_Error_:
+		s.verbErr = resp.Error.Message
+		return nil
+
+	case *ftlv1.CallResponse_Body:
+		s.start()
+		if s.events {
+			return s.writeEvent("", eventData(s.element, resp.Body))
+		}
+		if err := s.writeChunk(resp.Body); err != nil {
+			return err
+		}
+		return s.flush()
+
+	default:
+		return fmt.Errorf("invalid response type %T", resp)
+	}
+}
+
+func (s *streamWriter) writeChunk(body []byte) error {
+	switch s.element.(type) {
+	case *schema.String:
+		var str string
+		if err := json.Unmarshal(body, &str); err != nil {
+			return fmt.Errorf("invalid String response: %w", err)
+		}
+		_, err := s.w.Write([]byte(str))
+		return err
+
+	case *schema.Bytes:
+		var data []byte
+		if err := json.Unmarshal(body, &data); err != nil {
+			return fmt.Errorf("invalid Bytes response: %w", err)
+		}
+		_, err := s.w.Write(data)
+		return err
+
+	default:
+		_, err := s.w.Write(append(bytes.TrimSpace(body), '\n'))
+		return err
+	}
+}
+
+func (s *streamWriter) writeEvent(event string, data []string) error {
+	w := &bytes.Buffer{}
+	if event != "" {
+		fmt.Fprintf(w, "event: %s\n", event)
+	}
+	for _, line := range data {
+		fmt.Fprintf(w, "data: %s\n", line)
+	}
+	w.WriteString("\n")
+	if _, err := s.w.Write(w.Bytes()); err != nil {
+		return err
+	}
+	return s.flush()
+}
+
+func (s *streamWriter) flush() error {
+	err := s.rc.Flush()
+	if errors.Is(err, http.ErrNotSupported) {
+		// Responses will be delivered when the buffer fills or the stream ends.
+		return nil
+	}
+	return err
+}
+
+// eventData returns the data lines of a server-sent event for a response.
+//
+// String responses are sent as-is, everything else as JSON.
+func eventData(element schema.Type, body []byte) []string {
+	if _, ok := element.(*schema.String); ok {
+		var str string
+		if err := json.Unmarshal(body, &str); err == nil {
+			return strings.Split(strings.ReplaceAll(str, "\r\n", "\n"), "\n")
+		}
+	}
+	return []string{string(bytes.TrimSpace(body))}
+}
+
+func streamContentType(element schema.Type) string {
+	switch element.(type) {
+	case *schema.String:
+		return "text/plain; charset=utf-8"
+	case *schema.Bytes:
+		return "application/octet-stream"
+	default:
+		return NDJSONContentType
+	}
+}
+
+func acceptsEventStream(r *http.Request) bool {
+	for _, value := range r.Header.Values("Accept") {
+		for _, accept := range strings.Split(value, ",") {
+			mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accept))
+			if err == nil && mediaType == EventStreamContentType {
+				return true
+			}
+		}
+	}
+	return false
+}
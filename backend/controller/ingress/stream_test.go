@@ -0,0 +1,148 @@
+package ingress
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestHandleStream(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	sch, err := schema.ParseString("test", `
+		module test {
+			data Progress {
+				percent Int
+			}
+
+			export verb progress(HttpRequest<Unit>) stream test.Progress
+				+ingress http GET /progress
+
+			export verb lines(HttpRequest<Unit>) stream String
+				+ingress http GET /lines
+		}
+	`)
+	assert.NoError(t, err)
+	routes := []dal.IngressRoute{
+		{Path: "/progress", Module: "test", Verb: "progress"},
+		{Path: "/lines", Module: "test", Verb: "lines"},
+	}
+
+	body := func(data string) *ftlv1.CallResponse {
+		return &ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: []byte(data)}}
+	}
+	verbError := &ftlv1.CallResponse{Response: &ftlv1.CallResponse_Error_{Error: &ftlv1.CallResponse_Error{Message: "failed"}}}
+
+	for _, test := range []struct {
+		name        string
+		path        string
+		accept      string
+		responses   []*ftlv1.CallResponse
+		err         error
+		statusCode  int
+		contentType string
+		body        string
+		aborted     bool
+	}{
+		{name: "NDJSON",
+			path:        "/progress",
+			responses:   []*ftlv1.CallResponse{body(`{"percent":50}`), body(`{"percent":100}`)},
+			statusCode:  http.StatusOK,
+			contentType: "application/x-ndjson",
+			body:        "{\"percent\":50}\n{\"percent\":100}\n"},
+		{name: "Text",
+			path:        "/lines",
+			responses:   []*ftlv1.CallResponse{body(`"a\n"`), body(`"b"`)},
+			statusCode:  http.StatusOK,
+			contentType: "text/plain; charset=utf-8",
+			body:        "a\nb"},
+		{name: "Empty",
+			path:        "/progress",
+			statusCode:  http.StatusOK,
+			contentType: "application/x-ndjson"},
+		{name: "EventStream",
+			path:        "/progress",
+			accept:      "text/html, text/event-stream;q=0.9",
+			responses:   []*ftlv1.CallResponse{body(`{"percent":50}`), body(`{"percent":100}`)},
+			statusCode:  http.StatusOK,
+			contentType: "text/event-stream",
+			body:        "data: {\"percent\":50}\n\ndata: {\"percent\":100}\n\n"},
+		{name: "EventStreamMultilineString",
+			path:        "/lines",
+			accept:      "text/event-stream",
+			responses:   []*ftlv1.CallResponse{body(`"a\nb"`)},
+			statusCode:  http.StatusOK,
+			contentType: "text/event-stream",
+			body:        "data: a\ndata: b\n\n"},
+		{name: "VerbErrorBeforeFirstResponse",
+			path:       "/progress",
+			responses:  []*ftlv1.CallResponse{verbError},
+			statusCode: http.StatusInternalServerError},
+		{name: "CallError",
+			path:       "/progress",
+			err:        connect.NewError(connect.CodeResourceExhausted, context.Canceled),
+			statusCode: http.StatusTooManyRequests},
+		{name: "EventStreamVerbError",
+			path:        "/progress",
+			accept:      "text/event-stream",
+			responses:   []*ftlv1.CallResponse{body(`{"percent":50}`), verbError},
+			statusCode:  http.StatusOK,
+			contentType: "text/event-stream",
+			body:        "data: {\"percent\":50}\n\nevent: error\ndata: Internal Server Error\n\n"},
+		{name: "ChunkedVerbError",
+			path:       "/progress",
+			responses:  []*ftlv1.CallResponse{body(`{"percent":50}`), verbError},
+			statusCode: http.StatusOK,
+			body:       "{\"percent\":50}\n",
+			aborted:    true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			callStream := func(ctx context.Context, req *connect.Request[ftlv1.CallRequest], requestKey optional.Option[model.RequestKey], parentRequestKey optional.Option[model.RequestKey], requestSource string, send func(*ftlv1.CallResponse) error) error {
+				assert.Zero(t, req.Msg.IdempotencyKey)
+				for _, resp := range test.responses {
+					if err := send(resp); err != nil {
+						return err
+					}
+				}
+				return test.err
+			}
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, test.path, nil).WithContext(ctx)
+			req.Header.Set("Idempotency-Key", "key")
+			if test.accept != "" {
+				req.Header.Set("Accept", test.accept)
+			}
+			aborted := func() (aborted bool) {
+				defer func() {
+					if r := recover(); r != nil {
+						assert.Equal[any](t, http.ErrAbortHandler, r)
+						aborted = true
+					}
+				}()
+				Handle(time.Now(), sch, model.NewRequestKey(model.OriginIngress, "test"), routes, NewAuthenticator(nil, ""), nil, DefaultBodyLimits, rec, req, nil, callStream)
+				return false
+			}()
+			assert.Equal(t, test.aborted, aborted)
+			assert.Equal(t, test.statusCode, rec.Code)
+			if test.statusCode != http.StatusOK {
+				return
+			}
+			if test.contentType != "" {
+				assert.Equal(t, test.contentType, rec.Header().Get("Content-Type"))
+			}
+			assert.Equal(t, test.body, rec.Body.String())
+			assert.True(t, rec.Flushed || test.body == "")
+		})
+	}
+}
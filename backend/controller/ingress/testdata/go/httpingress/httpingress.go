@@ -195,3 +195,38 @@ type DirectTypeAlias = lib.NonFTLType
 func External2(ctx context.Context, req builtin.HttpRequest[DirectTypeAlias]) (builtin.HttpResponse[DirectTypeAlias, string], error) {
 	return builtin.HttpResponse[DirectTypeAlias, string]{Body: ftl.Some(req.Body)}, nil
 }
+
+var apiKeys = ftl.Secret[map[string]string]("apiKeys")
+
+//ftl:ingress http GET /whoami
+//ftl:auth apikey secret apiKeys
+func WhoAmI(ctx context.Context, req builtin.HttpRequest[ftl.Unit]) (builtin.HttpResponse[string, string], error) {
+	principal, ok := req.Principal.Get()
+	if !ok {
+		return builtin.HttpResponse[string, string]{Status: 401, Error: ftl.Some("not authenticated")}, nil
+	}
+	return builtin.HttpResponse[string, string]{Body: ftl.Some(principal.Scheme + ":" + principal.Subject)}, nil
+}
+
+type Progress struct {
+	Percent int `json:"percent"`
+}
+
+//ftl:ingress http GET /progress
+func StreamProgress(ctx context.Context, req builtin.HttpRequest[ftl.Unit], stream ftl.StreamWriter[Progress]) error {
+	for percent := 25; percent <= 100; percent += 25 {
+		if err := stream.Send(ctx, Progress{Percent: percent}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ChatMessage struct {
+	Text string `json:"text"`
+}
+
+//ftl:ingress ws /chat
+func Chat(ctx context.Context, req builtin.WebSocketMessage[ChatMessage]) error {
+	return ftl.SendWebSocketMessage(ctx, req.ConnectionId, ChatMessage{Text: "echo: " + req.Body.Text})
+}
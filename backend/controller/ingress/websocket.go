@@ -0,0 +1,230 @@
+package ingress
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+	"github.com/gorilla/websocket"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/controller/observability"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+const webSocketWriteTimeout = 10 * time.Second
+
+// WebSockets holds the WebSocket connections to "+ingress ws" verbs on this
+// controller.
+type WebSockets struct {
+	upgrader websocket.Upgrader
+	// Maximum size in bytes of a received message, or 0 for no limit.
+	maxMessageSize int64
+
+	lock sync.Mutex
+	// Keyed by model.WebSocketConnectionKey.String().
+	connections map[string]*webSocketConnection
+}
+
+type webSocketConnection struct {
+	key model.WebSocketConnectionKey
+	// Serialises writes, as a connection supports only one concurrent writer.
+	lock sync.Mutex
+	conn *websocket.Conn
+}
+
+// NewWebSockets creates a new WebSockets.
+//
+// Connections are accepted from pages served by the ingress itself, and from
+// allowOrigins. Connections that receive a message larger than maxMessageSize
+// bytes are closed, unless it is 0.
+func NewWebSockets(allowOrigins []string, maxMessageSize int64) *WebSockets {
+	return &WebSockets{
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get("Origin")
+				if origin == "" || slices.Contains(allowOrigins, "*") || slices.Contains(allowOrigins, origin) {
+					return true
+				}
+				u, err := url.Parse(origin)
+				return err == nil && strings.EqualFold(u.Host, r.Host)
+			},
+		},
+		maxMessageSize: maxMessageSize,
+		connections:    map[string]*webSocketConnection{},
+	}
+}
+
+// Has returns true if the connection is held by this controller.
+func (s *WebSockets) Has(key model.WebSocketConnectionKey) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, ok := s.connections[key.String()]
+	return ok
+}
+
+// Keys returns the keys of the connections held by this controller.
+func (s *WebSockets) Keys() []model.WebSocketConnectionKey {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	keys := make([]model.WebSocketConnectionKey, 0, len(s.connections))
+	for _, connection := range s.connections {
+		keys = append(keys, connection.key)
+	}
+	return keys
+}
+
+// Send a message to a connection.
+//
+// Returns false if the connection is not held by this controller.
+func (s *WebSockets) Send(key model.WebSocketConnectionKey, message dal.WebSocketMessage) (bool, error) {
+	s.lock.Lock()
+	connection, ok := s.connections[key.String()]
+	s.lock.Unlock()
+	if !ok {
+		return false, nil
+	}
+	messageType := websocket.TextMessage
+	if message.Binary {
+		messageType = websocket.BinaryMessage
+	}
+	connection.lock.Lock()
+	defer connection.lock.Unlock()
+	if err := connection.conn.SetWriteDeadline(time.Now().Add(webSocketWriteTimeout)); err != nil {
+		return true, fmt.Errorf("failed to send message to %s: %w", key, err)
+	}
+	if err := connection.conn.WriteMessage(messageType, message.Body); err != nil {
+		return true, fmt.Errorf("failed to send message to %s: %w", key, err)
+	}
+	return true, nil
+}
+
+// serve upgrades a request to a WebSocket connection, and calls the verb with
+// each message received on the connection until it is closed.
+//
+// Messages are passed to the verb one at a time, in the order they are
+// received.
+func (s *WebSockets) serve(
+	startTime time.Time,
+	sch *schema.Schema,
+	route *dal.IngressRoute,
+	verb *schema.Verb,
+	w http.ResponseWriter,
+	r *http.Request,
+	call func(context.Context, *connect.Request[ftlv1.CallRequest], optional.Option[model.RequestKey], optional.Option[model.RequestKey], string) (*connect.Response[ftlv1.CallResponse], error),
+) {
+	logger := log.FromContext(r.Context())
+	verbRef := &schemapb.Ref{Module: route.Module, Name: route.Verb}
+	request, ok := verb.Request.(*schema.Ref)
+	if !ok {
+		logger.Errorf(fmt.Errorf("request type %s must be builtin.WebSocketMessage", verb.Request), "invalid WebSocket verb %s", route.Verb)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		observability.Ingress.Request(r.Context(), r.Method, r.URL.Path, optional.Some(verbRef), startTime, optional.Some("invalid websocket verb"))
+		return
+	}
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader has already responded to the client.
+		logger.Debugf("failed to upgrade to WebSocket: %s", err)
+		observability.Ingress.Request(r.Context(), r.Method, r.URL.Path, optional.Some(verbRef), startTime, optional.Some("websocket upgrade failed"))
+		return
+	}
+	observability.Ingress.Request(r.Context(), r.Method, r.URL.Path, optional.Some(verbRef), startTime, optional.None[string]())
+	if s.maxMessageSize > 0 {
+		conn.SetReadLimit(s.maxMessageSize)
+	}
+
+	key := model.NewWebSocketConnectionKey(route.Module, route.Verb)
+	connection := &webSocketConnection{key: key, conn: conn}
+	s.lock.Lock()
+	s.connections[key.String()] = connection
+	s.lock.Unlock()
+	defer func() {
+		s.lock.Lock()
+		delete(s.connections, key.String())
+		s.lock.Unlock()
+		_ = conn.Close()
+	}()
+	logger.Debugf("WebSocket connection %s opened", key)
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			if errors.Is(err, websocket.ErrReadLimit) {
+				// The connection has already been closed with status 1009.
+				logger.Debugf("WebSocket connection %s closed: message larger than %d bytes", key, s.maxMessageSize)
+				return
+			}
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway, websocket.CloseNoStatusReceived) {
+				logger.Debugf("WebSocket connection %s failed: %s", key, err)
+			} else {
+				logger.Debugf("WebSocket connection %s closed", key)
+			}
+			return
+		}
+		body, err := buildWebSocketRequestBody(sch, request, key, data)
+		if err != nil {
+			// Only log at debug, as this is a client side error
+			logger.Debugf("bad WebSocket message on %s: %s", key, err)
+			connection.lock.Lock()
+			deadline := time.Now().Add(webSocketWriteTimeout)
+			_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseInvalidFramePayloadData, "invalid message"), deadline)
+			connection.lock.Unlock()
+			return
+		}
+		creq := connect.NewRequest(&ftlv1.CallRequest{
+			Metadata: &ftlv1.Metadata{},
+			Verb:     verbRef,
+			Body:     body,
+		})
+		requestKey := model.NewRequestKey(model.OriginIngress, "ws "+r.URL.Path)
+		resp, err := call(r.Context(), creq, optional.Some(requestKey), optional.None[model.RequestKey](), r.RemoteAddr)
+		if err != nil {
+			logger.Errorf(err, "failed to call verb %s", route.Verb)
+			continue
+		}
+		if verbErr, ok := resp.Msg.Response.(*ftlv1.CallResponse_Error_); ok {
+			logger.Debugf("verb %s failed to handle WebSocket message: %s", route.Verb, verbErr.Error.Message)
+		}
+	}
+}
+
+// buildWebSocketRequestBody builds the builtin.WebSocketMessage for a message
+// received on a connection.
+//
+// The message is decoded the same way as an HTTP request body.
+func buildWebSocketRequestBody(sch *schema.Schema, request *schema.Ref, key model.WebSocketConnectionKey, data []byte) ([]byte, error) {
+	bodyField, err := getBodyField(request, sch)
+	if err != nil {
+		return nil, err
+	}
+	body, err := valueForData(bodyField.Type, data)
+	if err != nil {
+		return nil, err
+	}
+	requestMap := map[string]any{
+		"connectionId": key.String(),
+		"body":         body,
+	}
+	requestMap, err = schema.TransformFromAliasedFields(request, sch, requestMap)
+	if err != nil {
+		return nil, err
+	}
+	if err := schema.ValidateRequestMap(request, []string{request.String()}, requestMap, sch); err != nil {
+		return nil, err
+	}
+	return json.Marshal(requestMap)
+}
@@ -0,0 +1,98 @@
+package ingress
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+	"github.com/gorilla/websocket"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestHandleWebSocket(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	sch, err := schema.ParseString("test", `
+		module test {
+			data Chat {
+				text String
+			}
+
+			export verb chat(builtin.WebSocketMessage<test.Chat>) Unit
+				+ingress ws /chat
+		}
+	`)
+	assert.NoError(t, err)
+	routes := []dal.IngressRoute{{Path: "/chat", Module: "test", Verb: "chat"}}
+
+	type webSocketMessage struct {
+		ConnectionID string         `json:"connectionId"`
+		Body         map[string]any `json:"body"`
+	}
+	received := make(chan webSocketMessage, 8)
+	call := func(ctx context.Context, req *connect.Request[ftlv1.CallRequest], requestKey optional.Option[model.RequestKey], parentRequestKey optional.Option[model.RequestKey], requestSource string) (*connect.Response[ftlv1.CallResponse], error) {
+		var msg webSocketMessage
+		assert.NoError(t, json.Unmarshal(req.Msg.Body, &msg))
+		received <- msg
+		return connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: []byte("{}")}}), nil
+	}
+	sockets := NewWebSockets(nil, 64)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Handle(time.Now(), sch, model.NewRequestKey(model.OriginIngress, "test"), routes, NewAuthenticator(nil, ""), sockets, DefaultBodyLimits, w, r.WithContext(ctx), call, nil)
+	}))
+	t.Cleanup(server.Close)
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/chat"
+
+	client, _, err := websocket.DefaultDialer.Dial(url, nil)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	assert.NoError(t, client.WriteMessage(websocket.TextMessage, []byte(`{"text":"hello"}`)))
+	msg := <-received
+	assert.Equal(t, map[string]any{"text": "hello"}, msg.Body)
+
+	key, err := model.ParseWebSocketConnectionKey(msg.ConnectionID)
+	assert.NoError(t, err)
+	assert.True(t, sockets.Has(key))
+	assert.Equal(t, []model.WebSocketConnectionKey{key}, sockets.Keys())
+	sent, err := sockets.Send(key, dal.WebSocketMessage{Body: []byte(`{"text":"hi"}`)})
+	assert.NoError(t, err)
+	assert.True(t, sent)
+	messageType, data, err := client.ReadMessage()
+	assert.NoError(t, err)
+	assert.Equal(t, websocket.TextMessage, messageType)
+	assert.Equal(t, `{"text":"hi"}`, string(data))
+
+	sent, err = sockets.Send(model.NewWebSocketConnectionKey("test", "chat"), dal.WebSocketMessage{Body: []byte("{}")})
+	assert.NoError(t, err)
+	assert.False(t, sent)
+
+	// Messages that don't match the verb's request type close the connection.
+	assert.NoError(t, client.WriteMessage(websocket.TextMessage, []byte(`{"text":1}`)))
+	_, _, err = client.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseInvalidFramePayloadData), "unexpected error %v", err)
+
+	// Messages larger than the limit close the connection.
+	client, _, err = websocket.DefaultDialer.Dial(url, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, client.WriteMessage(websocket.TextMessage, []byte(`{"text":"`+strings.Repeat("a", 64)+`"}`)))
+	_, _, err = client.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseMessageTooBig), "unexpected error %v", err)
+
+	// Plain HTTP requests can't be upgraded.
+	resp, err := http.Get(server.URL + "/chat") //nolint:noctx
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
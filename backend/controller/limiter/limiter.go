@@ -0,0 +1,111 @@
+// Package limiter enforces cluster-wide rate and concurrency limits on verbs.
+package limiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+var (
+	// ErrRateLimited is returned when a verb's rate limit has been exceeded.
+	ErrRateLimited = errors.New("rate limit exceeded")
+	// ErrConcurrencyLimited is returned when a verb's concurrency limit has been reached.
+	ErrConcurrencyLimited = errors.New("concurrency limit exceeded")
+)
+
+// concurrencySlotTTL bounds how long a slot is held if a controller dies
+// while a call is in flight.
+const concurrencySlotTTL = time.Second * 10
+
+type DAL interface {
+	TakeRateLimitToken(ctx context.Context, key string, limit int, period time.Duration) (bool, error)
+	AcquireConcurrencySlot(ctx context.Context, key string, limit int, ttl time.Duration) (id int64, ok bool, err error)
+	RenewConcurrencySlot(ctx context.Context, id int64, ttl time.Duration) error
+	ReleaseConcurrencySlot(ctx context.Context, id int64) error
+}
+
+// Limiter enforces the "+ratelimit" and "+concurrency" metadata of verbs.
+//
+// Rate limits are token buckets stored in the database, and concurrency limits
+// are counted slots in the database, so both apply across all controllers.
+type Limiter struct {
+	dal DAL
+}
+
+func New(dal DAL) *Limiter {
+	return &Limiter{dal: dal}
+}
+
+// Acquire permission to call verb.
+//
+// The returned function must be called when the call completes. Returns
+// [ErrRateLimited] or [ErrConcurrencyLimited] if the call must be rejected.
+//
+// The concurrency limit is checked first, so that calls rejected because of
+// it don't consume rate limit tokens.
+func (l *Limiter) Acquire(ctx context.Context, ref *schema.Ref, verb *schema.Verb) (release func(), err error) {
+	release = func() {}
+	if md, ok := verb.GetMetadataConcurrency().Get(); ok && md.Limit > 0 {
+		release, err = l.acquireConcurrencySlot(ctx, ref, md.Limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if md, ok := verb.GetMetadataRateLimit().Get(); ok {
+		period, err := md.PeriodDuration()
+		if err != nil {
+			release()
+			return nil, fmt.Errorf("%s: %w", ref, err)
+		}
+		ok, err := l.dal.TakeRateLimitToken(ctx, ref.String(), md.Limit, period)
+		if err != nil {
+			release()
+			return nil, fmt.Errorf("%s: failed to take rate limit token: %w", ref, err)
+		}
+		if !ok {
+			release()
+			return nil, fmt.Errorf("%s: %w (%d per %s)", ref, ErrRateLimited, md.Limit, md.Period)
+		}
+	}
+	return release, nil
+}
+
+func (l *Limiter) acquireConcurrencySlot(ctx context.Context, ref *schema.Ref, limit int) (release func(), err error) {
+	// The slot must outlive cancellation of the call, so that it is released
+	// rather than left to expire.
+	slotCtx := context.WithoutCancel(ctx)
+	key := ref.String()
+	id, ok, err := l.dal.AcquireConcurrencySlot(slotCtx, key, limit, concurrencySlotTTL)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to acquire concurrency slot: %w", ref, err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("%s: %w (%d)", ref, ErrConcurrencyLimited, limit)
+	}
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(concurrencySlotTTL / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := l.dal.RenewConcurrencySlot(slotCtx, id, concurrencySlotTTL); err != nil {
+					log.FromContext(ctx).Warnf("Failed to renew concurrency slot for %s: %s", ref, err)
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		if err := l.dal.ReleaseConcurrencySlot(slotCtx, id); err != nil {
+			log.FromContext(ctx).Warnf("Failed to release concurrency slot for %s: %s", ref, err)
+		}
+	}, nil
+}
@@ -0,0 +1,113 @@
+package limiter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+type fakeDAL struct {
+	tokens map[string]int
+	slots  map[int64]string
+	nextID int64
+}
+
+func newFakeDAL() *fakeDAL {
+	return &fakeDAL{tokens: map[string]int{}, slots: map[int64]string{}}
+}
+
+func (f *fakeDAL) TakeRateLimitToken(ctx context.Context, key string, limit int, period time.Duration) (bool, error) {
+	if f.tokens[key] >= limit {
+		return false, nil
+	}
+	f.tokens[key]++
+	return true, nil
+}
+
+func (f *fakeDAL) AcquireConcurrencySlot(ctx context.Context, key string, limit int, ttl time.Duration) (int64, bool, error) {
+	held := 0
+	for _, k := range f.slots {
+		if k == key {
+			held++
+		}
+	}
+	if held >= limit {
+		return 0, false, nil
+	}
+	f.nextID++
+	f.slots[f.nextID] = key
+	return f.nextID, true, nil
+}
+
+func (f *fakeDAL) RenewConcurrencySlot(ctx context.Context, id int64, ttl time.Duration) error {
+	return nil
+}
+
+func (f *fakeDAL) ReleaseConcurrencySlot(ctx context.Context, id int64) error {
+	delete(f.slots, id)
+	return nil
+}
+
+func TestRateLimit(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	limiter := New(newFakeDAL())
+	ref := &schema.Ref{Module: "echo", Name: "echo"}
+	verb := &schema.Verb{Name: "echo", Metadata: []schema.Metadata{&schema.MetadataRateLimit{Limit: 2, Period: "1s"}}}
+	for range 2 {
+		release, err := limiter.Acquire(ctx, ref, verb)
+		assert.NoError(t, err)
+		release()
+	}
+	_, err := limiter.Acquire(ctx, ref, verb)
+	assert.True(t, errors.Is(err, ErrRateLimited), "expected rate limit error but got %v", err)
+}
+
+func TestConcurrencyLimit(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	limiter := New(newFakeDAL())
+	ref := &schema.Ref{Module: "echo", Name: "echo"}
+	verb := &schema.Verb{Name: "echo", Metadata: []schema.Metadata{&schema.MetadataConcurrency{Limit: 2}}}
+	release1, err := limiter.Acquire(ctx, ref, verb)
+	assert.NoError(t, err)
+	release2, err := limiter.Acquire(ctx, ref, verb)
+	assert.NoError(t, err)
+	_, err = limiter.Acquire(ctx, ref, verb)
+	assert.True(t, errors.Is(err, ErrConcurrencyLimited), "expected concurrency limit error but got %v", err)
+
+	release1()
+	release3, err := limiter.Acquire(ctx, ref, verb)
+	assert.NoError(t, err)
+	release2()
+	release3()
+}
+
+func TestConcurrencyLimitDoesNotConsumeRateLimit(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	dal := newFakeDAL()
+	limiter := New(dal)
+	ref := &schema.Ref{Module: "echo", Name: "echo"}
+	verb := &schema.Verb{Name: "echo", Metadata: []schema.Metadata{
+		&schema.MetadataRateLimit{Limit: 2, Period: "1s"},
+		&schema.MetadataConcurrency{Limit: 1},
+	}}
+	release, err := limiter.Acquire(ctx, ref, verb)
+	assert.NoError(t, err)
+	_, err = limiter.Acquire(ctx, ref, verb)
+	assert.True(t, errors.Is(err, ErrConcurrencyLimited), "expected concurrency limit error but got %v", err)
+	assert.Equal(t, 1, dal.tokens[ref.String()])
+	release()
+
+	// A call rejected by the rate limit releases its concurrency slot.
+	release, err = limiter.Acquire(ctx, ref, verb)
+	assert.NoError(t, err)
+	release()
+	_, err = limiter.Acquire(ctx, ref, verb)
+	assert.True(t, errors.Is(err, ErrRateLimited), "expected rate limit error but got %v", err)
+	assert.Equal(t, 0, len(dal.slots))
+}
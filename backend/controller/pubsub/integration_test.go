@@ -39,6 +39,73 @@ func TestPubSub(t *testing.T) {
 	)
 }
 
+func TestBatchConsumption(t *testing.T) {
+	in.Run(t,
+		in.CopyModule("publisher"),
+		in.CopyModule("subscriber"),
+		in.Deploy("publisher"),
+		in.Deploy("subscriber"),
+
+		in.Call("publisher", "publishTen", in.Obj{}, func(t testing.TB, resp in.Obj) {}),
+
+		in.Sleep(time.Second*4),
+
+		// ten events are consumed in two batches of five
+		in.QueryRow("ftl",
+			fmt.Sprintf(`
+				SELECT COUNT(*)
+				FROM async_calls
+				WHERE
+					state = 'success'
+					AND origin = '%s'
+		`, dal.AsyncOriginPubSub{Subscription: schema.RefKey{Module: "subscriber", Name: "batchSubscription"}}.String()),
+			2),
+	)
+}
+
+func TestPartitionedTopic(t *testing.T) {
+	in.Run(t,
+		in.CopyModule("publisher"),
+		in.CopyModule("subscriber"),
+		in.Deploy("publisher"),
+		in.Deploy("subscriber"),
+
+		// publish 20 events across 5 keys
+		in.Call("publisher", "publishOrdered", in.Obj{}, func(t testing.TB, resp in.Obj) {}),
+
+		in.Sleep(time.Second*4),
+
+		// every partition has a cursor
+		in.QueryRow("ftl", `
+			SELECT COUNT(*)
+			FROM topic_subscription_cursors cursors
+			INNER JOIN topic_subscriptions subs ON cursors.subscription_id = subs.id
+			WHERE subs.name = 'orderedSubscription'
+		`, 4),
+
+		// all events were consumed
+		in.QueryRow("ftl", `
+			SELECT COUNT(*)
+			FROM async_calls
+			WHERE
+				state = 'success'
+				AND origin LIKE 'sub:subscriber.orderedSubscription%'
+		`, 20),
+
+		// events with the same key were published to the same partition
+		in.QueryRow("ftl", `
+			SELECT COUNT(*)
+			FROM (
+				SELECT partition_key
+				FROM topic_events
+				WHERE partition_key IS NOT NULL
+				GROUP BY partition_key
+				HAVING COUNT(DISTINCT partition) > 1
+			) AS split_keys
+		`, 0),
+	)
+}
+
 func TestConsumptionDelay(t *testing.T) {
 	in.Run(t,
 		in.CopyModule("publisher"),
@@ -81,6 +148,31 @@ func TestConsumptionDelay(t *testing.T) {
 	)
 }
 
+func TestSeekSubscription(t *testing.T) {
+	origin := dal.AsyncOriginPubSub{Subscription: schema.RefKey{Module: "subscriber", Name: "testSubscription"}}.String()
+	in.Run(t,
+		in.CopyModule("publisher"),
+		in.CopyModule("subscriber"),
+		in.Deploy("publisher"),
+		in.Deploy("subscriber"),
+
+		in.Call("publisher", "publishTen", in.Obj{}, func(t testing.TB, resp in.Obj) {}),
+		in.Sleep(time.Second*4),
+		in.QueryRow("ftl", fmt.Sprintf("SELECT COUNT(*) FROM async_calls WHERE state = 'success' AND origin = '%s'", origin), 10),
+
+		// a dry run reports the events that would be redelivered without moving the cursor
+		in.ExecWithExpectedOutput("Would move subscriber.testSubscription: 10 event(s) redelivered, 0 event(s) skipped\n",
+			"ftl", "pubsub", "subscription", "seek", "subscriber.testSubscription", "--since=1h", "--dry-run"),
+		in.Sleep(time.Second*2),
+		in.QueryRow("ftl", fmt.Sprintf("SELECT COUNT(*) FROM async_calls WHERE state = 'success' AND origin = '%s'", origin), 10),
+
+		// rewinding redelivers the events
+		in.Exec("ftl", "pubsub", "subscription", "seek", "subscriber.testSubscription", "--since=1h"),
+		in.Sleep(time.Second*4),
+		in.QueryRow("ftl", fmt.Sprintf("SELECT COUNT(*) FROM async_calls WHERE state = 'success' AND origin = '%s'", origin), 20),
+	)
+}
+
 func TestRetry(t *testing.T) {
 	retriesPerCall := 2
 	in.Run(t,
@@ -134,6 +226,58 @@ func TestRetry(t *testing.T) {
 	)
 }
 
+func TestDeadLetter(t *testing.T) {
+	in.Run(t,
+		in.CopyModule("publisher"),
+		in.CopyModule("subscriber"),
+		in.Deploy("publisher"),
+		in.Deploy("subscriber"),
+
+		// publish events
+		in.Call("publisher", "publishOneToTopic2", in.Obj{}, func(t testing.TB, resp in.Obj) {}),
+
+		in.Sleep(time.Second*4),
+
+		// check that the event was published to the dead letter topic after exhausting retries
+		in.QueryRow("ftl", `
+			SELECT COUNT(*)
+			FROM topic_dead_letters
+			JOIN topic_events ON topic_dead_letters.event_id = topic_events.id
+			JOIN topics ON topic_events.topic_id = topics.id
+			WHERE
+				topics.name = 'deadLetters'
+				AND topic_dead_letters.attempts = 2
+				AND topic_dead_letters.error LIKE '%always error: event%'
+		`, 1),
+
+		// the dead letter topic receives the event along with the error and attempts
+		in.QueryRow("ftl", `
+			SELECT COUNT(*)
+			FROM async_calls
+			WHERE
+				state = 'success'
+				AND origin = 'sub:subscriber.deadLetterSubscription'
+		`, 1),
+
+		// replaying the event fails again and returns it to the dead letters
+		in.Exec("ftl", "pubsub", "deadletter", "replay", "subscriber.deadLetteredSubscription"),
+		in.Sleep(time.Second*4),
+		in.QueryRow("ftl",
+			fmt.Sprintf(`
+				SELECT COUNT(*)
+				FROM async_calls
+				WHERE
+					state = 'error'
+					AND origin LIKE '%s:%%'
+		`, "dlq:subscriber.deadLetteredSubscription"),
+			2),
+		in.QueryRow("ftl", "SELECT attempts FROM topic_dead_letters", 4),
+
+		in.Exec("ftl", "pubsub", "deadletter", "purge", "subscriber.deadLetteredSubscription"),
+		in.QueryRow("ftl", "SELECT COUNT(*) FROM topic_dead_letters", 0),
+	)
+}
+
 func TestExternalPublishRuntimeCheck(t *testing.T) {
 	in.Run(t,
 		in.CopyModule("publisher"),
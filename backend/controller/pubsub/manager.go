@@ -2,12 +2,15 @@ package pubsub
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/alecthomas/types/optional"
 	"github.com/jpillora/backoff"
 
 	"github.com/TBD54566975/ftl/backend/controller/dal"
 	"github.com/TBD54566975/ftl/backend/controller/scheduledtask"
+	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/internal/log"
 )
 
@@ -20,7 +23,7 @@ const (
 
 type DAL interface {
 	ProgressSubscriptions(ctx context.Context, eventConsumptionDelay time.Duration) (count int, err error)
-	CompleteEventForSubscription(ctx context.Context, module, name string) error
+	CompleteEventForSubscription(ctx context.Context, module, name string, partition int) error
 }
 
 type Scheduler interface {
@@ -66,8 +69,30 @@ func (m *Manager) progressSubscriptions(ctx context.Context) (time.Duration, err
 }
 
 // OnCallCompletion is called within a transaction after an async call has completed to allow the subscription state to be updated.
-func (m *Manager) OnCallCompletion(ctx context.Context, tx *dal.Tx, origin dal.AsyncOriginPubSub, failed bool) error {
-	return m.dal.CompleteEventForSubscription(ctx, origin.Subscription.Module, origin.Subscription.Name)
+//
+// If the call failed permanently after the given number of attempts the event
+// is published to the subscription's dead letter topic, if it has one.
+func (m *Manager) OnCallCompletion(ctx context.Context, tx *dal.Tx, origin dal.AsyncOriginPubSub, sink schema.RefKey, failure optional.Option[string], attempts int) error {
+	if callError, ok := failure.Get(); ok {
+		if err := tx.DeadLetterEventForSubscription(ctx, origin.Subscription, origin.Partition, callError, attempts); err != nil {
+			return fmt.Errorf("failed to dead letter event: %w", err)
+		}
+	}
+	return m.dal.CompleteEventForSubscription(ctx, origin.Subscription.Module, origin.Subscription.Name, origin.Partition)
+}
+
+// OnDeadLetterCallCompletion is called within a transaction after a replayed dead letter event has been consumed.
+//
+// If the call failed permanently again the event is returned to the dead letters.
+func (m *Manager) OnDeadLetterCallCompletion(ctx context.Context, tx *dal.Tx, origin dal.AsyncOriginDeadLetter, sink schema.RefKey, failure optional.Option[string], attempts int) error {
+	callError, ok := failure.Get()
+	if !ok {
+		return nil
+	}
+	if err := tx.DeadLetterReplayedEvent(ctx, origin, callError, attempts); err != nil {
+		return fmt.Errorf("failed to dead letter replayed event: %w", err)
+	}
+	return nil
 }
 
 // AsyncCallDidCommit is called after an subscription's async call has been completed and committed to the database.
@@ -2,6 +2,7 @@ package publisher
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/TBD54566975/ftl/go-runtime/ftl"
@@ -47,3 +48,21 @@ func PublishOneToTopic2(ctx context.Context) error {
 	logger.Infof("Publishing to topic_2 %v", t)
 	return Topic2.Publish(ctx, PubSubEvent{Time: t})
 }
+
+//ftl:export
+var OrderedTopic = ftl.Topic[PubSubEvent]("orderedTopic", ftl.Partitions(4))
+
+//ftl:verb
+func PublishOrdered(ctx context.Context) error {
+	logger := ftl.LoggerFromContext(ctx)
+	for i := 0; i < 20; i++ {
+		t := time.Now()
+		key := fmt.Sprintf("key%d", i%5)
+		logger.Infof("Publishing %v with key %s", t, key)
+		err := OrderedTopic.PublishWithKey(ctx, key, PubSubEvent{Time: t})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
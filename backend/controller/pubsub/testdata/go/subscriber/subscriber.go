@@ -25,6 +25,15 @@ func Consume(ctx context.Context, req publisher.PubSubEvent) error {
 	return nil
 }
 
+var _ = ftl.Subscription(publisher.TestTopic, "batchSubscription")
+
+//ftl:verb
+//ftl:subscribe batchSubscription batch 5 1s
+func ConsumeBatch(ctx context.Context, req []publisher.PubSubEvent) error {
+	ftl.LoggerFromContext(ctx).Infof("Subscriber is consuming a batch of %d events", len(req))
+	return nil
+}
+
 var _ = ftl.Subscription(publisher.Topic2, "doomedSubscription")
 
 //ftl:verb
@@ -34,6 +43,37 @@ func ConsumeButFailAndRetry(ctx context.Context, req publisher.PubSubEvent) erro
 	return fmt.Errorf("always error: event %v", req.Time)
 }
 
+var DeadLetters = ftl.Topic[builtin.FailedEvent[publisher.PubSubEvent]]("deadLetters")
+
+var _ = ftl.Subscription(publisher.Topic2, "deadLetteredSubscription", ftl.DeadLetter(DeadLetters))
+
+//ftl:verb
+//ftl:subscribe deadLetteredSubscription
+//ftl:retry 1 1s 1s
+func ConsumeButDeadLetter(ctx context.Context, req publisher.PubSubEvent) error {
+	return fmt.Errorf("always error: event %v", req.Time)
+}
+
+var _ = ftl.Subscription(DeadLetters, "deadLetterSubscription")
+
+//ftl:verb
+//ftl:subscribe deadLetterSubscription
+func ConsumeDeadLetter(ctx context.Context, req builtin.FailedEvent[publisher.PubSubEvent]) error {
+	if req.Attempts != 2 || !strings.Contains(req.Error, "always error: event") || req.Event.Time.IsZero() {
+		return fmt.Errorf("unexpected dead letter: %v", req)
+	}
+	return nil
+}
+
+var _ = ftl.Subscription(publisher.OrderedTopic, "orderedSubscription")
+
+//ftl:verb
+//ftl:subscribe orderedSubscription
+func ConsumeOrdered(ctx context.Context, req publisher.PubSubEvent) error {
+	ftl.LoggerFromContext(ctx).Infof("Subscriber is consuming ordered event %v", req.Time)
+	return nil
+}
+
 //ftl:verb
 func PublishToExternalModule(ctx context.Context) error {
 	// Get around compile-time checks
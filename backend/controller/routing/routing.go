@@ -0,0 +1,250 @@
+// Package routing selects which runner a call to a module is routed to.
+package routing
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+// Strategy used to select a route from the routes available for a module.
+type Strategy string
+
+const (
+	// StrategyRandom selects a route uniformly at random.
+	StrategyRandom Strategy = "random"
+	// StrategyLeastOutstanding selects the route with the fewest in-flight requests.
+	StrategyLeastOutstanding Strategy = "least-outstanding"
+	// StrategyPowerOfTwo selects the less loaded of two randomly chosen routes.
+	StrategyPowerOfTwo Strategy = "power-of-two"
+	// StrategyConsistentHash selects a route by hashing the request key, so
+	// that all calls within a request chain land on the same runner.
+	StrategyConsistentHash Strategy = "consistent-hash"
+)
+
+func (s Strategy) Validate() error {
+	switch s {
+	case StrategyRandom, StrategyLeastOutstanding, StrategyPowerOfTwo, StrategyConsistentHash:
+		return nil
+	default:
+		return fmt.Errorf("unknown routing strategy %q", s)
+	}
+}
+
+type Config struct {
+	// Default strategy for modules without an override.
+	Default Strategy
+	// Per-module strategy overrides.
+	Modules map[string]Strategy
+	// A runner is an outlier if its average latency exceeds the module median by this factor.
+	OutlierFactor float64
+	// Minimum number of successful calls to a runner before it is considered for outlier detection.
+	OutlierMinSamples int
+}
+
+// ewmaWeight is the weight given to each new latency sample.
+const ewmaWeight = 0.1
+
+type runnerStats struct {
+	inflight atomic.Int64
+
+	lock    sync.Mutex
+	latency float64 // EWMA of call latency in nanoseconds.
+	samples int
+}
+
+func (r *runnerStats) observe(latency time.Duration) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.samples == 0 {
+		r.latency = float64(latency)
+	} else {
+		r.latency = ewmaWeight*float64(latency) + (1-ewmaWeight)*r.latency
+	}
+	r.samples++
+}
+
+func (r *runnerStats) snapshot() (latency float64, samples int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.latency, r.samples
+}
+
+// Router selects routes for calls and tracks per-runner in-flight requests and latency.
+type Router struct {
+	config Config
+
+	lock  sync.Mutex
+	stats map[string]*runnerStats
+}
+
+func New(config Config) *Router {
+	if config.Default == "" {
+		config.Default = StrategyRandom
+	}
+	return &Router{config: config, stats: map[string]*runnerStats{}}
+}
+
+func (r *Router) statsFor(runner model.RunnerKey) *runnerStats {
+	key := runner.String()
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	stats, ok := r.stats[key]
+	if !ok {
+		stats = &runnerStats{}
+		r.stats[key] = stats
+	}
+	return stats
+}
+
+// StrategyForModule returns the strategy configured for the given module.
+func (r *Router) StrategyForModule(module string) Strategy {
+	if strategy, ok := r.config.Modules[module]; ok {
+		return strategy
+	}
+	return r.config.Default
+}
+
+// Select a route for a call to module.
+//
+// Degraded routes are only considered if every route is degraded. "key" is
+// used by the consistent-hash strategy.
+func (r *Router) Select(module string, routes []dal.Route, key string) dal.Route {
+	candidates := make([]dal.Route, 0, len(routes))
+	for _, route := range routes {
+		if !route.Degraded {
+			candidates = append(candidates, route)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = routes
+	}
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+	switch r.StrategyForModule(module) {
+	case StrategyLeastOutstanding:
+		return r.leastOutstanding(candidates)
+	case StrategyPowerOfTwo:
+		i := rand.Intn(len(candidates))     //nolint:gosec
+		j := rand.Intn(len(candidates) - 1) //nolint:gosec
+		if j >= i {
+			j++
+		}
+		return r.leastOutstanding([]dal.Route{candidates[i], candidates[j]})
+	case StrategyConsistentHash:
+		return rendezvous(candidates, key)
+	default:
+		return candidates[rand.Intn(len(candidates))] //nolint:gosec
+	}
+}
+
+// Track records the start of a call to runner. The returned function must be
+// called when the call completes.
+//
+// Only the latency of successful calls is recorded, so that a runner that
+// fails quickly doesn't appear faster than its peers.
+func (r *Router) Track(runner model.RunnerKey) func(success bool) {
+	stats := r.statsFor(runner)
+	stats.inflight.Add(1)
+	start := time.Now()
+	return func(success bool) {
+		stats.inflight.Add(-1)
+		if success {
+			stats.observe(time.Since(start))
+		}
+	}
+}
+
+// Outliers returns the runners whose average latency is an outlier relative to
+// the other runners of the same module.
+//
+// Statistics for returned outliers and for runners no longer present in routes
+// are discarded, so that outliers are re-evaluated on fresh samples.
+func (r *Router) Outliers(routes map[string][]dal.Route) []model.RunnerKey {
+	live := map[string]bool{}
+	out := []model.RunnerKey{}
+	for _, moduleRoutes := range routes {
+		type sample struct {
+			runner  model.RunnerKey
+			latency float64
+		}
+		samples := make([]sample, 0, len(moduleRoutes))
+		for _, route := range moduleRoutes {
+			live[route.Runner.String()] = true
+			latency, count := r.statsFor(route.Runner).snapshot()
+			if count == 0 || count < r.config.OutlierMinSamples {
+				continue
+			}
+			samples = append(samples, sample{runner: route.Runner, latency: latency})
+		}
+		// A median needs enough runners to be meaningful.
+		if len(samples) < 3 || r.config.OutlierFactor <= 0 {
+			continue
+		}
+		sorted := make([]float64, len(samples))
+		for i, s := range samples {
+			sorted[i] = s.latency
+		}
+		sort.Float64s(sorted)
+		median := sorted[len(sorted)/2]
+		for _, s := range samples {
+			if s.latency > median*r.config.OutlierFactor {
+				out = append(out, s.runner)
+			}
+		}
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, runner := range out {
+		delete(live, runner.String())
+	}
+	for key := range r.stats {
+		if !live[key] {
+			delete(r.stats, key)
+		}
+	}
+	return out
+}
+
+func (r *Router) leastOutstanding(routes []dal.Route) dal.Route {
+	var best dal.Route
+	var bestLoad int64 = -1
+	ties := 0
+	for _, route := range routes {
+		load := r.statsFor(route.Runner).inflight.Load()
+		switch {
+		case bestLoad < 0 || load < bestLoad:
+			best, bestLoad, ties = route, load, 1
+		case load == bestLoad:
+			// Reservoir sample among equally loaded routes.
+			ties++
+			if rand.Intn(ties) == 0 { //nolint:gosec
+				best = route
+			}
+		}
+	}
+	return best
+}
+
+// rendezvous selects the route with the highest hash weight for key.
+func rendezvous(routes []dal.Route, key string) dal.Route {
+	var best dal.Route
+	var bestWeight uint64
+	for i, route := range routes {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(key))                   //nolint:errcheck
+		_, _ = h.Write([]byte(route.Runner.String())) //nolint:errcheck
+		if weight := h.Sum64(); i == 0 || weight > bestWeight {
+			best, bestWeight = route, weight
+		}
+	}
+	return best
+}
@@ -0,0 +1,108 @@
+package routing
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func testRoutes(n int) []dal.Route {
+	routes := make([]dal.Route, n)
+	for i := range routes {
+		routes[i] = dal.Route{
+			Module:   "echo",
+			Runner:   model.NewRunnerKey("localhost", fmt.Sprintf("%d", 9000+i)),
+			Endpoint: fmt.Sprintf("http://localhost:%d", 9000+i),
+		}
+	}
+	return routes
+}
+
+func TestLeastOutstanding(t *testing.T) {
+	router := New(Config{Default: StrategyLeastOutstanding})
+	routes := testRoutes(3)
+	done0 := router.Track(routes[0].Runner)
+	done1 := router.Track(routes[1].Runner)
+	for range 10 {
+		assert.Equal(t, routes[2].Runner, router.Select("echo", routes, "").Runner)
+	}
+	done0(true)
+	done1(true)
+}
+
+func TestPowerOfTwoAvoidsBusiest(t *testing.T) {
+	router := New(Config{Default: StrategyPowerOfTwo})
+	routes := testRoutes(2)
+	done := router.Track(routes[0].Runner)
+	defer done(true)
+	for range 10 {
+		assert.Equal(t, routes[1].Runner, router.Select("echo", routes, "").Runner)
+	}
+}
+
+func TestConsistentHash(t *testing.T) {
+	router := New(Config{Modules: map[string]Strategy{"echo": StrategyConsistentHash}})
+	routes := testRoutes(5)
+	first := router.Select("echo", routes, "req-1234")
+	for range 10 {
+		assert.Equal(t, first.Runner, router.Select("echo", routes, "req-1234").Runner)
+	}
+	// Removing an unrelated route must not move the key.
+	var remaining []dal.Route
+	for _, route := range routes {
+		if route.Runner.String() == first.Runner.String() || len(remaining) < 2 {
+			remaining = append(remaining, route)
+		}
+	}
+	assert.Equal(t, first.Runner, router.Select("echo", remaining, "req-1234").Runner)
+}
+
+func TestSelectSkipsDegraded(t *testing.T) {
+	router := New(Config{})
+	routes := testRoutes(3)
+	routes[0].Degraded = true
+	routes[2].Degraded = true
+	for range 10 {
+		assert.Equal(t, routes[1].Runner, router.Select("echo", routes, "").Runner)
+	}
+	routes[1].Degraded = true
+	router.Select("echo", routes, "") // Falls back to degraded routes.
+}
+
+func TestOutliers(t *testing.T) {
+	router := New(Config{OutlierFactor: 3, OutlierMinSamples: 1})
+	routes := testRoutes(4)
+	for i, route := range routes {
+		latency := time.Millisecond * 10
+		if i == 3 {
+			latency = time.Millisecond * 100
+		}
+		router.statsFor(route.Runner).observe(latency)
+	}
+	outliers := router.Outliers(map[string][]dal.Route{"echo": routes})
+	assert.Equal(t, []model.RunnerKey{routes[3].Runner}, outliers)
+	_, samples := router.statsFor(routes[3].Runner).snapshot()
+	assert.Equal(t, 0, samples, "outlier stats should be reset")
+
+	// Stats for runners that are no longer routed to are discarded.
+	router.Outliers(map[string][]dal.Route{"echo": routes[:1]})
+	assert.Equal(t, 1, len(router.stats))
+}
+
+func TestTrackIgnoresFailedCallLatency(t *testing.T) {
+	router := New(Config{})
+	routes := testRoutes(1)
+	router.Track(routes[0].Runner)(false)
+	_, samples := router.statsFor(routes[0].Runner).snapshot()
+	assert.Equal(t, 0, samples)
+	assert.Equal(t, 0, router.statsFor(routes[0].Runner).inflight.Load())
+
+	router.Track(routes[0].Runner)(true)
+	_, samples = router.statsFor(routes[0].Runner).snapshot()
+	assert.Equal(t, 1, samples)
+}
@@ -194,9 +194,12 @@ func (ns NullEventType) Value() (driver.Value, error) {
 type FsmStatus string
 
 const (
-	FsmStatusRunning   FsmStatus = "running"
-	FsmStatusCompleted FsmStatus = "completed"
-	FsmStatusFailed    FsmStatus = "failed"
+	FsmStatusRunning            FsmStatus = "running"
+	FsmStatusCompleted          FsmStatus = "completed"
+	FsmStatusFailed             FsmStatus = "failed"
+	FsmStatusCompensating       FsmStatus = "compensating"
+	FsmStatusCompensated        FsmStatus = "compensated"
+	FsmStatusCompensationFailed FsmStatus = "compensation_failed"
 )
 
 func (e *FsmStatus) Scan(src interface{}) error {
@@ -388,6 +391,17 @@ type AsyncCall struct {
 	Catching          bool
 	ParentRequestKey  optional.Option[string]
 	TraceContext      pqtype.NullRawMessage
+	Attempts          int32
+}
+
+type ConcurrencyLimit struct {
+	Key string
+}
+
+type ConcurrencySlot struct {
+	ID        int64
+	Key       string
+	ExpiresAt time.Time
 }
 
 type Controller struct {
@@ -409,6 +423,9 @@ type CronJob struct {
 	NextExecution time.Time
 	State         model.CronJobState
 	ModuleName    string
+	LastExecution optional.Option[time.Time]
+	Timezone      string
+	MissedRuns    string
 }
 
 type Deployment struct {
@@ -436,15 +453,36 @@ type EncryptionKey struct {
 }
 
 type FsmInstance struct {
-	ID               int64
-	CreatedAt        time.Time
-	Fsm              schema.RefKey
-	Key              string
-	Status           FsmStatus
-	CurrentState     optional.Option[schema.RefKey]
-	DestinationState optional.Option[schema.RefKey]
-	AsyncCallID      optional.Option[int64]
-	UpdatedAt        time.Time
+	ID                      int64
+	CreatedAt               time.Time
+	Fsm                     schema.RefKey
+	Key                     string
+	Status                  FsmStatus
+	CurrentState            optional.Option[schema.RefKey]
+	DestinationState        optional.Option[schema.RefKey]
+	AsyncCallID             optional.Option[int64]
+	UpdatedAt               time.Time
+	TimeoutAsyncCallID      optional.Option[int64]
+	NextEventType           optional.Option[string]
+	NextEventRequest        []byte
+	CompensatingAsyncCallID optional.Option[int64]
+}
+
+type FsmQueuedEvent struct {
+	ID            int64
+	CreatedAt     time.Time
+	FsmInstanceID int64
+	EventType     string
+	Request       []byte
+	RequestHash   []byte
+}
+
+type IdempotencyKey struct {
+	Verb      schema.RefKey
+	Key       string
+	Response  []byte
+	CreatedAt time.Time
+	ExpiresAt time.Time
 }
 
 type IngressRoute struct {
@@ -486,6 +524,19 @@ type ModuleSecret struct {
 	Url       string
 }
 
+type PausedCronJob struct {
+	ModuleName string
+	Verb       string
+	PausedAt   time.Time
+}
+
+type RateLimit struct {
+	Key       string
+	Tokens    float64
+	UpdatedAt time.Time
+	Period    sqltypes.Duration
+}
+
 type Request struct {
 	ID         int64
 	Origin     Origin
@@ -504,6 +555,7 @@ type Runner struct {
 	ModuleName         optional.Option[string]
 	DeploymentID       optional.Option[int64]
 	Labels             json.RawMessage
+	DegradedUntil      optional.Option[time.Time]
 }
 
 type Timeline struct {
@@ -521,13 +573,23 @@ type Timeline struct {
 }
 
 type Topic struct {
-	ID        int64
-	Key       model.TopicKey
-	CreatedAt time.Time
-	ModuleID  int64
-	Name      string
-	Type      string
-	Head      optional.Option[int64]
+	ID         int64
+	Key        model.TopicKey
+	CreatedAt  time.Time
+	ModuleID   int64
+	Name       string
+	Type       string
+	Head       optional.Option[int64]
+	Partitions int32
+}
+
+type TopicDeadLetter struct {
+	ID             int64
+	CreatedAt      time.Time
+	EventID        int64
+	SubscriptionID int64
+	Error          string
+	Attempts       int32
 }
 
 type TopicEvent struct {
@@ -539,6 +601,8 @@ type TopicEvent struct {
 	Caller       optional.Option[string]
 	RequestKey   optional.Option[string]
 	TraceContext pqtype.NullRawMessage
+	Partition    int32
+	PartitionKey optional.Option[string]
 }
 
 type TopicSubscriber struct {
@@ -552,16 +616,34 @@ type TopicSubscriber struct {
 	Backoff              sqltypes.Duration
 	MaxBackoff           sqltypes.Duration
 	CatchVerb            optional.Option[schema.RefKey]
+	BatchSize            optional.Option[int32]
+	BatchWait            sqltypes.Duration
 }
 
 type TopicSubscription struct {
-	ID           int64
-	Key          model.SubscriptionKey
-	CreatedAt    time.Time
-	TopicID      int64
-	ModuleID     int64
-	DeploymentID int64
-	Name         string
-	Cursor       optional.Option[int64]
-	State        TopicSubscriptionState
+	ID                int64
+	Key               model.SubscriptionKey
+	CreatedAt         time.Time
+	TopicID           int64
+	ModuleID          int64
+	DeploymentID      int64
+	Name              string
+	DeadLetterTopicID optional.Option[int64]
+}
+
+type TopicSubscriptionCursor struct {
+	ID             int64
+	SubscriptionID int64
+	Partition      int32
+	Cursor         optional.Option[int64]
+	State          TopicSubscriptionState
+	BatchStart     optional.Option[int64]
+}
+
+type WebsocketMessage struct {
+	ID            int64
+	CreatedAt     time.Time
+	ConnectionKey string
+	Body          []byte
+	IsBinary      bool
 }
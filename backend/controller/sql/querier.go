@@ -22,10 +22,30 @@ type Querier interface {
 	// Reserve a pending async call for execution, returning the associated lease
 	// reservation key and accompanying metadata.
 	AcquireAsyncCall(ctx context.Context, ttl sqltypes.Duration) (AcquireAsyncCallRow, error)
+	// Take a slot if fewer than "capacity" unexpired slots are held. Returns no
+	// rows if the limit has been reached.
+	//
+	// Must be called in the same transaction as LockConcurrencyLimit.
+	AcquireConcurrencySlot(ctx context.Context, key string, ttl sqltypes.Duration, capacity int64) (int64, error)
 	AssociateArtefactWithDeployment(ctx context.Context, arg AssociateArtefactWithDeploymentParams) error
 	AsyncCallQueueDepth(ctx context.Context) (int64, error)
-	BeginConsumingTopicEvent(ctx context.Context, subscription model.SubscriptionKey, event model.TopicEventKey) error
-	CompleteEventForSubscription(ctx context.Context, name string, module string) error
+	// Moves the cursor to the last event of the batch being consumed, which
+	// starts at batch_start.
+	BeginConsumingTopicEvent(ctx context.Context, batchStart model.TopicEventKey, subscription model.SubscriptionKey, event model.TopicEventKey) error
+	// Delete the pending timeout of an FSM instance, if any.
+	CancelFSMTimeout(ctx context.Context, fsm schema.RefKey, key string) (int64, error)
+	// Delete a scheduled call that is not yet executing.
+	CancelScheduledCall(ctx context.Context, origin string) (int64, error)
+	// Claim an idempotency key for a call, replacing any expired claim.
+	ClaimIdempotencyKey(ctx context.Context, verb schema.RefKey, key string, ttl sqltypes.Duration) (int64, error)
+	ClearFSMQueue(ctx context.Context, fsm schema.RefKey, key string) (int64, error)
+	ClearNextFSMEvent(ctx context.Context, fsm schema.RefKey, key string) error
+	// Mark an FSM instance as compensating the state entered by the given transition.
+	CompensateFSMInstance(ctx context.Context, compensatingAsyncCallID int64, fsm schema.RefKey, key string) (bool, error)
+	CompleteEventForSubscription(ctx context.Context, name string, partition int32, module string) error
+	// Counts the events in a topic partition after the "from" event, up to and
+	// including the "to" event. A NULL event represents the start of the partition.
+	CountTopicEventsBetween(ctx context.Context, arg CountTopicEventsBetweenParams) (int64, error)
 	// Create a new artefact and return the artefact ID.
 	CreateArtefact(ctx context.Context, digest []byte, content []byte) (int64, error)
 	CreateAsyncCall(ctx context.Context, arg CreateAsyncCallParams) (int64, error)
@@ -34,18 +54,30 @@ type Querier interface {
 	CreateIngressRoute(ctx context.Context, arg CreateIngressRouteParams) error
 	CreateOnlyEncryptionKey(ctx context.Context, key []byte) error
 	CreateRequest(ctx context.Context, origin Origin, key model.RequestKey, sourceAddr string) error
+	// Mark a runner as degraded for the given period, deprioritising it for routing.
+	DegradeRunner(ctx context.Context, ttl sqltypes.Duration, key model.RunnerKey) error
 	DeleteOldTimelineEvents(ctx context.Context, timeout sqltypes.Duration, type_ EventType) (int64, error)
+	DeleteStaleWebSocketMessages(ctx context.Context, maxAge sqltypes.Duration) (int64, error)
 	DeleteSubscribers(ctx context.Context, deployment model.DeploymentKey) ([]model.SubscriberKey, error)
 	DeleteSubscriptions(ctx context.Context, deployment model.DeploymentKey) ([]model.SubscriptionKey, error)
+	DeleteTopicDeadLetters(ctx context.Context, module string, name string, event optional.Option[model.TopicEventKey]) (int64, error)
 	DeregisterRunner(ctx context.Context, key model.RunnerKey) (int64, error)
 	EndCronJob(ctx context.Context, nextExecution time.Time, key model.CronJobKey, startTime time.Time) (EndCronJobRow, error)
+	ExpireConcurrencySlots(ctx context.Context) (int64, error)
+	ExpireIdempotencyKeys(ctx context.Context) (int64, error)
 	ExpireLeases(ctx context.Context) (int64, error)
+	// Delete token buckets that have not been used for a full period, and so have
+	// refilled.
+	ExpireRateLimits(ctx context.Context) (int64, error)
 	ExpireRunnerReservations(ctx context.Context) (int64, error)
 	FailAsyncCall(ctx context.Context, error string, iD int64) (bool, error)
 	FailAsyncCallWithRetry(ctx context.Context, arg FailAsyncCallWithRetryParams) (bool, error)
 	FailFSMInstance(ctx context.Context, fsm schema.RefKey, key string) (bool, error)
+	FinishFSMCompensation(ctx context.Context, status FsmStatus, fsm schema.RefKey, key string) (bool, error)
 	// Mark an FSM transition as completed, updating the current state and clearing the async call ID.
-	FinishFSMTransition(ctx context.Context, fsm schema.RefKey, key string) (bool, error)
+	//
+	// Returns the next event set by the state verb, if any, which is also cleared.
+	FinishFSMTransition(ctx context.Context, fsm schema.RefKey, key string) (FinishFSMTransitionRow, error)
 	GetActiveControllers(ctx context.Context) ([]Controller, error)
 	GetActiveDeploymentSchemas(ctx context.Context) ([]GetActiveDeploymentSchemasRow, error)
 	GetActiveDeployments(ctx context.Context) ([]GetActiveDeploymentsRow, error)
@@ -55,6 +87,9 @@ type Querier interface {
 	// Return the digests that exist in the database.
 	GetArtefactDigests(ctx context.Context, digests [][]byte) ([]GetArtefactDigestsRow, error)
 	GetCronJobs(ctx context.Context) ([]GetCronJobsRow, error)
+	// Returns the dead letter topic for a subscription, along with the batch of
+	// events the subscription is currently consuming from the given partition.
+	GetDeadLetterEventsForSubscription(ctx context.Context, module string, name string, partition int32) ([]GetDeadLetterEventsForSubscriptionRow, error)
 	GetDeployment(ctx context.Context, key model.DeploymentKey) (GetDeploymentRow, error)
 	// Get all artefacts matching the given digests.
 	GetDeploymentArtefacts(ctx context.Context, deploymentID int64) ([]GetDeploymentArtefactsRow, error)
@@ -65,14 +100,23 @@ type Querier interface {
 	GetDeploymentsWithArtefacts(ctx context.Context, digests [][]byte, schema []byte, count int64) ([]GetDeploymentsWithArtefactsRow, error)
 	GetDeploymentsWithMinReplicas(ctx context.Context) ([]GetDeploymentsWithMinReplicasRow, error)
 	GetExistingDeploymentForModule(ctx context.Context, name string) (GetExistingDeploymentForModuleRow, error)
+	// Transitions completed by an FSM instance before the given async call, most recent first.
+	GetFSMCompletedTransitions(ctx context.Context, origin string, beforeID int64) ([]GetFSMCompletedTransitionsRow, error)
 	GetFSMInstance(ctx context.Context, fsm schema.RefKey, key string) (FsmInstance, error)
+	GetFSMQueueDepth(ctx context.Context, fsm schema.RefKey, key string) (int64, error)
+	// Every async call made to execute a transition of an FSM instance, oldest first.
+	GetFSMTransitionHistory(ctx context.Context, origin string) ([]GetFSMTransitionHistoryRow, error)
+	GetIdempotentResponse(ctx context.Context, verb schema.RefKey, key string) ([]byte, error)
 	GetIdleRunners(ctx context.Context, labels json.RawMessage, limit int64) ([]Runner, error)
 	// Get the runner endpoints corresponding to the given ingress route.
 	GetIngressRoutes(ctx context.Context, method string) ([]GetIngressRoutesRow, error)
 	GetLeaseInfo(ctx context.Context, key leases.Key) (GetLeaseInfoRow, error)
 	GetModulesByID(ctx context.Context, ids []int64) ([]Module, error)
-	GetNextEventForSubscription(ctx context.Context, consumptionDelay sqltypes.Duration, topic model.TopicKey, cursor optional.Option[model.TopicEventKey]) (GetNextEventForSubscriptionRow, error)
+	// Returns up to max_events events in the partition after the cursor.
+	GetNextEventsForSubscription(ctx context.Context, arg GetNextEventsForSubscriptionParams) ([]GetNextEventsForSubscriptionRow, error)
 	GetOnlyEncryptionKey(ctx context.Context) ([]byte, error)
+	// Returns the last event in the topic partition that is ordered before the given position.
+	GetPreviousTopicEvent(ctx context.Context, arg GetPreviousTopicEventParams) (TopicEvent, error)
 	GetProcessList(ctx context.Context) ([]GetProcessListRow, error)
 	GetRandomSubscriber(ctx context.Context, key model.SubscriptionKey) (GetRandomSubscriberRow, error)
 	// Retrieve routing information for a runner.
@@ -81,33 +125,77 @@ type Querier interface {
 	GetRunner(ctx context.Context, key model.RunnerKey) (GetRunnerRow, error)
 	GetRunnerState(ctx context.Context, key model.RunnerKey) (RunnerState, error)
 	GetRunnersForDeployment(ctx context.Context, key model.DeploymentKey) ([]GetRunnersForDeploymentRow, error)
+	// Count the running instances of an FSM in, or transitioning to, each state.
+	GetRunningFSMStates(ctx context.Context, fsm schema.RefKey) ([]GetRunningFSMStatesRow, error)
 	GetSchemaForDeployment(ctx context.Context, key model.DeploymentKey) (*schema.Module, error)
 	GetStaleCronJobs(ctx context.Context, dollar_1 sqltypes.Duration) ([]GetStaleCronJobsRow, error)
 	GetSubscription(ctx context.Context, column1 string, column2 string) (TopicSubscription, error)
+	GetSubscriptionCursors(ctx context.Context, subscriptionID int64) ([]TopicSubscriptionCursor, error)
 	// Results may not be ready to be scheduled yet due to event consumption delay
 	// Sorting ensures that brand new events (that may not be ready for consumption)
 	// don't prevent older events from being consumed
+	// Partitions of subscriptions that are waiting for a batch to fill are excluded
+	// so that they don't prevent other subscriptions from being consumed
 	GetSubscriptionsNeedingUpdate(ctx context.Context) ([]GetSubscriptionsNeedingUpdateRow, error)
 	GetTopic(ctx context.Context, dollar_1 int64) (Topic, error)
+	GetTopicDeadLetters(ctx context.Context, arg GetTopicDeadLettersParams) ([]GetTopicDeadLettersRow, error)
 	GetTopicEvent(ctx context.Context, dollar_1 int64) (TopicEvent, error)
+	GetTopicEventByKey(ctx context.Context, key model.TopicEventKey) (TopicEvent, error)
 	InsertSubscriber(ctx context.Context, arg InsertSubscriberParams) error
 	InsertTimelineCallEvent(ctx context.Context, arg InsertTimelineCallEventParams) error
 	InsertTimelineDeploymentCreatedEvent(ctx context.Context, arg InsertTimelineDeploymentCreatedEventParams) error
 	InsertTimelineDeploymentUpdatedEvent(ctx context.Context, arg InsertTimelineDeploymentUpdatedEventParams) error
 	InsertTimelineEvent(ctx context.Context, arg InsertTimelineEventParams) error
 	InsertTimelineLogEvent(ctx context.Context, arg InsertTimelineLogEventParams) error
+	InsertTopicDeadLetter(ctx context.Context, arg InsertTopicDeadLetterParams) error
+	InsertWebSocketMessage(ctx context.Context, connectionKey string, body []byte, isBinary bool) error
 	// Mark any controller entries that haven't been updated recently as dead.
 	KillStaleControllers(ctx context.Context, timeout sqltypes.Duration) (int64, error)
 	KillStaleRunners(ctx context.Context, timeout sqltypes.Duration) (int64, error)
+	ListCronJobs(ctx context.Context) ([]ListCronJobsRow, error)
+	ListFSMInstances(ctx context.Context, arg ListFSMInstancesParams) ([]ListFSMInstancesRow, error)
+	// Scheduled calls that have not yet completed, soonest first.
+	ListScheduledCalls(ctx context.Context, verb optional.Option[schema.RefKey], limit int32) ([]ListScheduledCallsRow, error)
 	LoadAsyncCall(ctx context.Context, id int64) (AsyncCall, error)
+	// Lock the semaphore row for a concurrency limit until the end of the
+	// transaction, creating it if necessary.
+	LockConcurrencyLimit(ctx context.Context, key string) error
+	// Redirect the pending transitions and timeouts of an FSM that target a removed state to a new state.
+	MigrateFSMAsyncCalls(ctx context.Context, toState schema.RefKey, fromState schema.RefKey, fsm schema.RefKey) (int64, error)
+	// Move the running instances of an FSM in, or transitioning to, a removed state to a new state.
+	MigrateFSMState(ctx context.Context, fromState schema.RefKey, toState schema.RefKey, fsm schema.RefKey) (int64, error)
 	NewLease(ctx context.Context, key leases.Key, ttl sqltypes.Duration, metadata pqtype.NullRawMessage) (uuid.UUID, error)
+	PauseCronJob(ctx context.Context, moduleName string, verb string) error
+	// Remove and return the oldest event queued for an FSM instance.
+	PopNextFSMEvent(ctx context.Context, fsm schema.RefKey, key string) (PopNextFSMEventRow, error)
 	PublishEventForTopic(ctx context.Context, arg PublishEventForTopicParams) error
+	// Queue an event for an FSM instance that is executing a transition.
+	//
+	// If "dedupe" is true the event is dropped if an identical event is already
+	// queued, in which case no rows are affected.
+	QueueFSMEvent(ctx context.Context, arg QueueFSMEventParams) (int64, error)
+	ReleaseConcurrencySlot(ctx context.Context, id int64) error
+	ReleaseIdempotencyKey(ctx context.Context, verb schema.RefKey, key string) error
 	ReleaseLease(ctx context.Context, idempotencyKey uuid.UUID, key leases.Key) (bool, error)
+	RenewConcurrencySlot(ctx context.Context, ttl sqltypes.Duration, iD int64) (int64, error)
 	RenewLease(ctx context.Context, ttl sqltypes.Duration, idempotencyKey uuid.UUID, key leases.Key) (bool, error)
 	// Find an idle runner and reserve it for the given deployment.
 	ReserveRunner(ctx context.Context, reservationTimeout time.Time, deploymentKey model.DeploymentKey, labels json.RawMessage) (Runner, error)
+	// Moves each partition cursor of a subscription to the head of the partition.
+	ResetSubscriptionCursors(ctx context.Context, key model.SubscriptionKey) error
+	// Resumes a paused cron job, moving its next execution forward so that
+	// executions missed while paused are skipped. Returns the number of paused
+	// jobs that were resumed.
+	ResumeCronJob(ctx context.Context, arg ResumeCronJobParams) (int64, error)
+	// Schedules a call unless a call with the same origin has not yet completed.
+	ScheduleAsyncCall(ctx context.Context, arg ScheduleAsyncCallParams) (int64, error)
 	SetDeploymentDesiredReplicas(ctx context.Context, key model.DeploymentKey, minReplicas int32) error
-	SetSubscriptionCursor(ctx context.Context, column1 model.SubscriptionKey, column2 model.TopicEventKey) error
+	// Record the async call that will fire the timeout of the current state of an FSM instance.
+	SetFSMTimeout(ctx context.Context, asyncCallID int64, fsm schema.RefKey, key string) error
+	SetIdempotentResponse(ctx context.Context, arg SetIdempotentResponseParams) error
+	// Set the event to send to an FSM instance once its executing transition completes.
+	SetNextFSMEvent(ctx context.Context, arg SetNextFSMEventParams) (int64, error)
+	SetSubscriptionCursorByID(ctx context.Context, cursor optional.Option[int64], cursorID int64) error
 	StartCronJobs(ctx context.Context, keys []string) ([]StartCronJobsRow, error)
 	// Start a new FSM transition, populating the destination state and async call ID.
 	//
@@ -115,6 +203,13 @@ type Querier interface {
 	StartFSMTransition(ctx context.Context, arg StartFSMTransitionParams) (FsmInstance, error)
 	SucceedAsyncCall(ctx context.Context, response []byte, iD int64) (bool, error)
 	SucceedFSMInstance(ctx context.Context, fsm schema.RefKey, key string) (bool, error)
+	// Take a token from the token bucket for the given key, which refills at
+	// "capacity" tokens per "period". Returns no rows if the bucket is empty.
+	TakeRateLimitToken(ctx context.Context, key string, capacity int64, period sqltypes.Duration) (float64, error)
+	// Remove and return the messages queued for a WebSocket connection, oldest first.
+	TakeWebSocketMessages(ctx context.Context, connectionKey string) ([]TakeWebSocketMessagesRow, error)
+	// Starts a cron job immediately, regardless of its next execution time or whether it is paused.
+	TriggerCronJob(ctx context.Context, key model.CronJobKey) (TriggerCronJobRow, error)
 	UpsertController(ctx context.Context, key model.ControllerKey, endpoint string) (int64, error)
 	UpsertModule(ctx context.Context, language string, name string) (int64, error)
 	// Upsert a runner and return the deployment ID that it is assigned to, if any.
@@ -124,7 +219,11 @@ type Querier interface {
 	// and the parent statement will fail due to a foreign key constraint.
 	UpsertRunner(ctx context.Context, arg UpsertRunnerParams) (optional.Option[int64], error)
 	UpsertSubscription(ctx context.Context, arg UpsertSubscriptionParams) (UpsertSubscriptionRow, error)
-	UpsertTopic(ctx context.Context, arg UpsertTopicParams) error
+	// Ensures that a subscription has a cursor for each partition of its topic.
+	UpsertSubscriptionCursors(ctx context.Context, subscriptionID int64) error
+	// Inserts or updates a topic. The number of partitions of an existing topic
+	// can not change, so no rows are affected if it differs.
+	UpsertTopic(ctx context.Context, arg UpsertTopicParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -105,6 +105,12 @@ WITH matches AS (
 SELECT COUNT(*)
 FROM matches;
 
+-- name: DegradeRunner :exec
+-- Mark a runner as degraded for the given period, deprioritising it for routing.
+UPDATE runners
+SET degraded_until = (NOW() AT TIME ZONE 'utc') + sqlc.arg('ttl')::INTERVAL
+WHERE key = sqlc.arg('key')::runner_key;
+
 -- name: DeregisterRunner :one
 WITH matches AS (
     UPDATE runners
@@ -124,7 +130,8 @@ SELECT DISTINCT ON (r.key) r.key                                   AS runner_key
                            r.module_name,
                            COALESCE(CASE
                                         WHEN r.deployment_id IS NOT NULL
-                                            THEN d.key END, NULL) AS deployment_key
+                                            THEN d.key END, NULL) AS deployment_key,
+                           r.degraded_until
 FROM runners r
          LEFT JOIN deployments d on d.id = r.deployment_id
 WHERE r.state <> 'dead'
@@ -231,13 +238,15 @@ SELECT DISTINCT ON (r.key) r.key                                   AS runner_key
                            r.module_name,
                            COALESCE(CASE
                                         WHEN r.deployment_id IS NOT NULL
-                                            THEN d.key END, NULL) AS deployment_key
+                                            THEN d.key END, NULL) AS deployment_key,
+                           r.degraded_until
 FROM runners r
          LEFT JOIN deployments d on d.id = r.deployment_id OR r.deployment_id IS NULL
 WHERE r.key = sqlc.arg('key')::runner_key;
 
 -- name: GetRoutingTable :many
-SELECT endpoint, r.key AS runner_key, r.module_name, d.key deployment_key
+SELECT endpoint, r.key AS runner_key, r.module_name, d.key deployment_key,
+       COALESCE(r.degraded_until > (NOW() AT TIME ZONE 'utc'), FALSE)::BOOLEAN AS degraded
 FROM runners r
          LEFT JOIN deployments d on r.deployment_id = d.id
 WHERE state = 'assigned'
@@ -468,6 +477,93 @@ FROM expired;
 -- name: GetLeaseInfo :one
 SELECT expires_at, metadata FROM leases WHERE key = @key::lease_key;
 
+-- name: TakeRateLimitToken :one
+-- Take a token from the token bucket for the given key, which refills at
+-- "capacity" tokens per "period". Returns no rows if the bucket is empty.
+INSERT INTO rate_limits (key, tokens, period)
+VALUES (sqlc.arg('key')::TEXT, sqlc.arg('capacity')::BIGINT - 1, sqlc.arg('period')::INTERVAL)
+ON CONFLICT (key) DO UPDATE
+SET tokens = LEAST(
+      sqlc.arg('capacity')::BIGINT,
+      rate_limits.tokens + sqlc.arg('capacity')::BIGINT * EXTRACT(EPOCH FROM (NOW() AT TIME ZONE 'utc') - rate_limits.updated_at)::DOUBLE PRECISION / EXTRACT(EPOCH FROM sqlc.arg('period')::INTERVAL)::DOUBLE PRECISION
+    ) - 1,
+    updated_at = (NOW() AT TIME ZONE 'utc'),
+    period = EXCLUDED.period
+WHERE LEAST(
+      sqlc.arg('capacity')::BIGINT,
+      rate_limits.tokens + sqlc.arg('capacity')::BIGINT * EXTRACT(EPOCH FROM (NOW() AT TIME ZONE 'utc') - rate_limits.updated_at)::DOUBLE PRECISION / EXTRACT(EPOCH FROM sqlc.arg('period')::INTERVAL)::DOUBLE PRECISION
+    ) >= 1
+RETURNING tokens::DOUBLE PRECISION;
+
+-- name: ExpireRateLimits :execrows
+-- Delete token buckets that have not been used for a full period, and so have
+-- refilled.
+DELETE FROM rate_limits
+WHERE updated_at + period < (NOW() AT TIME ZONE 'utc');
+
+-- name: LockConcurrencyLimit :exec
+-- Lock the semaphore row for a concurrency limit until the end of the
+-- transaction, creating it if necessary.
+INSERT INTO concurrency_limits (key)
+VALUES (sqlc.arg('key')::TEXT)
+ON CONFLICT (key) DO UPDATE SET key = EXCLUDED.key;
+
+-- name: AcquireConcurrencySlot :one
+-- Take a slot if fewer than "capacity" unexpired slots are held. Returns no
+-- rows if the limit has been reached.
+--
+-- Must be called in the same transaction as LockConcurrencyLimit.
+INSERT INTO concurrency_slots (key, expires_at)
+SELECT sqlc.arg('key')::TEXT, (NOW() AT TIME ZONE 'utc') + sqlc.arg('ttl')::INTERVAL
+WHERE (
+  SELECT COUNT(*)
+  FROM concurrency_slots
+  WHERE key = sqlc.arg('key')::TEXT AND expires_at > (NOW() AT TIME ZONE 'utc')
+) < sqlc.arg('capacity')::BIGINT
+RETURNING id;
+
+-- name: RenewConcurrencySlot :execrows
+UPDATE concurrency_slots
+SET expires_at = (NOW() AT TIME ZONE 'utc') + sqlc.arg('ttl')::INTERVAL
+WHERE id = sqlc.arg('id')::BIGINT;
+
+-- name: ReleaseConcurrencySlot :exec
+DELETE FROM concurrency_slots
+WHERE id = sqlc.arg('id')::BIGINT;
+
+-- name: ExpireConcurrencySlots :execrows
+DELETE FROM concurrency_slots
+WHERE expires_at < (NOW() AT TIME ZONE 'utc');
+
+-- name: ClaimIdempotencyKey :execrows
+-- Claim an idempotency key for a call, replacing any expired claim.
+INSERT INTO idempotency_keys (verb, key, expires_at)
+VALUES (sqlc.arg('verb')::schema_ref, sqlc.arg('key')::TEXT, (NOW() AT TIME ZONE 'utc') + sqlc.arg('ttl')::INTERVAL)
+ON CONFLICT (verb, key) DO UPDATE
+SET response = NULL,
+    created_at = (NOW() AT TIME ZONE 'utc'),
+    expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at < (NOW() AT TIME ZONE 'utc');
+
+-- name: GetIdempotentResponse :one
+SELECT response
+FROM idempotency_keys
+WHERE verb = sqlc.arg('verb')::schema_ref AND key = sqlc.arg('key')::TEXT;
+
+-- name: SetIdempotentResponse :exec
+UPDATE idempotency_keys
+SET response = sqlc.arg('response')::BYTEA,
+    expires_at = (NOW() AT TIME ZONE 'utc') + sqlc.arg('ttl')::INTERVAL
+WHERE verb = sqlc.arg('verb')::schema_ref AND key = sqlc.arg('key')::TEXT;
+
+-- name: ReleaseIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE verb = sqlc.arg('verb')::schema_ref AND key = sqlc.arg('key')::TEXT AND response IS NULL;
+
+-- name: ExpireIdempotencyKeys :execrows
+DELETE FROM idempotency_keys
+WHERE expires_at < (NOW() AT TIME ZONE 'utc');
+
 -- name: CreateAsyncCall :one
 INSERT INTO async_calls (
   verb,
@@ -478,7 +574,8 @@ INSERT INTO async_calls (
   max_backoff,
   catch_verb,
   parent_request_key,
-  trace_context
+  trace_context,
+  scheduled_at
 )
 VALUES (
   @verb,
@@ -489,7 +586,8 @@ VALUES (
   @max_backoff::interval,
   @catch_verb,
   @parent_request_key,
-  @trace_context::jsonb
+  @trace_context::jsonb,
+  COALESCE(sqlc.narg('scheduled_at')::TIMESTAMPTZ, NOW() AT TIME ZONE 'utc')
 )
 RETURNING id;
 
@@ -536,7 +634,8 @@ RETURNING
   max_backoff,
   parent_request_key,
   trace_context,
-  catching;
+  catching,
+  attempts;
 
 -- name: SucceedAsyncCall :one
 UPDATE async_calls
@@ -573,7 +672,8 @@ INSERT INTO async_calls (
   max_backoff,
   scheduled_at,
   catching,
-  error
+  error,
+  attempts
 )
 SELECT
   updated.verb,
@@ -585,7 +685,8 @@ SELECT
   @max_backoff::interval,
   @scheduled_at::TIMESTAMPTZ,
   @catching::bool,
-  @original_error
+  @original_error,
+  updated.attempts + CASE WHEN updated.catching THEN 0 ELSE 1 END
 FROM updated
 RETURNING true;
 
@@ -594,11 +695,68 @@ SELECT *
 FROM async_calls
 WHERE id = @id;
 
+-- name: ScheduleAsyncCall :execrows
+-- Schedules a call unless a call with the same origin has not yet completed.
+INSERT INTO async_calls (
+  verb,
+  origin,
+  request,
+  remaining_attempts,
+  backoff,
+  max_backoff,
+  catch_verb,
+  scheduled_at
+)
+VALUES (
+  @verb,
+  @origin,
+  @request,
+  @remaining_attempts,
+  @backoff::interval,
+  @max_backoff::interval,
+  @catch_verb,
+  @scheduled_at::TIMESTAMPTZ
+)
+ON CONFLICT (origin) WHERE state IN ('pending', 'executing') AND origin LIKE 'scheduled:%' DO NOTHING;
+
+-- name: ListScheduledCalls :many
+-- Scheduled calls that have not yet completed, soonest first.
+SELECT id, verb, origin, state, scheduled_at, remaining_attempts, error, created_at
+FROM async_calls
+WHERE
+  origin LIKE 'scheduled:%'
+  AND state IN ('pending', 'executing')
+  AND (sqlc.narg('verb')::schema_ref IS NULL OR verb = sqlc.narg('verb')::schema_ref)
+ORDER BY scheduled_at, id
+LIMIT sqlc.arg('limit')::INT;
+
+-- name: CancelScheduledCall :execrows
+-- Delete a scheduled call that is not yet executing.
+DELETE FROM async_calls
+WHERE origin = @origin::TEXT AND state = 'pending';
+
 -- name: GetFSMInstance :one
 SELECT *
 FROM fsm_instances
 WHERE fsm = @fsm::schema_ref AND key = @key;
 
+-- name: ListFSMInstances :many
+SELECT i.*, (SELECT COUNT(*) FROM fsm_queued_events q WHERE q.fsm_instance_id = i.id) AS queued_events
+FROM fsm_instances i
+WHERE
+  i.fsm = @fsm::schema_ref
+  AND (sqlc.narg('status')::fsm_status IS NULL OR i.status = sqlc.narg('status')::fsm_status)
+  AND (sqlc.narg('state')::schema_ref IS NULL OR i.current_state = sqlc.narg('state')::schema_ref)
+ORDER BY i.updated_at DESC, i.id DESC
+LIMIT sqlc.arg('limit')::INT;
+
+-- name: GetFSMTransitionHistory :many
+-- Every async call made to execute a transition of an FSM instance, oldest first.
+SELECT id, created_at, verb, state, error, request, catching
+FROM async_calls
+WHERE origin = @origin::TEXT
+ORDER BY id;
+
 -- name: StartFSMTransition :one
 -- Start a new FSM transition, populating the destination state and async call ID.
 --
@@ -618,23 +776,68 @@ ON CONFLICT(fsm, key) DO
 UPDATE SET
   destination_state = @destination_state::schema_ref,
   async_call_id = @async_call_id::BIGINT,
+  timeout_async_call_id = NULL,
   updated_at = NOW() AT TIME ZONE 'utc'
 WHERE
   fsm_instances.async_call_id IS NULL
   AND fsm_instances.destination_state IS NULL
 RETURNING *;
 
+-- name: SetFSMTimeout :exec
+-- Record the async call that will fire the timeout of the current state of an FSM instance.
+UPDATE fsm_instances
+SET timeout_async_call_id = @async_call_id::BIGINT
+WHERE fsm = @fsm::schema_ref AND key = @key::TEXT;
+
+-- name: CancelFSMTimeout :execrows
+-- Delete the pending timeout of an FSM instance, if any.
+DELETE FROM async_calls
+WHERE
+  state = 'pending'
+  AND id = (
+    SELECT timeout_async_call_id
+    FROM fsm_instances
+    WHERE fsm = @fsm::schema_ref AND key = @key::TEXT
+  );
+
 -- name: FinishFSMTransition :one
 -- Mark an FSM transition as completed, updating the current state and clearing the async call ID.
+--
+-- Returns the next event set by the state verb, if any, which is also cleared.
 UPDATE fsm_instances
 SET
-  current_state = destination_state,
+  current_state = fsm_instances.destination_state,
   destination_state = NULL,
   async_call_id = NULL,
+  next_event_type = NULL,
+  next_event_request = NULL,
   updated_at = NOW() AT TIME ZONE 'utc'
+FROM (
+  SELECT id, next_event_type, next_event_request
+  FROM fsm_instances
+  WHERE fsm = @fsm::schema_ref AND key = @key::TEXT
+  FOR UPDATE
+) AS previous
+WHERE fsm_instances.id = previous.id
+RETURNING previous.next_event_type, previous.next_event_request;
+
+-- name: SetNextFSMEvent :execrows
+-- Set the event to send to an FSM instance once its executing transition completes.
+UPDATE fsm_instances
+SET
+  next_event_type = @event_type::TEXT,
+  next_event_request = @request
 WHERE
   fsm = @fsm::schema_ref AND key = @key::TEXT
-RETURNING true;
+  AND destination_state IS NOT NULL
+  AND next_event_type IS NULL;
+
+-- name: ClearNextFSMEvent :exec
+UPDATE fsm_instances
+SET
+  next_event_type = NULL,
+  next_event_request = NULL
+WHERE fsm = @fsm::schema_ref AND key = @key::TEXT;
 
 -- name: SucceedFSMInstance :one
 UPDATE fsm_instances
@@ -643,6 +846,8 @@ SET
   destination_state = NULL,
   async_call_id = NULL,
   status = 'completed'::fsm_status,
+  next_event_type = NULL,
+  next_event_request = NULL,
   updated_at = NOW() AT TIME ZONE 'utc'
 WHERE
   fsm = @fsm::schema_ref AND key = @key::TEXT
@@ -654,23 +859,149 @@ SET
   current_state = NULL,
   async_call_id = NULL,
   status = 'failed'::fsm_status,
+  next_event_type = NULL,
+  next_event_request = NULL,
+  updated_at = NOW() AT TIME ZONE 'utc'
+WHERE
+  fsm = @fsm::schema_ref AND key = @key::TEXT
+RETURNING true;
+
+-- name: GetFSMCompletedTransitions :many
+-- Transitions completed by an FSM instance before the given async call, most recent first.
+SELECT id, verb, request
+FROM async_calls
+WHERE
+  origin = @origin::TEXT
+  AND state = 'success'
+  AND NOT catching
+  AND id < @before_id::BIGINT
+ORDER BY id DESC;
+
+-- name: CompensateFSMInstance :one
+-- Mark an FSM instance as compensating the state entered by the given transition.
+UPDATE fsm_instances
+SET
+  current_state = NULL,
+  destination_state = NULL,
+  async_call_id = NULL,
+  status = 'compensating'::fsm_status,
+  compensating_async_call_id = @compensating_async_call_id::BIGINT,
+  next_event_type = NULL,
+  next_event_request = NULL,
+  updated_at = NOW() AT TIME ZONE 'utc'
+WHERE
+  fsm = @fsm::schema_ref AND key = @key::TEXT
+RETURNING true;
+
+-- name: FinishFSMCompensation :one
+UPDATE fsm_instances
+SET
+  status = @status::fsm_status,
+  compensating_async_call_id = NULL,
   updated_at = NOW() AT TIME ZONE 'utc'
 WHERE
   fsm = @fsm::schema_ref AND key = @key::TEXT
 RETURNING true;
 
--- name: UpsertTopic :exec
-INSERT INTO topics (key, module_id, name, type)
+-- name: GetFSMQueueDepth :one
+SELECT COUNT(q.id)
+FROM fsm_queued_events q
+JOIN fsm_instances i ON q.fsm_instance_id = i.id
+WHERE i.fsm = @fsm::schema_ref AND i.key = @key::TEXT;
+
+-- name: QueueFSMEvent :execrows
+-- Queue an event for an FSM instance that is executing a transition.
+--
+-- If "dedupe" is true the event is dropped if an identical event is already
+-- queued, in which case no rows are affected.
+INSERT INTO fsm_queued_events (fsm_instance_id, event_type, request, request_hash)
+SELECT i.id, @event_type::TEXT, @request, @request_hash
+FROM fsm_instances i
+WHERE
+  i.fsm = @fsm::schema_ref AND i.key = @key::TEXT
+  AND (
+    NOT @dedupe::BOOLEAN
+    OR NOT EXISTS (
+      SELECT 1
+      FROM fsm_queued_events q
+      WHERE q.fsm_instance_id = i.id AND q.request_hash = @request_hash
+    )
+  );
+
+-- name: PopNextFSMEvent :one
+-- Remove and return the oldest event queued for an FSM instance.
+DELETE FROM fsm_queued_events
+WHERE id = (
+  SELECT q.id
+  FROM fsm_queued_events q
+  JOIN fsm_instances i ON q.fsm_instance_id = i.id
+  WHERE i.fsm = @fsm::schema_ref AND i.key = @key::TEXT
+  ORDER BY q.id
+  LIMIT 1
+)
+RETURNING event_type, request;
+
+-- name: ClearFSMQueue :execrows
+DELETE FROM fsm_queued_events
+USING fsm_instances i
+WHERE fsm_queued_events.fsm_instance_id = i.id AND i.fsm = @fsm::schema_ref AND i.key = @key::TEXT;
+
+-- name: GetRunningFSMStates :many
+-- Count the running instances of an FSM in, or transitioning to, each state.
+SELECT states.state::schema_ref AS state, COUNT(*) AS instances
+FROM (
+  SELECT current_state AS state
+  FROM fsm_instances
+  WHERE fsm = @fsm::schema_ref AND status = 'running' AND current_state IS NOT NULL
+  UNION ALL
+  SELECT destination_state AS state
+  FROM fsm_instances
+  WHERE fsm = @fsm::schema_ref AND status = 'running' AND destination_state IS NOT NULL
+) AS states
+GROUP BY states.state
+ORDER BY states.state;
+
+-- name: MigrateFSMState :execrows
+-- Move the running instances of an FSM in, or transitioning to, a removed state to a new state.
+UPDATE fsm_instances
+SET
+  current_state = CASE WHEN current_state = @from_state::schema_ref THEN @to_state::schema_ref ELSE current_state END,
+  destination_state = CASE WHEN destination_state = @from_state::schema_ref THEN @to_state::schema_ref ELSE destination_state END,
+  updated_at = NOW() AT TIME ZONE 'utc'
+WHERE
+  fsm = @fsm::schema_ref
+  AND status = 'running'
+  AND (current_state = @from_state::schema_ref OR destination_state = @from_state::schema_ref);
+
+-- name: MigrateFSMAsyncCalls :execrows
+-- Redirect the pending transitions and timeouts of an FSM that target a removed state to a new state.
+UPDATE async_calls
+SET verb = @to_state::schema_ref
+WHERE
+  state = 'pending'
+  AND verb = @from_state::schema_ref
+  AND id IN (
+    SELECT async_call_id FROM fsm_instances WHERE fsm = @fsm::schema_ref AND async_call_id IS NOT NULL
+    UNION
+    SELECT timeout_async_call_id FROM fsm_instances WHERE fsm = @fsm::schema_ref AND timeout_async_call_id IS NOT NULL
+  );
+
+-- name: UpsertTopic :execrows
+-- Inserts or updates a topic. The number of partitions of an existing topic
+-- can not change, so no rows are affected if it differs.
+INSERT INTO topics (key, module_id, name, type, partitions)
 VALUES (
   sqlc.arg('topic')::topic_key,
   (SELECT id FROM modules WHERE name = sqlc.arg('module')::TEXT LIMIT 1),
   sqlc.arg('name')::TEXT,
-  sqlc.arg('event_type')::TEXT
+  sqlc.arg('event_type')::TEXT,
+  sqlc.arg('partitions')::INT
 )
 ON CONFLICT (name, module_id) DO
 UPDATE SET
-  type = sqlc.arg('event_type')::TEXT
-RETURNING id;
+  type = sqlc.arg('event_type')::TEXT,
+  partitions = sqlc.arg('partitions')::INT
+WHERE topics.partitions = sqlc.arg('partitions')::INT;
 
 -- name: UpsertSubscription :one
 INSERT INTO topic_subscriptions (
@@ -678,7 +1009,8 @@ INSERT INTO topic_subscriptions (
   topic_id,
   module_id,
   deployment_id,
-  name)
+  name,
+  dead_letter_topic_id)
 VALUES (
   sqlc.arg('key')::subscription_key,
   (
@@ -690,19 +1022,38 @@ VALUES (
   ),
   (SELECT id FROM modules WHERE name = sqlc.arg('module')::TEXT),
   (SELECT id FROM deployments WHERE key = sqlc.arg('deployment')::deployment_key),
-  sqlc.arg('name')::TEXT
+  sqlc.arg('name')::TEXT,
+  (
+    SELECT topics.id as id
+    FROM topics
+    INNER JOIN modules ON topics.module_id = modules.id
+    WHERE modules.name = sqlc.narg('dead_letter_module')::TEXT
+      AND topics.name = sqlc.narg('dead_letter_name')::TEXT
+  )
 )
 ON CONFLICT (name, module_id) DO
 UPDATE SET
   topic_id = excluded.topic_id,
+  dead_letter_topic_id = excluded.dead_letter_topic_id,
   deployment_id = (SELECT id FROM deployments WHERE key = sqlc.arg('deployment')::deployment_key)
 RETURNING
   id,
+  dead_letter_topic_id,
   CASE
     WHEN xmax = 0 THEN true
     ELSE false
   END AS inserted;
 
+-- name: UpsertSubscriptionCursors :exec
+-- Ensures that a subscription has a cursor for each partition of its topic.
+INSERT INTO topic_subscription_cursors (subscription_id, partition)
+SELECT subs.id, partitions.partition
+FROM topic_subscriptions subs
+INNER JOIN topics ON subs.topic_id = topics.id
+CROSS JOIN LATERAL generate_series(0, topics.partitions - 1) AS partitions(partition)
+WHERE subs.id = sqlc.arg('subscription_id')::BIGINT
+ON CONFLICT (subscription_id, partition) DO NOTHING;
+
 -- name: DeleteSubscriptions :many
 DELETE FROM topic_subscriptions
 WHERE deployment_id IN (
@@ -730,7 +1081,9 @@ INSERT INTO topic_subscribers (
   retry_attempts,
   backoff,
   max_backoff,
-  catch_verb
+  catch_verb,
+  batch_size,
+  batch_wait
 )
 VALUES (
   sqlc.arg('key')::subscriber_key,
@@ -746,52 +1099,96 @@ VALUES (
   sqlc.arg('retry_attempts'),
   sqlc.arg('backoff')::interval,
   sqlc.arg('max_backoff')::interval,
-  sqlc.arg('catch_verb')
+  sqlc.arg('catch_verb'),
+  sqlc.narg('batch_size')::INT,
+  sqlc.arg('batch_wait')::interval
 );
 
 -- name: PublishEventForTopic :exec
+WITH topic AS (
+  SELECT topics.id, topics.partitions
+  FROM topics
+  INNER JOIN modules ON topics.module_id = modules.id
+  WHERE modules.name = sqlc.arg('module')::TEXT
+    AND topics.name = sqlc.arg('topic')::TEXT
+)
 INSERT INTO topic_events (
     "key",
     topic_id,
     caller,
     payload,
     request_key,
-    trace_context
+    trace_context,
+    partition,
+    partition_key
   )
 VALUES (
   sqlc.arg('key')::topic_event_key,
-  (
-    SELECT topics.id
-    FROM topics
-    INNER JOIN modules ON topics.module_id = modules.id
-    WHERE modules.name = sqlc.arg('module')::TEXT
-      AND topics.name = sqlc.arg('topic')::TEXT
-  ),
+  (SELECT id FROM topic),
   sqlc.arg('caller')::TEXT,
   sqlc.arg('payload'),
   sqlc.arg('request_key')::TEXT,
-  sqlc.arg('trace_context')::jsonb
+  sqlc.arg('trace_context')::jsonb,
+  (SELECT MOD(sqlc.arg('partition_hash')::BIGINT, partitions) FROM topic),
+  sqlc.narg('partition_key')::TEXT
 );
 
 -- name: GetSubscriptionsNeedingUpdate :many
 -- Results may not be ready to be scheduled yet due to event consumption delay
 -- Sorting ensures that brand new events (that may not be ready for consumption)
 -- don't prevent older events from being consumed
+-- Partitions of subscriptions that are waiting for a batch to fill are excluded
+-- so that they don't prevent other subscriptions from being consumed
 SELECT
   subs.key::subscription_key as key,
+  cursors.partition,
   curser.key as cursor,
   topics.key::topic_key as topic,
   subs.name
-FROM topic_subscriptions subs
+FROM topic_subscription_cursors cursors
+INNER JOIN topic_subscriptions subs ON cursors.subscription_id = subs.id
 LEFT JOIN topics ON subs.topic_id = topics.id
-LEFT JOIN topic_events curser ON subs.cursor = curser.id
-WHERE subs.cursor IS DISTINCT FROM topics.head
-  AND subs.state = 'idle'
+LEFT JOIN topic_events curser ON cursors.cursor = curser.id
+WHERE cursors.cursor IS DISTINCT FROM (
+    SELECT head.id
+    FROM topic_events head
+    WHERE head.topic_id = subs.topic_id
+      AND head.partition = cursors.partition
+    ORDER BY head.created_at DESC, head.id DESC
+    LIMIT 1
+  )
+  AND cursors.state = 'idle'
+  AND NOT EXISTS (
+    SELECT 1
+    FROM topic_subscribers batching
+    WHERE batching.topic_subscriptions_id = subs.id
+      AND batching.batch_size IS NOT NULL
+      AND (
+        SELECT COUNT(*)
+        FROM (
+          SELECT 1
+          FROM topic_events pending
+          WHERE pending.topic_id = subs.topic_id
+            AND pending.partition = cursors.partition
+            AND (pending.created_at, pending.id) > (COALESCE(curser.created_at, '1900-01-01'), COALESCE(curser.id, 0))
+          LIMIT batching.batch_size
+        ) AS batch
+      ) < batching.batch_size
+      AND NOT EXISTS (
+        SELECT 1
+        FROM topic_events waited
+        WHERE waited.topic_id = subs.topic_id
+          AND waited.partition = cursors.partition
+          AND (waited.created_at, waited.id) > (COALESCE(curser.created_at, '1900-01-01'), COALESCE(curser.id, 0))
+          AND NOW() - waited.created_at >= batching.batch_wait
+      )
+  )
 ORDER BY curser.created_at
 LIMIT 3
-FOR UPDATE OF subs SKIP LOCKED;
+FOR UPDATE OF cursors SKIP LOCKED;
 
--- name: GetNextEventForSubscription :one
+-- name: GetNextEventsForSubscription :many
+-- Returns up to max_events events in the partition after the cursor.
 WITH cursor AS (
   SELECT
     created_at,
@@ -805,13 +1202,15 @@ SELECT events."key" as event,
         events.caller,
         events.request_key,
         events.trace_context,
-        NOW() - events.created_at >= sqlc.arg('consumption_delay')::interval AS ready
+        NOW() - events.created_at >= sqlc.arg('consumption_delay')::interval AS ready,
+        NOW() - events.created_at >= sqlc.arg('batch_wait')::interval AS batch_waited
 FROM topics
 LEFT JOIN topic_events as events ON events.topic_id = topics.id
 WHERE topics.key = sqlc.arg('topic')::topic_key
+  AND events.partition = sqlc.arg('partition')::INT
   AND (events.created_at, events.id) > (SELECT COALESCE(MAX(cursor.created_at), '1900-01-01'), COALESCE(MAX(cursor.id), 0) FROM cursor)
 ORDER BY events.created_at, events.id
-LIMIT 1;
+LIMIT sqlc.arg('max_events')::INT;
 
 -- name: GetRandomSubscriber :one
 SELECT
@@ -819,7 +1218,9 @@ SELECT
   subscribers.retry_attempts as retry_attempts,
   subscribers.backoff as backoff,
   subscribers.max_backoff as max_backoff,
-  subscribers.catch_verb as catch_verb
+  subscribers.catch_verb as catch_verb,
+  subscribers.batch_size as batch_size,
+  subscribers.batch_wait as batch_wait
 FROM topic_subscribers as subscribers
 JOIN topic_subscriptions ON subscribers.topic_subscriptions_id = topic_subscriptions.id
 WHERE topic_subscriptions.key = sqlc.arg('key')::subscription_key
@@ -827,15 +1228,19 @@ ORDER BY RANDOM()
 LIMIT 1;
 
 -- name: BeginConsumingTopicEvent :exec
+-- Moves the cursor to the last event of the batch being consumed, which
+-- starts at batch_start.
 WITH event AS (
   SELECT *
   FROM topic_events
   WHERE "key" = sqlc.arg('event')::topic_event_key
 )
-UPDATE topic_subscriptions
+UPDATE topic_subscription_cursors
 SET state = 'executing',
-    cursor = (SELECT id FROM event)
-WHERE key = sqlc.arg('subscription')::subscription_key;
+    cursor = (SELECT id FROM event),
+    batch_start = (SELECT id FROM topic_events WHERE "key" = sqlc.arg('batch_start')::topic_event_key)
+WHERE subscription_id = (SELECT id FROM topic_subscriptions WHERE key = sqlc.arg('subscription')::subscription_key)
+  AND partition = (SELECT partition FROM event);
 
 -- name: CompleteEventForSubscription :exec
 WITH module AS (
@@ -843,10 +1248,90 @@ WITH module AS (
   FROM modules
   WHERE name = sqlc.arg('module')::TEXT
 )
-UPDATE topic_subscriptions
+UPDATE topic_subscription_cursors
 SET state = 'idle'
-WHERE name = @name::TEXT
-      AND module_id = (SELECT id FROM module);
+WHERE subscription_id = (
+    SELECT id
+    FROM topic_subscriptions
+    WHERE name = @name::TEXT
+      AND module_id = (SELECT id FROM module)
+  )
+  AND partition = sqlc.arg('partition')::INT;
+
+-- name: GetDeadLetterEventsForSubscription :many
+-- Returns the dead letter topic for a subscription, along with the batch of
+-- events the subscription is currently consuming from the given partition.
+SELECT
+  subs."key" AS subscription,
+  dead_letter_modules.name AS module,
+  dead_letter_topics.name AS topic,
+  events."key" AS event,
+  events.payload,
+  events.caller,
+  events.request_key,
+  events.trace_context,
+  events.partition_key
+FROM topic_subscriptions subs
+INNER JOIN modules ON subs.module_id = modules.id
+INNER JOIN topics dead_letter_topics ON subs.dead_letter_topic_id = dead_letter_topics.id
+INNER JOIN modules dead_letter_modules ON dead_letter_topics.module_id = dead_letter_modules.id
+INNER JOIN topic_subscription_cursors cursors ON cursors.subscription_id = subs.id
+INNER JOIN topic_events batch_start ON COALESCE(cursors.batch_start, cursors.cursor) = batch_start.id
+INNER JOIN topic_events batch_end ON cursors.cursor = batch_end.id
+INNER JOIN topic_events events ON events.topic_id = subs.topic_id
+  AND events.partition = cursors.partition
+  AND (events.created_at, events.id) >= (batch_start.created_at, batch_start.id)
+  AND (events.created_at, events.id) <= (batch_end.created_at, batch_end.id)
+WHERE modules.name = sqlc.arg('module')::TEXT
+  AND subs.name = sqlc.arg('name')::TEXT
+  AND cursors.partition = sqlc.arg('partition')::INT
+ORDER BY events.created_at, events.id;
+
+-- name: InsertTopicDeadLetter :exec
+INSERT INTO topic_dead_letters (event_id, subscription_id, error, attempts)
+VALUES (
+  (SELECT id FROM topic_events WHERE "key" = sqlc.arg('event')::topic_event_key),
+  (SELECT id FROM topic_subscriptions WHERE "key" = sqlc.arg('subscription')::subscription_key),
+  sqlc.arg('error')::TEXT,
+  sqlc.arg('attempts')::INT
+)
+ON CONFLICT (event_id) DO
+UPDATE SET
+  error = excluded.error,
+  attempts = topic_dead_letters.attempts + excluded.attempts,
+  created_at = excluded.created_at;
+
+-- name: GetTopicDeadLetters :many
+SELECT
+  subs."key" AS subscription,
+  topics."key" AS topic,
+  events."key" AS event,
+  events.payload,
+  events.request_key,
+  events.trace_context,
+  dead_letters.error,
+  dead_letters.attempts,
+  dead_letters.created_at
+FROM topic_dead_letters dead_letters
+INNER JOIN topic_events events ON dead_letters.event_id = events.id
+INNER JOIN topics ON events.topic_id = topics.id
+INNER JOIN topic_subscriptions subs ON dead_letters.subscription_id = subs.id
+INNER JOIN modules ON subs.module_id = modules.id
+WHERE modules.name = sqlc.arg('module')::TEXT
+  AND subs.name = sqlc.arg('name')::TEXT
+  AND (sqlc.narg('event')::topic_event_key IS NULL OR events."key" = sqlc.narg('event')::topic_event_key)
+ORDER BY dead_letters.created_at, dead_letters.id
+LIMIT sqlc.arg('max_results')::INT;
+
+-- name: DeleteTopicDeadLetters :execrows
+DELETE FROM topic_dead_letters dead_letters
+USING topic_events events, topic_subscriptions subs, modules
+WHERE dead_letters.event_id = events.id
+  AND dead_letters.subscription_id = subs.id
+  AND subs.module_id = modules.id
+  AND modules.name = sqlc.arg('module')::TEXT
+  AND subs.name = sqlc.arg('name')::TEXT
+  AND (sqlc.narg('event')::topic_event_key IS NULL OR events."key" = sqlc.narg('event')::topic_event_key);
 
 -- name: GetSubscription :one
 WITH module AS (
@@ -859,15 +1344,66 @@ FROM topic_subscriptions
 WHERE name = $1::TEXT
       AND module_id = (SELECT id FROM module);
 
--- name: SetSubscriptionCursor :exec
-WITH event AS (
-  SELECT id, created_at, key, topic_id, payload
+-- name: ResetSubscriptionCursors :exec
+-- Moves each partition cursor of a subscription to the head of the partition.
+UPDATE topic_subscription_cursors cursors
+SET cursor = (
+  SELECT head.id
+  FROM topic_events head
+  WHERE head.topic_id = subs.topic_id
+    AND head.partition = cursors.partition
+  ORDER BY head.created_at DESC, head.id DESC
+  LIMIT 1
+)
+FROM topic_subscriptions subs
+WHERE cursors.subscription_id = subs.id
+  AND subs.key = sqlc.arg('key')::subscription_key;
+
+-- name: GetSubscriptionCursors :many
+SELECT *
+FROM topic_subscription_cursors
+WHERE subscription_id = sqlc.arg('subscription_id')::BIGINT
+ORDER BY partition;
+
+-- name: SetSubscriptionCursorByID :exec
+UPDATE topic_subscription_cursors
+SET cursor = sqlc.narg('cursor')::BIGINT
+WHERE id = sqlc.arg('cursor_id')::BIGINT;
+
+-- name: GetTopicEventByKey :one
+SELECT *
+FROM topic_events
+WHERE "key" = sqlc.arg('key')::topic_event_key;
+
+-- name: GetPreviousTopicEvent :one
+-- Returns the last event in the topic partition that is ordered before the given position.
+SELECT *
+FROM topic_events
+WHERE topic_id = sqlc.arg('topic_id')::BIGINT
+  AND partition = sqlc.arg('partition')::INT
+  AND (created_at, id) < (sqlc.arg('created_at')::TIMESTAMPTZ, sqlc.arg('event_id')::BIGINT)
+ORDER BY created_at DESC, id DESC
+LIMIT 1;
+
+-- name: CountTopicEventsBetween :one
+-- Counts the events in a topic partition after the "from" event, up to and
+-- including the "to" event. A NULL event represents the start of the partition.
+WITH from_event AS (
+  SELECT created_at, id
+  FROM topic_events
+  WHERE id = sqlc.narg('from_id')::BIGINT
+),
+to_event AS (
+  SELECT created_at, id
   FROM topic_events
-  WHERE "key" = $2::topic_event_key
+  WHERE id = sqlc.narg('to_id')::BIGINT
 )
-UPDATE topic_subscriptions
-SET cursor = (SELECT id FROM event)
-WHERE key = $1::subscription_key;
+SELECT COUNT(*)
+FROM topic_events
+WHERE topic_id = sqlc.arg('topic_id')::BIGINT
+  AND partition = sqlc.arg('partition')::INT
+  AND (created_at, id) > (SELECT COALESCE(MAX(from_event.created_at), '1900-01-01'), COALESCE(MAX(from_event.id), 0) FROM from_event)
+  AND (created_at, id) <= (SELECT COALESCE(MAX(to_event.created_at), '1900-01-01'), COALESCE(MAX(to_event.id), 0) FROM to_event);
 
 -- name: GetTopic :one
 SELECT *
@@ -887,3 +1423,22 @@ WHERE id = 1;
 -- name: CreateOnlyEncryptionKey :exec
 INSERT INTO encryption_keys (id, key)
 VALUES (1, $1);
+
+-- name: InsertWebSocketMessage :exec
+INSERT INTO websocket_messages (connection_key, body, is_binary)
+VALUES (sqlc.arg('connection_key')::TEXT, sqlc.arg('body')::BYTEA, sqlc.arg('is_binary')::BOOLEAN);
+
+-- name: TakeWebSocketMessages :many
+-- Remove and return the messages queued for a WebSocket connection, oldest first.
+WITH taken AS (
+  DELETE FROM websocket_messages
+  WHERE connection_key = sqlc.arg('connection_key')::TEXT
+  RETURNING id, body, is_binary
+)
+SELECT body, is_binary
+FROM taken
+ORDER BY id;
+
+-- name: DeleteStaleWebSocketMessages :execrows
+DELETE FROM websocket_messages
+WHERE created_at < (NOW() AT TIME ZONE 'utc') - sqlc.arg('max_age')::INTERVAL;
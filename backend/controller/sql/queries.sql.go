@@ -56,7 +56,8 @@ RETURNING
   max_backoff,
   parent_request_key,
   trace_context,
-  catching
+  catching,
+  attempts
 `
 
 type AcquireAsyncCallRow struct {
@@ -76,6 +77,7 @@ type AcquireAsyncCallRow struct {
 	ParentRequestKey    optional.Option[string]
 	TraceContext        pqtype.NullRawMessage
 	Catching            bool
+	Attempts            int32
 }
 
 // Reserve a pending async call for execution, returning the associated lease
@@ -100,10 +102,33 @@ func (q *Queries) AcquireAsyncCall(ctx context.Context, ttl sqltypes.Duration) (
 		&i.ParentRequestKey,
 		&i.TraceContext,
 		&i.Catching,
+		&i.Attempts,
 	)
 	return i, err
 }
 
+const acquireConcurrencySlot = `-- name: AcquireConcurrencySlot :one
+INSERT INTO concurrency_slots (key, expires_at)
+SELECT $1::TEXT, (NOW() AT TIME ZONE 'utc') + $2::INTERVAL
+WHERE (
+  SELECT COUNT(*)
+  FROM concurrency_slots
+  WHERE key = $1::TEXT AND expires_at > (NOW() AT TIME ZONE 'utc')
+) < $3::BIGINT
+RETURNING id
+`
+
+// Take a slot if fewer than "capacity" unexpired slots are held. Returns no
+// rows if the limit has been reached.
+//
+// Must be called in the same transaction as LockConcurrencyLimit.
+func (q *Queries) AcquireConcurrencySlot(ctx context.Context, key string, ttl sqltypes.Duration, capacity int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, acquireConcurrencySlot, key, ttl, capacity)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
 const associateArtefactWithDeployment = `-- name: AssociateArtefactWithDeployment :exec
 INSERT INTO deployment_artefacts (deployment_id, artefact_id, executable, path)
 VALUES ((SELECT id FROM deployments WHERE key = $1::deployment_key), $2, $3, $4)
@@ -141,38 +166,191 @@ func (q *Queries) AsyncCallQueueDepth(ctx context.Context) (int64, error) {
 
 const beginConsumingTopicEvent = `-- name: BeginConsumingTopicEvent :exec
 WITH event AS (
-  SELECT id, created_at, key, topic_id, payload, caller, request_key, trace_context
+  SELECT id, created_at, key, topic_id, payload, caller, request_key, trace_context, partition, partition_key
   FROM topic_events
-  WHERE "key" = $2::topic_event_key
+  WHERE "key" = $3::topic_event_key
 )
-UPDATE topic_subscriptions
+UPDATE topic_subscription_cursors
 SET state = 'executing',
-    cursor = (SELECT id FROM event)
-WHERE key = $1::subscription_key
+    cursor = (SELECT id FROM event),
+    batch_start = (SELECT id FROM topic_events WHERE "key" = $1::topic_event_key)
+WHERE subscription_id = (SELECT id FROM topic_subscriptions WHERE key = $2::subscription_key)
+  AND partition = (SELECT partition FROM event)
+`
+
+// Moves the cursor to the last event of the batch being consumed, which
+// starts at batch_start.
+func (q *Queries) BeginConsumingTopicEvent(ctx context.Context, batchStart model.TopicEventKey, subscription model.SubscriptionKey, event model.TopicEventKey) error {
+	_, err := q.db.ExecContext(ctx, beginConsumingTopicEvent, batchStart, subscription, event)
+	return err
+}
+
+const cancelFSMTimeout = `-- name: CancelFSMTimeout :execrows
+DELETE FROM async_calls
+WHERE
+  state = 'pending'
+  AND id = (
+    SELECT timeout_async_call_id
+    FROM fsm_instances
+    WHERE fsm = $1::schema_ref AND key = $2::TEXT
+  )
+`
+
+// Delete the pending timeout of an FSM instance, if any.
+func (q *Queries) CancelFSMTimeout(ctx context.Context, fsm schema.RefKey, key string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, cancelFSMTimeout, fsm, key)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const cancelScheduledCall = `-- name: CancelScheduledCall :execrows
+DELETE FROM async_calls
+WHERE origin = $1::TEXT AND state = 'pending'
+`
+
+// Delete a scheduled call that is not yet executing.
+func (q *Queries) CancelScheduledCall(ctx context.Context, origin string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, cancelScheduledCall, origin)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const claimIdempotencyKey = `-- name: ClaimIdempotencyKey :execrows
+INSERT INTO idempotency_keys (verb, key, expires_at)
+VALUES ($1::schema_ref, $2::TEXT, (NOW() AT TIME ZONE 'utc') + $3::INTERVAL)
+ON CONFLICT (verb, key) DO UPDATE
+SET response = NULL,
+    created_at = (NOW() AT TIME ZONE 'utc'),
+    expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at < (NOW() AT TIME ZONE 'utc')
+`
+
+// Claim an idempotency key for a call, replacing any expired claim.
+func (q *Queries) ClaimIdempotencyKey(ctx context.Context, verb schema.RefKey, key string, ttl sqltypes.Duration) (int64, error) {
+	result, err := q.db.ExecContext(ctx, claimIdempotencyKey, verb, key, ttl)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const clearFSMQueue = `-- name: ClearFSMQueue :execrows
+DELETE FROM fsm_queued_events
+USING fsm_instances i
+WHERE fsm_queued_events.fsm_instance_id = i.id AND i.fsm = $1::schema_ref AND i.key = $2::TEXT
+`
+
+func (q *Queries) ClearFSMQueue(ctx context.Context, fsm schema.RefKey, key string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, clearFSMQueue, fsm, key)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const clearNextFSMEvent = `-- name: ClearNextFSMEvent :exec
+UPDATE fsm_instances
+SET
+  next_event_type = NULL,
+  next_event_request = NULL
+WHERE fsm = $1::schema_ref AND key = $2::TEXT
 `
 
-func (q *Queries) BeginConsumingTopicEvent(ctx context.Context, subscription model.SubscriptionKey, event model.TopicEventKey) error {
-	_, err := q.db.ExecContext(ctx, beginConsumingTopicEvent, subscription, event)
+func (q *Queries) ClearNextFSMEvent(ctx context.Context, fsm schema.RefKey, key string) error {
+	_, err := q.db.ExecContext(ctx, clearNextFSMEvent, fsm, key)
 	return err
 }
 
+const compensateFSMInstance = `-- name: CompensateFSMInstance :one
+UPDATE fsm_instances
+SET
+  current_state = NULL,
+  destination_state = NULL,
+  async_call_id = NULL,
+  status = 'compensating'::fsm_status,
+  compensating_async_call_id = $1::BIGINT,
+  next_event_type = NULL,
+  next_event_request = NULL,
+  updated_at = NOW() AT TIME ZONE 'utc'
+WHERE
+  fsm = $2::schema_ref AND key = $3::TEXT
+RETURNING true
+`
+
+// Mark an FSM instance as compensating the state entered by the given transition.
+func (q *Queries) CompensateFSMInstance(ctx context.Context, compensatingAsyncCallID int64, fsm schema.RefKey, key string) (bool, error) {
+	row := q.db.QueryRowContext(ctx, compensateFSMInstance, compensatingAsyncCallID, fsm, key)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const completeEventForSubscription = `-- name: CompleteEventForSubscription :exec
 WITH module AS (
   SELECT id
   FROM modules
-  WHERE name = $2::TEXT
+  WHERE name = $3::TEXT
 )
-UPDATE topic_subscriptions
+UPDATE topic_subscription_cursors
 SET state = 'idle'
-WHERE name = $1::TEXT
+WHERE subscription_id = (
+    SELECT id
+    FROM topic_subscriptions
+    WHERE name = $1::TEXT
       AND module_id = (SELECT id FROM module)
+  )
+  AND partition = $2::INT
 `
 
-func (q *Queries) CompleteEventForSubscription(ctx context.Context, name string, module string) error {
-	_, err := q.db.ExecContext(ctx, completeEventForSubscription, name, module)
+func (q *Queries) CompleteEventForSubscription(ctx context.Context, name string, partition int32, module string) error {
+	_, err := q.db.ExecContext(ctx, completeEventForSubscription, name, partition, module)
 	return err
 }
 
+const countTopicEventsBetween = `-- name: CountTopicEventsBetween :one
+WITH from_event AS (
+  SELECT created_at, id
+  FROM topic_events
+  WHERE id = $3::BIGINT
+),
+to_event AS (
+  SELECT created_at, id
+  FROM topic_events
+  WHERE id = $4::BIGINT
+)
+SELECT COUNT(*)
+FROM topic_events
+WHERE topic_id = $1::BIGINT
+  AND partition = $2::INT
+  AND (created_at, id) > (SELECT COALESCE(MAX(from_event.created_at), '1900-01-01'), COALESCE(MAX(from_event.id), 0) FROM from_event)
+  AND (created_at, id) <= (SELECT COALESCE(MAX(to_event.created_at), '1900-01-01'), COALESCE(MAX(to_event.id), 0) FROM to_event)
+`
+
+type CountTopicEventsBetweenParams struct {
+	TopicID   int64
+	Partition int32
+	FromID    optional.Option[int64]
+	ToID      optional.Option[int64]
+}
+
+// Counts the events in a topic partition after the "from" event, up to and
+// including the "to" event. A NULL event represents the start of the partition.
+func (q *Queries) CountTopicEventsBetween(ctx context.Context, arg CountTopicEventsBetweenParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countTopicEventsBetween,
+		arg.TopicID,
+		arg.Partition,
+		arg.FromID,
+		arg.ToID,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createArtefact = `-- name: CreateArtefact :one
 INSERT INTO artefacts (digest, content)
 VALUES ($1, $2)
@@ -197,7 +375,8 @@ INSERT INTO async_calls (
   max_backoff,
   catch_verb,
   parent_request_key,
-  trace_context
+  trace_context,
+  scheduled_at
 )
 VALUES (
   $1,
@@ -208,7 +387,8 @@ VALUES (
   $6::interval,
   $7,
   $8,
-  $9::jsonb
+  $9::jsonb,
+  COALESCE($10::TIMESTAMPTZ, NOW() AT TIME ZONE 'utc')
 )
 RETURNING id
 `
@@ -223,6 +403,7 @@ type CreateAsyncCallParams struct {
 	CatchVerb         optional.Option[schema.RefKey]
 	ParentRequestKey  optional.Option[string]
 	TraceContext      json.RawMessage
+	ScheduledAt       optional.Option[time.Time]
 }
 
 func (q *Queries) CreateAsyncCall(ctx context.Context, arg CreateAsyncCallParams) (int64, error) {
@@ -236,6 +417,7 @@ func (q *Queries) CreateAsyncCall(ctx context.Context, arg CreateAsyncCallParams
 		arg.CatchVerb,
 		arg.ParentRequestKey,
 		arg.TraceContext,
+		arg.ScheduledAt,
 	)
 	var id int64
 	err := row.Scan(&id)
@@ -243,15 +425,17 @@ func (q *Queries) CreateAsyncCall(ctx context.Context, arg CreateAsyncCallParams
 }
 
 const createCronJob = `-- name: CreateCronJob :exec
-INSERT INTO cron_jobs (key, deployment_id, module_name, verb, schedule, start_time, next_execution)
+INSERT INTO cron_jobs (key, deployment_id, module_name, verb, schedule, timezone, missed_runs, start_time, next_execution)
   VALUES (
     $1::cron_job_key,
     (SELECT id FROM deployments WHERE key = $2::deployment_key LIMIT 1),
     $3::TEXT,
     $4::TEXT,
     $5::TEXT,
-    $6::TIMESTAMPTZ,
-    $7::TIMESTAMPTZ)
+    $6::TEXT,
+    $7::TEXT,
+    $8::TIMESTAMPTZ,
+    $9::TIMESTAMPTZ)
 `
 
 type CreateCronJobParams struct {
@@ -260,6 +444,8 @@ type CreateCronJobParams struct {
 	ModuleName    string
 	Verb          string
 	Schedule      string
+	Timezone      string
+	MissedRuns    string
 	StartTime     time.Time
 	NextExecution time.Time
 }
@@ -271,6 +457,8 @@ func (q *Queries) CreateCronJob(ctx context.Context, arg CreateCronJobParams) er
 		arg.ModuleName,
 		arg.Verb,
 		arg.Schedule,
+		arg.Timezone,
+		arg.MissedRuns,
 		arg.StartTime,
 		arg.NextExecution,
 	)
@@ -331,6 +519,18 @@ func (q *Queries) CreateRequest(ctx context.Context, origin Origin, key model.Re
 	return err
 }
 
+const degradeRunner = `-- name: DegradeRunner :exec
+UPDATE runners
+SET degraded_until = (NOW() AT TIME ZONE 'utc') + $1::INTERVAL
+WHERE key = $2::runner_key
+`
+
+// Mark a runner as degraded for the given period, deprioritising it for routing.
+func (q *Queries) DegradeRunner(ctx context.Context, ttl sqltypes.Duration, key model.RunnerKey) error {
+	_, err := q.db.ExecContext(ctx, degradeRunner, ttl, key)
+	return err
+}
+
 const deleteOldTimelineEvents = `-- name: DeleteOldTimelineEvents :one
 WITH deleted AS (
     DELETE FROM timeline
@@ -349,6 +549,19 @@ func (q *Queries) DeleteOldTimelineEvents(ctx context.Context, timeout sqltypes.
 	return count, err
 }
 
+const deleteStaleWebSocketMessages = `-- name: DeleteStaleWebSocketMessages :execrows
+DELETE FROM websocket_messages
+WHERE created_at < (NOW() AT TIME ZONE 'utc') - $1::INTERVAL
+`
+
+func (q *Queries) DeleteStaleWebSocketMessages(ctx context.Context, maxAge sqltypes.Duration) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteStaleWebSocketMessages, maxAge)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteSubscribers = `-- name: DeleteSubscribers :many
 DELETE FROM topic_subscribers
 WHERE deployment_id IN (
@@ -415,6 +628,25 @@ func (q *Queries) DeleteSubscriptions(ctx context.Context, deployment model.Depl
 	return items, nil
 }
 
+const deleteTopicDeadLetters = `-- name: DeleteTopicDeadLetters :execrows
+DELETE FROM topic_dead_letters dead_letters
+USING topic_events events, topic_subscriptions subs, modules
+WHERE dead_letters.event_id = events.id
+  AND dead_letters.subscription_id = subs.id
+  AND subs.module_id = modules.id
+  AND modules.name = $1::TEXT
+  AND subs.name = $2::TEXT
+  AND ($3::topic_event_key IS NULL OR events."key" = $3::topic_event_key)
+`
+
+func (q *Queries) DeleteTopicDeadLetters(ctx context.Context, module string, name string, event optional.Option[model.TopicEventKey]) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteTopicDeadLetters, module, name, event)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deregisterRunner = `-- name: DeregisterRunner :one
 WITH matches AS (
     UPDATE runners
@@ -441,9 +673,9 @@ UPDATE cron_jobs
   WHERE key = $2::cron_job_key
     AND state = 'executing'
     AND start_time = $3::TIMESTAMPTZ
-  RETURNING id, key, deployment_id, verb, schedule, start_time, next_execution, state, module_name
+  RETURNING id, key, deployment_id, verb, schedule, start_time, next_execution, state, module_name, last_execution, timezone, missed_runs
 )
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.timezone, j.missed_runs, j.start_time, j.next_execution, j.state
   FROM j
   INNER JOIN deployments d on j.deployment_id = d.id
   LIMIT 1
@@ -455,6 +687,8 @@ type EndCronJobRow struct {
 	Module        string
 	Verb          string
 	Schedule      string
+	Timezone      string
+	MissedRuns    string
 	StartTime     time.Time
 	NextExecution time.Time
 	State         model.CronJobState
@@ -469,6 +703,8 @@ func (q *Queries) EndCronJob(ctx context.Context, nextExecution time.Time, key m
 		&i.Module,
 		&i.Verb,
 		&i.Schedule,
+		&i.Timezone,
+		&i.MissedRuns,
 		&i.StartTime,
 		&i.NextExecution,
 		&i.State,
@@ -476,6 +712,32 @@ func (q *Queries) EndCronJob(ctx context.Context, nextExecution time.Time, key m
 	return i, err
 }
 
+const expireConcurrencySlots = `-- name: ExpireConcurrencySlots :execrows
+DELETE FROM concurrency_slots
+WHERE expires_at < (NOW() AT TIME ZONE 'utc')
+`
+
+func (q *Queries) ExpireConcurrencySlots(ctx context.Context) (int64, error) {
+	result, err := q.db.ExecContext(ctx, expireConcurrencySlots)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const expireIdempotencyKeys = `-- name: ExpireIdempotencyKeys :execrows
+DELETE FROM idempotency_keys
+WHERE expires_at < (NOW() AT TIME ZONE 'utc')
+`
+
+func (q *Queries) ExpireIdempotencyKeys(ctx context.Context) (int64, error) {
+	result, err := q.db.ExecContext(ctx, expireIdempotencyKeys)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const expireLeases = `-- name: ExpireLeases :one
 WITH expired AS (
     DELETE FROM leases
@@ -493,6 +755,21 @@ func (q *Queries) ExpireLeases(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const expireRateLimits = `-- name: ExpireRateLimits :execrows
+DELETE FROM rate_limits
+WHERE updated_at + period < (NOW() AT TIME ZONE 'utc')
+`
+
+// Delete token buckets that have not been used for a full period, and so have
+// refilled.
+func (q *Queries) ExpireRateLimits(ctx context.Context) (int64, error) {
+	result, err := q.db.ExecContext(ctx, expireRateLimits)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const expireRunnerReservations = `-- name: ExpireRunnerReservations :one
 WITH rows AS (
     UPDATE runners
@@ -535,7 +812,7 @@ WITH updated AS (
   SET state = 'error'::async_call_state,
       error = $7::TEXT
   WHERE id = $8::BIGINT
-  RETURNING id, created_at, lease_id, verb, state, origin, scheduled_at, request, response, error, remaining_attempts, backoff, max_backoff, catch_verb, catching, parent_request_key, trace_context
+  RETURNING id, created_at, lease_id, verb, state, origin, scheduled_at, request, response, error, remaining_attempts, backoff, max_backoff, catch_verb, catching, parent_request_key, trace_context, attempts
 )
 INSERT INTO async_calls (
   verb,
@@ -547,7 +824,8 @@ INSERT INTO async_calls (
   max_backoff,
   scheduled_at,
   catching,
-  error
+  error,
+  attempts
 )
 SELECT
   updated.verb,
@@ -559,7 +837,8 @@ SELECT
   $3::interval,
   $4::TIMESTAMPTZ,
   $5::bool,
-  $6
+  $6,
+  updated.attempts + CASE WHEN updated.catching THEN 0 ELSE 1 END
 FROM updated
 RETURNING true
 `
@@ -597,6 +876,8 @@ SET
   current_state = NULL,
   async_call_id = NULL,
   status = 'failed'::fsm_status,
+  next_event_type = NULL,
+  next_event_request = NULL,
   updated_at = NOW() AT TIME ZONE 'utc'
 WHERE
   fsm = $1::schema_ref AND key = $2::TEXT
@@ -610,26 +891,58 @@ func (q *Queries) FailFSMInstance(ctx context.Context, fsm schema.RefKey, key st
 	return column_1, err
 }
 
-const finishFSMTransition = `-- name: FinishFSMTransition :one
+const finishFSMCompensation = `-- name: FinishFSMCompensation :one
 UPDATE fsm_instances
 SET
-  current_state = destination_state,
-  destination_state = NULL,
-  async_call_id = NULL,
+  status = $1::fsm_status,
+  compensating_async_call_id = NULL,
   updated_at = NOW() AT TIME ZONE 'utc'
 WHERE
-  fsm = $1::schema_ref AND key = $2::TEXT
+  fsm = $2::schema_ref AND key = $3::TEXT
 RETURNING true
 `
 
-// Mark an FSM transition as completed, updating the current state and clearing the async call ID.
-func (q *Queries) FinishFSMTransition(ctx context.Context, fsm schema.RefKey, key string) (bool, error) {
-	row := q.db.QueryRowContext(ctx, finishFSMTransition, fsm, key)
+func (q *Queries) FinishFSMCompensation(ctx context.Context, status FsmStatus, fsm schema.RefKey, key string) (bool, error) {
+	row := q.db.QueryRowContext(ctx, finishFSMCompensation, status, fsm, key)
 	var column_1 bool
 	err := row.Scan(&column_1)
 	return column_1, err
 }
 
+const finishFSMTransition = `-- name: FinishFSMTransition :one
+UPDATE fsm_instances
+SET
+  current_state = fsm_instances.destination_state,
+  destination_state = NULL,
+  async_call_id = NULL,
+  next_event_type = NULL,
+  next_event_request = NULL,
+  updated_at = NOW() AT TIME ZONE 'utc'
+FROM (
+  SELECT id, next_event_type, next_event_request
+  FROM fsm_instances
+  WHERE fsm = $1::schema_ref AND key = $2::TEXT
+  FOR UPDATE
+) AS previous
+WHERE fsm_instances.id = previous.id
+RETURNING previous.next_event_type, previous.next_event_request
+`
+
+type FinishFSMTransitionRow struct {
+	NextEventType    optional.Option[string]
+	NextEventRequest []byte
+}
+
+// Mark an FSM transition as completed, updating the current state and clearing the async call ID.
+//
+// Returns the next event set by the state verb, if any, which is also cleared.
+func (q *Queries) FinishFSMTransition(ctx context.Context, fsm schema.RefKey, key string) (FinishFSMTransitionRow, error) {
+	row := q.db.QueryRowContext(ctx, finishFSMTransition, fsm, key)
+	var i FinishFSMTransitionRow
+	err := row.Scan(&i.NextEventType, &i.NextEventRequest)
+	return i, err
+}
+
 const getActiveControllers = `-- name: GetActiveControllers :many
 SELECT id, key, created, last_seen, state, endpoint
 FROM controller c
@@ -803,7 +1116,8 @@ SELECT DISTINCT ON (r.key) r.key                                   AS runner_key
                            r.module_name,
                            COALESCE(CASE
                                         WHEN r.deployment_id IS NOT NULL
-                                            THEN d.key END, NULL) AS deployment_key
+                                            THEN d.key END, NULL) AS deployment_key,
+                           r.degraded_until
 FROM runners r
          LEFT JOIN deployments d on d.id = r.deployment_id
 WHERE r.state <> 'dead'
@@ -818,6 +1132,7 @@ type GetActiveRunnersRow struct {
 	LastSeen      time.Time
 	ModuleName    optional.Option[string]
 	DeploymentKey optional.Option[string]
+	DegradedUntil optional.Option[time.Time]
 }
 
 func (q *Queries) GetActiveRunners(ctx context.Context) ([]GetActiveRunnersRow, error) {
@@ -837,6 +1152,7 @@ func (q *Queries) GetActiveRunners(ctx context.Context) ([]GetActiveRunnersRow,
 			&i.LastSeen,
 			&i.ModuleName,
 			&i.DeploymentKey,
+			&i.DegradedUntil,
 		); err != nil {
 			return nil, err
 		}
@@ -900,10 +1216,11 @@ func (q *Queries) GetArtefactDigests(ctx context.Context, digests [][]byte) ([]G
 }
 
 const getCronJobs = `-- name: GetCronJobs :many
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.timezone, j.missed_runs, j.start_time, j.next_execution, j.state
 FROM cron_jobs j
   INNER JOIN deployments d on j.deployment_id = d.id
 WHERE d.min_replicas > 0
+  AND NOT EXISTS (SELECT 1 FROM paused_cron_jobs p WHERE p.module_name = j.module_name AND p.verb = j.verb)
 `
 
 type GetCronJobsRow struct {
@@ -912,6 +1229,8 @@ type GetCronJobsRow struct {
 	Module        string
 	Verb          string
 	Schedule      string
+	Timezone      string
+	MissedRuns    string
 	StartTime     time.Time
 	NextExecution time.Time
 	State         model.CronJobState
@@ -932,6 +1251,8 @@ func (q *Queries) GetCronJobs(ctx context.Context) ([]GetCronJobsRow, error) {
 			&i.Module,
 			&i.Verb,
 			&i.Schedule,
+			&i.Timezone,
+			&i.MissedRuns,
 			&i.StartTime,
 			&i.NextExecution,
 			&i.State,
@@ -949,6 +1270,81 @@ func (q *Queries) GetCronJobs(ctx context.Context) ([]GetCronJobsRow, error) {
 	return items, nil
 }
 
+const getDeadLetterEventsForSubscription = `-- name: GetDeadLetterEventsForSubscription :many
+SELECT
+  subs."key" AS subscription,
+  dead_letter_modules.name AS module,
+  dead_letter_topics.name AS topic,
+  events."key" AS event,
+  events.payload,
+  events.caller,
+  events.request_key,
+  events.trace_context,
+  events.partition_key
+FROM topic_subscriptions subs
+INNER JOIN modules ON subs.module_id = modules.id
+INNER JOIN topics dead_letter_topics ON subs.dead_letter_topic_id = dead_letter_topics.id
+INNER JOIN modules dead_letter_modules ON dead_letter_topics.module_id = dead_letter_modules.id
+INNER JOIN topic_subscription_cursors cursors ON cursors.subscription_id = subs.id
+INNER JOIN topic_events batch_start ON COALESCE(cursors.batch_start, cursors.cursor) = batch_start.id
+INNER JOIN topic_events batch_end ON cursors.cursor = batch_end.id
+INNER JOIN topic_events events ON events.topic_id = subs.topic_id
+  AND events.partition = cursors.partition
+  AND (events.created_at, events.id) >= (batch_start.created_at, batch_start.id)
+  AND (events.created_at, events.id) <= (batch_end.created_at, batch_end.id)
+WHERE modules.name = $1::TEXT
+  AND subs.name = $2::TEXT
+  AND cursors.partition = $3::INT
+ORDER BY events.created_at, events.id
+`
+
+type GetDeadLetterEventsForSubscriptionRow struct {
+	Subscription model.SubscriptionKey
+	Module       string
+	Topic        string
+	Event        model.TopicEventKey
+	Payload      []byte
+	Caller       optional.Option[string]
+	RequestKey   optional.Option[string]
+	TraceContext pqtype.NullRawMessage
+	PartitionKey optional.Option[string]
+}
+
+// Returns the dead letter topic for a subscription, along with the batch of
+// events the subscription is currently consuming from the given partition.
+func (q *Queries) GetDeadLetterEventsForSubscription(ctx context.Context, module string, name string, partition int32) ([]GetDeadLetterEventsForSubscriptionRow, error) {
+	rows, err := q.db.QueryContext(ctx, getDeadLetterEventsForSubscription, module, name, partition)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDeadLetterEventsForSubscriptionRow
+	for rows.Next() {
+		var i GetDeadLetterEventsForSubscriptionRow
+		if err := rows.Scan(
+			&i.Subscription,
+			&i.Module,
+			&i.Topic,
+			&i.Event,
+			&i.Payload,
+			&i.Caller,
+			&i.RequestKey,
+			&i.TraceContext,
+			&i.PartitionKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getDeployment = `-- name: GetDeployment :one
 SELECT d.id, d.created_at, d.module_id, d.key, d.schema, d.labels, d.min_replicas, m.language, m.name AS module_name, d.min_replicas
 FROM deployments d
@@ -1255,8 +1651,49 @@ func (q *Queries) GetExistingDeploymentForModule(ctx context.Context, name strin
 	return i, err
 }
 
+const getFSMCompletedTransitions = `-- name: GetFSMCompletedTransitions :many
+SELECT id, verb, request
+FROM async_calls
+WHERE
+  origin = $1::TEXT
+  AND state = 'success'
+  AND NOT catching
+  AND id < $2::BIGINT
+ORDER BY id DESC
+`
+
+type GetFSMCompletedTransitionsRow struct {
+	ID      int64
+	Verb    schema.RefKey
+	Request []byte
+}
+
+// Transitions completed by an FSM instance before the given async call, most recent first.
+func (q *Queries) GetFSMCompletedTransitions(ctx context.Context, origin string, beforeID int64) ([]GetFSMCompletedTransitionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getFSMCompletedTransitions, origin, beforeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetFSMCompletedTransitionsRow
+	for rows.Next() {
+		var i GetFSMCompletedTransitionsRow
+		if err := rows.Scan(&i.ID, &i.Verb, &i.Request); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getFSMInstance = `-- name: GetFSMInstance :one
-SELECT id, created_at, fsm, key, status, current_state, destination_state, async_call_id, updated_at
+SELECT id, created_at, fsm, key, status, current_state, destination_state, async_call_id, updated_at, timeout_async_call_id, next_event_type, next_event_request, compensating_async_call_id
 FROM fsm_instances
 WHERE fsm = $1::schema_ref AND key = $2
 `
@@ -1274,12 +1711,92 @@ func (q *Queries) GetFSMInstance(ctx context.Context, fsm schema.RefKey, key str
 		&i.DestinationState,
 		&i.AsyncCallID,
 		&i.UpdatedAt,
+		&i.TimeoutAsyncCallID,
+		&i.NextEventType,
+		&i.NextEventRequest,
+		&i.CompensatingAsyncCallID,
 	)
 	return i, err
 }
 
+const getFSMQueueDepth = `-- name: GetFSMQueueDepth :one
+SELECT COUNT(q.id)
+FROM fsm_queued_events q
+JOIN fsm_instances i ON q.fsm_instance_id = i.id
+WHERE i.fsm = $1::schema_ref AND i.key = $2::TEXT
+`
+
+func (q *Queries) GetFSMQueueDepth(ctx context.Context, fsm schema.RefKey, key string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getFSMQueueDepth, fsm, key)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const getFSMTransitionHistory = `-- name: GetFSMTransitionHistory :many
+SELECT id, created_at, verb, state, error, request, catching
+FROM async_calls
+WHERE origin = $1::TEXT
+ORDER BY id
+`
+
+type GetFSMTransitionHistoryRow struct {
+	ID        int64
+	CreatedAt time.Time
+	Verb      schema.RefKey
+	State     AsyncCallState
+	Error     optional.Option[string]
+	Request   []byte
+	Catching  bool
+}
+
+// Every async call made to execute a transition of an FSM instance, oldest first.
+func (q *Queries) GetFSMTransitionHistory(ctx context.Context, origin string) ([]GetFSMTransitionHistoryRow, error) {
+	rows, err := q.db.QueryContext(ctx, getFSMTransitionHistory, origin)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetFSMTransitionHistoryRow
+	for rows.Next() {
+		var i GetFSMTransitionHistoryRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Verb,
+			&i.State,
+			&i.Error,
+			&i.Request,
+			&i.Catching,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getIdempotentResponse = `-- name: GetIdempotentResponse :one
+SELECT response
+FROM idempotency_keys
+WHERE verb = $1::schema_ref AND key = $2::TEXT
+`
+
+func (q *Queries) GetIdempotentResponse(ctx context.Context, verb schema.RefKey, key string) ([]byte, error) {
+	row := q.db.QueryRowContext(ctx, getIdempotentResponse, verb, key)
+	var response []byte
+	err := row.Scan(&response)
+	return response, err
+}
+
 const getIdleRunners = `-- name: GetIdleRunners :many
-SELECT id, key, created, last_seen, reservation_timeout, state, endpoint, module_name, deployment_id, labels
+SELECT id, key, created, last_seen, reservation_timeout, state, endpoint, module_name, deployment_id, labels, degraded_until
 FROM runners
 WHERE labels @> $1::jsonb
   AND state = 'idle'
@@ -1306,6 +1823,7 @@ func (q *Queries) GetIdleRunners(ctx context.Context, labels json.RawMessage, li
 			&i.ModuleName,
 			&i.DeploymentID,
 			&i.Labels,
+			&i.DegradedUntil,
 		); err != nil {
 			return nil, err
 		}
@@ -1414,13 +1932,13 @@ func (q *Queries) GetModulesByID(ctx context.Context, ids []int64) ([]Module, er
 	return items, nil
 }
 
-const getNextEventForSubscription = `-- name: GetNextEventForSubscription :one
+const getNextEventsForSubscription = `-- name: GetNextEventsForSubscription :many
 WITH cursor AS (
   SELECT
     created_at,
     id
   FROM topic_events
-  WHERE "key" = $3::topic_event_key
+  WHERE "key" = $6::topic_event_key
 )
 SELECT events."key" as event,
         events.payload,
@@ -1428,16 +1946,27 @@ SELECT events."key" as event,
         events.caller,
         events.request_key,
         events.trace_context,
-        NOW() - events.created_at >= $1::interval AS ready
+        NOW() - events.created_at >= $1::interval AS ready,
+        NOW() - events.created_at >= $2::interval AS batch_waited
 FROM topics
 LEFT JOIN topic_events as events ON events.topic_id = topics.id
-WHERE topics.key = $2::topic_key
+WHERE topics.key = $3::topic_key
+  AND events.partition = $4::INT
   AND (events.created_at, events.id) > (SELECT COALESCE(MAX(cursor.created_at), '1900-01-01'), COALESCE(MAX(cursor.id), 0) FROM cursor)
 ORDER BY events.created_at, events.id
-LIMIT 1
+LIMIT $5::INT
 `
 
-type GetNextEventForSubscriptionRow struct {
+type GetNextEventsForSubscriptionParams struct {
+	ConsumptionDelay sqltypes.Duration
+	BatchWait        sqltypes.Duration
+	Topic            model.TopicKey
+	Partition        int32
+	MaxEvents        int32
+	Cursor           optional.Option[model.TopicEventKey]
+}
+
+type GetNextEventsForSubscriptionRow struct {
 	Event        optional.Option[model.TopicEventKey]
 	Payload      []byte
 	CreatedAt    optional.Option[time.Time]
@@ -1445,24 +1974,50 @@ type GetNextEventForSubscriptionRow struct {
 	RequestKey   optional.Option[string]
 	TraceContext pqtype.NullRawMessage
 	Ready        bool
+	BatchWaited  bool
 }
 
-func (q *Queries) GetNextEventForSubscription(ctx context.Context, consumptionDelay sqltypes.Duration, topic model.TopicKey, cursor optional.Option[model.TopicEventKey]) (GetNextEventForSubscriptionRow, error) {
-	row := q.db.QueryRowContext(ctx, getNextEventForSubscription, consumptionDelay, topic, cursor)
-	var i GetNextEventForSubscriptionRow
-	err := row.Scan(
-		&i.Event,
-		&i.Payload,
-		&i.CreatedAt,
-		&i.Caller,
-		&i.RequestKey,
-		&i.TraceContext,
-		&i.Ready,
+// Returns up to max_events events in the partition after the cursor.
+func (q *Queries) GetNextEventsForSubscription(ctx context.Context, arg GetNextEventsForSubscriptionParams) ([]GetNextEventsForSubscriptionRow, error) {
+	rows, err := q.db.QueryContext(ctx, getNextEventsForSubscription,
+		arg.ConsumptionDelay,
+		arg.BatchWait,
+		arg.Topic,
+		arg.Partition,
+		arg.MaxEvents,
+		arg.Cursor,
 	)
-	return i, err
-}
-
-const getOnlyEncryptionKey = `-- name: GetOnlyEncryptionKey :one
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetNextEventsForSubscriptionRow
+	for rows.Next() {
+		var i GetNextEventsForSubscriptionRow
+		if err := rows.Scan(
+			&i.Event,
+			&i.Payload,
+			&i.CreatedAt,
+			&i.Caller,
+			&i.RequestKey,
+			&i.TraceContext,
+			&i.Ready,
+			&i.BatchWaited,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOnlyEncryptionKey = `-- name: GetOnlyEncryptionKey :one
 SELECT key
 FROM encryption_keys
 WHERE id = 1
@@ -1475,6 +2030,47 @@ func (q *Queries) GetOnlyEncryptionKey(ctx context.Context) ([]byte, error) {
 	return key, err
 }
 
+const getPreviousTopicEvent = `-- name: GetPreviousTopicEvent :one
+SELECT id, created_at, key, topic_id, payload, caller, request_key, trace_context, partition, partition_key
+FROM topic_events
+WHERE topic_id = $1::BIGINT
+  AND partition = $2::INT
+  AND (created_at, id) < ($3::TIMESTAMPTZ, $4::BIGINT)
+ORDER BY created_at DESC, id DESC
+LIMIT 1
+`
+
+type GetPreviousTopicEventParams struct {
+	TopicID   int64
+	Partition int32
+	CreatedAt time.Time
+	EventID   int64
+}
+
+// Returns the last event in the topic partition that is ordered before the given position.
+func (q *Queries) GetPreviousTopicEvent(ctx context.Context, arg GetPreviousTopicEventParams) (TopicEvent, error) {
+	row := q.db.QueryRowContext(ctx, getPreviousTopicEvent,
+		arg.TopicID,
+		arg.Partition,
+		arg.CreatedAt,
+		arg.EventID,
+	)
+	var i TopicEvent
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.Key,
+		&i.TopicID,
+		&i.Payload,
+		&i.Caller,
+		&i.RequestKey,
+		&i.TraceContext,
+		&i.Partition,
+		&i.PartitionKey,
+	)
+	return i, err
+}
+
 const getProcessList = `-- name: GetProcessList :many
 SELECT d.min_replicas,
        d.key   AS deployment_key,
@@ -1533,7 +2129,9 @@ SELECT
   subscribers.retry_attempts as retry_attempts,
   subscribers.backoff as backoff,
   subscribers.max_backoff as max_backoff,
-  subscribers.catch_verb as catch_verb
+  subscribers.catch_verb as catch_verb,
+  subscribers.batch_size as batch_size,
+  subscribers.batch_wait as batch_wait
 FROM topic_subscribers as subscribers
 JOIN topic_subscriptions ON subscribers.topic_subscriptions_id = topic_subscriptions.id
 WHERE topic_subscriptions.key = $1::subscription_key
@@ -1547,6 +2145,8 @@ type GetRandomSubscriberRow struct {
 	Backoff       sqltypes.Duration
 	MaxBackoff    sqltypes.Duration
 	CatchVerb     optional.Option[schema.RefKey]
+	BatchSize     optional.Option[int32]
+	BatchWait     sqltypes.Duration
 }
 
 func (q *Queries) GetRandomSubscriber(ctx context.Context, key model.SubscriptionKey) (GetRandomSubscriberRow, error) {
@@ -1558,6 +2158,8 @@ func (q *Queries) GetRandomSubscriber(ctx context.Context, key model.Subscriptio
 		&i.Backoff,
 		&i.MaxBackoff,
 		&i.CatchVerb,
+		&i.BatchSize,
+		&i.BatchWait,
 	)
 	return i, err
 }
@@ -1592,7 +2194,8 @@ func (q *Queries) GetRouteForRunner(ctx context.Context, key model.RunnerKey) (G
 }
 
 const getRoutingTable = `-- name: GetRoutingTable :many
-SELECT endpoint, r.key AS runner_key, r.module_name, d.key deployment_key
+SELECT endpoint, r.key AS runner_key, r.module_name, d.key deployment_key,
+       COALESCE(r.degraded_until > (NOW() AT TIME ZONE 'utc'), FALSE)::BOOLEAN AS degraded
 FROM runners r
          LEFT JOIN deployments d on r.deployment_id = d.id
 WHERE state = 'assigned'
@@ -1605,6 +2208,7 @@ type GetRoutingTableRow struct {
 	RunnerKey     model.RunnerKey
 	ModuleName    optional.Option[string]
 	DeploymentKey optional.Option[model.DeploymentKey]
+	Degraded      bool
 }
 
 func (q *Queries) GetRoutingTable(ctx context.Context, modules []string) ([]GetRoutingTableRow, error) {
@@ -1621,6 +2225,7 @@ func (q *Queries) GetRoutingTable(ctx context.Context, modules []string) ([]GetR
 			&i.RunnerKey,
 			&i.ModuleName,
 			&i.DeploymentKey,
+			&i.Degraded,
 		); err != nil {
 			return nil, err
 		}
@@ -1644,7 +2249,8 @@ SELECT DISTINCT ON (r.key) r.key                                   AS runner_key
                            r.module_name,
                            COALESCE(CASE
                                         WHEN r.deployment_id IS NOT NULL
-                                            THEN d.key END, NULL) AS deployment_key
+                                            THEN d.key END, NULL) AS deployment_key,
+                           r.degraded_until
 FROM runners r
          LEFT JOIN deployments d on d.id = r.deployment_id OR r.deployment_id IS NULL
 WHERE r.key = $1::runner_key
@@ -1658,6 +2264,7 @@ type GetRunnerRow struct {
 	LastSeen      time.Time
 	ModuleName    optional.Option[string]
 	DeploymentKey optional.Option[string]
+	DegradedUntil optional.Option[time.Time]
 }
 
 func (q *Queries) GetRunner(ctx context.Context, key model.RunnerKey) (GetRunnerRow, error) {
@@ -1671,6 +2278,7 @@ func (q *Queries) GetRunner(ctx context.Context, key model.RunnerKey) (GetRunner
 		&i.LastSeen,
 		&i.ModuleName,
 		&i.DeploymentKey,
+		&i.DegradedUntil,
 	)
 	return i, err
 }
@@ -1689,7 +2297,7 @@ func (q *Queries) GetRunnerState(ctx context.Context, key model.RunnerKey) (Runn
 }
 
 const getRunnersForDeployment = `-- name: GetRunnersForDeployment :many
-SELECT r.id, r.key, created, last_seen, reservation_timeout, state, endpoint, module_name, deployment_id, r.labels, d.id, created_at, module_id, d.key, schema, d.labels, min_replicas
+SELECT r.id, r.key, created, last_seen, reservation_timeout, state, endpoint, module_name, deployment_id, r.labels, degraded_until, d.id, created_at, module_id, d.key, schema, d.labels, min_replicas
 FROM runners r
          INNER JOIN deployments d on r.deployment_id = d.id
 WHERE state = 'assigned'
@@ -1707,6 +2315,7 @@ type GetRunnersForDeploymentRow struct {
 	ModuleName         optional.Option[string]
 	DeploymentID       optional.Option[int64]
 	Labels             json.RawMessage
+	DegradedUntil      optional.Option[time.Time]
 	ID_2               int64
 	CreatedAt          time.Time
 	ModuleID           int64
@@ -1736,6 +2345,7 @@ func (q *Queries) GetRunnersForDeployment(ctx context.Context, key model.Deploym
 			&i.ModuleName,
 			&i.DeploymentID,
 			&i.Labels,
+			&i.DegradedUntil,
 			&i.ID_2,
 			&i.CreatedAt,
 			&i.ModuleID,
@@ -1757,6 +2367,50 @@ func (q *Queries) GetRunnersForDeployment(ctx context.Context, key model.Deploym
 	return items, nil
 }
 
+const getRunningFSMStates = `-- name: GetRunningFSMStates :many
+SELECT states.state::schema_ref AS state, COUNT(*) AS instances
+FROM (
+  SELECT current_state AS state
+  FROM fsm_instances
+  WHERE fsm = $1::schema_ref AND status = 'running' AND current_state IS NOT NULL
+  UNION ALL
+  SELECT destination_state AS state
+  FROM fsm_instances
+  WHERE fsm = $1::schema_ref AND status = 'running' AND destination_state IS NOT NULL
+) AS states
+GROUP BY states.state
+ORDER BY states.state
+`
+
+type GetRunningFSMStatesRow struct {
+	State     schema.RefKey
+	Instances int64
+}
+
+// Count the running instances of an FSM in, or transitioning to, each state.
+func (q *Queries) GetRunningFSMStates(ctx context.Context, fsm schema.RefKey) ([]GetRunningFSMStatesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRunningFSMStates, fsm)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRunningFSMStatesRow
+	for rows.Next() {
+		var i GetRunningFSMStatesRow
+		if err := rows.Scan(&i.State, &i.Instances); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSchemaForDeployment = `-- name: GetSchemaForDeployment :one
 SELECT schema FROM deployments WHERE key = $1::deployment_key
 `
@@ -1769,7 +2423,7 @@ func (q *Queries) GetSchemaForDeployment(ctx context.Context, key model.Deployme
 }
 
 const getStaleCronJobs = `-- name: GetStaleCronJobs :many
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.timezone, j.missed_runs, j.start_time, j.next_execution, j.state
 FROM cron_jobs j
   INNER JOIN deployments d on j.deployment_id = d.id
 WHERE state = 'executing'
@@ -1782,6 +2436,8 @@ type GetStaleCronJobsRow struct {
 	Module        string
 	Verb          string
 	Schedule      string
+	Timezone      string
+	MissedRuns    string
 	StartTime     time.Time
 	NextExecution time.Time
 	State         model.CronJobState
@@ -1802,6 +2458,8 @@ func (q *Queries) GetStaleCronJobs(ctx context.Context, dollar_1 sqltypes.Durati
 			&i.Module,
 			&i.Verb,
 			&i.Schedule,
+			&i.Timezone,
+			&i.MissedRuns,
 			&i.StartTime,
 			&i.NextExecution,
 			&i.State,
@@ -1825,7 +2483,7 @@ WITH module AS (
   FROM modules
   WHERE name = $2::TEXT
 )
-SELECT id, key, created_at, topic_id, module_id, deployment_id, name, cursor, state
+SELECT id, key, created_at, topic_id, module_id, deployment_id, name, dead_letter_topic_id
 FROM topic_subscriptions
 WHERE name = $1::TEXT
       AND module_id = (SELECT id FROM module)
@@ -1842,38 +2500,111 @@ func (q *Queries) GetSubscription(ctx context.Context, column1 string, column2 s
 		&i.ModuleID,
 		&i.DeploymentID,
 		&i.Name,
-		&i.Cursor,
-		&i.State,
+		&i.DeadLetterTopicID,
 	)
 	return i, err
 }
 
+const getSubscriptionCursors = `-- name: GetSubscriptionCursors :many
+SELECT id, subscription_id, partition, cursor, state, batch_start
+FROM topic_subscription_cursors
+WHERE subscription_id = $1::BIGINT
+ORDER BY partition
+`
+
+func (q *Queries) GetSubscriptionCursors(ctx context.Context, subscriptionID int64) ([]TopicSubscriptionCursor, error) {
+	rows, err := q.db.QueryContext(ctx, getSubscriptionCursors, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TopicSubscriptionCursor
+	for rows.Next() {
+		var i TopicSubscriptionCursor
+		if err := rows.Scan(
+			&i.ID,
+			&i.SubscriptionID,
+			&i.Partition,
+			&i.Cursor,
+			&i.State,
+			&i.BatchStart,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSubscriptionsNeedingUpdate = `-- name: GetSubscriptionsNeedingUpdate :many
 SELECT
   subs.key::subscription_key as key,
+  cursors.partition,
   curser.key as cursor,
   topics.key::topic_key as topic,
   subs.name
-FROM topic_subscriptions subs
+FROM topic_subscription_cursors cursors
+INNER JOIN topic_subscriptions subs ON cursors.subscription_id = subs.id
 LEFT JOIN topics ON subs.topic_id = topics.id
-LEFT JOIN topic_events curser ON subs.cursor = curser.id
-WHERE subs.cursor IS DISTINCT FROM topics.head
-  AND subs.state = 'idle'
+LEFT JOIN topic_events curser ON cursors.cursor = curser.id
+WHERE cursors.cursor IS DISTINCT FROM (
+    SELECT head.id
+    FROM topic_events head
+    WHERE head.topic_id = subs.topic_id
+      AND head.partition = cursors.partition
+    ORDER BY head.created_at DESC, head.id DESC
+    LIMIT 1
+  )
+  AND cursors.state = 'idle'
+  AND NOT EXISTS (
+    SELECT 1
+    FROM topic_subscribers batching
+    WHERE batching.topic_subscriptions_id = subs.id
+      AND batching.batch_size IS NOT NULL
+      AND (
+        SELECT COUNT(*)
+        FROM (
+          SELECT 1
+          FROM topic_events pending
+          WHERE pending.topic_id = subs.topic_id
+            AND pending.partition = cursors.partition
+            AND (pending.created_at, pending.id) > (COALESCE(curser.created_at, '1900-01-01'), COALESCE(curser.id, 0))
+          LIMIT batching.batch_size
+        ) AS batch
+      ) < batching.batch_size
+      AND NOT EXISTS (
+        SELECT 1
+        FROM topic_events waited
+        WHERE waited.topic_id = subs.topic_id
+          AND waited.partition = cursors.partition
+          AND (waited.created_at, waited.id) > (COALESCE(curser.created_at, '1900-01-01'), COALESCE(curser.id, 0))
+          AND NOW() - waited.created_at >= batching.batch_wait
+      )
+  )
 ORDER BY curser.created_at
 LIMIT 3
-FOR UPDATE OF subs SKIP LOCKED
+FOR UPDATE OF cursors SKIP LOCKED
 `
 
 type GetSubscriptionsNeedingUpdateRow struct {
-	Key    model.SubscriptionKey
-	Cursor optional.Option[model.TopicEventKey]
-	Topic  model.TopicKey
-	Name   string
+	Key       model.SubscriptionKey
+	Partition int32
+	Cursor    optional.Option[model.TopicEventKey]
+	Topic     model.TopicKey
+	Name      string
 }
 
 // Results may not be ready to be scheduled yet due to event consumption delay
 // Sorting ensures that brand new events (that may not be ready for consumption)
 // don't prevent older events from being consumed
+// Partitions of subscriptions that are waiting for a batch to fill are excluded
+// so that they don't prevent other subscriptions from being consumed
 func (q *Queries) GetSubscriptionsNeedingUpdate(ctx context.Context) ([]GetSubscriptionsNeedingUpdateRow, error) {
 	rows, err := q.db.QueryContext(ctx, getSubscriptionsNeedingUpdate)
 	if err != nil {
@@ -1885,6 +2616,7 @@ func (q *Queries) GetSubscriptionsNeedingUpdate(ctx context.Context) ([]GetSubsc
 		var i GetSubscriptionsNeedingUpdateRow
 		if err := rows.Scan(
 			&i.Key,
+			&i.Partition,
 			&i.Cursor,
 			&i.Topic,
 			&i.Name,
@@ -1903,7 +2635,7 @@ func (q *Queries) GetSubscriptionsNeedingUpdate(ctx context.Context) ([]GetSubsc
 }
 
 const getTopic = `-- name: GetTopic :one
-SELECT id, key, created_at, module_id, name, type, head
+SELECT id, key, created_at, module_id, name, type, head, partitions
 FROM topics
 WHERE id = $1::BIGINT
 `
@@ -1919,12 +2651,93 @@ func (q *Queries) GetTopic(ctx context.Context, dollar_1 int64) (Topic, error) {
 		&i.Name,
 		&i.Type,
 		&i.Head,
+		&i.Partitions,
 	)
 	return i, err
 }
 
+const getTopicDeadLetters = `-- name: GetTopicDeadLetters :many
+SELECT
+  subs."key" AS subscription,
+  topics."key" AS topic,
+  events."key" AS event,
+  events.payload,
+  events.request_key,
+  events.trace_context,
+  dead_letters.error,
+  dead_letters.attempts,
+  dead_letters.created_at
+FROM topic_dead_letters dead_letters
+INNER JOIN topic_events events ON dead_letters.event_id = events.id
+INNER JOIN topics ON events.topic_id = topics.id
+INNER JOIN topic_subscriptions subs ON dead_letters.subscription_id = subs.id
+INNER JOIN modules ON subs.module_id = modules.id
+WHERE modules.name = $1::TEXT
+  AND subs.name = $2::TEXT
+  AND ($3::topic_event_key IS NULL OR events."key" = $3::topic_event_key)
+ORDER BY dead_letters.created_at, dead_letters.id
+LIMIT $4::INT
+`
+
+type GetTopicDeadLettersParams struct {
+	Module     string
+	Name       string
+	Event      optional.Option[model.TopicEventKey]
+	MaxResults int32
+}
+
+type GetTopicDeadLettersRow struct {
+	Subscription model.SubscriptionKey
+	Topic        model.TopicKey
+	Event        model.TopicEventKey
+	Payload      []byte
+	RequestKey   optional.Option[string]
+	TraceContext pqtype.NullRawMessage
+	Error        string
+	Attempts     int32
+	CreatedAt    time.Time
+}
+
+func (q *Queries) GetTopicDeadLetters(ctx context.Context, arg GetTopicDeadLettersParams) ([]GetTopicDeadLettersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTopicDeadLetters,
+		arg.Module,
+		arg.Name,
+		arg.Event,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTopicDeadLettersRow
+	for rows.Next() {
+		var i GetTopicDeadLettersRow
+		if err := rows.Scan(
+			&i.Subscription,
+			&i.Topic,
+			&i.Event,
+			&i.Payload,
+			&i.RequestKey,
+			&i.TraceContext,
+			&i.Error,
+			&i.Attempts,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTopicEvent = `-- name: GetTopicEvent :one
-SELECT id, created_at, key, topic_id, payload, caller, request_key, trace_context
+SELECT id, created_at, key, topic_id, payload, caller, request_key, trace_context, partition, partition_key
 FROM topic_events
 WHERE id = $1::BIGINT
 `
@@ -1941,6 +2754,32 @@ func (q *Queries) GetTopicEvent(ctx context.Context, dollar_1 int64) (TopicEvent
 		&i.Caller,
 		&i.RequestKey,
 		&i.TraceContext,
+		&i.Partition,
+		&i.PartitionKey,
+	)
+	return i, err
+}
+
+const getTopicEventByKey = `-- name: GetTopicEventByKey :one
+SELECT id, created_at, key, topic_id, payload, caller, request_key, trace_context, partition, partition_key
+FROM topic_events
+WHERE "key" = $1::topic_event_key
+`
+
+func (q *Queries) GetTopicEventByKey(ctx context.Context, key model.TopicEventKey) (TopicEvent, error) {
+	row := q.db.QueryRowContext(ctx, getTopicEventByKey, key)
+	var i TopicEvent
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.Key,
+		&i.TopicID,
+		&i.Payload,
+		&i.Caller,
+		&i.RequestKey,
+		&i.TraceContext,
+		&i.Partition,
+		&i.PartitionKey,
 	)
 	return i, err
 }
@@ -1954,7 +2793,9 @@ INSERT INTO topic_subscribers (
   retry_attempts,
   backoff,
   max_backoff,
-  catch_verb
+  catch_verb,
+  batch_size,
+  batch_wait
 )
 VALUES (
   $1::subscriber_key,
@@ -1970,7 +2811,9 @@ VALUES (
   $6,
   $7::interval,
   $8::interval,
-  $9
+  $9,
+  $10::INT,
+  $11::interval
 )
 `
 
@@ -1984,6 +2827,8 @@ type InsertSubscriberParams struct {
 	Backoff          sqltypes.Duration
 	MaxBackoff       sqltypes.Duration
 	CatchVerb        optional.Option[schema.RefKey]
+	BatchSize        optional.Option[int32]
+	BatchWait        sqltypes.Duration
 }
 
 func (q *Queries) InsertSubscriber(ctx context.Context, arg InsertSubscriberParams) error {
@@ -1997,6 +2842,8 @@ func (q *Queries) InsertSubscriber(ctx context.Context, arg InsertSubscriberPara
 		arg.Backoff,
 		arg.MaxBackoff,
 		arg.CatchVerb,
+		arg.BatchSize,
+		arg.BatchWait,
 	)
 	return err
 }
@@ -2215,6 +3062,48 @@ func (q *Queries) InsertTimelineLogEvent(ctx context.Context, arg InsertTimeline
 	return err
 }
 
+const insertTopicDeadLetter = `-- name: InsertTopicDeadLetter :exec
+INSERT INTO topic_dead_letters (event_id, subscription_id, error, attempts)
+VALUES (
+  (SELECT id FROM topic_events WHERE "key" = $1::topic_event_key),
+  (SELECT id FROM topic_subscriptions WHERE "key" = $2::subscription_key),
+  $3::TEXT,
+  $4::INT
+)
+ON CONFLICT (event_id) DO
+UPDATE SET
+  error = excluded.error,
+  attempts = topic_dead_letters.attempts + excluded.attempts,
+  created_at = excluded.created_at
+`
+
+type InsertTopicDeadLetterParams struct {
+	Event        model.TopicEventKey
+	Subscription model.SubscriptionKey
+	Error        string
+	Attempts     int32
+}
+
+func (q *Queries) InsertTopicDeadLetter(ctx context.Context, arg InsertTopicDeadLetterParams) error {
+	_, err := q.db.ExecContext(ctx, insertTopicDeadLetter,
+		arg.Event,
+		arg.Subscription,
+		arg.Error,
+		arg.Attempts,
+	)
+	return err
+}
+
+const insertWebSocketMessage = `-- name: InsertWebSocketMessage :exec
+INSERT INTO websocket_messages (connection_key, body, is_binary)
+VALUES ($1::TEXT, $2::BYTEA, $3::BOOLEAN)
+`
+
+func (q *Queries) InsertWebSocketMessage(ctx context.Context, connectionKey string, body []byte, isBinary bool) error {
+	_, err := q.db.ExecContext(ctx, insertWebSocketMessage, connectionKey, body, isBinary)
+	return err
+}
+
 const killStaleControllers = `-- name: KillStaleControllers :one
 WITH matches AS (
     UPDATE controller
@@ -2251,108 +3140,469 @@ func (q *Queries) KillStaleRunners(ctx context.Context, timeout sqltypes.Duratio
 	return count, err
 }
 
-const loadAsyncCall = `-- name: LoadAsyncCall :one
-SELECT id, created_at, lease_id, verb, state, origin, scheduled_at, request, response, error, remaining_attempts, backoff, max_backoff, catch_verb, catching, parent_request_key, trace_context
-FROM async_calls
-WHERE id = $1
+const listCronJobs = `-- name: ListCronJobs :many
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.timezone, j.missed_runs, j.start_time, j.next_execution, j.state,
+  j.last_execution, p.paused_at
+FROM cron_jobs j
+  INNER JOIN deployments d on j.deployment_id = d.id
+  LEFT JOIN paused_cron_jobs p on p.module_name = j.module_name AND p.verb = j.verb
+WHERE d.min_replicas > 0
+ORDER BY j.module_name, j.verb
 `
 
-func (q *Queries) LoadAsyncCall(ctx context.Context, id int64) (AsyncCall, error) {
-	row := q.db.QueryRowContext(ctx, loadAsyncCall, id)
-	var i AsyncCall
-	err := row.Scan(
-		&i.ID,
-		&i.CreatedAt,
-		&i.LeaseID,
-		&i.Verb,
-		&i.State,
-		&i.Origin,
-		&i.ScheduledAt,
-		&i.Request,
-		&i.Response,
-		&i.Error,
-		&i.RemainingAttempts,
-		&i.Backoff,
-		&i.MaxBackoff,
-		&i.CatchVerb,
-		&i.Catching,
-		&i.ParentRequestKey,
-		&i.TraceContext,
-	)
-	return i, err
+type ListCronJobsRow struct {
+	Key           model.CronJobKey
+	DeploymentKey model.DeploymentKey
+	Module        string
+	Verb          string
+	Schedule      string
+	Timezone      string
+	MissedRuns    string
+	StartTime     time.Time
+	NextExecution time.Time
+	State         model.CronJobState
+	LastExecution optional.Option[time.Time]
+	PausedAt      optional.Option[time.Time]
 }
 
-const newLease = `-- name: NewLease :one
-INSERT INTO leases (
-  idempotency_key,
-  key,
-  expires_at,
-  metadata
-)
-VALUES (
-  gen_random_uuid(),
-  $1::lease_key,
-  (NOW() AT TIME ZONE 'utc') + $2::interval,
-  $3::JSONB
-)
-RETURNING idempotency_key
+func (q *Queries) ListCronJobs(ctx context.Context) ([]ListCronJobsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCronJobs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCronJobsRow
+	for rows.Next() {
+		var i ListCronJobsRow
+		if err := rows.Scan(
+			&i.Key,
+			&i.DeploymentKey,
+			&i.Module,
+			&i.Verb,
+			&i.Schedule,
+			&i.Timezone,
+			&i.MissedRuns,
+			&i.StartTime,
+			&i.NextExecution,
+			&i.State,
+			&i.LastExecution,
+			&i.PausedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFSMInstances = `-- name: ListFSMInstances :many
+SELECT i.id, i.created_at, i.fsm, i.key, i.status, i.current_state, i.destination_state, i.async_call_id, i.updated_at, i.timeout_async_call_id, i.next_event_type, i.next_event_request, i.compensating_async_call_id, (SELECT COUNT(*) FROM fsm_queued_events q WHERE q.fsm_instance_id = i.id) AS queued_events
+FROM fsm_instances i
+WHERE
+  i.fsm = $1::schema_ref
+  AND ($2::fsm_status IS NULL OR i.status = $2::fsm_status)
+  AND ($3::schema_ref IS NULL OR i.current_state = $3::schema_ref)
+ORDER BY i.updated_at DESC, i.id DESC
+LIMIT $4::INT
 `
 
-func (q *Queries) NewLease(ctx context.Context, key leases.Key, ttl sqltypes.Duration, metadata pqtype.NullRawMessage) (uuid.UUID, error) {
-	row := q.db.QueryRowContext(ctx, newLease, key, ttl, metadata)
-	var idempotency_key uuid.UUID
-	err := row.Scan(&idempotency_key)
-	return idempotency_key, err
+type ListFSMInstancesParams struct {
+	Fsm    schema.RefKey
+	Status NullFsmStatus
+	State  optional.Option[schema.RefKey]
+	Limit  int32
 }
 
-const publishEventForTopic = `-- name: PublishEventForTopic :exec
-INSERT INTO topic_events (
+type ListFSMInstancesRow struct {
+	ID                      int64
+	CreatedAt               time.Time
+	Fsm                     schema.RefKey
+	Key                     string
+	Status                  FsmStatus
+	CurrentState            optional.Option[schema.RefKey]
+	DestinationState        optional.Option[schema.RefKey]
+	AsyncCallID             optional.Option[int64]
+	UpdatedAt               time.Time
+	TimeoutAsyncCallID      optional.Option[int64]
+	NextEventType           optional.Option[string]
+	NextEventRequest        []byte
+	CompensatingAsyncCallID optional.Option[int64]
+	QueuedEvents            int64
+}
+
+func (q *Queries) ListFSMInstances(ctx context.Context, arg ListFSMInstancesParams) ([]ListFSMInstancesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listFSMInstances,
+		arg.Fsm,
+		arg.Status,
+		arg.State,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFSMInstancesRow
+	for rows.Next() {
+		var i ListFSMInstancesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Fsm,
+			&i.Key,
+			&i.Status,
+			&i.CurrentState,
+			&i.DestinationState,
+			&i.AsyncCallID,
+			&i.UpdatedAt,
+			&i.TimeoutAsyncCallID,
+			&i.NextEventType,
+			&i.NextEventRequest,
+			&i.CompensatingAsyncCallID,
+			&i.QueuedEvents,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScheduledCalls = `-- name: ListScheduledCalls :many
+SELECT id, verb, origin, state, scheduled_at, remaining_attempts, error, created_at
+FROM async_calls
+WHERE
+  origin LIKE 'scheduled:%'
+  AND state IN ('pending', 'executing')
+  AND ($1::schema_ref IS NULL OR verb = $1::schema_ref)
+ORDER BY scheduled_at, id
+LIMIT $2::INT
+`
+
+type ListScheduledCallsRow struct {
+	ID                int64
+	Verb              schema.RefKey
+	Origin            string
+	State             AsyncCallState
+	ScheduledAt       time.Time
+	RemainingAttempts int32
+	Error             optional.Option[string]
+	CreatedAt         time.Time
+}
+
+// Scheduled calls that have not yet completed, soonest first.
+func (q *Queries) ListScheduledCalls(ctx context.Context, verb optional.Option[schema.RefKey], limit int32) ([]ListScheduledCallsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listScheduledCalls, verb, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListScheduledCallsRow
+	for rows.Next() {
+		var i ListScheduledCallsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Verb,
+			&i.Origin,
+			&i.State,
+			&i.ScheduledAt,
+			&i.RemainingAttempts,
+			&i.Error,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const loadAsyncCall = `-- name: LoadAsyncCall :one
+SELECT id, created_at, lease_id, verb, state, origin, scheduled_at, request, response, error, remaining_attempts, backoff, max_backoff, catch_verb, catching, parent_request_key, trace_context, attempts
+FROM async_calls
+WHERE id = $1
+`
+
+func (q *Queries) LoadAsyncCall(ctx context.Context, id int64) (AsyncCall, error) {
+	row := q.db.QueryRowContext(ctx, loadAsyncCall, id)
+	var i AsyncCall
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.LeaseID,
+		&i.Verb,
+		&i.State,
+		&i.Origin,
+		&i.ScheduledAt,
+		&i.Request,
+		&i.Response,
+		&i.Error,
+		&i.RemainingAttempts,
+		&i.Backoff,
+		&i.MaxBackoff,
+		&i.CatchVerb,
+		&i.Catching,
+		&i.ParentRequestKey,
+		&i.TraceContext,
+		&i.Attempts,
+	)
+	return i, err
+}
+
+const lockConcurrencyLimit = `-- name: LockConcurrencyLimit :exec
+INSERT INTO concurrency_limits (key)
+VALUES ($1::TEXT)
+ON CONFLICT (key) DO UPDATE SET key = EXCLUDED.key
+`
+
+// Lock the semaphore row for a concurrency limit until the end of the
+// transaction, creating it if necessary.
+func (q *Queries) LockConcurrencyLimit(ctx context.Context, key string) error {
+	_, err := q.db.ExecContext(ctx, lockConcurrencyLimit, key)
+	return err
+}
+
+const migrateFSMAsyncCalls = `-- name: MigrateFSMAsyncCalls :execrows
+UPDATE async_calls
+SET verb = $1::schema_ref
+WHERE
+  state = 'pending'
+  AND verb = $2::schema_ref
+  AND id IN (
+    SELECT async_call_id FROM fsm_instances WHERE fsm = $3::schema_ref AND async_call_id IS NOT NULL
+    UNION
+    SELECT timeout_async_call_id FROM fsm_instances WHERE fsm = $3::schema_ref AND timeout_async_call_id IS NOT NULL
+  )
+`
+
+// Redirect the pending transitions and timeouts of an FSM that target a removed state to a new state.
+func (q *Queries) MigrateFSMAsyncCalls(ctx context.Context, toState schema.RefKey, fromState schema.RefKey, fsm schema.RefKey) (int64, error) {
+	result, err := q.db.ExecContext(ctx, migrateFSMAsyncCalls, toState, fromState, fsm)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const migrateFSMState = `-- name: MigrateFSMState :execrows
+UPDATE fsm_instances
+SET
+  current_state = CASE WHEN current_state = $1::schema_ref THEN $2::schema_ref ELSE current_state END,
+  destination_state = CASE WHEN destination_state = $1::schema_ref THEN $2::schema_ref ELSE destination_state END,
+  updated_at = NOW() AT TIME ZONE 'utc'
+WHERE
+  fsm = $3::schema_ref
+  AND status = 'running'
+  AND (current_state = $1::schema_ref OR destination_state = $1::schema_ref)
+`
+
+// Move the running instances of an FSM in, or transitioning to, a removed state to a new state.
+func (q *Queries) MigrateFSMState(ctx context.Context, fromState schema.RefKey, toState schema.RefKey, fsm schema.RefKey) (int64, error) {
+	result, err := q.db.ExecContext(ctx, migrateFSMState, fromState, toState, fsm)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const newLease = `-- name: NewLease :one
+INSERT INTO leases (
+  idempotency_key,
+  key,
+  expires_at,
+  metadata
+)
+VALUES (
+  gen_random_uuid(),
+  $1::lease_key,
+  (NOW() AT TIME ZONE 'utc') + $2::interval,
+  $3::JSONB
+)
+RETURNING idempotency_key
+`
+
+func (q *Queries) NewLease(ctx context.Context, key leases.Key, ttl sqltypes.Duration, metadata pqtype.NullRawMessage) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, newLease, key, ttl, metadata)
+	var idempotency_key uuid.UUID
+	err := row.Scan(&idempotency_key)
+	return idempotency_key, err
+}
+
+const pauseCronJob = `-- name: PauseCronJob :exec
+INSERT INTO paused_cron_jobs (module_name, verb)
+VALUES ($1::TEXT, $2::TEXT)
+ON CONFLICT (module_name, verb) DO NOTHING
+`
+
+func (q *Queries) PauseCronJob(ctx context.Context, moduleName string, verb string) error {
+	_, err := q.db.ExecContext(ctx, pauseCronJob, moduleName, verb)
+	return err
+}
+
+const popNextFSMEvent = `-- name: PopNextFSMEvent :one
+DELETE FROM fsm_queued_events
+WHERE id = (
+  SELECT q.id
+  FROM fsm_queued_events q
+  JOIN fsm_instances i ON q.fsm_instance_id = i.id
+  WHERE i.fsm = $1::schema_ref AND i.key = $2::TEXT
+  ORDER BY q.id
+  LIMIT 1
+)
+RETURNING event_type, request
+`
+
+type PopNextFSMEventRow struct {
+	EventType string
+	Request   []byte
+}
+
+// Remove and return the oldest event queued for an FSM instance.
+func (q *Queries) PopNextFSMEvent(ctx context.Context, fsm schema.RefKey, key string) (PopNextFSMEventRow, error) {
+	row := q.db.QueryRowContext(ctx, popNextFSMEvent, fsm, key)
+	var i PopNextFSMEventRow
+	err := row.Scan(&i.EventType, &i.Request)
+	return i, err
+}
+
+const publishEventForTopic = `-- name: PublishEventForTopic :exec
+WITH topic AS (
+  SELECT topics.id, topics.partitions
+  FROM topics
+  INNER JOIN modules ON topics.module_id = modules.id
+  WHERE modules.name = $8::TEXT
+    AND topics.name = $9::TEXT
+)
+INSERT INTO topic_events (
     "key",
     topic_id,
     caller,
     payload,
     request_key,
-    trace_context
+    trace_context,
+    partition,
+    partition_key
   )
 VALUES (
   $1::topic_event_key,
-  (
-    SELECT topics.id
-    FROM topics
-    INNER JOIN modules ON topics.module_id = modules.id
-    WHERE modules.name = $2::TEXT
-      AND topics.name = $3::TEXT
-  ),
+  (SELECT id FROM topic),
+  $2::TEXT,
+  $3,
   $4::TEXT,
-  $5,
-  $6::TEXT,
-  $7::jsonb
+  $5::jsonb,
+  (SELECT MOD($6::BIGINT, partitions) FROM topic),
+  $7::TEXT
 )
 `
 
 type PublishEventForTopicParams struct {
-	Key          model.TopicEventKey
-	Module       string
-	Topic        string
-	Caller       string
-	Payload      []byte
-	RequestKey   string
-	TraceContext json.RawMessage
+	Key           model.TopicEventKey
+	Caller        string
+	Payload       []byte
+	RequestKey    string
+	TraceContext  json.RawMessage
+	PartitionHash int64
+	PartitionKey  optional.Option[string]
+	Module        string
+	Topic         string
 }
 
 func (q *Queries) PublishEventForTopic(ctx context.Context, arg PublishEventForTopicParams) error {
 	_, err := q.db.ExecContext(ctx, publishEventForTopic,
 		arg.Key,
-		arg.Module,
-		arg.Topic,
 		arg.Caller,
 		arg.Payload,
 		arg.RequestKey,
 		arg.TraceContext,
+		arg.PartitionHash,
+		arg.PartitionKey,
+		arg.Module,
+		arg.Topic,
 	)
 	return err
 }
 
+const queueFSMEvent = `-- name: QueueFSMEvent :execrows
+INSERT INTO fsm_queued_events (fsm_instance_id, event_type, request, request_hash)
+SELECT i.id, $1::TEXT, $2, $3
+FROM fsm_instances i
+WHERE
+  i.fsm = $4::schema_ref AND i.key = $5::TEXT
+  AND (
+    NOT $6::BOOLEAN
+    OR NOT EXISTS (
+      SELECT 1
+      FROM fsm_queued_events q
+      WHERE q.fsm_instance_id = i.id AND q.request_hash = $3
+    )
+  )
+`
+
+type QueueFSMEventParams struct {
+	EventType   string
+	Request     []byte
+	RequestHash []byte
+	Fsm         schema.RefKey
+	Key         string
+	Dedupe      bool
+}
+
+// Queue an event for an FSM instance that is executing a transition.
+//
+// If "dedupe" is true the event is dropped if an identical event is already
+// queued, in which case no rows are affected.
+func (q *Queries) QueueFSMEvent(ctx context.Context, arg QueueFSMEventParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, queueFSMEvent,
+		arg.EventType,
+		arg.Request,
+		arg.RequestHash,
+		arg.Fsm,
+		arg.Key,
+		arg.Dedupe,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const releaseConcurrencySlot = `-- name: ReleaseConcurrencySlot :exec
+DELETE FROM concurrency_slots
+WHERE id = $1::BIGINT
+`
+
+func (q *Queries) ReleaseConcurrencySlot(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, releaseConcurrencySlot, id)
+	return err
+}
+
+const releaseIdempotencyKey = `-- name: ReleaseIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE verb = $1::schema_ref AND key = $2::TEXT AND response IS NULL
+`
+
+func (q *Queries) ReleaseIdempotencyKey(ctx context.Context, verb schema.RefKey, key string) error {
+	_, err := q.db.ExecContext(ctx, releaseIdempotencyKey, verb, key)
+	return err
+}
+
 const releaseLease = `-- name: ReleaseLease :one
 DELETE FROM leases
 WHERE idempotency_key = $1 AND key = $2::lease_key
@@ -2366,6 +3616,20 @@ func (q *Queries) ReleaseLease(ctx context.Context, idempotencyKey uuid.UUID, ke
 	return column_1, err
 }
 
+const renewConcurrencySlot = `-- name: RenewConcurrencySlot :execrows
+UPDATE concurrency_slots
+SET expires_at = (NOW() AT TIME ZONE 'utc') + $1::INTERVAL
+WHERE id = $2::BIGINT
+`
+
+func (q *Queries) RenewConcurrencySlot(ctx context.Context, ttl sqltypes.Duration, iD int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, renewConcurrencySlot, ttl, iD)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const renewLease = `-- name: RenewLease :one
 UPDATE leases
 SET expires_at = (NOW() AT TIME ZONE 'utc') + $1::interval
@@ -2395,7 +3659,7 @@ WHERE id = (SELECT id
             WHERE r.state = 'idle'
               AND r.labels @> $3::jsonb
             LIMIT 1 FOR UPDATE SKIP LOCKED)
-RETURNING runners.id, runners.key, runners.created, runners.last_seen, runners.reservation_timeout, runners.state, runners.endpoint, runners.module_name, runners.deployment_id, runners.labels
+RETURNING runners.id, runners.key, runners.created, runners.last_seen, runners.reservation_timeout, runners.state, runners.endpoint, runners.module_name, runners.deployment_id, runners.labels, runners.degraded_until
 `
 
 // Find an idle runner and reserve it for the given deployment.
@@ -2413,10 +3677,123 @@ func (q *Queries) ReserveRunner(ctx context.Context, reservationTimeout time.Tim
 		&i.ModuleName,
 		&i.DeploymentID,
 		&i.Labels,
+		&i.DegradedUntil,
 	)
 	return i, err
 }
 
+const resetSubscriptionCursors = `-- name: ResetSubscriptionCursors :exec
+UPDATE topic_subscription_cursors cursors
+SET cursor = (
+  SELECT head.id
+  FROM topic_events head
+  WHERE head.topic_id = subs.topic_id
+    AND head.partition = cursors.partition
+  ORDER BY head.created_at DESC, head.id DESC
+  LIMIT 1
+)
+FROM topic_subscriptions subs
+WHERE cursors.subscription_id = subs.id
+  AND subs.key = $1::subscription_key
+`
+
+// Moves each partition cursor of a subscription to the head of the partition.
+func (q *Queries) ResetSubscriptionCursors(ctx context.Context, key model.SubscriptionKey) error {
+	_, err := q.db.ExecContext(ctx, resetSubscriptionCursors, key)
+	return err
+}
+
+const resumeCronJob = `-- name: ResumeCronJob :one
+WITH resumed AS (
+  DELETE FROM paused_cron_jobs
+  WHERE module_name = $1::TEXT
+    AND verb = $2::TEXT
+  RETURNING module_name
+), rescheduled AS (
+  UPDATE cron_jobs
+  SET next_execution = $3::TIMESTAMPTZ
+  WHERE key = $4::cron_job_key
+    AND state = 'idle'
+    AND EXISTS (SELECT 1 FROM resumed)
+)
+SELECT COUNT(*) FROM resumed
+`
+
+type ResumeCronJobParams struct {
+	ModuleName    string
+	Verb          string
+	NextExecution time.Time
+	Key           model.CronJobKey
+}
+
+// Resumes a paused cron job, moving its next execution forward so that
+// executions missed while paused are skipped. Returns the number of paused
+// jobs that were resumed.
+func (q *Queries) ResumeCronJob(ctx context.Context, arg ResumeCronJobParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, resumeCronJob,
+		arg.ModuleName,
+		arg.Verb,
+		arg.NextExecution,
+		arg.Key,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const scheduleAsyncCall = `-- name: ScheduleAsyncCall :execrows
+INSERT INTO async_calls (
+  verb,
+  origin,
+  request,
+  remaining_attempts,
+  backoff,
+  max_backoff,
+  catch_verb,
+  scheduled_at
+)
+VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5::interval,
+  $6::interval,
+  $7,
+  $8::TIMESTAMPTZ
+)
+ON CONFLICT (origin) WHERE state IN ('pending', 'executing') AND origin LIKE 'scheduled:%' DO NOTHING
+`
+
+type ScheduleAsyncCallParams struct {
+	Verb              schema.RefKey
+	Origin            string
+	Request           []byte
+	RemainingAttempts int32
+	Backoff           sqltypes.Duration
+	MaxBackoff        sqltypes.Duration
+	CatchVerb         optional.Option[schema.RefKey]
+	ScheduledAt       time.Time
+}
+
+// Schedules a call unless a call with the same origin has not yet completed.
+func (q *Queries) ScheduleAsyncCall(ctx context.Context, arg ScheduleAsyncCallParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, scheduleAsyncCall,
+		arg.Verb,
+		arg.Origin,
+		arg.Request,
+		arg.RemainingAttempts,
+		arg.Backoff,
+		arg.MaxBackoff,
+		arg.CatchVerb,
+		arg.ScheduledAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const setDeploymentDesiredReplicas = `-- name: SetDeploymentDesiredReplicas :exec
 UPDATE deployments
 SET min_replicas = $2
@@ -2429,19 +3806,82 @@ func (q *Queries) SetDeploymentDesiredReplicas(ctx context.Context, key model.De
 	return err
 }
 
-const setSubscriptionCursor = `-- name: SetSubscriptionCursor :exec
-WITH event AS (
-  SELECT id, created_at, key, topic_id, payload
-  FROM topic_events
-  WHERE "key" = $2::topic_event_key
-)
-UPDATE topic_subscriptions
-SET cursor = (SELECT id FROM event)
-WHERE key = $1::subscription_key
+const setFSMTimeout = `-- name: SetFSMTimeout :exec
+UPDATE fsm_instances
+SET timeout_async_call_id = $1::BIGINT
+WHERE fsm = $2::schema_ref AND key = $3::TEXT
 `
 
-func (q *Queries) SetSubscriptionCursor(ctx context.Context, column1 model.SubscriptionKey, column2 model.TopicEventKey) error {
-	_, err := q.db.ExecContext(ctx, setSubscriptionCursor, column1, column2)
+// Record the async call that will fire the timeout of the current state of an FSM instance.
+func (q *Queries) SetFSMTimeout(ctx context.Context, asyncCallID int64, fsm schema.RefKey, key string) error {
+	_, err := q.db.ExecContext(ctx, setFSMTimeout, asyncCallID, fsm, key)
+	return err
+}
+
+const setIdempotentResponse = `-- name: SetIdempotentResponse :exec
+UPDATE idempotency_keys
+SET response = $1::BYTEA,
+    expires_at = (NOW() AT TIME ZONE 'utc') + $2::INTERVAL
+WHERE verb = $3::schema_ref AND key = $4::TEXT
+`
+
+type SetIdempotentResponseParams struct {
+	Response []byte
+	Ttl      sqltypes.Duration
+	Verb     schema.RefKey
+	Key      string
+}
+
+func (q *Queries) SetIdempotentResponse(ctx context.Context, arg SetIdempotentResponseParams) error {
+	_, err := q.db.ExecContext(ctx, setIdempotentResponse,
+		arg.Response,
+		arg.Ttl,
+		arg.Verb,
+		arg.Key,
+	)
+	return err
+}
+
+const setNextFSMEvent = `-- name: SetNextFSMEvent :execrows
+UPDATE fsm_instances
+SET
+  next_event_type = $1::TEXT,
+  next_event_request = $2
+WHERE
+  fsm = $3::schema_ref AND key = $4::TEXT
+  AND destination_state IS NOT NULL
+  AND next_event_type IS NULL
+`
+
+type SetNextFSMEventParams struct {
+	EventType string
+	Request   []byte
+	Fsm       schema.RefKey
+	Key       string
+}
+
+// Set the event to send to an FSM instance once its executing transition completes.
+func (q *Queries) SetNextFSMEvent(ctx context.Context, arg SetNextFSMEventParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setNextFSMEvent,
+		arg.EventType,
+		arg.Request,
+		arg.Fsm,
+		arg.Key,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setSubscriptionCursorByID = `-- name: SetSubscriptionCursorByID :exec
+UPDATE topic_subscription_cursors
+SET cursor = $1::BIGINT
+WHERE id = $2::BIGINT
+`
+
+func (q *Queries) SetSubscriptionCursorByID(ctx context.Context, cursor optional.Option[int64], cursorID int64) error {
+	_, err := q.db.ExecContext(ctx, setSubscriptionCursorByID, cursor, cursorID)
 	return err
 }
 
@@ -2449,13 +3889,15 @@ const startCronJobs = `-- name: StartCronJobs :many
 WITH updates AS (
   UPDATE cron_jobs
   SET state = 'executing',
-    start_time = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
+    start_time = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ,
+    last_execution = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
   WHERE key = ANY ($1)
     AND state = 'idle'
     AND start_time < next_execution
     AND (next_execution AT TIME ZONE 'utc') < (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
+    AND NOT EXISTS (SELECT 1 FROM paused_cron_jobs p WHERE p.module_name = cron_jobs.module_name AND p.verb = cron_jobs.verb)
   RETURNING id, key, state, start_time, next_execution)
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule,
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.timezone, j.missed_runs,
   COALESCE(u.start_time, j.start_time) as start_time,
   COALESCE(u.next_execution, j.next_execution) as next_execution,
   COALESCE(u.state, j.state) as state,
@@ -2473,6 +3915,8 @@ type StartCronJobsRow struct {
 	Module         string
 	Verb           string
 	Schedule       string
+	Timezone       string
+	MissedRuns     string
 	StartTime      time.Time
 	NextExecution  time.Time
 	State          model.CronJobState
@@ -2495,6 +3939,8 @@ func (q *Queries) StartCronJobs(ctx context.Context, keys []string) ([]StartCron
 			&i.Module,
 			&i.Verb,
 			&i.Schedule,
+			&i.Timezone,
+			&i.MissedRuns,
 			&i.StartTime,
 			&i.NextExecution,
 			&i.State,
@@ -2530,11 +3976,12 @@ ON CONFLICT(fsm, key) DO
 UPDATE SET
   destination_state = $3::schema_ref,
   async_call_id = $4::BIGINT,
+  timeout_async_call_id = NULL,
   updated_at = NOW() AT TIME ZONE 'utc'
 WHERE
   fsm_instances.async_call_id IS NULL
   AND fsm_instances.destination_state IS NULL
-RETURNING id, created_at, fsm, key, status, current_state, destination_state, async_call_id, updated_at
+RETURNING id, created_at, fsm, key, status, current_state, destination_state, async_call_id, updated_at, timeout_async_call_id, next_event_type, next_event_request, compensating_async_call_id
 `
 
 type StartFSMTransitionParams struct {
@@ -2565,6 +4012,10 @@ func (q *Queries) StartFSMTransition(ctx context.Context, arg StartFSMTransition
 		&i.DestinationState,
 		&i.AsyncCallID,
 		&i.UpdatedAt,
+		&i.TimeoutAsyncCallID,
+		&i.NextEventType,
+		&i.NextEventRequest,
+		&i.CompensatingAsyncCallID,
 	)
 	return i, err
 }
@@ -2593,6 +4044,8 @@ SET
   destination_state = NULL,
   async_call_id = NULL,
   status = 'completed'::fsm_status,
+  next_event_type = NULL,
+  next_event_request = NULL,
   updated_at = NOW() AT TIME ZONE 'utc'
 WHERE
   fsm = $1::schema_ref AND key = $2::TEXT
@@ -2606,6 +4059,120 @@ func (q *Queries) SucceedFSMInstance(ctx context.Context, fsm schema.RefKey, key
 	return column_1, err
 }
 
+const takeRateLimitToken = `-- name: TakeRateLimitToken :one
+INSERT INTO rate_limits (key, tokens, period)
+VALUES ($1::TEXT, $2::BIGINT - 1, $3::INTERVAL)
+ON CONFLICT (key) DO UPDATE
+SET tokens = LEAST(
+      $2::BIGINT,
+      rate_limits.tokens + $2::BIGINT * EXTRACT(EPOCH FROM (NOW() AT TIME ZONE 'utc') - rate_limits.updated_at)::DOUBLE PRECISION / EXTRACT(EPOCH FROM $3::INTERVAL)::DOUBLE PRECISION
+    ) - 1,
+    updated_at = (NOW() AT TIME ZONE 'utc'),
+    period = EXCLUDED.period
+WHERE LEAST(
+      $2::BIGINT,
+      rate_limits.tokens + $2::BIGINT * EXTRACT(EPOCH FROM (NOW() AT TIME ZONE 'utc') - rate_limits.updated_at)::DOUBLE PRECISION / EXTRACT(EPOCH FROM $3::INTERVAL)::DOUBLE PRECISION
+    ) >= 1
+RETURNING tokens::DOUBLE PRECISION
+`
+
+// Take a token from the token bucket for the given key, which refills at
+// "capacity" tokens per "period". Returns no rows if the bucket is empty.
+func (q *Queries) TakeRateLimitToken(ctx context.Context, key string, capacity int64, period sqltypes.Duration) (float64, error) {
+	row := q.db.QueryRowContext(ctx, takeRateLimitToken, key, capacity, period)
+	var tokens float64
+	err := row.Scan(&tokens)
+	return tokens, err
+}
+
+const takeWebSocketMessages = `-- name: TakeWebSocketMessages :many
+WITH taken AS (
+  DELETE FROM websocket_messages
+  WHERE connection_key = $1::TEXT
+  RETURNING id, body, is_binary
+)
+SELECT body, is_binary
+FROM taken
+ORDER BY id
+`
+
+type TakeWebSocketMessagesRow struct {
+	Body     []byte
+	IsBinary bool
+}
+
+// Remove and return the messages queued for a WebSocket connection, oldest first.
+func (q *Queries) TakeWebSocketMessages(ctx context.Context, connectionKey string) ([]TakeWebSocketMessagesRow, error) {
+	rows, err := q.db.QueryContext(ctx, takeWebSocketMessages, connectionKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TakeWebSocketMessagesRow
+	for rows.Next() {
+		var i TakeWebSocketMessagesRow
+		if err := rows.Scan(&i.Body, &i.IsBinary); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const triggerCronJob = `-- name: TriggerCronJob :one
+WITH j AS (
+UPDATE cron_jobs
+  SET state = 'executing',
+    start_time = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ,
+    last_execution = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
+  WHERE key = $1::cron_job_key
+    AND state = 'idle'
+  RETURNING id, key, deployment_id, verb, schedule, start_time, next_execution, state, module_name, last_execution, timezone, missed_runs
+)
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.timezone, j.missed_runs, j.start_time, j.next_execution, j.state
+  FROM j
+  INNER JOIN deployments d on j.deployment_id = d.id
+  LIMIT 1
+`
+
+type TriggerCronJobRow struct {
+	Key           model.CronJobKey
+	DeploymentKey model.DeploymentKey
+	Module        string
+	Verb          string
+	Schedule      string
+	Timezone      string
+	MissedRuns    string
+	StartTime     time.Time
+	NextExecution time.Time
+	State         model.CronJobState
+}
+
+// Starts a cron job immediately, regardless of its next execution time or whether it is paused.
+func (q *Queries) TriggerCronJob(ctx context.Context, key model.CronJobKey) (TriggerCronJobRow, error) {
+	row := q.db.QueryRowContext(ctx, triggerCronJob, key)
+	var i TriggerCronJobRow
+	err := row.Scan(
+		&i.Key,
+		&i.DeploymentKey,
+		&i.Module,
+		&i.Verb,
+		&i.Schedule,
+		&i.Timezone,
+		&i.MissedRuns,
+		&i.StartTime,
+		&i.NextExecution,
+		&i.State,
+	)
+	return i, err
+}
+
 const upsertController = `-- name: UpsertController :one
 INSERT INTO controller (key, endpoint)
 VALUES ($1, $2)
@@ -2693,7 +4260,8 @@ INSERT INTO topic_subscriptions (
   topic_id,
   module_id,
   deployment_id,
-  name)
+  name,
+  dead_letter_topic_id)
 VALUES (
   $1::subscription_key,
   (
@@ -2705,14 +4273,23 @@ VALUES (
   ),
   (SELECT id FROM modules WHERE name = $4::TEXT),
   (SELECT id FROM deployments WHERE key = $5::deployment_key),
-  $6::TEXT
+  $6::TEXT,
+  (
+    SELECT topics.id as id
+    FROM topics
+    INNER JOIN modules ON topics.module_id = modules.id
+    WHERE modules.name = $7::TEXT
+      AND topics.name = $8::TEXT
+  )
 )
 ON CONFLICT (name, module_id) DO
 UPDATE SET
   topic_id = excluded.topic_id,
+  dead_letter_topic_id = excluded.dead_letter_topic_id,
   deployment_id = (SELECT id FROM deployments WHERE key = $5::deployment_key)
 RETURNING
   id,
+  dead_letter_topic_id,
   CASE
     WHEN xmax = 0 THEN true
     ELSE false
@@ -2720,17 +4297,20 @@ RETURNING
 `
 
 type UpsertSubscriptionParams struct {
-	Key         model.SubscriptionKey
-	TopicModule string
-	TopicName   string
-	Module      string
-	Deployment  model.DeploymentKey
-	Name        string
+	Key              model.SubscriptionKey
+	TopicModule      string
+	TopicName        string
+	Module           string
+	Deployment       model.DeploymentKey
+	Name             string
+	DeadLetterModule optional.Option[string]
+	DeadLetterName   optional.Option[string]
 }
 
 type UpsertSubscriptionRow struct {
-	ID       int64
-	Inserted bool
+	ID                int64
+	DeadLetterTopicID optional.Option[int64]
+	Inserted          bool
 }
 
 func (q *Queries) UpsertSubscription(ctx context.Context, arg UpsertSubscriptionParams) (UpsertSubscriptionRow, error) {
@@ -2741,39 +4321,66 @@ func (q *Queries) UpsertSubscription(ctx context.Context, arg UpsertSubscription
 		arg.Module,
 		arg.Deployment,
 		arg.Name,
+		arg.DeadLetterModule,
+		arg.DeadLetterName,
 	)
 	var i UpsertSubscriptionRow
-	err := row.Scan(&i.ID, &i.Inserted)
+	err := row.Scan(&i.ID, &i.DeadLetterTopicID, &i.Inserted)
 	return i, err
 }
 
-const upsertTopic = `-- name: UpsertTopic :exec
-INSERT INTO topics (key, module_id, name, type)
+const upsertSubscriptionCursors = `-- name: UpsertSubscriptionCursors :exec
+INSERT INTO topic_subscription_cursors (subscription_id, partition)
+SELECT subs.id, partitions.partition
+FROM topic_subscriptions subs
+INNER JOIN topics ON subs.topic_id = topics.id
+CROSS JOIN LATERAL generate_series(0, topics.partitions - 1) AS partitions(partition)
+WHERE subs.id = $1::BIGINT
+ON CONFLICT (subscription_id, partition) DO NOTHING
+`
+
+// Ensures that a subscription has a cursor for each partition of its topic.
+func (q *Queries) UpsertSubscriptionCursors(ctx context.Context, subscriptionID int64) error {
+	_, err := q.db.ExecContext(ctx, upsertSubscriptionCursors, subscriptionID)
+	return err
+}
+
+const upsertTopic = `-- name: UpsertTopic :execrows
+INSERT INTO topics (key, module_id, name, type, partitions)
 VALUES (
   $1::topic_key,
   (SELECT id FROM modules WHERE name = $2::TEXT LIMIT 1),
   $3::TEXT,
-  $4::TEXT
+  $4::TEXT,
+  $5::INT
 )
 ON CONFLICT (name, module_id) DO
 UPDATE SET
-  type = $4::TEXT
-RETURNING id
+  type = $4::TEXT,
+  partitions = $5::INT
+WHERE topics.partitions = $5::INT
 `
 
 type UpsertTopicParams struct {
-	Topic     model.TopicKey
-	Module    string
-	Name      string
-	EventType string
+	Topic      model.TopicKey
+	Module     string
+	Name       string
+	EventType  string
+	Partitions int32
 }
 
-func (q *Queries) UpsertTopic(ctx context.Context, arg UpsertTopicParams) error {
-	_, err := q.db.ExecContext(ctx, upsertTopic,
+// Inserts or updates a topic. The number of partitions of an existing topic
+// can not change, so no rows are affected if it differs.
+func (q *Queries) UpsertTopic(ctx context.Context, arg UpsertTopicParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, upsertTopic,
 		arg.Topic,
 		arg.Module,
 		arg.Name,
 		arg.EventType,
+		arg.Partitions,
 	)
-	return err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
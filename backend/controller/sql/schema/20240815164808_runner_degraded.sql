@@ -0,0 +1,5 @@
+-- migrate:up
+ALTER TABLE runners ADD COLUMN degraded_until TIMESTAMPTZ;
+
+-- migrate:down
+ALTER TABLE runners DROP COLUMN degraded_until;
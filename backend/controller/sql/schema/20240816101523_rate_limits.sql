@@ -0,0 +1,10 @@
+-- migrate:up
+-- Token buckets used to enforce cluster-wide verb rate limits.
+CREATE TABLE rate_limits (
+    key TEXT PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc')
+);
+
+-- migrate:down
+DROP TABLE rate_limits;
@@ -0,0 +1,16 @@
+-- migrate:up
+-- Responses to calls made with an idempotency key, replayed for duplicate calls.
+CREATE TABLE idempotency_keys (
+    verb schema_ref NOT NULL,
+    key TEXT NOT NULL,
+    -- NULL while the call is in flight.
+    response BYTEA,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    expires_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (verb, key)
+);
+
+CREATE INDEX idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
+
+-- migrate:down
+DROP TABLE idempotency_keys;
@@ -0,0 +1,29 @@
+-- migrate:up
+-- Topic that events are published to once they have permanently failed to be
+-- consumed by the subscription.
+ALTER TABLE topic_subscriptions
+    ADD COLUMN dead_letter_topic_id BIGINT NULL REFERENCES topics(id) ON DELETE SET NULL;
+
+-- Events that were published to a dead letter topic, along with the reason
+-- they failed. Rows are removed when the event is replayed or purged.
+CREATE TABLE topic_dead_letters (
+    id BIGINT NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+
+    -- The event in the dead letter topic.
+    event_id BIGINT UNIQUE NOT NULL REFERENCES topic_events(id) ON DELETE CASCADE,
+
+    -- The subscription that failed to consume the event.
+    subscription_id BIGINT NOT NULL REFERENCES topic_subscriptions(id) ON DELETE CASCADE,
+
+    error TEXT NOT NULL,
+    attempts INT NOT NULL
+);
+
+CREATE INDEX topic_dead_letters_subscription_idx ON topic_dead_letters (subscription_id);
+
+-- migrate:down
+DROP TABLE topic_dead_letters;
+
+ALTER TABLE topic_subscriptions
+    DROP COLUMN dead_letter_topic_id;
@@ -0,0 +1,60 @@
+-- migrate:up
+-- Number of partitions events in the topic are distributed across.
+ALTER TABLE topics
+    ADD COLUMN partitions INT NOT NULL DEFAULT 1;
+
+ALTER TABLE topic_events
+    ADD COLUMN partition INT NOT NULL DEFAULT 0,
+    -- Events with the same key are always published to the same partition.
+    ADD COLUMN partition_key TEXT NULL;
+
+CREATE INDEX topic_events_partition_idx ON topic_events (topic_id, partition, created_at, id);
+
+-- A cursor over one partition of a topic for a subscription.
+--
+-- Each partition of a subscription is consumed independently, preserving the
+-- order of events within the partition.
+CREATE TABLE topic_subscription_cursors (
+    id BIGINT NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    subscription_id BIGINT NOT NULL REFERENCES topic_subscriptions(id) ON DELETE CASCADE,
+    partition INT NOT NULL,
+
+    -- Cursor pointing into the topic_events table.
+    cursor BIGINT REFERENCES topic_events(id) ON DELETE CASCADE,
+
+    -- State is 'executing' when there is an unfinished async_call for the current cursor.
+    state topic_subscription_state NOT NULL DEFAULT 'idle'
+);
+
+CREATE UNIQUE INDEX topic_subscription_cursors_partition_idx ON topic_subscription_cursors (subscription_id, partition);
+
+INSERT INTO topic_subscription_cursors (subscription_id, partition, cursor, state)
+SELECT id, 0, cursor, state
+FROM topic_subscriptions;
+
+ALTER TABLE topic_subscriptions
+    DROP COLUMN cursor,
+    DROP COLUMN state;
+
+-- migrate:down
+ALTER TABLE topic_subscriptions
+    ADD COLUMN cursor BIGINT REFERENCES topic_events(id) ON DELETE CASCADE,
+    ADD COLUMN state topic_subscription_state NOT NULL DEFAULT 'idle';
+
+UPDATE topic_subscriptions
+SET cursor = cursors.cursor,
+    state = cursors.state
+FROM topic_subscription_cursors cursors
+WHERE cursors.subscription_id = topic_subscriptions.id
+  AND cursors.partition = 0;
+
+DROP TABLE topic_subscription_cursors;
+
+DROP INDEX topic_events_partition_idx;
+
+ALTER TABLE topic_events
+    DROP COLUMN partition,
+    DROP COLUMN partition_key;
+
+ALTER TABLE topics
+    DROP COLUMN partitions;
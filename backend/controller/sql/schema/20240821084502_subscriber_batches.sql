@@ -0,0 +1,20 @@
+-- migrate:up
+ALTER TABLE topic_subscribers
+    -- Maximum number of events delivered to the sink in each call, or NULL if
+    -- the sink consumes events one at a time.
+    ADD COLUMN batch_size INT,
+    -- Maximum time to wait for a batch to fill before delivering a partial batch.
+    ADD COLUMN batch_wait INTERVAL NOT NULL DEFAULT '0s';
+
+ALTER TABLE topic_subscription_cursors
+    -- First event of the batch currently being consumed. The batch includes
+    -- every event in the partition up to and including the cursor.
+    ADD COLUMN batch_start BIGINT REFERENCES topic_events(id) ON DELETE SET NULL;
+
+-- migrate:down
+ALTER TABLE topic_subscription_cursors
+    DROP COLUMN batch_start;
+
+ALTER TABLE topic_subscribers
+    DROP COLUMN batch_size,
+    DROP COLUMN batch_wait;
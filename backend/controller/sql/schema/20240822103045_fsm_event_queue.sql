@@ -0,0 +1,20 @@
+-- migrate:up
+-- Events sent to an FSM instance while it is executing a transition. Each
+-- event is applied in order once the active transition completes.
+CREATE TABLE fsm_queued_events (
+    id BIGINT NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    fsm_instance_id BIGINT NOT NULL REFERENCES fsm_instances(id) ON DELETE CASCADE,
+    -- Schema type of the event, used to select the transition when the event
+    -- is dequeued.
+    event_type TEXT NOT NULL,
+    request BYTEA NOT NULL,
+    -- SHA-256 of the event type and cleartext request, used to drop duplicate
+    -- events.
+    request_hash BYTEA NOT NULL
+);
+
+CREATE INDEX fsm_queued_events_fsm_instance_id_idx ON fsm_queued_events (fsm_instance_id, id);
+
+-- migrate:down
+DROP TABLE fsm_queued_events;
@@ -0,0 +1,9 @@
+-- migrate:up
+-- The pending async call that will take the timeout transition out of the
+-- current state, if the state has one. Cleared when any transition starts.
+ALTER TABLE fsm_instances
+    ADD COLUMN timeout_async_call_id BIGINT REFERENCES async_calls(id) ON DELETE SET NULL;
+
+-- migrate:down
+ALTER TABLE fsm_instances
+    DROP COLUMN timeout_async_call_id;
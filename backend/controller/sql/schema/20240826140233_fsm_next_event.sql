@@ -0,0 +1,11 @@
+-- migrate:up
+-- The event set by the executing state verb with FSMNext, which is sent to the
+-- instance when the transition completes.
+ALTER TABLE fsm_instances
+    ADD COLUMN next_event_type TEXT,
+    ADD COLUMN next_event_request BYTEA;
+
+-- migrate:down
+ALTER TABLE fsm_instances
+    DROP COLUMN next_event_type,
+    DROP COLUMN next_event_request;
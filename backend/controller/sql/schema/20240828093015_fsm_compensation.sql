@@ -0,0 +1,15 @@
+-- migrate:up
+ALTER TYPE fsm_status ADD VALUE IF NOT EXISTS 'compensating';
+ALTER TYPE fsm_status ADD VALUE IF NOT EXISTS 'compensated';
+ALTER TYPE fsm_status ADD VALUE IF NOT EXISTS 'compensation_failed';
+
+-- While an instance is compensating, the async call of the transition into the
+-- state whose compensation is executing. Compensation continues with the
+-- states completed before it.
+ALTER TABLE fsm_instances
+    ADD COLUMN compensating_async_call_id BIGINT REFERENCES async_calls(id) ON DELETE SET NULL;
+
+-- migrate:down
+-- Values can't be removed from an enum, so the statuses are left in place.
+ALTER TABLE fsm_instances
+    DROP COLUMN compensating_async_call_id;
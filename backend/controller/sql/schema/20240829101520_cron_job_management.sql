@@ -0,0 +1,19 @@
+-- migrate:up
+-- Cron jobs paused by an operator. Keyed by verb rather than cron job so that
+-- a paused job stays paused when its module is redeployed.
+CREATE TABLE paused_cron_jobs
+(
+    module_name TEXT        NOT NULL,
+    verb        TEXT        NOT NULL,
+    paused_at   TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    PRIMARY KEY (module_name, verb)
+);
+
+ALTER TABLE cron_jobs
+    ADD COLUMN last_execution TIMESTAMPTZ;
+
+-- migrate:down
+ALTER TABLE cron_jobs
+    DROP COLUMN last_execution;
+
+DROP TABLE paused_cron_jobs;
@@ -0,0 +1,15 @@
+-- migrate:up
+-- The IANA timezone the schedule is evaluated in.
+ALTER TABLE cron_jobs
+    ADD COLUMN timezone TEXT NOT NULL DEFAULT 'UTC';
+
+-- What to do with executions that were missed: 'skip', 'once' or 'all'.
+ALTER TABLE cron_jobs
+    ADD COLUMN missed_runs TEXT NOT NULL DEFAULT 'once';
+
+-- migrate:down
+ALTER TABLE cron_jobs
+    DROP COLUMN missed_runs;
+
+ALTER TABLE cron_jobs
+    DROP COLUMN timezone;
@@ -0,0 +1,41 @@
+-- migrate:up
+-- Messages sent to WebSocket connections held by another controller. They are
+-- removed by the controller holding the connection once delivered.
+CREATE TABLE websocket_messages
+(
+    id             BIGINT      NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    connection_key TEXT        NOT NULL,
+    body           BYTEA       NOT NULL,
+    is_binary      BOOLEAN     NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX websocket_messages_connection_key_idx ON websocket_messages (connection_key);
+CREATE INDEX websocket_messages_created_at_idx ON websocket_messages (created_at);
+
+-- Notifies controllers of new messages, with the key of the connection they
+-- are for.
+CREATE OR REPLACE FUNCTION notify_websocket_message() RETURNS TRIGGER AS
+$$
+BEGIN
+    PERFORM pg_notify('websocket_messages_events', jsonb_build_object(
+            'table', TG_TABLE_NAME,
+            'action', TG_OP,
+            'new', new.connection_key
+        )::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER websocket_messages_notify_event
+    AFTER INSERT
+    ON websocket_messages
+    FOR EACH ROW
+EXECUTE PROCEDURE notify_websocket_message();
+
+-- migrate:down
+DROP TRIGGER websocket_messages_notify_event ON websocket_messages;
+
+DROP FUNCTION notify_websocket_message();
+
+DROP TABLE websocket_messages;
@@ -0,0 +1,29 @@
+-- migrate:up
+-- One row per verb with a concurrency limit, locked while admitting a call so
+-- that slots are counted and taken atomically across controllers.
+CREATE TABLE concurrency_limits (
+    key TEXT PRIMARY KEY
+);
+
+-- Calls holding a concurrency slot. Slots are renewed while the call is in
+-- flight, so the slots of a controller that dies expire.
+CREATE TABLE concurrency_slots
+(
+    id         BIGINT      NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    key        TEXT        NOT NULL REFERENCES concurrency_limits (key) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX concurrency_slots_key_idx ON concurrency_slots (key);
+CREATE INDEX concurrency_slots_expires_at_idx ON concurrency_slots (expires_at);
+
+-- The refill period of each bucket, so that buckets that have refilled can be
+-- deleted.
+ALTER TABLE rate_limits ADD COLUMN period INTERVAL NOT NULL DEFAULT INTERVAL '1 day';
+
+-- migrate:down
+ALTER TABLE rate_limits DROP COLUMN period;
+
+DROP TABLE concurrency_slots;
+
+DROP TABLE concurrency_limits;
@@ -0,0 +1,7 @@
+-- migrate:up
+-- The number of times the verb of an async call has failed, carried over to
+-- each retry.
+ALTER TABLE async_calls ADD COLUMN attempts INT NOT NULL DEFAULT 0;
+
+-- migrate:down
+ALTER TABLE async_calls DROP COLUMN attempts;
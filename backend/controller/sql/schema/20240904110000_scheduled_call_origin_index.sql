@@ -0,0 +1,7 @@
+-- migrate:up
+-- At most one scheduled call with each key can be pending or executing.
+CREATE UNIQUE INDEX async_calls_pending_scheduled_origin_idx ON async_calls (origin)
+  WHERE state IN ('pending', 'executing') AND origin LIKE 'scheduled:%';
+
+-- migrate:down
+DROP INDEX async_calls_pending_scheduled_origin_idx;
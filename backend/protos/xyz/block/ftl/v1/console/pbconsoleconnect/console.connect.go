@@ -45,6 +45,9 @@ const (
 	// ConsoleServiceGetEventsProcedure is the fully-qualified name of the ConsoleService's GetEvents
 	// RPC.
 	ConsoleServiceGetEventsProcedure = "/xyz.block.ftl.v1.console.ConsoleService/GetEvents"
+	// ConsoleServiceGetSchemaGraphProcedure is the fully-qualified name of the ConsoleService's
+	// GetSchemaGraph RPC.
+	ConsoleServiceGetSchemaGraphProcedure = "/xyz.block.ftl.v1.console.ConsoleService/GetSchemaGraph"
 )
 
 // ConsoleServiceClient is a client for the xyz.block.ftl.v1.console.ConsoleService service.
@@ -54,6 +57,8 @@ type ConsoleServiceClient interface {
 	GetModules(context.Context, *connect.Request[console.GetModulesRequest]) (*connect.Response[console.GetModulesResponse], error)
 	StreamEvents(context.Context, *connect.Request[console.StreamEventsRequest]) (*connect.ServerStreamForClient[console.StreamEventsResponse], error)
 	GetEvents(context.Context, *connect.Request[console.EventsQuery]) (*connect.Response[console.GetEventsResponse], error)
+	// Render FSMs, verb calls and pub/sub flows as a DOT or Mermaid diagram.
+	GetSchemaGraph(context.Context, *connect.Request[console.GetSchemaGraphRequest]) (*connect.Response[console.GetSchemaGraphResponse], error)
 }
 
 // NewConsoleServiceClient constructs a client for the xyz.block.ftl.v1.console.ConsoleService
@@ -87,15 +92,21 @@ func NewConsoleServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+ConsoleServiceGetEventsProcedure,
 			opts...,
 		),
+		getSchemaGraph: connect.NewClient[console.GetSchemaGraphRequest, console.GetSchemaGraphResponse](
+			httpClient,
+			baseURL+ConsoleServiceGetSchemaGraphProcedure,
+			opts...,
+		),
 	}
 }
 
 // consoleServiceClient implements ConsoleServiceClient.
 type consoleServiceClient struct {
-	ping         *connect.Client[v1.PingRequest, v1.PingResponse]
-	getModules   *connect.Client[console.GetModulesRequest, console.GetModulesResponse]
-	streamEvents *connect.Client[console.StreamEventsRequest, console.StreamEventsResponse]
-	getEvents    *connect.Client[console.EventsQuery, console.GetEventsResponse]
+	ping           *connect.Client[v1.PingRequest, v1.PingResponse]
+	getModules     *connect.Client[console.GetModulesRequest, console.GetModulesResponse]
+	streamEvents   *connect.Client[console.StreamEventsRequest, console.StreamEventsResponse]
+	getEvents      *connect.Client[console.EventsQuery, console.GetEventsResponse]
+	getSchemaGraph *connect.Client[console.GetSchemaGraphRequest, console.GetSchemaGraphResponse]
 }
 
 // Ping calls xyz.block.ftl.v1.console.ConsoleService.Ping.
@@ -118,6 +129,11 @@ func (c *consoleServiceClient) GetEvents(ctx context.Context, req *connect.Reque
 	return c.getEvents.CallUnary(ctx, req)
 }
 
+// GetSchemaGraph calls xyz.block.ftl.v1.console.ConsoleService.GetSchemaGraph.
+func (c *consoleServiceClient) GetSchemaGraph(ctx context.Context, req *connect.Request[console.GetSchemaGraphRequest]) (*connect.Response[console.GetSchemaGraphResponse], error) {
+	return c.getSchemaGraph.CallUnary(ctx, req)
+}
+
 // ConsoleServiceHandler is an implementation of the xyz.block.ftl.v1.console.ConsoleService
 // service.
 type ConsoleServiceHandler interface {
@@ -126,6 +142,8 @@ type ConsoleServiceHandler interface {
 	GetModules(context.Context, *connect.Request[console.GetModulesRequest]) (*connect.Response[console.GetModulesResponse], error)
 	StreamEvents(context.Context, *connect.Request[console.StreamEventsRequest], *connect.ServerStream[console.StreamEventsResponse]) error
 	GetEvents(context.Context, *connect.Request[console.EventsQuery]) (*connect.Response[console.GetEventsResponse], error)
+	// Render FSMs, verb calls and pub/sub flows as a DOT or Mermaid diagram.
+	GetSchemaGraph(context.Context, *connect.Request[console.GetSchemaGraphRequest]) (*connect.Response[console.GetSchemaGraphResponse], error)
 }
 
 // NewConsoleServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -155,6 +173,11 @@ func NewConsoleServiceHandler(svc ConsoleServiceHandler, opts ...connect.Handler
 		svc.GetEvents,
 		opts...,
 	)
+	consoleServiceGetSchemaGraphHandler := connect.NewUnaryHandler(
+		ConsoleServiceGetSchemaGraphProcedure,
+		svc.GetSchemaGraph,
+		opts...,
+	)
 	return "/xyz.block.ftl.v1.console.ConsoleService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ConsoleServicePingProcedure:
@@ -165,6 +188,8 @@ func NewConsoleServiceHandler(svc ConsoleServiceHandler, opts ...connect.Handler
 			consoleServiceStreamEventsHandler.ServeHTTP(w, r)
 		case ConsoleServiceGetEventsProcedure:
 			consoleServiceGetEventsHandler.ServeHTTP(w, r)
+		case ConsoleServiceGetSchemaGraphProcedure:
+			consoleServiceGetSchemaGraphHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -189,3 +214,7 @@ func (UnimplementedConsoleServiceHandler) StreamEvents(context.Context, *connect
 func (UnimplementedConsoleServiceHandler) GetEvents(context.Context, *connect.Request[console.EventsQuery]) (*connect.Response[console.GetEventsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.console.ConsoleService.GetEvents is not implemented"))
 }
+
+func (UnimplementedConsoleServiceHandler) GetSchemaGraph(context.Context, *connect.Request[console.GetSchemaGraphRequest]) (*connect.Response[console.GetSchemaGraphResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.console.ConsoleService.GetSchemaGraph is not implemented"))
+}
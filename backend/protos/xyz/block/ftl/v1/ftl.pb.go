@@ -129,6 +129,116 @@ func (RunnerState) EnumDescriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{1}
 }
 
+type CircuitBreakerState int32
+
+const (
+	// Calls are allowed.
+	CircuitBreakerState_CIRCUIT_BREAKER_CLOSED CircuitBreakerState = 0
+	// Calls are rejected.
+	CircuitBreakerState_CIRCUIT_BREAKER_OPEN CircuitBreakerState = 1
+	// A single trial call is allowed to determine whether the breaker closes.
+	CircuitBreakerState_CIRCUIT_BREAKER_HALF_OPEN CircuitBreakerState = 2
+)
+
+// Enum value maps for CircuitBreakerState.
+var (
+	CircuitBreakerState_name = map[int32]string{
+		0: "CIRCUIT_BREAKER_CLOSED",
+		1: "CIRCUIT_BREAKER_OPEN",
+		2: "CIRCUIT_BREAKER_HALF_OPEN",
+	}
+	CircuitBreakerState_value = map[string]int32{
+		"CIRCUIT_BREAKER_CLOSED":    0,
+		"CIRCUIT_BREAKER_OPEN":      1,
+		"CIRCUIT_BREAKER_HALF_OPEN": 2,
+	}
+)
+
+func (x CircuitBreakerState) Enum() *CircuitBreakerState {
+	p := new(CircuitBreakerState)
+	*p = x
+	return p
+}
+
+func (x CircuitBreakerState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CircuitBreakerState) Descriptor() protoreflect.EnumDescriptor {
+	return file_xyz_block_ftl_v1_ftl_proto_enumTypes[2].Descriptor()
+}
+
+func (CircuitBreakerState) Type() protoreflect.EnumType {
+	return &file_xyz_block_ftl_v1_ftl_proto_enumTypes[2]
+}
+
+func (x CircuitBreakerState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CircuitBreakerState.Descriptor instead.
+func (CircuitBreakerState) EnumDescriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{2}
+}
+
+type FSMStatus int32
+
+const (
+	FSMStatus_FSM_STATUS_RUNNING             FSMStatus = 0
+	FSMStatus_FSM_STATUS_COMPLETED           FSMStatus = 1
+	FSMStatus_FSM_STATUS_FAILED              FSMStatus = 2
+	FSMStatus_FSM_STATUS_COMPENSATING        FSMStatus = 3
+	FSMStatus_FSM_STATUS_COMPENSATED         FSMStatus = 4
+	FSMStatus_FSM_STATUS_COMPENSATION_FAILED FSMStatus = 5
+)
+
+// Enum value maps for FSMStatus.
+var (
+	FSMStatus_name = map[int32]string{
+		0: "FSM_STATUS_RUNNING",
+		1: "FSM_STATUS_COMPLETED",
+		2: "FSM_STATUS_FAILED",
+		3: "FSM_STATUS_COMPENSATING",
+		4: "FSM_STATUS_COMPENSATED",
+		5: "FSM_STATUS_COMPENSATION_FAILED",
+	}
+	FSMStatus_value = map[string]int32{
+		"FSM_STATUS_RUNNING":             0,
+		"FSM_STATUS_COMPLETED":           1,
+		"FSM_STATUS_FAILED":              2,
+		"FSM_STATUS_COMPENSATING":        3,
+		"FSM_STATUS_COMPENSATED":         4,
+		"FSM_STATUS_COMPENSATION_FAILED": 5,
+	}
+)
+
+func (x FSMStatus) Enum() *FSMStatus {
+	p := new(FSMStatus)
+	*p = x
+	return p
+}
+
+func (x FSMStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FSMStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_xyz_block_ftl_v1_ftl_proto_enumTypes[3].Descriptor()
+}
+
+func (FSMStatus) Type() protoreflect.EnumType {
+	return &file_xyz_block_ftl_v1_ftl_proto_enumTypes[3]
+}
+
+func (x FSMStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FSMStatus.Descriptor instead.
+func (FSMStatus) EnumDescriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{3}
+}
+
 type ConfigProvider int32
 
 const (
@@ -165,11 +275,11 @@ func (x ConfigProvider) String() string {
 }
 
 func (ConfigProvider) Descriptor() protoreflect.EnumDescriptor {
-	return file_xyz_block_ftl_v1_ftl_proto_enumTypes[2].Descriptor()
+	return file_xyz_block_ftl_v1_ftl_proto_enumTypes[4].Descriptor()
 }
 
 func (ConfigProvider) Type() protoreflect.EnumType {
-	return &file_xyz_block_ftl_v1_ftl_proto_enumTypes[2]
+	return &file_xyz_block_ftl_v1_ftl_proto_enumTypes[4]
 }
 
 func (x ConfigProvider) Number() protoreflect.EnumNumber {
@@ -178,7 +288,7 @@ func (x ConfigProvider) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ConfigProvider.Descriptor instead.
 func (ConfigProvider) EnumDescriptor() ([]byte, []int) {
-	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{2}
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{4}
 }
 
 type SecretProvider int32
@@ -225,11 +335,11 @@ func (x SecretProvider) String() string {
 }
 
 func (SecretProvider) Descriptor() protoreflect.EnumDescriptor {
-	return file_xyz_block_ftl_v1_ftl_proto_enumTypes[3].Descriptor()
+	return file_xyz_block_ftl_v1_ftl_proto_enumTypes[5].Descriptor()
 }
 
 func (SecretProvider) Type() protoreflect.EnumType {
-	return &file_xyz_block_ftl_v1_ftl_proto_enumTypes[3]
+	return &file_xyz_block_ftl_v1_ftl_proto_enumTypes[5]
 }
 
 func (x SecretProvider) Number() protoreflect.EnumNumber {
@@ -238,7 +348,7 @@ func (x SecretProvider) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SecretProvider.Descriptor instead.
 func (SecretProvider) EnumDescriptor() ([]byte, []int) {
-	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{3}
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{5}
 }
 
 type ModuleContextResponse_DBType int32
@@ -268,11 +378,11 @@ func (x ModuleContextResponse_DBType) String() string {
 }
 
 func (ModuleContextResponse_DBType) Descriptor() protoreflect.EnumDescriptor {
-	return file_xyz_block_ftl_v1_ftl_proto_enumTypes[4].Descriptor()
+	return file_xyz_block_ftl_v1_ftl_proto_enumTypes[6].Descriptor()
 }
 
 func (ModuleContextResponse_DBType) Type() protoreflect.EnumType {
-	return &file_xyz_block_ftl_v1_ftl_proto_enumTypes[4]
+	return &file_xyz_block_ftl_v1_ftl_proto_enumTypes[6]
 }
 
 func (x ModuleContextResponse_DBType) Number() protoreflect.EnumNumber {
@@ -541,9 +651,14 @@ type CallRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// When executing a transition of an FSM instance, the "fsm" and
+	// "fsmInstance" keys identify the instance.
 	Metadata *Metadata   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	Verb     *schema.Ref `protobuf:"bytes,2,opt,name=verb,proto3" json:"verb,omitempty"`
 	Body     []byte      `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// Duplicate calls to the same verb with the same key receive the response
+	// of the first call rather than executing the verb again.
+	IdempotencyKey *string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
 }
 
 func (x *CallRequest) Reset() {
@@ -599,6 +714,13 @@ func (x *CallRequest) GetBody() []byte {
 	return nil
 }
 
+func (x *CallRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
 type CallResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -890,19 +1012,19 @@ func (*SendFSMEventResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{10}
 }
 
-type PublishEventRequest struct {
+type SetNextFSMEventRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Topic *schema.Ref `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
-	Body  []byte      `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
-	// Only verb name is included because this verb will be in the same module as topic
-	Caller string `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	Fsm      *schema.Ref  `protobuf:"bytes,1,opt,name=fsm,proto3" json:"fsm,omitempty"`
+	Instance string       `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	Event    *schema.Type `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	Body     []byte       `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
 }
 
-func (x *PublishEventRequest) Reset() {
-	*x = PublishEventRequest{}
+func (x *SetNextFSMEventRequest) Reset() {
+	*x = SetNextFSMEventRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -910,13 +1032,13 @@ func (x *PublishEventRequest) Reset() {
 	}
 }
 
-func (x *PublishEventRequest) String() string {
+func (x *SetNextFSMEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishEventRequest) ProtoMessage() {}
+func (*SetNextFSMEventRequest) ProtoMessage() {}
 
-func (x *PublishEventRequest) ProtoReflect() protoreflect.Message {
+func (x *SetNextFSMEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -928,40 +1050,47 @@ func (x *PublishEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishEventRequest.ProtoReflect.Descriptor instead.
-func (*PublishEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetNextFSMEventRequest.ProtoReflect.Descriptor instead.
+func (*SetNextFSMEventRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *PublishEventRequest) GetTopic() *schema.Ref {
+func (x *SetNextFSMEventRequest) GetFsm() *schema.Ref {
 	if x != nil {
-		return x.Topic
+		return x.Fsm
 	}
 	return nil
 }
 
-func (x *PublishEventRequest) GetBody() []byte {
+func (x *SetNextFSMEventRequest) GetInstance() string {
 	if x != nil {
-		return x.Body
+		return x.Instance
+	}
+	return ""
+}
+
+func (x *SetNextFSMEventRequest) GetEvent() *schema.Type {
+	if x != nil {
+		return x.Event
 	}
 	return nil
 }
 
-func (x *PublishEventRequest) GetCaller() string {
+func (x *SetNextFSMEventRequest) GetBody() []byte {
 	if x != nil {
-		return x.Caller
+		return x.Body
 	}
-	return ""
+	return nil
 }
 
-type PublishEventResponse struct {
+type SetNextFSMEventResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *PublishEventResponse) Reset() {
-	*x = PublishEventResponse{}
+func (x *SetNextFSMEventResponse) Reset() {
+	*x = SetNextFSMEventResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -969,13 +1098,13 @@ func (x *PublishEventResponse) Reset() {
 	}
 }
 
-func (x *PublishEventResponse) String() string {
+func (x *SetNextFSMEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishEventResponse) ProtoMessage() {}
+func (*SetNextFSMEventResponse) ProtoMessage() {}
 
-func (x *PublishEventResponse) ProtoReflect() protoreflect.Message {
+func (x *SetNextFSMEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -987,19 +1116,26 @@ func (x *PublishEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishEventResponse.ProtoReflect.Descriptor instead.
-func (*PublishEventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetNextFSMEventResponse.ProtoReflect.Descriptor instead.
+func (*SetNextFSMEventResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{12}
 }
 
-type GetSchemaRequest struct {
+type PublishEventRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Topic *schema.Ref `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Body  []byte      `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// Only verb name is included because this verb will be in the same module as topic
+	Caller string `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	// Events with the same partition key are consumed in the order they were published.
+	PartitionKey *string `protobuf:"bytes,4,opt,name=partition_key,json=partitionKey,proto3,oneof" json:"partition_key,omitempty"`
 }
 
-func (x *GetSchemaRequest) Reset() {
-	*x = GetSchemaRequest{}
+func (x *PublishEventRequest) Reset() {
+	*x = PublishEventRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1007,13 +1143,13 @@ func (x *GetSchemaRequest) Reset() {
 	}
 }
 
-func (x *GetSchemaRequest) String() string {
+func (x *PublishEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSchemaRequest) ProtoMessage() {}
+func (*PublishEventRequest) ProtoMessage() {}
 
-func (x *GetSchemaRequest) ProtoReflect() protoreflect.Message {
+func (x *PublishEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1025,21 +1161,47 @@ func (x *GetSchemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSchemaRequest.ProtoReflect.Descriptor instead.
-func (*GetSchemaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublishEventRequest.ProtoReflect.Descriptor instead.
+func (*PublishEventRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{13}
 }
 
-type GetSchemaResponse struct {
+func (x *PublishEventRequest) GetTopic() *schema.Ref {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+func (x *PublishEventRequest) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *PublishEventRequest) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *PublishEventRequest) GetPartitionKey() string {
+	if x != nil && x.PartitionKey != nil {
+		return *x.PartitionKey
+	}
+	return ""
+}
+
+type PublishEventResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Schema *schema.Schema `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
 }
 
-func (x *GetSchemaResponse) Reset() {
-	*x = GetSchemaResponse{}
+func (x *PublishEventResponse) Reset() {
+	*x = PublishEventResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1047,13 +1209,13 @@ func (x *GetSchemaResponse) Reset() {
 	}
 }
 
-func (x *GetSchemaResponse) String() string {
+func (x *PublishEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSchemaResponse) ProtoMessage() {}
+func (*PublishEventResponse) ProtoMessage() {}
 
-func (x *GetSchemaResponse) ProtoReflect() protoreflect.Message {
+func (x *PublishEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1065,26 +1227,23 @@ func (x *GetSchemaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSchemaResponse.ProtoReflect.Descriptor instead.
-func (*GetSchemaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublishEventResponse.ProtoReflect.Descriptor instead.
+func (*PublishEventResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetSchemaResponse) GetSchema() *schema.Schema {
-	if x != nil {
-		return x.Schema
-	}
-	return nil
-}
-
-type PullSchemaRequest struct {
+type RetryPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Count      int32                `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	MinBackoff *durationpb.Duration `protobuf:"bytes,2,opt,name=min_backoff,json=minBackoff,proto3" json:"min_backoff,omitempty"`
+	MaxBackoff *durationpb.Duration `protobuf:"bytes,3,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`
 }
 
-func (x *PullSchemaRequest) Reset() {
-	*x = PullSchemaRequest{}
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1092,13 +1251,13 @@ func (x *PullSchemaRequest) Reset() {
 	}
 }
 
-func (x *PullSchemaRequest) String() string {
+func (x *RetryPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PullSchemaRequest) ProtoMessage() {}
+func (*RetryPolicy) ProtoMessage() {}
 
-func (x *PullSchemaRequest) ProtoReflect() protoreflect.Message {
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1110,28 +1269,49 @@ func (x *PullSchemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PullSchemaRequest.ProtoReflect.Descriptor instead.
-func (*PullSchemaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{15}
 }
 
-type PullSchemaResponse struct {
+func (x *RetryPolicy) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMinBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.MinBackoff
+	}
+	return nil
+}
+
+func (x *RetryPolicy) GetMaxBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBackoff
+	}
+	return nil
+}
+
+type ScheduleCallRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeploymentKey string `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
-	ModuleName    string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
-	// For deletes this will not be present.
-	Schema *schema.Module `protobuf:"bytes,4,opt,name=schema,proto3,oneof" json:"schema,omitempty"`
-	// If true there are more schema changes immediately following this one as part of the initial batch.
-	// If false this is the last schema change in the initial batch, but others may follow later.
-	More       bool                 `protobuf:"varint,3,opt,name=more,proto3" json:"more,omitempty"`
-	ChangeType DeploymentChangeType `protobuf:"varint,5,opt,name=change_type,json=changeType,proto3,enum=xyz.block.ftl.v1.DeploymentChangeType" json:"change_type,omitempty"`
+	Verb        *schema.Ref            `protobuf:"bytes,1,opt,name=verb,proto3" json:"verb,omitempty"`
+	Body        []byte                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	ScheduledAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	// Overrides the retry policy of the verb.
+	Retry *RetryPolicy `protobuf:"bytes,4,opt,name=retry,proto3,oneof" json:"retry,omitempty"`
+	// If a call to the same verb with the same key has not yet completed, no
+	// call is scheduled. A key is generated if not provided.
+	Key *string `protobuf:"bytes,5,opt,name=key,proto3,oneof" json:"key,omitempty"`
 }
 
-func (x *PullSchemaResponse) Reset() {
-	*x = PullSchemaResponse{}
+func (x *ScheduleCallRequest) Reset() {
+	*x = ScheduleCallRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1139,13 +1319,13 @@ func (x *PullSchemaResponse) Reset() {
 	}
 }
 
-func (x *PullSchemaResponse) String() string {
+func (x *ScheduleCallRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PullSchemaResponse) ProtoMessage() {}
+func (*ScheduleCallRequest) ProtoMessage() {}
 
-func (x *PullSchemaResponse) ProtoReflect() protoreflect.Message {
+func (x *ScheduleCallRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1157,56 +1337,58 @@ func (x *PullSchemaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PullSchemaResponse.ProtoReflect.Descriptor instead.
-func (*PullSchemaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScheduleCallRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleCallRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *PullSchemaResponse) GetDeploymentKey() string {
+func (x *ScheduleCallRequest) GetVerb() *schema.Ref {
 	if x != nil {
-		return x.DeploymentKey
+		return x.Verb
 	}
-	return ""
+	return nil
 }
 
-func (x *PullSchemaResponse) GetModuleName() string {
+func (x *ScheduleCallRequest) GetBody() []byte {
 	if x != nil {
-		return x.ModuleName
+		return x.Body
 	}
-	return ""
+	return nil
 }
 
-func (x *PullSchemaResponse) GetSchema() *schema.Module {
+func (x *ScheduleCallRequest) GetScheduledAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Schema
+		return x.ScheduledAt
 	}
 	return nil
 }
 
-func (x *PullSchemaResponse) GetMore() bool {
+func (x *ScheduleCallRequest) GetRetry() *RetryPolicy {
 	if x != nil {
-		return x.More
+		return x.Retry
 	}
-	return false
+	return nil
 }
 
-func (x *PullSchemaResponse) GetChangeType() DeploymentChangeType {
-	if x != nil {
-		return x.ChangeType
+func (x *ScheduleCallRequest) GetKey() string {
+	if x != nil && x.Key != nil {
+		return *x.Key
 	}
-	return DeploymentChangeType_DEPLOYMENT_ADDED
+	return ""
 }
 
-type GetArtefactDiffsRequest struct {
+type ScheduleCallResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClientDigests []string `protobuf:"bytes,1,rep,name=client_digests,json=clientDigests,proto3" json:"client_digests,omitempty"`
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// False if a call with the same key had already been scheduled.
+	Scheduled bool `protobuf:"varint,2,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
 }
 
-func (x *GetArtefactDiffsRequest) Reset() {
-	*x = GetArtefactDiffsRequest{}
+func (x *ScheduleCallResponse) Reset() {
+	*x = ScheduleCallResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1214,13 +1396,13 @@ func (x *GetArtefactDiffsRequest) Reset() {
 	}
 }
 
-func (x *GetArtefactDiffsRequest) String() string {
+func (x *ScheduleCallResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetArtefactDiffsRequest) ProtoMessage() {}
+func (*ScheduleCallResponse) ProtoMessage() {}
 
-func (x *GetArtefactDiffsRequest) ProtoReflect() protoreflect.Message {
+func (x *ScheduleCallResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1232,30 +1414,40 @@ func (x *GetArtefactDiffsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetArtefactDiffsRequest.ProtoReflect.Descriptor instead.
-func (*GetArtefactDiffsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScheduleCallResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleCallResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetArtefactDiffsRequest) GetClientDigests() []string {
+func (x *ScheduleCallResponse) GetKey() string {
 	if x != nil {
-		return x.ClientDigests
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-type GetArtefactDiffsResponse struct {
+func (x *ScheduleCallResponse) GetScheduled() bool {
+	if x != nil {
+		return x.Scheduled
+	}
+	return false
+}
+
+type SendWebSocketMessageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MissingDigests []string `protobuf:"bytes,1,rep,name=missing_digests,json=missingDigests,proto3" json:"missing_digests,omitempty"`
-	// Artefacts that the client already has, and their path+executable status.
-	ClientArtefacts []*DeploymentArtefact `protobuf:"bytes,2,rep,name=client_artefacts,json=clientArtefacts,proto3" json:"client_artefacts,omitempty"`
+	// The connection to send the message to, as received by a WebSocket ingress
+	// verb in builtin.WebSocketMessage.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Body         []byte `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// Send the body as a binary message rather than a text message.
+	Binary bool `protobuf:"varint,3,opt,name=binary,proto3" json:"binary,omitempty"`
 }
 
-func (x *GetArtefactDiffsResponse) Reset() {
-	*x = GetArtefactDiffsResponse{}
+func (x *SendWebSocketMessageRequest) Reset() {
+	*x = SendWebSocketMessageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1263,13 +1455,13 @@ func (x *GetArtefactDiffsResponse) Reset() {
 	}
 }
 
-func (x *GetArtefactDiffsResponse) String() string {
+func (x *SendWebSocketMessageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetArtefactDiffsResponse) ProtoMessage() {}
+func (*SendWebSocketMessageRequest) ProtoMessage() {}
 
-func (x *GetArtefactDiffsResponse) ProtoReflect() protoreflect.Message {
+func (x *SendWebSocketMessageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1281,35 +1473,40 @@ func (x *GetArtefactDiffsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetArtefactDiffsResponse.ProtoReflect.Descriptor instead.
-func (*GetArtefactDiffsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendWebSocketMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendWebSocketMessageRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetArtefactDiffsResponse) GetMissingDigests() []string {
+func (x *SendWebSocketMessageRequest) GetConnectionId() string {
 	if x != nil {
-		return x.MissingDigests
+		return x.ConnectionId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetArtefactDiffsResponse) GetClientArtefacts() []*DeploymentArtefact {
+func (x *SendWebSocketMessageRequest) GetBody() []byte {
 	if x != nil {
-		return x.ClientArtefacts
+		return x.Body
 	}
 	return nil
 }
 
-type UploadArtefactRequest struct {
+func (x *SendWebSocketMessageRequest) GetBinary() bool {
+	if x != nil {
+		return x.Binary
+	}
+	return false
+}
+
+type SendWebSocketMessageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *UploadArtefactRequest) Reset() {
-	*x = UploadArtefactRequest{}
+func (x *SendWebSocketMessageResponse) Reset() {
+	*x = SendWebSocketMessageResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1317,13 +1514,13 @@ func (x *UploadArtefactRequest) Reset() {
 	}
 }
 
-func (x *UploadArtefactRequest) String() string {
+func (x *SendWebSocketMessageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadArtefactRequest) ProtoMessage() {}
+func (*SendWebSocketMessageResponse) ProtoMessage() {}
 
-func (x *UploadArtefactRequest) ProtoReflect() protoreflect.Message {
+func (x *SendWebSocketMessageResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1335,28 +1532,19 @@ func (x *UploadArtefactRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadArtefactRequest.ProtoReflect.Descriptor instead.
-func (*UploadArtefactRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendWebSocketMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendWebSocketMessageResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *UploadArtefactRequest) GetContent() []byte {
-	if x != nil {
-		return x.Content
-	}
-	return nil
-}
-
-type UploadArtefactResponse struct {
+type GetSchemaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Digest []byte `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
 }
 
-func (x *UploadArtefactResponse) Reset() {
-	*x = UploadArtefactResponse{}
+func (x *GetSchemaRequest) Reset() {
+	*x = GetSchemaRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1364,13 +1552,13 @@ func (x *UploadArtefactResponse) Reset() {
 	}
 }
 
-func (x *UploadArtefactResponse) String() string {
+func (x *GetSchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadArtefactResponse) ProtoMessage() {}
+func (*GetSchemaRequest) ProtoMessage() {}
 
-func (x *UploadArtefactResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSchemaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1382,30 +1570,21 @@ func (x *UploadArtefactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadArtefactResponse.ProtoReflect.Descriptor instead.
-func (*UploadArtefactResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetSchemaRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *UploadArtefactResponse) GetDigest() []byte {
-	if x != nil {
-		return x.Digest
-	}
-	return nil
-}
-
-type DeploymentArtefact struct {
+type GetSchemaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Digest     string `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
-	Path       string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Executable bool   `protobuf:"varint,3,opt,name=executable,proto3" json:"executable,omitempty"`
+	Schema *schema.Schema `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
 }
 
-func (x *DeploymentArtefact) Reset() {
-	*x = DeploymentArtefact{}
+func (x *GetSchemaResponse) Reset() {
+	*x = GetSchemaResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1413,13 +1592,13 @@ func (x *DeploymentArtefact) Reset() {
 	}
 }
 
-func (x *DeploymentArtefact) String() string {
+func (x *GetSchemaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeploymentArtefact) ProtoMessage() {}
+func (*GetSchemaResponse) ProtoMessage() {}
 
-func (x *DeploymentArtefact) ProtoReflect() protoreflect.Message {
+func (x *GetSchemaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1431,45 +1610,26 @@ func (x *DeploymentArtefact) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeploymentArtefact.ProtoReflect.Descriptor instead.
-func (*DeploymentArtefact) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSchemaResponse.ProtoReflect.Descriptor instead.
+func (*GetSchemaResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *DeploymentArtefact) GetDigest() string {
-	if x != nil {
-		return x.Digest
-	}
-	return ""
-}
-
-func (x *DeploymentArtefact) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *DeploymentArtefact) GetExecutable() bool {
+func (x *GetSchemaResponse) GetSchema() *schema.Schema {
 	if x != nil {
-		return x.Executable
+		return x.Schema
 	}
-	return false
+	return nil
 }
 
-type CreateDeploymentRequest struct {
+type PullSchemaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Schema    *schema.Module        `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
-	Artefacts []*DeploymentArtefact `protobuf:"bytes,2,rep,name=artefacts,proto3" json:"artefacts,omitempty"`
-	// Runner labels required to run this deployment.
-	Labels *structpb.Struct `protobuf:"bytes,3,opt,name=labels,proto3,oneof" json:"labels,omitempty"`
 }
 
-func (x *CreateDeploymentRequest) Reset() {
-	*x = CreateDeploymentRequest{}
+func (x *PullSchemaRequest) Reset() {
+	*x = PullSchemaRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1477,13 +1637,13 @@ func (x *CreateDeploymentRequest) Reset() {
 	}
 }
 
-func (x *CreateDeploymentRequest) String() string {
+func (x *PullSchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDeploymentRequest) ProtoMessage() {}
+func (*PullSchemaRequest) ProtoMessage() {}
 
-func (x *CreateDeploymentRequest) ProtoReflect() protoreflect.Message {
+func (x *PullSchemaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1495,44 +1655,28 @@ func (x *CreateDeploymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDeploymentRequest.ProtoReflect.Descriptor instead.
-func (*CreateDeploymentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PullSchemaRequest.ProtoReflect.Descriptor instead.
+func (*PullSchemaRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CreateDeploymentRequest) GetSchema() *schema.Module {
-	if x != nil {
-		return x.Schema
-	}
-	return nil
-}
-
-func (x *CreateDeploymentRequest) GetArtefacts() []*DeploymentArtefact {
-	if x != nil {
-		return x.Artefacts
-	}
-	return nil
-}
-
-func (x *CreateDeploymentRequest) GetLabels() *structpb.Struct {
-	if x != nil {
-		return x.Labels
-	}
-	return nil
-}
-
-type CreateDeploymentResponse struct {
+type PullSchemaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	DeploymentKey string `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
-	// Currently active deployment for this module, if any.
-	ActiveDeploymentKey *string `protobuf:"bytes,2,opt,name=active_deployment_key,json=activeDeploymentKey,proto3,oneof" json:"active_deployment_key,omitempty"`
+	ModuleName    string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// For deletes this will not be present.
+	Schema *schema.Module `protobuf:"bytes,4,opt,name=schema,proto3,oneof" json:"schema,omitempty"`
+	// If true there are more schema changes immediately following this one as part of the initial batch.
+	// If false this is the last schema change in the initial batch, but others may follow later.
+	More       bool                 `protobuf:"varint,3,opt,name=more,proto3" json:"more,omitempty"`
+	ChangeType DeploymentChangeType `protobuf:"varint,5,opt,name=change_type,json=changeType,proto3,enum=xyz.block.ftl.v1.DeploymentChangeType" json:"change_type,omitempty"`
 }
 
-func (x *CreateDeploymentResponse) Reset() {
-	*x = CreateDeploymentResponse{}
+func (x *PullSchemaResponse) Reset() {
+	*x = PullSchemaResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1540,13 +1684,13 @@ func (x *CreateDeploymentResponse) Reset() {
 	}
 }
 
-func (x *CreateDeploymentResponse) String() string {
+func (x *PullSchemaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDeploymentResponse) ProtoMessage() {}
+func (*PullSchemaResponse) ProtoMessage() {}
 
-func (x *CreateDeploymentResponse) ProtoReflect() protoreflect.Message {
+func (x *PullSchemaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1558,36 +1702,56 @@ func (x *CreateDeploymentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDeploymentResponse.ProtoReflect.Descriptor instead.
-func (*CreateDeploymentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PullSchemaResponse.ProtoReflect.Descriptor instead.
+func (*PullSchemaResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CreateDeploymentResponse) GetDeploymentKey() string {
+func (x *PullSchemaResponse) GetDeploymentKey() string {
 	if x != nil {
 		return x.DeploymentKey
 	}
 	return ""
 }
 
-func (x *CreateDeploymentResponse) GetActiveDeploymentKey() string {
-	if x != nil && x.ActiveDeploymentKey != nil {
-		return *x.ActiveDeploymentKey
+func (x *PullSchemaResponse) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
 	}
 	return ""
 }
 
-type GetDeploymentArtefactsRequest struct {
+func (x *PullSchemaResponse) GetSchema() *schema.Module {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+func (x *PullSchemaResponse) GetMore() bool {
+	if x != nil {
+		return x.More
+	}
+	return false
+}
+
+func (x *PullSchemaResponse) GetChangeType() DeploymentChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return DeploymentChangeType_DEPLOYMENT_ADDED
+}
+
+type GetArtefactDiffsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeploymentKey string                `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
-	HaveArtefacts []*DeploymentArtefact `protobuf:"bytes,2,rep,name=have_artefacts,json=haveArtefacts,proto3" json:"have_artefacts,omitempty"`
+	ClientDigests []string `protobuf:"bytes,1,rep,name=client_digests,json=clientDigests,proto3" json:"client_digests,omitempty"`
 }
 
-func (x *GetDeploymentArtefactsRequest) Reset() {
-	*x = GetDeploymentArtefactsRequest{}
+func (x *GetArtefactDiffsRequest) Reset() {
+	*x = GetArtefactDiffsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1595,13 +1759,13 @@ func (x *GetDeploymentArtefactsRequest) Reset() {
 	}
 }
 
-func (x *GetDeploymentArtefactsRequest) String() string {
+func (x *GetArtefactDiffsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeploymentArtefactsRequest) ProtoMessage() {}
+func (*GetArtefactDiffsRequest) ProtoMessage() {}
 
-func (x *GetDeploymentArtefactsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetArtefactDiffsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1613,36 +1777,30 @@ func (x *GetDeploymentArtefactsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeploymentArtefactsRequest.ProtoReflect.Descriptor instead.
-func (*GetDeploymentArtefactsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetArtefactDiffsRequest.ProtoReflect.Descriptor instead.
+func (*GetArtefactDiffsRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetDeploymentArtefactsRequest) GetDeploymentKey() string {
-	if x != nil {
-		return x.DeploymentKey
-	}
-	return ""
-}
-
-func (x *GetDeploymentArtefactsRequest) GetHaveArtefacts() []*DeploymentArtefact {
+func (x *GetArtefactDiffsRequest) GetClientDigests() []string {
 	if x != nil {
-		return x.HaveArtefacts
+		return x.ClientDigests
 	}
 	return nil
 }
 
-type GetDeploymentArtefactsResponse struct {
+type GetArtefactDiffsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Artefact *DeploymentArtefact `protobuf:"bytes,1,opt,name=artefact,proto3" json:"artefact,omitempty"`
-	Chunk    []byte              `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	MissingDigests []string `protobuf:"bytes,1,rep,name=missing_digests,json=missingDigests,proto3" json:"missing_digests,omitempty"`
+	// Artefacts that the client already has, and their path+executable status.
+	ClientArtefacts []*DeploymentArtefact `protobuf:"bytes,2,rep,name=client_artefacts,json=clientArtefacts,proto3" json:"client_artefacts,omitempty"`
 }
 
-func (x *GetDeploymentArtefactsResponse) Reset() {
-	*x = GetDeploymentArtefactsResponse{}
+func (x *GetArtefactDiffsResponse) Reset() {
+	*x = GetArtefactDiffsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1650,13 +1808,13 @@ func (x *GetDeploymentArtefactsResponse) Reset() {
 	}
 }
 
-func (x *GetDeploymentArtefactsResponse) String() string {
+func (x *GetArtefactDiffsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeploymentArtefactsResponse) ProtoMessage() {}
+func (*GetArtefactDiffsResponse) ProtoMessage() {}
 
-func (x *GetDeploymentArtefactsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetArtefactDiffsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1668,35 +1826,35 @@ func (x *GetDeploymentArtefactsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeploymentArtefactsResponse.ProtoReflect.Descriptor instead.
-func (*GetDeploymentArtefactsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetArtefactDiffsResponse.ProtoReflect.Descriptor instead.
+func (*GetArtefactDiffsResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetDeploymentArtefactsResponse) GetArtefact() *DeploymentArtefact {
+func (x *GetArtefactDiffsResponse) GetMissingDigests() []string {
 	if x != nil {
-		return x.Artefact
+		return x.MissingDigests
 	}
 	return nil
 }
 
-func (x *GetDeploymentArtefactsResponse) GetChunk() []byte {
+func (x *GetArtefactDiffsResponse) GetClientArtefacts() []*DeploymentArtefact {
 	if x != nil {
-		return x.Chunk
+		return x.ClientArtefacts
 	}
 	return nil
 }
 
-type GetDeploymentRequest struct {
+type UploadArtefactRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeploymentKey string `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *GetDeploymentRequest) Reset() {
-	*x = GetDeploymentRequest{}
+func (x *UploadArtefactRequest) Reset() {
+	*x = UploadArtefactRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1704,13 +1862,13 @@ func (x *GetDeploymentRequest) Reset() {
 	}
 }
 
-func (x *GetDeploymentRequest) String() string {
+func (x *UploadArtefactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeploymentRequest) ProtoMessage() {}
+func (*UploadArtefactRequest) ProtoMessage() {}
 
-func (x *GetDeploymentRequest) ProtoReflect() protoreflect.Message {
+func (x *UploadArtefactRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1722,29 +1880,28 @@ func (x *GetDeploymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeploymentRequest.ProtoReflect.Descriptor instead.
-func (*GetDeploymentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadArtefactRequest.ProtoReflect.Descriptor instead.
+func (*UploadArtefactRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetDeploymentRequest) GetDeploymentKey() string {
+func (x *UploadArtefactRequest) GetContent() []byte {
 	if x != nil {
-		return x.DeploymentKey
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-type GetDeploymentResponse struct {
+type UploadArtefactResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Schema    *schema.Module        `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
-	Artefacts []*DeploymentArtefact `protobuf:"bytes,2,rep,name=artefacts,proto3" json:"artefacts,omitempty"`
+	Digest []byte `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
 }
 
-func (x *GetDeploymentResponse) Reset() {
-	*x = GetDeploymentResponse{}
+func (x *UploadArtefactResponse) Reset() {
+	*x = UploadArtefactResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1752,13 +1909,13 @@ func (x *GetDeploymentResponse) Reset() {
 	}
 }
 
-func (x *GetDeploymentResponse) String() string {
+func (x *UploadArtefactResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeploymentResponse) ProtoMessage() {}
+func (*UploadArtefactResponse) ProtoMessage() {}
 
-func (x *GetDeploymentResponse) ProtoReflect() protoreflect.Message {
+func (x *UploadArtefactResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1770,41 +1927,30 @@ func (x *GetDeploymentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeploymentResponse.ProtoReflect.Descriptor instead.
-func (*GetDeploymentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadArtefactResponse.ProtoReflect.Descriptor instead.
+func (*UploadArtefactResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetDeploymentResponse) GetSchema() *schema.Module {
-	if x != nil {
-		return x.Schema
-	}
-	return nil
-}
-
-func (x *GetDeploymentResponse) GetArtefacts() []*DeploymentArtefact {
+func (x *UploadArtefactResponse) GetDigest() []byte {
 	if x != nil {
-		return x.Artefacts
+		return x.Digest
 	}
 	return nil
 }
 
-type RegisterRunnerRequest struct {
+type DeploymentArtefact struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key        string           `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Endpoint   string           `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	Deployment *string          `protobuf:"bytes,3,opt,name=deployment,proto3,oneof" json:"deployment,omitempty"`
-	State      RunnerState      `protobuf:"varint,4,opt,name=state,proto3,enum=xyz.block.ftl.v1.RunnerState" json:"state,omitempty"`
-	Labels     *structpb.Struct `protobuf:"bytes,5,opt,name=labels,proto3" json:"labels,omitempty"`
-	// If present, the reason the Runner is transitioning from ASSIGNED to IDLE.
-	Error *string `protobuf:"bytes,7,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	Digest     string `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	Path       string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Executable bool   `protobuf:"varint,3,opt,name=executable,proto3" json:"executable,omitempty"`
 }
 
-func (x *RegisterRunnerRequest) Reset() {
-	*x = RegisterRunnerRequest{}
+func (x *DeploymentArtefact) Reset() {
+	*x = DeploymentArtefact{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1812,13 +1958,13 @@ func (x *RegisterRunnerRequest) Reset() {
 	}
 }
 
-func (x *RegisterRunnerRequest) String() string {
+func (x *DeploymentArtefact) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterRunnerRequest) ProtoMessage() {}
+func (*DeploymentArtefact) ProtoMessage() {}
 
-func (x *RegisterRunnerRequest) ProtoReflect() protoreflect.Message {
+func (x *DeploymentArtefact) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1830,61 +1976,45 @@ func (x *RegisterRunnerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterRunnerRequest.ProtoReflect.Descriptor instead.
-func (*RegisterRunnerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeploymentArtefact.ProtoReflect.Descriptor instead.
+func (*DeploymentArtefact) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *RegisterRunnerRequest) GetKey() string {
+func (x *DeploymentArtefact) GetDigest() string {
 	if x != nil {
-		return x.Key
+		return x.Digest
 	}
 	return ""
 }
 
-func (x *RegisterRunnerRequest) GetEndpoint() string {
+func (x *DeploymentArtefact) GetPath() string {
 	if x != nil {
-		return x.Endpoint
-	}
-	return ""
-}
-
-func (x *RegisterRunnerRequest) GetDeployment() string {
-	if x != nil && x.Deployment != nil {
-		return *x.Deployment
+		return x.Path
 	}
 	return ""
 }
 
-func (x *RegisterRunnerRequest) GetState() RunnerState {
-	if x != nil {
-		return x.State
-	}
-	return RunnerState_RUNNER_IDLE
-}
-
-func (x *RegisterRunnerRequest) GetLabels() *structpb.Struct {
+func (x *DeploymentArtefact) GetExecutable() bool {
 	if x != nil {
-		return x.Labels
-	}
-	return nil
-}
-
-func (x *RegisterRunnerRequest) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
+		return x.Executable
 	}
-	return ""
+	return false
 }
 
-type RegisterRunnerResponse struct {
+type CreateDeploymentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Schema    *schema.Module        `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Artefacts []*DeploymentArtefact `protobuf:"bytes,2,rep,name=artefacts,proto3" json:"artefacts,omitempty"`
+	// Runner labels required to run this deployment.
+	Labels *structpb.Struct `protobuf:"bytes,3,opt,name=labels,proto3,oneof" json:"labels,omitempty"`
 }
 
-func (x *RegisterRunnerResponse) Reset() {
-	*x = RegisterRunnerResponse{}
+func (x *CreateDeploymentRequest) Reset() {
+	*x = CreateDeploymentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1892,13 +2022,13 @@ func (x *RegisterRunnerResponse) Reset() {
 	}
 }
 
-func (x *RegisterRunnerResponse) String() string {
+func (x *CreateDeploymentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterRunnerResponse) ProtoMessage() {}
+func (*CreateDeploymentRequest) ProtoMessage() {}
 
-func (x *RegisterRunnerResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateDeploymentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1910,22 +2040,44 @@ func (x *RegisterRunnerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterRunnerResponse.ProtoReflect.Descriptor instead.
-func (*RegisterRunnerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateDeploymentRequest.ProtoReflect.Descriptor instead.
+func (*CreateDeploymentRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{29}
 }
 
-type UpdateDeployRequest struct {
+func (x *CreateDeploymentRequest) GetSchema() *schema.Module {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+func (x *CreateDeploymentRequest) GetArtefacts() []*DeploymentArtefact {
+	if x != nil {
+		return x.Artefacts
+	}
+	return nil
+}
+
+func (x *CreateDeploymentRequest) GetLabels() *structpb.Struct {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type CreateDeploymentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	DeploymentKey string `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
-	MinReplicas   int32  `protobuf:"varint,2,opt,name=min_replicas,json=minReplicas,proto3" json:"min_replicas,omitempty"`
+	// Currently active deployment for this module, if any.
+	ActiveDeploymentKey *string `protobuf:"bytes,2,opt,name=active_deployment_key,json=activeDeploymentKey,proto3,oneof" json:"active_deployment_key,omitempty"`
 }
 
-func (x *UpdateDeployRequest) Reset() {
-	*x = UpdateDeployRequest{}
+func (x *CreateDeploymentResponse) Reset() {
+	*x = CreateDeploymentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1933,13 +2085,13 @@ func (x *UpdateDeployRequest) Reset() {
 	}
 }
 
-func (x *UpdateDeployRequest) String() string {
+func (x *CreateDeploymentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDeployRequest) ProtoMessage() {}
+func (*CreateDeploymentResponse) ProtoMessage() {}
 
-func (x *UpdateDeployRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateDeploymentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1951,33 +2103,36 @@ func (x *UpdateDeployRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDeployRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDeployRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateDeploymentResponse.ProtoReflect.Descriptor instead.
+func (*CreateDeploymentResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *UpdateDeployRequest) GetDeploymentKey() string {
+func (x *CreateDeploymentResponse) GetDeploymentKey() string {
 	if x != nil {
 		return x.DeploymentKey
 	}
 	return ""
 }
 
-func (x *UpdateDeployRequest) GetMinReplicas() int32 {
-	if x != nil {
-		return x.MinReplicas
+func (x *CreateDeploymentResponse) GetActiveDeploymentKey() string {
+	if x != nil && x.ActiveDeploymentKey != nil {
+		return *x.ActiveDeploymentKey
 	}
-	return 0
+	return ""
 }
 
-type UpdateDeployResponse struct {
+type GetDeploymentArtefactsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	DeploymentKey string                `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
+	HaveArtefacts []*DeploymentArtefact `protobuf:"bytes,2,rep,name=have_artefacts,json=haveArtefacts,proto3" json:"have_artefacts,omitempty"`
 }
 
-func (x *UpdateDeployResponse) Reset() {
-	*x = UpdateDeployResponse{}
+func (x *GetDeploymentArtefactsRequest) Reset() {
+	*x = GetDeploymentArtefactsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1985,13 +2140,13 @@ func (x *UpdateDeployResponse) Reset() {
 	}
 }
 
-func (x *UpdateDeployResponse) String() string {
+func (x *GetDeploymentArtefactsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDeployResponse) ProtoMessage() {}
+func (*GetDeploymentArtefactsRequest) ProtoMessage() {}
 
-func (x *UpdateDeployResponse) ProtoReflect() protoreflect.Message {
+func (x *GetDeploymentArtefactsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2003,22 +2158,36 @@ func (x *UpdateDeployResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDeployResponse.ProtoReflect.Descriptor instead.
-func (*UpdateDeployResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDeploymentArtefactsRequest.ProtoReflect.Descriptor instead.
+func (*GetDeploymentArtefactsRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{31}
 }
 
-type ReplaceDeployRequest struct {
+func (x *GetDeploymentArtefactsRequest) GetDeploymentKey() string {
+	if x != nil {
+		return x.DeploymentKey
+	}
+	return ""
+}
+
+func (x *GetDeploymentArtefactsRequest) GetHaveArtefacts() []*DeploymentArtefact {
+	if x != nil {
+		return x.HaveArtefacts
+	}
+	return nil
+}
+
+type GetDeploymentArtefactsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeploymentKey string `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
-	MinReplicas   int32  `protobuf:"varint,2,opt,name=min_replicas,json=minReplicas,proto3" json:"min_replicas,omitempty"`
+	Artefact *DeploymentArtefact `protobuf:"bytes,1,opt,name=artefact,proto3" json:"artefact,omitempty"`
+	Chunk    []byte              `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
 }
 
-func (x *ReplaceDeployRequest) Reset() {
-	*x = ReplaceDeployRequest{}
+func (x *GetDeploymentArtefactsResponse) Reset() {
+	*x = GetDeploymentArtefactsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2026,13 +2195,13 @@ func (x *ReplaceDeployRequest) Reset() {
 	}
 }
 
-func (x *ReplaceDeployRequest) String() string {
+func (x *GetDeploymentArtefactsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplaceDeployRequest) ProtoMessage() {}
+func (*GetDeploymentArtefactsResponse) ProtoMessage() {}
 
-func (x *ReplaceDeployRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDeploymentArtefactsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2044,33 +2213,35 @@ func (x *ReplaceDeployRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplaceDeployRequest.ProtoReflect.Descriptor instead.
-func (*ReplaceDeployRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDeploymentArtefactsResponse.ProtoReflect.Descriptor instead.
+func (*GetDeploymentArtefactsResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ReplaceDeployRequest) GetDeploymentKey() string {
+func (x *GetDeploymentArtefactsResponse) GetArtefact() *DeploymentArtefact {
 	if x != nil {
-		return x.DeploymentKey
+		return x.Artefact
 	}
-	return ""
+	return nil
 }
 
-func (x *ReplaceDeployRequest) GetMinReplicas() int32 {
+func (x *GetDeploymentArtefactsResponse) GetChunk() []byte {
 	if x != nil {
-		return x.MinReplicas
+		return x.Chunk
 	}
-	return 0
+	return nil
 }
 
-type ReplaceDeployResponse struct {
+type GetDeploymentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	DeploymentKey string `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
 }
 
-func (x *ReplaceDeployResponse) Reset() {
-	*x = ReplaceDeployResponse{}
+func (x *GetDeploymentRequest) Reset() {
+	*x = GetDeploymentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2078,13 +2249,13 @@ func (x *ReplaceDeployResponse) Reset() {
 	}
 }
 
-func (x *ReplaceDeployResponse) String() string {
+func (x *GetDeploymentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplaceDeployResponse) ProtoMessage() {}
+func (*GetDeploymentRequest) ProtoMessage() {}
 
-func (x *ReplaceDeployResponse) ProtoReflect() protoreflect.Message {
+func (x *GetDeploymentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2096,27 +2267,29 @@ func (x *ReplaceDeployResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplaceDeployResponse.ProtoReflect.Descriptor instead.
-func (*ReplaceDeployResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDeploymentRequest.ProtoReflect.Descriptor instead.
+func (*GetDeploymentRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{33}
 }
 
-type StreamDeploymentLogsRequest struct {
+func (x *GetDeploymentRequest) GetDeploymentKey() string {
+	if x != nil {
+		return x.DeploymentKey
+	}
+	return ""
+}
+
+type GetDeploymentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeploymentKey string                 `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
-	RequestKey    *string                `protobuf:"bytes,2,opt,name=request_key,json=requestKey,proto3,oneof" json:"request_key,omitempty"`
-	TimeStamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
-	LogLevel      int32                  `protobuf:"varint,4,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
-	Attributes    map[string]string      `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
-	Error         *string                `protobuf:"bytes,7,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	Schema    *schema.Module        `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Artefacts []*DeploymentArtefact `protobuf:"bytes,2,rep,name=artefacts,proto3" json:"artefacts,omitempty"`
 }
 
-func (x *StreamDeploymentLogsRequest) Reset() {
-	*x = StreamDeploymentLogsRequest{}
+func (x *GetDeploymentResponse) Reset() {
+	*x = GetDeploymentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2124,13 +2297,13 @@ func (x *StreamDeploymentLogsRequest) Reset() {
 	}
 }
 
-func (x *StreamDeploymentLogsRequest) String() string {
+func (x *GetDeploymentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamDeploymentLogsRequest) ProtoMessage() {}
+func (*GetDeploymentResponse) ProtoMessage() {}
 
-func (x *StreamDeploymentLogsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDeploymentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2142,68 +2315,41 @@ func (x *StreamDeploymentLogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamDeploymentLogsRequest.ProtoReflect.Descriptor instead.
-func (*StreamDeploymentLogsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDeploymentResponse.ProtoReflect.Descriptor instead.
+func (*GetDeploymentResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *StreamDeploymentLogsRequest) GetDeploymentKey() string {
-	if x != nil {
-		return x.DeploymentKey
-	}
-	return ""
-}
-
-func (x *StreamDeploymentLogsRequest) GetRequestKey() string {
-	if x != nil && x.RequestKey != nil {
-		return *x.RequestKey
-	}
-	return ""
-}
-
-func (x *StreamDeploymentLogsRequest) GetTimeStamp() *timestamppb.Timestamp {
+func (x *GetDeploymentResponse) GetSchema() *schema.Module {
 	if x != nil {
-		return x.TimeStamp
+		return x.Schema
 	}
 	return nil
 }
 
-func (x *StreamDeploymentLogsRequest) GetLogLevel() int32 {
-	if x != nil {
-		return x.LogLevel
-	}
-	return 0
-}
-
-func (x *StreamDeploymentLogsRequest) GetAttributes() map[string]string {
+func (x *GetDeploymentResponse) GetArtefacts() []*DeploymentArtefact {
 	if x != nil {
-		return x.Attributes
+		return x.Artefacts
 	}
 	return nil
 }
 
-func (x *StreamDeploymentLogsRequest) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *StreamDeploymentLogsRequest) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
-	}
-	return ""
-}
-
-type StreamDeploymentLogsResponse struct {
+type RegisterRunnerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Key        string           `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Endpoint   string           `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Deployment *string          `protobuf:"bytes,3,opt,name=deployment,proto3,oneof" json:"deployment,omitempty"`
+	State      RunnerState      `protobuf:"varint,4,opt,name=state,proto3,enum=xyz.block.ftl.v1.RunnerState" json:"state,omitempty"`
+	Labels     *structpb.Struct `protobuf:"bytes,5,opt,name=labels,proto3" json:"labels,omitempty"`
+	// If present, the reason the Runner is transitioning from ASSIGNED to IDLE.
+	Error *string `protobuf:"bytes,7,opt,name=error,proto3,oneof" json:"error,omitempty"`
 }
 
-func (x *StreamDeploymentLogsResponse) Reset() {
-	*x = StreamDeploymentLogsResponse{}
+func (x *RegisterRunnerRequest) Reset() {
+	*x = RegisterRunnerRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2211,13 +2357,13 @@ func (x *StreamDeploymentLogsResponse) Reset() {
 	}
 }
 
-func (x *StreamDeploymentLogsResponse) String() string {
+func (x *RegisterRunnerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamDeploymentLogsResponse) ProtoMessage() {}
+func (*RegisterRunnerRequest) ProtoMessage() {}
 
-func (x *StreamDeploymentLogsResponse) ProtoReflect() protoreflect.Message {
+func (x *RegisterRunnerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2229,19 +2375,61 @@ func (x *StreamDeploymentLogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamDeploymentLogsResponse.ProtoReflect.Descriptor instead.
-func (*StreamDeploymentLogsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegisterRunnerRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRunnerRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{35}
 }
 
-type StatusRequest struct {
+func (x *RegisterRunnerRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RegisterRunnerRequest) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *RegisterRunnerRequest) GetDeployment() string {
+	if x != nil && x.Deployment != nil {
+		return *x.Deployment
+	}
+	return ""
+}
+
+func (x *RegisterRunnerRequest) GetState() RunnerState {
+	if x != nil {
+		return x.State
+	}
+	return RunnerState_RUNNER_IDLE
+}
+
+func (x *RegisterRunnerRequest) GetLabels() *structpb.Struct {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *RegisterRunnerRequest) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type RegisterRunnerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *StatusRequest) Reset() {
-	*x = StatusRequest{}
+func (x *RegisterRunnerResponse) Reset() {
+	*x = RegisterRunnerResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2249,13 +2437,13 @@ func (x *StatusRequest) Reset() {
 	}
 }
 
-func (x *StatusRequest) String() string {
+func (x *RegisterRunnerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusRequest) ProtoMessage() {}
+func (*RegisterRunnerResponse) ProtoMessage() {}
 
-func (x *StatusRequest) ProtoReflect() protoreflect.Message {
+func (x *RegisterRunnerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2267,25 +2455,22 @@ func (x *StatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
-func (*StatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegisterRunnerResponse.ProtoReflect.Descriptor instead.
+func (*RegisterRunnerResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{36}
 }
 
-type StatusResponse struct {
+type UpdateDeployRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Controllers   []*StatusResponse_Controller   `protobuf:"bytes,1,rep,name=controllers,proto3" json:"controllers,omitempty"`
-	Runners       []*StatusResponse_Runner       `protobuf:"bytes,2,rep,name=runners,proto3" json:"runners,omitempty"`
-	Deployments   []*StatusResponse_Deployment   `protobuf:"bytes,3,rep,name=deployments,proto3" json:"deployments,omitempty"`
-	IngressRoutes []*StatusResponse_IngressRoute `protobuf:"bytes,4,rep,name=ingress_routes,json=ingressRoutes,proto3" json:"ingress_routes,omitempty"`
-	Routes        []*StatusResponse_Route        `protobuf:"bytes,5,rep,name=routes,proto3" json:"routes,omitempty"`
+	DeploymentKey string `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
+	MinReplicas   int32  `protobuf:"varint,2,opt,name=min_replicas,json=minReplicas,proto3" json:"min_replicas,omitempty"`
 }
 
-func (x *StatusResponse) Reset() {
-	*x = StatusResponse{}
+func (x *UpdateDeployRequest) Reset() {
+	*x = UpdateDeployRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2293,13 +2478,13 @@ func (x *StatusResponse) Reset() {
 	}
 }
 
-func (x *StatusResponse) String() string {
+func (x *UpdateDeployRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusResponse) ProtoMessage() {}
+func (*UpdateDeployRequest) ProtoMessage() {}
 
-func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateDeployRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2311,54 +2496,33 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
-func (*StatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateDeployRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDeployRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *StatusResponse) GetControllers() []*StatusResponse_Controller {
-	if x != nil {
-		return x.Controllers
-	}
-	return nil
-}
-
-func (x *StatusResponse) GetRunners() []*StatusResponse_Runner {
-	if x != nil {
-		return x.Runners
-	}
-	return nil
-}
-
-func (x *StatusResponse) GetDeployments() []*StatusResponse_Deployment {
-	if x != nil {
-		return x.Deployments
-	}
-	return nil
-}
-
-func (x *StatusResponse) GetIngressRoutes() []*StatusResponse_IngressRoute {
+func (x *UpdateDeployRequest) GetDeploymentKey() string {
 	if x != nil {
-		return x.IngressRoutes
+		return x.DeploymentKey
 	}
-	return nil
+	return ""
 }
 
-func (x *StatusResponse) GetRoutes() []*StatusResponse_Route {
+func (x *UpdateDeployRequest) GetMinReplicas() int32 {
 	if x != nil {
-		return x.Routes
+		return x.MinReplicas
 	}
-	return nil
+	return 0
 }
 
-type ProcessListRequest struct {
+type UpdateDeployResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ProcessListRequest) Reset() {
-	*x = ProcessListRequest{}
+func (x *UpdateDeployResponse) Reset() {
+	*x = UpdateDeployResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2366,13 +2530,13 @@ func (x *ProcessListRequest) Reset() {
 	}
 }
 
-func (x *ProcessListRequest) String() string {
+func (x *UpdateDeployResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessListRequest) ProtoMessage() {}
+func (*UpdateDeployResponse) ProtoMessage() {}
 
-func (x *ProcessListRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateDeployResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2384,35 +2548,36 @@ func (x *ProcessListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessListRequest.ProtoReflect.Descriptor instead.
-func (*ProcessListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateDeployResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDeployResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{38}
 }
 
-type ProcessListResponse struct {
+type ReplaceDeployRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Processes []*ProcessListResponse_Process `protobuf:"bytes,1,rep,name=processes,proto3" json:"processes,omitempty"`
+	DeploymentKey string `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
+	MinReplicas   int32  `protobuf:"varint,2,opt,name=min_replicas,json=minReplicas,proto3" json:"min_replicas,omitempty"`
 }
 
-func (x *ProcessListResponse) Reset() {
-	*x = ProcessListResponse{}
-	if protoimpl.UnsafeEnabled {
+func (x *ReplaceDeployRequest) Reset() {
+	*x = ReplaceDeployRequest{}
+	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProcessListResponse) String() string {
+func (x *ReplaceDeployRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessListResponse) ProtoMessage() {}
+func (*ReplaceDeployRequest) ProtoMessage() {}
 
-func (x *ProcessListResponse) ProtoReflect() protoreflect.Message {
+func (x *ReplaceDeployRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2424,28 +2589,33 @@ func (x *ProcessListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessListResponse.ProtoReflect.Descriptor instead.
-func (*ProcessListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReplaceDeployRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceDeployRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ProcessListResponse) GetProcesses() []*ProcessListResponse_Process {
+func (x *ReplaceDeployRequest) GetDeploymentKey() string {
 	if x != nil {
-		return x.Processes
+		return x.DeploymentKey
 	}
-	return nil
+	return ""
 }
 
-type ResetSubscriptionRequest struct {
+func (x *ReplaceDeployRequest) GetMinReplicas() int32 {
+	if x != nil {
+		return x.MinReplicas
+	}
+	return 0
+}
+
+type ReplaceDeployResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Subscription *schema.Ref `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
 }
 
-func (x *ResetSubscriptionRequest) Reset() {
-	*x = ResetSubscriptionRequest{}
+func (x *ReplaceDeployResponse) Reset() {
+	*x = ReplaceDeployResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2453,13 +2623,13 @@ func (x *ResetSubscriptionRequest) Reset() {
 	}
 }
 
-func (x *ResetSubscriptionRequest) String() string {
+func (x *ReplaceDeployResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetSubscriptionRequest) ProtoMessage() {}
+func (*ReplaceDeployResponse) ProtoMessage() {}
 
-func (x *ResetSubscriptionRequest) ProtoReflect() protoreflect.Message {
+func (x *ReplaceDeployResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2471,26 +2641,27 @@ func (x *ResetSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetSubscriptionRequest.ProtoReflect.Descriptor instead.
-func (*ResetSubscriptionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReplaceDeployResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceDeployResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ResetSubscriptionRequest) GetSubscription() *schema.Ref {
-	if x != nil {
-		return x.Subscription
-	}
-	return nil
-}
-
-type ResetSubscriptionResponse struct {
+type StreamDeploymentLogsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	DeploymentKey string                 `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
+	RequestKey    *string                `protobuf:"bytes,2,opt,name=request_key,json=requestKey,proto3,oneof" json:"request_key,omitempty"`
+	TimeStamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
+	LogLevel      int32                  `protobuf:"varint,4,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
+	Attributes    map[string]string      `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Error         *string                `protobuf:"bytes,7,opt,name=error,proto3,oneof" json:"error,omitempty"`
 }
 
-func (x *ResetSubscriptionResponse) Reset() {
-	*x = ResetSubscriptionResponse{}
+func (x *StreamDeploymentLogsRequest) Reset() {
+	*x = StreamDeploymentLogsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2498,13 +2669,13 @@ func (x *ResetSubscriptionResponse) Reset() {
 	}
 }
 
-func (x *ResetSubscriptionResponse) String() string {
+func (x *StreamDeploymentLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetSubscriptionResponse) ProtoMessage() {}
+func (*StreamDeploymentLogsRequest) ProtoMessage() {}
 
-func (x *ResetSubscriptionResponse) ProtoReflect() protoreflect.Message {
+func (x *StreamDeploymentLogsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2516,21 +2687,68 @@ func (x *ResetSubscriptionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetSubscriptionResponse.ProtoReflect.Descriptor instead.
-func (*ResetSubscriptionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamDeploymentLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamDeploymentLogsRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{41}
 }
 
-type DeployRequest struct {
+func (x *StreamDeploymentLogsRequest) GetDeploymentKey() string {
+	if x != nil {
+		return x.DeploymentKey
+	}
+	return ""
+}
+
+func (x *StreamDeploymentLogsRequest) GetRequestKey() string {
+	if x != nil && x.RequestKey != nil {
+		return *x.RequestKey
+	}
+	return ""
+}
+
+func (x *StreamDeploymentLogsRequest) GetTimeStamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return nil
+}
+
+func (x *StreamDeploymentLogsRequest) GetLogLevel() int32 {
+	if x != nil {
+		return x.LogLevel
+	}
+	return 0
+}
+
+func (x *StreamDeploymentLogsRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *StreamDeploymentLogsRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StreamDeploymentLogsRequest) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type StreamDeploymentLogsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	DeploymentKey string `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
 }
 
-func (x *DeployRequest) Reset() {
-	*x = DeployRequest{}
+func (x *StreamDeploymentLogsResponse) Reset() {
+	*x = StreamDeploymentLogsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2538,13 +2756,13 @@ func (x *DeployRequest) Reset() {
 	}
 }
 
-func (x *DeployRequest) String() string {
+func (x *StreamDeploymentLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployRequest) ProtoMessage() {}
+func (*StreamDeploymentLogsResponse) ProtoMessage() {}
 
-func (x *DeployRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamDeploymentLogsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2556,26 +2774,19 @@ func (x *DeployRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployRequest.ProtoReflect.Descriptor instead.
-func (*DeployRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamDeploymentLogsResponse.ProtoReflect.Descriptor instead.
+func (*StreamDeploymentLogsResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *DeployRequest) GetDeploymentKey() string {
-	if x != nil {
-		return x.DeploymentKey
-	}
-	return ""
-}
-
-type DeployResponse struct {
+type StatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeployResponse) Reset() {
-	*x = DeployResponse{}
+func (x *StatusRequest) Reset() {
+	*x = StatusRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2583,13 +2794,13 @@ func (x *DeployResponse) Reset() {
 	}
 }
 
-func (x *DeployResponse) String() string {
+func (x *StatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployResponse) ProtoMessage() {}
+func (*StatusRequest) ProtoMessage() {}
 
-func (x *DeployResponse) ProtoReflect() protoreflect.Message {
+func (x *StatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2601,21 +2812,26 @@ func (x *DeployResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployResponse.ProtoReflect.Descriptor instead.
-func (*DeployResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
+func (*StatusRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{43}
 }
 
-type TerminateRequest struct {
+type StatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeploymentKey string `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
+	Controllers     []*StatusResponse_Controller     `protobuf:"bytes,1,rep,name=controllers,proto3" json:"controllers,omitempty"`
+	Runners         []*StatusResponse_Runner         `protobuf:"bytes,2,rep,name=runners,proto3" json:"runners,omitempty"`
+	Deployments     []*StatusResponse_Deployment     `protobuf:"bytes,3,rep,name=deployments,proto3" json:"deployments,omitempty"`
+	IngressRoutes   []*StatusResponse_IngressRoute   `protobuf:"bytes,4,rep,name=ingress_routes,json=ingressRoutes,proto3" json:"ingress_routes,omitempty"`
+	Routes          []*StatusResponse_Route          `protobuf:"bytes,5,rep,name=routes,proto3" json:"routes,omitempty"`
+	CircuitBreakers []*StatusResponse_CircuitBreaker `protobuf:"bytes,6,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers,omitempty"`
 }
 
-func (x *TerminateRequest) Reset() {
-	*x = TerminateRequest{}
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2623,13 +2839,13 @@ func (x *TerminateRequest) Reset() {
 	}
 }
 
-func (x *TerminateRequest) String() string {
+func (x *StatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TerminateRequest) ProtoMessage() {}
+func (*StatusResponse) ProtoMessage() {}
 
-func (x *TerminateRequest) ProtoReflect() protoreflect.Message {
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2641,28 +2857,61 @@ func (x *TerminateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TerminateRequest.ProtoReflect.Descriptor instead.
-func (*TerminateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *TerminateRequest) GetDeploymentKey() string {
+func (x *StatusResponse) GetControllers() []*StatusResponse_Controller {
 	if x != nil {
-		return x.DeploymentKey
+		return x.Controllers
 	}
-	return ""
+	return nil
 }
 
-type ReserveRequest struct {
+func (x *StatusResponse) GetRunners() []*StatusResponse_Runner {
+	if x != nil {
+		return x.Runners
+	}
+	return nil
+}
+
+func (x *StatusResponse) GetDeployments() []*StatusResponse_Deployment {
+	if x != nil {
+		return x.Deployments
+	}
+	return nil
+}
+
+func (x *StatusResponse) GetIngressRoutes() []*StatusResponse_IngressRoute {
+	if x != nil {
+		return x.IngressRoutes
+	}
+	return nil
+}
+
+func (x *StatusResponse) GetRoutes() []*StatusResponse_Route {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *StatusResponse) GetCircuitBreakers() []*StatusResponse_CircuitBreaker {
+	if x != nil {
+		return x.CircuitBreakers
+	}
+	return nil
+}
+
+type ProcessListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	DeploymentKey string `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
 }
 
-func (x *ReserveRequest) Reset() {
-	*x = ReserveRequest{}
+func (x *ProcessListRequest) Reset() {
+	*x = ProcessListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2670,13 +2919,13 @@ func (x *ReserveRequest) Reset() {
 	}
 }
 
-func (x *ReserveRequest) String() string {
+func (x *ProcessListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReserveRequest) ProtoMessage() {}
+func (*ProcessListRequest) ProtoMessage() {}
 
-func (x *ReserveRequest) ProtoReflect() protoreflect.Message {
+func (x *ProcessListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2688,26 +2937,21 @@ func (x *ReserveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReserveRequest.ProtoReflect.Descriptor instead.
-func (*ReserveRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessListRequest.ProtoReflect.Descriptor instead.
+func (*ProcessListRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ReserveRequest) GetDeploymentKey() string {
-	if x != nil {
-		return x.DeploymentKey
-	}
-	return ""
-}
-
-type ReserveResponse struct {
+type ProcessListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Processes []*ProcessListResponse_Process `protobuf:"bytes,1,rep,name=processes,proto3" json:"processes,omitempty"`
 }
 
-func (x *ReserveResponse) Reset() {
-	*x = ReserveResponse{}
+func (x *ProcessListResponse) Reset() {
+	*x = ProcessListResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2715,13 +2959,13 @@ func (x *ReserveResponse) Reset() {
 	}
 }
 
-func (x *ReserveResponse) String() string {
+func (x *ProcessListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReserveResponse) ProtoMessage() {}
+func (*ProcessListResponse) ProtoMessage() {}
 
-func (x *ReserveResponse) ProtoReflect() protoreflect.Message {
+func (x *ProcessListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2733,22 +2977,28 @@ func (x *ReserveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReserveResponse.ProtoReflect.Descriptor instead.
-func (*ReserveResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessListResponse.ProtoReflect.Descriptor instead.
+func (*ProcessListResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{46}
 }
 
-type ConfigRef struct {
+func (x *ProcessListResponse) GetProcesses() []*ProcessListResponse_Process {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
+}
+
+type ResetSubscriptionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Module *string `protobuf:"bytes,1,opt,name=module,proto3,oneof" json:"module,omitempty"`
-	Name   string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Subscription *schema.Ref `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
 }
 
-func (x *ConfigRef) Reset() {
-	*x = ConfigRef{}
+func (x *ResetSubscriptionRequest) Reset() {
+	*x = ResetSubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2756,13 +3006,13 @@ func (x *ConfigRef) Reset() {
 	}
 }
 
-func (x *ConfigRef) String() string {
+func (x *ResetSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigRef) ProtoMessage() {}
+func (*ResetSubscriptionRequest) ProtoMessage() {}
 
-func (x *ConfigRef) ProtoReflect() protoreflect.Message {
+func (x *ResetSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2774,37 +3024,26 @@ func (x *ConfigRef) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigRef.ProtoReflect.Descriptor instead.
-func (*ConfigRef) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ResetSubscriptionRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ConfigRef) GetModule() string {
-	if x != nil && x.Module != nil {
-		return *x.Module
-	}
-	return ""
-}
-
-func (x *ConfigRef) GetName() string {
+func (x *ResetSubscriptionRequest) GetSubscription() *schema.Ref {
 	if x != nil {
-		return x.Name
+		return x.Subscription
 	}
-	return ""
+	return nil
 }
 
-type ListConfigRequest struct {
+type ResetSubscriptionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Module        *string         `protobuf:"bytes,1,opt,name=module,proto3,oneof" json:"module,omitempty"`
-	IncludeValues *bool           `protobuf:"varint,2,opt,name=include_values,json=includeValues,proto3,oneof" json:"include_values,omitempty"`
-	Provider      *ConfigProvider `protobuf:"varint,3,opt,name=provider,proto3,enum=xyz.block.ftl.v1.ConfigProvider,oneof" json:"provider,omitempty"`
 }
 
-func (x *ListConfigRequest) Reset() {
-	*x = ListConfigRequest{}
+func (x *ResetSubscriptionResponse) Reset() {
+	*x = ResetSubscriptionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2812,13 +3051,13 @@ func (x *ListConfigRequest) Reset() {
 	}
 }
 
-func (x *ListConfigRequest) String() string {
+func (x *ResetSubscriptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListConfigRequest) ProtoMessage() {}
+func (*ResetSubscriptionResponse) ProtoMessage() {}
 
-func (x *ListConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *ResetSubscriptionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2830,42 +3069,28 @@ func (x *ListConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListConfigRequest.ProtoReflect.Descriptor instead.
-func (*ListConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*ResetSubscriptionResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ListConfigRequest) GetModule() string {
-	if x != nil && x.Module != nil {
-		return *x.Module
-	}
-	return ""
-}
-
-func (x *ListConfigRequest) GetIncludeValues() bool {
-	if x != nil && x.IncludeValues != nil {
-		return *x.IncludeValues
-	}
-	return false
-}
-
-func (x *ListConfigRequest) GetProvider() ConfigProvider {
-	if x != nil && x.Provider != nil {
-		return *x.Provider
-	}
-	return ConfigProvider_CONFIG_INLINE
-}
-
-type ListConfigResponse struct {
+type SeekSubscriptionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Configs []*ListConfigResponse_Config `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	Subscription *schema.Ref `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// Types that are assignable to Target:
+	//
+	//	*SeekSubscriptionRequest_EventKey
+	//	*SeekSubscriptionRequest_Time
+	Target isSeekSubscriptionRequest_Target `protobuf_oneof:"target"`
+	// Report the effect of the seek without moving the cursor.
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *ListConfigResponse) Reset() {
-	*x = ListConfigResponse{}
+func (x *SeekSubscriptionRequest) Reset() {
+	*x = SeekSubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2873,13 +3098,13 @@ func (x *ListConfigResponse) Reset() {
 	}
 }
 
-func (x *ListConfigResponse) String() string {
+func (x *SeekSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListConfigResponse) ProtoMessage() {}
+func (*SeekSubscriptionRequest) ProtoMessage() {}
 
-func (x *ListConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *SeekSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2891,28 +3116,77 @@ func (x *ListConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListConfigResponse.ProtoReflect.Descriptor instead.
-func (*ListConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SeekSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*SeekSubscriptionRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ListConfigResponse) GetConfigs() []*ListConfigResponse_Config {
+func (x *SeekSubscriptionRequest) GetSubscription() *schema.Ref {
 	if x != nil {
-		return x.Configs
+		return x.Subscription
 	}
 	return nil
 }
 
-type GetConfigRequest struct {
+func (m *SeekSubscriptionRequest) GetTarget() isSeekSubscriptionRequest_Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (x *SeekSubscriptionRequest) GetEventKey() string {
+	if x, ok := x.GetTarget().(*SeekSubscriptionRequest_EventKey); ok {
+		return x.EventKey
+	}
+	return ""
+}
+
+func (x *SeekSubscriptionRequest) GetTime() *timestamppb.Timestamp {
+	if x, ok := x.GetTarget().(*SeekSubscriptionRequest_Time); ok {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *SeekSubscriptionRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type isSeekSubscriptionRequest_Target interface {
+	isSeekSubscriptionRequest_Target()
+}
+
+type SeekSubscriptionRequest_EventKey struct {
+	// Consume from this event onwards.
+	EventKey string `protobuf:"bytes,2,opt,name=event_key,json=eventKey,proto3,oneof"`
+}
+
+type SeekSubscriptionRequest_Time struct {
+	// Consume events published at or after this time.
+	Time *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3,oneof"`
+}
+
+func (*SeekSubscriptionRequest_EventKey) isSeekSubscriptionRequest_Target() {}
+
+func (*SeekSubscriptionRequest_Time) isSeekSubscriptionRequest_Target() {}
+
+type SeekSubscriptionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ref *ConfigRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	// Number of previously consumed events that will be consumed again.
+	Redelivered int64 `protobuf:"varint,1,opt,name=redelivered,proto3" json:"redelivered,omitempty"`
+	// Number of unconsumed events that will be skipped.
+	Skipped int64 `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
 }
 
-func (x *GetConfigRequest) Reset() {
-	*x = GetConfigRequest{}
+func (x *SeekSubscriptionResponse) Reset() {
+	*x = SeekSubscriptionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2920,13 +3194,13 @@ func (x *GetConfigRequest) Reset() {
 	}
 }
 
-func (x *GetConfigRequest) String() string {
+func (x *SeekSubscriptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigRequest) ProtoMessage() {}
+func (*SeekSubscriptionResponse) ProtoMessage() {}
 
-func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *SeekSubscriptionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2938,28 +3212,44 @@ func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SeekSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*SeekSubscriptionResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetConfigRequest) GetRef() *ConfigRef {
+func (x *SeekSubscriptionResponse) GetRedelivered() int64 {
 	if x != nil {
-		return x.Ref
+		return x.Redelivered
 	}
-	return nil
+	return 0
 }
 
-type GetConfigResponse struct {
+func (x *SeekSubscriptionResponse) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+// An event that a subscription permanently failed to consume.
+type DeadLetter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	EventKey     string      `protobuf:"bytes,1,opt,name=event_key,json=eventKey,proto3" json:"event_key,omitempty"`
+	Subscription *schema.Ref `protobuf:"bytes,2,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// The dead letter topic the event was published to.
+	Topic     *schema.Ref            `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	Error     string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Attempts  int32                  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// JSON encoded event.
+	Payload []byte `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
-func (x *GetConfigResponse) Reset() {
-	*x = GetConfigResponse{}
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2967,13 +3257,13 @@ func (x *GetConfigResponse) Reset() {
 	}
 }
 
-func (x *GetConfigResponse) String() string {
+func (x *DeadLetter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigResponse) ProtoMessage() {}
+func (*DeadLetter) ProtoMessage() {}
 
-func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2985,30 +3275,73 @@ func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetConfigResponse) GetValue() []byte {
+func (x *DeadLetter) GetEventKey() string {
 	if x != nil {
-		return x.Value
+		return x.EventKey
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetSubscription() *schema.Ref {
+	if x != nil {
+		return x.Subscription
 	}
 	return nil
 }
 
-type SetConfigRequest struct {
+func (x *DeadLetter) GetTopic() *schema.Ref {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type ListDeadLettersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider *ConfigProvider `protobuf:"varint,1,opt,name=provider,proto3,enum=xyz.block.ftl.v1.ConfigProvider,oneof" json:"provider,omitempty"`
-	Ref      *ConfigRef      `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
-	Value    []byte          `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Subscription *schema.Ref `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// Only return this event.
+	EventKey *string `protobuf:"bytes,2,opt,name=event_key,json=eventKey,proto3,oneof" json:"event_key,omitempty"`
+	Limit    *int32  `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
 }
 
-func (x *SetConfigRequest) Reset() {
-	*x = SetConfigRequest{}
+func (x *ListDeadLettersRequest) Reset() {
+	*x = ListDeadLettersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3016,13 +3349,13 @@ func (x *SetConfigRequest) Reset() {
 	}
 }
 
-func (x *SetConfigRequest) String() string {
+func (x *ListDeadLettersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetConfigRequest) ProtoMessage() {}
+func (*ListDeadLettersRequest) ProtoMessage() {}
 
-func (x *SetConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *ListDeadLettersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3034,40 +3367,42 @@ func (x *SetConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetConfigRequest.ProtoReflect.Descriptor instead.
-func (*SetConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *SetConfigRequest) GetProvider() ConfigProvider {
-	if x != nil && x.Provider != nil {
-		return *x.Provider
+func (x *ListDeadLettersRequest) GetSubscription() *schema.Ref {
+	if x != nil {
+		return x.Subscription
 	}
-	return ConfigProvider_CONFIG_INLINE
+	return nil
 }
 
-func (x *SetConfigRequest) GetRef() *ConfigRef {
-	if x != nil {
-		return x.Ref
+func (x *ListDeadLettersRequest) GetEventKey() string {
+	if x != nil && x.EventKey != nil {
+		return *x.EventKey
 	}
-	return nil
+	return ""
 }
 
-func (x *SetConfigRequest) GetValue() []byte {
-	if x != nil {
-		return x.Value
+func (x *ListDeadLettersRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
 	}
-	return nil
+	return 0
 }
 
-type SetConfigResponse struct {
+type ListDeadLettersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	DeadLetters []*DeadLetter `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
 }
 
-func (x *SetConfigResponse) Reset() {
-	*x = SetConfigResponse{}
+func (x *ListDeadLettersResponse) Reset() {
+	*x = ListDeadLettersResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3075,13 +3410,13 @@ func (x *SetConfigResponse) Reset() {
 	}
 }
 
-func (x *SetConfigResponse) String() string {
+func (x *ListDeadLettersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetConfigResponse) ProtoMessage() {}
+func (*ListDeadLettersResponse) ProtoMessage() {}
 
-func (x *SetConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *ListDeadLettersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3093,22 +3428,30 @@ func (x *SetConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetConfigResponse.ProtoReflect.Descriptor instead.
-func (*SetConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{53}
 }
 
-type UnsetConfigRequest struct {
+func (x *ListDeadLettersResponse) GetDeadLetters() []*DeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+type ReplayDeadLettersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider *ConfigProvider `protobuf:"varint,1,opt,name=provider,proto3,enum=xyz.block.ftl.v1.ConfigProvider,oneof" json:"provider,omitempty"`
-	Ref      *ConfigRef      `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	Subscription *schema.Ref `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// Only replay this event.
+	EventKey *string `protobuf:"bytes,2,opt,name=event_key,json=eventKey,proto3,oneof" json:"event_key,omitempty"`
 }
 
-func (x *UnsetConfigRequest) Reset() {
-	*x = UnsetConfigRequest{}
+func (x *ReplayDeadLettersRequest) Reset() {
+	*x = ReplayDeadLettersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3116,13 +3459,13 @@ func (x *UnsetConfigRequest) Reset() {
 	}
 }
 
-func (x *UnsetConfigRequest) String() string {
+func (x *ReplayDeadLettersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsetConfigRequest) ProtoMessage() {}
+func (*ReplayDeadLettersRequest) ProtoMessage() {}
 
-func (x *UnsetConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *ReplayDeadLettersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3134,33 +3477,35 @@ func (x *UnsetConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsetConfigRequest.ProtoReflect.Descriptor instead.
-func (*UnsetConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReplayDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *UnsetConfigRequest) GetProvider() ConfigProvider {
-	if x != nil && x.Provider != nil {
-		return *x.Provider
+func (x *ReplayDeadLettersRequest) GetSubscription() *schema.Ref {
+	if x != nil {
+		return x.Subscription
 	}
-	return ConfigProvider_CONFIG_INLINE
+	return nil
 }
 
-func (x *UnsetConfigRequest) GetRef() *ConfigRef {
-	if x != nil {
-		return x.Ref
+func (x *ReplayDeadLettersRequest) GetEventKey() string {
+	if x != nil && x.EventKey != nil {
+		return *x.EventKey
 	}
-	return nil
+	return ""
 }
 
-type UnsetConfigResponse struct {
+type ReplayDeadLettersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *UnsetConfigResponse) Reset() {
-	*x = UnsetConfigResponse{}
+func (x *ReplayDeadLettersResponse) Reset() {
+	*x = ReplayDeadLettersResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3168,13 +3513,13 @@ func (x *UnsetConfigResponse) Reset() {
 	}
 }
 
-func (x *UnsetConfigResponse) String() string {
+func (x *ReplayDeadLettersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsetConfigResponse) ProtoMessage() {}
+func (*ReplayDeadLettersResponse) ProtoMessage() {}
 
-func (x *UnsetConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *ReplayDeadLettersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3186,23 +3531,30 @@ func (x *UnsetConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsetConfigResponse.ProtoReflect.Descriptor instead.
-func (*UnsetConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReplayDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{55}
 }
 
-type ListSecretsRequest struct {
+func (x *ReplayDeadLettersResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PurgeDeadLettersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Module        *string         `protobuf:"bytes,1,opt,name=module,proto3,oneof" json:"module,omitempty"`
-	IncludeValues *bool           `protobuf:"varint,2,opt,name=include_values,json=includeValues,proto3,oneof" json:"include_values,omitempty"`
-	Provider      *SecretProvider `protobuf:"varint,3,opt,name=provider,proto3,enum=xyz.block.ftl.v1.SecretProvider,oneof" json:"provider,omitempty"`
+	Subscription *schema.Ref `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// Only purge this event.
+	EventKey *string `protobuf:"bytes,2,opt,name=event_key,json=eventKey,proto3,oneof" json:"event_key,omitempty"`
 }
 
-func (x *ListSecretsRequest) Reset() {
-	*x = ListSecretsRequest{}
+func (x *PurgeDeadLettersRequest) Reset() {
+	*x = PurgeDeadLettersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3210,13 +3562,13 @@ func (x *ListSecretsRequest) Reset() {
 	}
 }
 
-func (x *ListSecretsRequest) String() string {
+func (x *PurgeDeadLettersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSecretsRequest) ProtoMessage() {}
+func (*PurgeDeadLettersRequest) ProtoMessage() {}
 
-func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
+func (x *PurgeDeadLettersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3228,42 +3580,35 @@ func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSecretsRequest.ProtoReflect.Descriptor instead.
-func (*ListSecretsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PurgeDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDeadLettersRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *ListSecretsRequest) GetModule() string {
-	if x != nil && x.Module != nil {
-		return *x.Module
-	}
-	return ""
-}
-
-func (x *ListSecretsRequest) GetIncludeValues() bool {
-	if x != nil && x.IncludeValues != nil {
-		return *x.IncludeValues
+func (x *PurgeDeadLettersRequest) GetSubscription() *schema.Ref {
+	if x != nil {
+		return x.Subscription
 	}
-	return false
+	return nil
 }
 
-func (x *ListSecretsRequest) GetProvider() SecretProvider {
-	if x != nil && x.Provider != nil {
-		return *x.Provider
+func (x *PurgeDeadLettersRequest) GetEventKey() string {
+	if x != nil && x.EventKey != nil {
+		return *x.EventKey
 	}
-	return SecretProvider_SECRET_INLINE
+	return ""
 }
 
-type ListSecretsResponse struct {
+type PurgeDeadLettersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Secrets []*ListSecretsResponse_Secret `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *ListSecretsResponse) Reset() {
-	*x = ListSecretsResponse{}
+func (x *PurgeDeadLettersResponse) Reset() {
+	*x = PurgeDeadLettersResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3271,13 +3616,13 @@ func (x *ListSecretsResponse) Reset() {
 	}
 }
 
-func (x *ListSecretsResponse) String() string {
+func (x *PurgeDeadLettersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSecretsResponse) ProtoMessage() {}
+func (*PurgeDeadLettersResponse) ProtoMessage() {}
 
-func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
+func (x *PurgeDeadLettersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3289,28 +3634,38 @@ func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSecretsResponse.ProtoReflect.Descriptor instead.
-func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PurgeDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDeadLettersResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *ListSecretsResponse) GetSecrets() []*ListSecretsResponse_Secret {
+func (x *PurgeDeadLettersResponse) GetCount() int32 {
 	if x != nil {
-		return x.Secrets
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-type GetSecretRequest struct {
+type FSMInstance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ref *ConfigRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
-}
-
-func (x *GetSecretRequest) Reset() {
-	*x = GetSecretRequest{}
+	Fsm    *schema.Ref `protobuf:"bytes,1,opt,name=fsm,proto3" json:"fsm,omitempty"`
+	Key    string      `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Status FSMStatus   `protobuf:"varint,3,opt,name=status,proto3,enum=xyz.block.ftl.v1.FSMStatus" json:"status,omitempty"`
+	// Unset until the instance has completed its first transition.
+	CurrentState *schema.Ref `protobuf:"bytes,4,opt,name=current_state,json=currentState,proto3,oneof" json:"current_state,omitempty"`
+	// Set while a transition is executing.
+	DestinationState *schema.Ref            `protobuf:"bytes,5,opt,name=destination_state,json=destinationState,proto3,oneof" json:"destination_state,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Number of events waiting for the executing transition to complete.
+	QueuedEvents int64 `protobuf:"varint,8,opt,name=queued_events,json=queuedEvents,proto3" json:"queued_events,omitempty"`
+}
+
+func (x *FSMInstance) Reset() {
+	*x = FSMInstance{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3318,13 +3673,13 @@ func (x *GetSecretRequest) Reset() {
 	}
 }
 
-func (x *GetSecretRequest) String() string {
+func (x *FSMInstance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSecretRequest) ProtoMessage() {}
+func (*FSMInstance) ProtoMessage() {}
 
-func (x *GetSecretRequest) ProtoReflect() protoreflect.Message {
+func (x *FSMInstance) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3336,28 +3691,87 @@ func (x *GetSecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSecretRequest.ProtoReflect.Descriptor instead.
-func (*GetSecretRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FSMInstance.ProtoReflect.Descriptor instead.
+func (*FSMInstance) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetSecretRequest) GetRef() *ConfigRef {
+func (x *FSMInstance) GetFsm() *schema.Ref {
 	if x != nil {
-		return x.Ref
+		return x.Fsm
 	}
 	return nil
 }
 
-type GetSecretResponse struct {
+func (x *FSMInstance) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *FSMInstance) GetStatus() FSMStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FSMStatus_FSM_STATUS_RUNNING
+}
+
+func (x *FSMInstance) GetCurrentState() *schema.Ref {
+	if x != nil {
+		return x.CurrentState
+	}
+	return nil
+}
+
+func (x *FSMInstance) GetDestinationState() *schema.Ref {
+	if x != nil {
+		return x.DestinationState
+	}
+	return nil
+}
+
+func (x *FSMInstance) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FSMInstance) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *FSMInstance) GetQueuedEvents() int64 {
+	if x != nil {
+		return x.QueuedEvents
+	}
+	return 0
+}
+
+// An attempt to execute a transition of an FSM instance.
+type FSMTransitionAttempt struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// The destination state of the transition.
+	State *schema.Ref `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// One of "pending", "executing", "success" or "error".
+	Status    string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Error     *string                `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// JSON encoded event.
+	Event []byte `protobuf:"bytes,5,opt,name=event,proto3" json:"event,omitempty"`
+	// True if this attempt is executing the catch verb of the transition.
+	Catching bool `protobuf:"varint,6,opt,name=catching,proto3" json:"catching,omitempty"`
 }
 
-func (x *GetSecretResponse) Reset() {
-	*x = GetSecretResponse{}
+func (x *FSMTransitionAttempt) Reset() {
+	*x = FSMTransitionAttempt{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3365,13 +3779,13 @@ func (x *GetSecretResponse) Reset() {
 	}
 }
 
-func (x *GetSecretResponse) String() string {
+func (x *FSMTransitionAttempt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSecretResponse) ProtoMessage() {}
+func (*FSMTransitionAttempt) ProtoMessage() {}
 
-func (x *GetSecretResponse) ProtoReflect() protoreflect.Message {
+func (x *FSMTransitionAttempt) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3383,30 +3797,67 @@ func (x *GetSecretResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSecretResponse.ProtoReflect.Descriptor instead.
-func (*GetSecretResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FSMTransitionAttempt.ProtoReflect.Descriptor instead.
+func (*FSMTransitionAttempt) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *GetSecretResponse) GetValue() []byte {
+func (x *FSMTransitionAttempt) GetState() *schema.Ref {
 	if x != nil {
-		return x.Value
+		return x.State
 	}
 	return nil
 }
 
-type SetSecretRequest struct {
+func (x *FSMTransitionAttempt) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FSMTransitionAttempt) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *FSMTransitionAttempt) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FSMTransitionAttempt) GetEvent() []byte {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *FSMTransitionAttempt) GetCatching() bool {
+	if x != nil {
+		return x.Catching
+	}
+	return false
+}
+
+type ListFSMInstancesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider *SecretProvider `protobuf:"varint,1,opt,name=provider,proto3,enum=xyz.block.ftl.v1.SecretProvider,oneof" json:"provider,omitempty"`
-	Ref      *ConfigRef      `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
-	Value    []byte          `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Fsm    *schema.Ref `protobuf:"bytes,1,opt,name=fsm,proto3" json:"fsm,omitempty"`
+	Status *FSMStatus  `protobuf:"varint,2,opt,name=status,proto3,enum=xyz.block.ftl.v1.FSMStatus,oneof" json:"status,omitempty"`
+	// Only return instances in this state.
+	State *schema.Ref `protobuf:"bytes,3,opt,name=state,proto3,oneof" json:"state,omitempty"`
+	Limit *int32      `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
 }
 
-func (x *SetSecretRequest) Reset() {
-	*x = SetSecretRequest{}
+func (x *ListFSMInstancesRequest) Reset() {
+	*x = ListFSMInstancesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3414,13 +3865,13 @@ func (x *SetSecretRequest) Reset() {
 	}
 }
 
-func (x *SetSecretRequest) String() string {
+func (x *ListFSMInstancesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetSecretRequest) ProtoMessage() {}
+func (*ListFSMInstancesRequest) ProtoMessage() {}
 
-func (x *SetSecretRequest) ProtoReflect() protoreflect.Message {
+func (x *ListFSMInstancesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3432,40 +3883,49 @@ func (x *SetSecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetSecretRequest.ProtoReflect.Descriptor instead.
-func (*SetSecretRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListFSMInstancesRequest.ProtoReflect.Descriptor instead.
+func (*ListFSMInstancesRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *SetSecretRequest) GetProvider() SecretProvider {
-	if x != nil && x.Provider != nil {
-		return *x.Provider
+func (x *ListFSMInstancesRequest) GetFsm() *schema.Ref {
+	if x != nil {
+		return x.Fsm
 	}
-	return SecretProvider_SECRET_INLINE
+	return nil
 }
 
-func (x *SetSecretRequest) GetRef() *ConfigRef {
-	if x != nil {
-		return x.Ref
+func (x *ListFSMInstancesRequest) GetStatus() FSMStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
-	return nil
+	return FSMStatus_FSM_STATUS_RUNNING
 }
 
-func (x *SetSecretRequest) GetValue() []byte {
+func (x *ListFSMInstancesRequest) GetState() *schema.Ref {
 	if x != nil {
-		return x.Value
+		return x.State
 	}
 	return nil
 }
 
-type SetSecretResponse struct {
+func (x *ListFSMInstancesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type ListFSMInstancesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Instances []*FSMInstance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
 }
 
-func (x *SetSecretResponse) Reset() {
-	*x = SetSecretResponse{}
+func (x *ListFSMInstancesResponse) Reset() {
+	*x = ListFSMInstancesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3473,13 +3933,13 @@ func (x *SetSecretResponse) Reset() {
 	}
 }
 
-func (x *SetSecretResponse) String() string {
+func (x *ListFSMInstancesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetSecretResponse) ProtoMessage() {}
+func (*ListFSMInstancesResponse) ProtoMessage() {}
 
-func (x *SetSecretResponse) ProtoReflect() protoreflect.Message {
+func (x *ListFSMInstancesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3491,22 +3951,29 @@ func (x *SetSecretResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetSecretResponse.ProtoReflect.Descriptor instead.
-func (*SetSecretResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListFSMInstancesResponse.ProtoReflect.Descriptor instead.
+func (*ListFSMInstancesResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{61}
 }
 
-type UnsetSecretRequest struct {
+func (x *ListFSMInstancesResponse) GetInstances() []*FSMInstance {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+type GetFSMInstanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider *SecretProvider `protobuf:"varint,1,opt,name=provider,proto3,enum=xyz.block.ftl.v1.SecretProvider,oneof" json:"provider,omitempty"`
-	Ref      *ConfigRef      `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	Fsm      *schema.Ref `protobuf:"bytes,1,opt,name=fsm,proto3" json:"fsm,omitempty"`
+	Instance string      `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
 }
 
-func (x *UnsetSecretRequest) Reset() {
-	*x = UnsetSecretRequest{}
+func (x *GetFSMInstanceRequest) Reset() {
+	*x = GetFSMInstanceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3514,13 +3981,13 @@ func (x *UnsetSecretRequest) Reset() {
 	}
 }
 
-func (x *UnsetSecretRequest) String() string {
+func (x *GetFSMInstanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsetSecretRequest) ProtoMessage() {}
+func (*GetFSMInstanceRequest) ProtoMessage() {}
 
-func (x *UnsetSecretRequest) ProtoReflect() protoreflect.Message {
+func (x *GetFSMInstanceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3532,33 +3999,37 @@ func (x *UnsetSecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsetSecretRequest.ProtoReflect.Descriptor instead.
-func (*UnsetSecretRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFSMInstanceRequest.ProtoReflect.Descriptor instead.
+func (*GetFSMInstanceRequest) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *UnsetSecretRequest) GetProvider() SecretProvider {
-	if x != nil && x.Provider != nil {
-		return *x.Provider
+func (x *GetFSMInstanceRequest) GetFsm() *schema.Ref {
+	if x != nil {
+		return x.Fsm
 	}
-	return SecretProvider_SECRET_INLINE
+	return nil
 }
 
-func (x *UnsetSecretRequest) GetRef() *ConfigRef {
+func (x *GetFSMInstanceRequest) GetInstance() string {
 	if x != nil {
-		return x.Ref
+		return x.Instance
 	}
-	return nil
+	return ""
 }
 
-type UnsetSecretResponse struct {
+type GetFSMInstanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Instance *FSMInstance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Every transition attempt of the instance, oldest first.
+	History []*FSMTransitionAttempt `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
 }
 
-func (x *UnsetSecretResponse) Reset() {
-	*x = UnsetSecretResponse{}
+func (x *GetFSMInstanceResponse) Reset() {
+	*x = GetFSMInstanceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3566,13 +4037,13 @@ func (x *UnsetSecretResponse) Reset() {
 	}
 }
 
-func (x *UnsetSecretResponse) String() string {
+func (x *GetFSMInstanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsetSecretResponse) ProtoMessage() {}
+func (*GetFSMInstanceResponse) ProtoMessage() {}
 
-func (x *UnsetSecretResponse) ProtoReflect() protoreflect.Message {
+func (x *GetFSMInstanceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3584,22 +4055,43 @@ func (x *UnsetSecretResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsetSecretResponse.ProtoReflect.Descriptor instead.
-func (*UnsetSecretResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFSMInstanceResponse.ProtoReflect.Descriptor instead.
+func (*GetFSMInstanceResponse) Descriptor() ([]byte, []int) {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{63}
 }
 
-type ModuleContextResponse_Ref struct {
+func (x *GetFSMInstanceResponse) GetInstance() *FSMInstance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *GetFSMInstanceResponse) GetHistory() []*FSMTransitionAttempt {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type ScheduledCall struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Module *string `protobuf:"bytes,1,opt,name=module,proto3,oneof" json:"module,omitempty"`
-	Name   string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Verb *schema.Ref `protobuf:"bytes,1,opt,name=verb,proto3" json:"verb,omitempty"`
+	Key  string      `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// "pending" or "executing".
+	State             string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	ScheduledAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	RemainingAttempts int32                  `protobuf:"varint,5,opt,name=remaining_attempts,json=remainingAttempts,proto3" json:"remaining_attempts,omitempty"`
+	// The error of the previous attempt, if it failed.
+	Error     *string                `protobuf:"bytes,6,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *ModuleContextResponse_Ref) Reset() {
-	*x = ModuleContextResponse_Ref{}
+func (x *ScheduledCall) Reset() {
+	*x = ScheduledCall{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3607,13 +4099,13 @@ func (x *ModuleContextResponse_Ref) Reset() {
 	}
 }
 
-func (x *ModuleContextResponse_Ref) String() string {
+func (x *ScheduledCall) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModuleContextResponse_Ref) ProtoMessage() {}
+func (*ScheduledCall) ProtoMessage() {}
 
-func (x *ModuleContextResponse_Ref) ProtoReflect() protoreflect.Message {
+func (x *ScheduledCall) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3625,37 +4117,72 @@ func (x *ModuleContextResponse_Ref) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModuleContextResponse_Ref.ProtoReflect.Descriptor instead.
-func (*ModuleContextResponse_Ref) Descriptor() ([]byte, []int) {
-	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use ScheduledCall.ProtoReflect.Descriptor instead.
+func (*ScheduledCall) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ModuleContextResponse_Ref) GetModule() string {
-	if x != nil && x.Module != nil {
-		return *x.Module
+func (x *ScheduledCall) GetVerb() *schema.Ref {
+	if x != nil {
+		return x.Verb
+	}
+	return nil
+}
+
+func (x *ScheduledCall) GetKey() string {
+	if x != nil {
+		return x.Key
 	}
 	return ""
 }
 
-func (x *ModuleContextResponse_Ref) GetName() string {
+func (x *ScheduledCall) GetState() string {
 	if x != nil {
-		return x.Name
+		return x.State
 	}
 	return ""
 }
 
-type ModuleContextResponse_DSN struct {
+func (x *ScheduledCall) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *ScheduledCall) GetRemainingAttempts() int32 {
+	if x != nil {
+		return x.RemainingAttempts
+	}
+	return 0
+}
+
+func (x *ScheduledCall) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *ScheduledCall) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListScheduledCallsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type ModuleContextResponse_DBType `protobuf:"varint,2,opt,name=type,proto3,enum=xyz.block.ftl.v1.ModuleContextResponse_DBType" json:"type,omitempty"`
-	Dsn  string                       `protobuf:"bytes,3,opt,name=dsn,proto3" json:"dsn,omitempty"`
+	// Only list calls to this verb.
+	Verb  *schema.Ref `protobuf:"bytes,1,opt,name=verb,proto3,oneof" json:"verb,omitempty"`
+	Limit *int32      `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
 }
 
-func (x *ModuleContextResponse_DSN) Reset() {
-	*x = ModuleContextResponse_DSN{}
+func (x *ListScheduledCallsRequest) Reset() {
+	*x = ListScheduledCallsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3663,13 +4190,13 @@ func (x *ModuleContextResponse_DSN) Reset() {
 	}
 }
 
-func (x *ModuleContextResponse_DSN) String() string {
+func (x *ListScheduledCallsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModuleContextResponse_DSN) ProtoMessage() {}
+func (*ListScheduledCallsRequest) ProtoMessage() {}
 
-func (x *ModuleContextResponse_DSN) ProtoReflect() protoreflect.Message {
+func (x *ListScheduledCallsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3681,58 +4208,51 @@ func (x *ModuleContextResponse_DSN) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModuleContextResponse_DSN.ProtoReflect.Descriptor instead.
-func (*ModuleContextResponse_DSN) Descriptor() ([]byte, []int) {
-	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{3, 1}
-}
-
-func (x *ModuleContextResponse_DSN) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use ListScheduledCallsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledCallsRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ModuleContextResponse_DSN) GetType() ModuleContextResponse_DBType {
+func (x *ListScheduledCallsRequest) GetVerb() *schema.Ref {
 	if x != nil {
-		return x.Type
+		return x.Verb
 	}
-	return ModuleContextResponse_POSTGRES
+	return nil
 }
 
-func (x *ModuleContextResponse_DSN) GetDsn() string {
-	if x != nil {
-		return x.Dsn
+func (x *ListScheduledCallsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
 	}
-	return ""
+	return 0
 }
 
-type Metadata_Pair struct {
+type ListScheduledCallsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Soonest first.
+	Calls []*ScheduledCall `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
 }
 
-func (x *Metadata_Pair) Reset() {
-	*x = Metadata_Pair{}
+func (x *ListScheduledCallsResponse) Reset() {
+	*x = ListScheduledCallsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[68]
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Metadata_Pair) String() string {
+func (x *ListScheduledCallsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Metadata_Pair) ProtoMessage() {}
+func (*ListScheduledCallsResponse) ProtoMessage() {}
 
-func (x *Metadata_Pair) ProtoReflect() protoreflect.Message {
-	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[68]
+func (x *ListScheduledCallsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3743,51 +4263,44 @@ func (x *Metadata_Pair) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Metadata_Pair.ProtoReflect.Descriptor instead.
-func (*Metadata_Pair) Descriptor() ([]byte, []int) {
-	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{4, 0}
-}
-
-func (x *Metadata_Pair) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
+// Deprecated: Use ListScheduledCallsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledCallsResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *Metadata_Pair) GetValue() string {
+func (x *ListScheduledCallsResponse) GetCalls() []*ScheduledCall {
 	if x != nil {
-		return x.Value
+		return x.Calls
 	}
-	return ""
+	return nil
 }
 
-type CallResponse_Error struct {
+type CancelScheduledCallRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string  `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Stack   *string `protobuf:"bytes,2,opt,name=stack,proto3,oneof" json:"stack,omitempty"` // TODO: Richer error type.
+	Verb *schema.Ref `protobuf:"bytes,1,opt,name=verb,proto3" json:"verb,omitempty"`
+	Key  string      `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *CallResponse_Error) Reset() {
-	*x = CallResponse_Error{}
+func (x *CancelScheduledCallRequest) Reset() {
+	*x = CancelScheduledCallRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[69]
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CallResponse_Error) String() string {
+func (x *CancelScheduledCallRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CallResponse_Error) ProtoMessage() {}
+func (*CancelScheduledCallRequest) ProtoMessage() {}
 
-func (x *CallResponse_Error) ProtoReflect() protoreflect.Message {
-	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[69]
+func (x *CancelScheduledCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3798,52 +4311,48 @@ func (x *CallResponse_Error) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CallResponse_Error.ProtoReflect.Descriptor instead.
-func (*CallResponse_Error) Descriptor() ([]byte, []int) {
-	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{6, 0}
+// Deprecated: Use CancelScheduledCallRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledCallRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *CallResponse_Error) GetMessage() string {
+func (x *CancelScheduledCallRequest) GetVerb() *schema.Ref {
 	if x != nil {
-		return x.Message
+		return x.Verb
 	}
-	return ""
+	return nil
 }
 
-func (x *CallResponse_Error) GetStack() string {
-	if x != nil && x.Stack != nil {
-		return *x.Stack
+func (x *CancelScheduledCallRequest) GetKey() string {
+	if x != nil {
+		return x.Key
 	}
 	return ""
 }
 
-type StatusResponse_Controller struct {
+type CancelScheduledCallResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Key      string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	Version  string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *StatusResponse_Controller) Reset() {
-	*x = StatusResponse_Controller{}
+func (x *CancelScheduledCallResponse) Reset() {
+	*x = CancelScheduledCallResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[71]
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatusResponse_Controller) String() string {
+func (x *CancelScheduledCallResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusResponse_Controller) ProtoMessage() {}
+func (*CancelScheduledCallResponse) ProtoMessage() {}
 
-func (x *StatusResponse_Controller) ProtoReflect() protoreflect.Message {
-	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[71]
+func (x *CancelScheduledCallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3854,62 +4363,49 @@ func (x *StatusResponse_Controller) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusResponse_Controller.ProtoReflect.Descriptor instead.
-func (*StatusResponse_Controller) Descriptor() ([]byte, []int) {
-	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{37, 0}
-}
-
-func (x *StatusResponse_Controller) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-func (x *StatusResponse_Controller) GetEndpoint() string {
-	if x != nil {
-		return x.Endpoint
-	}
-	return ""
-}
-
-func (x *StatusResponse_Controller) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
+// Deprecated: Use CancelScheduledCallResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledCallResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{68}
 }
 
-type StatusResponse_Runner struct {
+type CronJob struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key        string           `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Languages  []string         `protobuf:"bytes,2,rep,name=languages,proto3" json:"languages,omitempty"`
-	Endpoint   string           `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	State      RunnerState      `protobuf:"varint,4,opt,name=state,proto3,enum=xyz.block.ftl.v1.RunnerState" json:"state,omitempty"`
-	Deployment *string          `protobuf:"bytes,5,opt,name=deployment,proto3,oneof" json:"deployment,omitempty"`
-	Labels     *structpb.Struct `protobuf:"bytes,6,opt,name=labels,proto3" json:"labels,omitempty"`
-}
-
-func (x *StatusResponse_Runner) Reset() {
-	*x = StatusResponse_Runner{}
+	Verb          *schema.Ref `protobuf:"bytes,1,opt,name=verb,proto3" json:"verb,omitempty"`
+	DeploymentKey string      `protobuf:"bytes,2,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
+	Schedule      string      `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// "idle" or "executing".
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	NextExecution *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=next_execution,json=nextExecution,proto3" json:"next_execution,omitempty"`
+	// Unset if the job has not executed in the current deployment.
+	LastExecution *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_execution,json=lastExecution,proto3,oneof" json:"last_execution,omitempty"`
+	// Set if the job has been paused.
+	PausedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=paused_at,json=pausedAt,proto3,oneof" json:"paused_at,omitempty"`
+	// The IANA timezone the schedule is evaluated in.
+	Timezone string `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// What happens to missed executions: "skip", "once" or "all".
+	MissedRuns string `protobuf:"bytes,9,opt,name=missed_runs,json=missedRuns,proto3" json:"missed_runs,omitempty"`
+}
+
+func (x *CronJob) Reset() {
+	*x = CronJob{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[72]
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatusResponse_Runner) String() string {
+func (x *CronJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusResponse_Runner) ProtoMessage() {}
+func (*CronJob) ProtoMessage() {}
 
-func (x *StatusResponse_Runner) ProtoReflect() protoreflect.Message {
-	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[72]
+func (x *CronJob) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3920,84 +4416,97 @@ func (x *StatusResponse_Runner) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusResponse_Runner.ProtoReflect.Descriptor instead.
-func (*StatusResponse_Runner) Descriptor() ([]byte, []int) {
-	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{37, 1}
+// Deprecated: Use CronJob.ProtoReflect.Descriptor instead.
+func (*CronJob) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *StatusResponse_Runner) GetKey() string {
+func (x *CronJob) GetVerb() *schema.Ref {
 	if x != nil {
-		return x.Key
+		return x.Verb
 	}
-	return ""
+	return nil
 }
 
-func (x *StatusResponse_Runner) GetLanguages() []string {
+func (x *CronJob) GetDeploymentKey() string {
 	if x != nil {
-		return x.Languages
+		return x.DeploymentKey
 	}
-	return nil
+	return ""
 }
 
-func (x *StatusResponse_Runner) GetEndpoint() string {
+func (x *CronJob) GetSchedule() string {
 	if x != nil {
-		return x.Endpoint
+		return x.Schedule
 	}
 	return ""
 }
 
-func (x *StatusResponse_Runner) GetState() RunnerState {
+func (x *CronJob) GetState() string {
 	if x != nil {
 		return x.State
 	}
-	return RunnerState_RUNNER_IDLE
+	return ""
 }
 
-func (x *StatusResponse_Runner) GetDeployment() string {
-	if x != nil && x.Deployment != nil {
-		return *x.Deployment
+func (x *CronJob) GetNextExecution() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextExecution
 	}
-	return ""
+	return nil
 }
 
-func (x *StatusResponse_Runner) GetLabels() *structpb.Struct {
+func (x *CronJob) GetLastExecution() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Labels
+		return x.LastExecution
 	}
 	return nil
 }
 
-type StatusResponse_Deployment struct {
+func (x *CronJob) GetPausedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PausedAt
+	}
+	return nil
+}
+
+func (x *CronJob) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CronJob) GetMissedRuns() string {
+	if x != nil {
+		return x.MissedRuns
+	}
+	return ""
+}
+
+type ListCronJobsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Key         string           `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Language    string           `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
-	Name        string           `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	MinReplicas int32            `protobuf:"varint,4,opt,name=min_replicas,json=minReplicas,proto3" json:"min_replicas,omitempty"`
-	Replicas    int32            `protobuf:"varint,7,opt,name=replicas,proto3" json:"replicas,omitempty"`
-	Labels      *structpb.Struct `protobuf:"bytes,5,opt,name=labels,proto3" json:"labels,omitempty"`
-	Schema      *schema.Module   `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
 }
 
-func (x *StatusResponse_Deployment) Reset() {
-	*x = StatusResponse_Deployment{}
+func (x *ListCronJobsRequest) Reset() {
+	*x = ListCronJobsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[73]
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatusResponse_Deployment) String() string {
+func (x *ListCronJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusResponse_Deployment) ProtoMessage() {}
+func (*ListCronJobsRequest) ProtoMessage() {}
 
-func (x *StatusResponse_Deployment) ProtoReflect() protoreflect.Message {
-	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[73]
+func (x *ListCronJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4008,88 +4517,83 @@ func (x *StatusResponse_Deployment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusResponse_Deployment.ProtoReflect.Descriptor instead.
-func (*StatusResponse_Deployment) Descriptor() ([]byte, []int) {
-	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{37, 2}
+// Deprecated: Use ListCronJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListCronJobsRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *StatusResponse_Deployment) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
+type ListCronJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs []*CronJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
 }
 
-func (x *StatusResponse_Deployment) GetLanguage() string {
-	if x != nil {
-		return x.Language
+func (x *ListCronJobsResponse) Reset() {
+	*x = ListCronJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *StatusResponse_Deployment) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *ListCronJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StatusResponse_Deployment) GetMinReplicas() int32 {
-	if x != nil {
-		return x.MinReplicas
+func (*ListCronJobsResponse) ProtoMessage() {}
+
+func (x *ListCronJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *StatusResponse_Deployment) GetReplicas() int32 {
-	if x != nil {
-		return x.Replicas
-	}
-	return 0
+// Deprecated: Use ListCronJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListCronJobsResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *StatusResponse_Deployment) GetLabels() *structpb.Struct {
+func (x *ListCronJobsResponse) GetJobs() []*CronJob {
 	if x != nil {
-		return x.Labels
+		return x.Jobs
 	}
 	return nil
 }
 
-func (x *StatusResponse_Deployment) GetSchema() *schema.Module {
-	if x != nil {
-		return x.Schema
-	}
-	return nil
-}
-
-type StatusResponse_IngressRoute struct {
+type TriggerCronJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeploymentKey string      `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
-	Verb          *schema.Ref `protobuf:"bytes,2,opt,name=verb,proto3" json:"verb,omitempty"`
-	Method        string      `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
-	Path          string      `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Verb *schema.Ref `protobuf:"bytes,1,opt,name=verb,proto3" json:"verb,omitempty"`
 }
 
-func (x *StatusResponse_IngressRoute) Reset() {
-	*x = StatusResponse_IngressRoute{}
+func (x *TriggerCronJobRequest) Reset() {
+	*x = TriggerCronJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[74]
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatusResponse_IngressRoute) String() string {
+func (x *TriggerCronJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusResponse_IngressRoute) ProtoMessage() {}
+func (*TriggerCronJobRequest) ProtoMessage() {}
 
-func (x *StatusResponse_IngressRoute) ProtoReflect() protoreflect.Message {
-	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[74]
+func (x *TriggerCronJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4100,67 +4604,81 @@ func (x *StatusResponse_IngressRoute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusResponse_IngressRoute.ProtoReflect.Descriptor instead.
-func (*StatusResponse_IngressRoute) Descriptor() ([]byte, []int) {
-	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{37, 3}
-}
-
-func (x *StatusResponse_IngressRoute) GetDeploymentKey() string {
-	if x != nil {
-		return x.DeploymentKey
-	}
-	return ""
+// Deprecated: Use TriggerCronJobRequest.ProtoReflect.Descriptor instead.
+func (*TriggerCronJobRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *StatusResponse_IngressRoute) GetVerb() *schema.Ref {
+func (x *TriggerCronJobRequest) GetVerb() *schema.Ref {
 	if x != nil {
 		return x.Verb
 	}
 	return nil
 }
 
-func (x *StatusResponse_IngressRoute) GetMethod() string {
-	if x != nil {
-		return x.Method
+type TriggerCronJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TriggerCronJobResponse) Reset() {
+	*x = TriggerCronJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *StatusResponse_IngressRoute) GetPath() string {
-	if x != nil {
-		return x.Path
+func (x *TriggerCronJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerCronJobResponse) ProtoMessage() {}
+
+func (x *TriggerCronJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type StatusResponse_Route struct {
+// Deprecated: Use TriggerCronJobResponse.ProtoReflect.Descriptor instead.
+func (*TriggerCronJobResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{73}
+}
+
+type PauseCronJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Module     string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
-	Runner     string `protobuf:"bytes,2,opt,name=runner,proto3" json:"runner,omitempty"`
-	Deployment string `protobuf:"bytes,3,opt,name=deployment,proto3" json:"deployment,omitempty"`
-	Endpoint   string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Verb *schema.Ref `protobuf:"bytes,1,opt,name=verb,proto3" json:"verb,omitempty"`
 }
 
-func (x *StatusResponse_Route) Reset() {
-	*x = StatusResponse_Route{}
+func (x *PauseCronJobRequest) Reset() {
+	*x = PauseCronJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[75]
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatusResponse_Route) String() string {
+func (x *PauseCronJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusResponse_Route) ProtoMessage() {}
+func (*PauseCronJobRequest) ProtoMessage() {}
 
-func (x *StatusResponse_Route) ProtoReflect() protoreflect.Message {
-	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[75]
+func (x *PauseCronJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4171,51 +4689,66 @@ func (x *StatusResponse_Route) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusResponse_Route.ProtoReflect.Descriptor instead.
-func (*StatusResponse_Route) Descriptor() ([]byte, []int) {
-	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{37, 4}
+// Deprecated: Use PauseCronJobRequest.ProtoReflect.Descriptor instead.
+func (*PauseCronJobRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *StatusResponse_Route) GetModule() string {
+func (x *PauseCronJobRequest) GetVerb() *schema.Ref {
 	if x != nil {
-		return x.Module
+		return x.Verb
 	}
-	return ""
+	return nil
 }
 
-func (x *StatusResponse_Route) GetRunner() string {
-	if x != nil {
-		return x.Runner
-	}
-	return ""
+type PauseCronJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *StatusResponse_Route) GetDeployment() string {
-	if x != nil {
-		return x.Deployment
+func (x *PauseCronJobResponse) Reset() {
+	*x = PauseCronJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *StatusResponse_Route) GetEndpoint() string {
-	if x != nil {
-		return x.Endpoint
+func (x *PauseCronJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseCronJobResponse) ProtoMessage() {}
+
+func (x *PauseCronJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type ProcessListResponse_ProcessRunner struct {
+// Deprecated: Use PauseCronJobResponse.ProtoReflect.Descriptor instead.
+func (*PauseCronJobResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{75}
+}
+
+type ResumeCronJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key      string           `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Endpoint string           `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	Labels   *structpb.Struct `protobuf:"bytes,3,opt,name=labels,proto3" json:"labels,omitempty"`
+	Verb *schema.Ref `protobuf:"bytes,1,opt,name=verb,proto3" json:"verb,omitempty"`
 }
 
-func (x *ProcessListResponse_ProcessRunner) Reset() {
-	*x = ProcessListResponse_ProcessRunner{}
+func (x *ResumeCronJobRequest) Reset() {
+	*x = ResumeCronJobRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4223,13 +4756,13 @@ func (x *ProcessListResponse_ProcessRunner) Reset() {
 	}
 }
 
-func (x *ProcessListResponse_ProcessRunner) String() string {
+func (x *ResumeCronJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessListResponse_ProcessRunner) ProtoMessage() {}
+func (*ResumeCronJobRequest) ProtoMessage() {}
 
-func (x *ProcessListResponse_ProcessRunner) ProtoReflect() protoreflect.Message {
+func (x *ResumeCronJobRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4241,45 +4774,26 @@ func (x *ProcessListResponse_ProcessRunner) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessListResponse_ProcessRunner.ProtoReflect.Descriptor instead.
-func (*ProcessListResponse_ProcessRunner) Descriptor() ([]byte, []int) {
-	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{39, 0}
-}
-
-func (x *ProcessListResponse_ProcessRunner) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-func (x *ProcessListResponse_ProcessRunner) GetEndpoint() string {
-	if x != nil {
-		return x.Endpoint
-	}
-	return ""
+// Deprecated: Use ResumeCronJobRequest.ProtoReflect.Descriptor instead.
+func (*ResumeCronJobRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ProcessListResponse_ProcessRunner) GetLabels() *structpb.Struct {
+func (x *ResumeCronJobRequest) GetVerb() *schema.Ref {
 	if x != nil {
-		return x.Labels
+		return x.Verb
 	}
 	return nil
 }
 
-type ProcessListResponse_Process struct {
+type ResumeCronJobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Deployment  string                             `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
-	MinReplicas int32                              `protobuf:"varint,2,opt,name=min_replicas,json=minReplicas,proto3" json:"min_replicas,omitempty"`
-	Labels      *structpb.Struct                   `protobuf:"bytes,3,opt,name=labels,proto3" json:"labels,omitempty"`
-	Runner      *ProcessListResponse_ProcessRunner `protobuf:"bytes,4,opt,name=runner,proto3,oneof" json:"runner,omitempty"`
 }
 
-func (x *ProcessListResponse_Process) Reset() {
-	*x = ProcessListResponse_Process{}
+func (x *ResumeCronJobResponse) Reset() {
+	*x = ResumeCronJobResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4287,13 +4801,13 @@ func (x *ProcessListResponse_Process) Reset() {
 	}
 }
 
-func (x *ProcessListResponse_Process) String() string {
+func (x *ResumeCronJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessListResponse_Process) ProtoMessage() {}
+func (*ResumeCronJobResponse) ProtoMessage() {}
 
-func (x *ProcessListResponse_Process) ProtoReflect() protoreflect.Message {
+func (x *ResumeCronJobResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4305,50 +4819,21 @@ func (x *ProcessListResponse_Process) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessListResponse_Process.ProtoReflect.Descriptor instead.
-func (*ProcessListResponse_Process) Descriptor() ([]byte, []int) {
-	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{39, 1}
-}
-
-func (x *ProcessListResponse_Process) GetDeployment() string {
-	if x != nil {
-		return x.Deployment
-	}
-	return ""
-}
-
-func (x *ProcessListResponse_Process) GetMinReplicas() int32 {
-	if x != nil {
-		return x.MinReplicas
-	}
-	return 0
-}
-
-func (x *ProcessListResponse_Process) GetLabels() *structpb.Struct {
-	if x != nil {
-		return x.Labels
-	}
-	return nil
-}
-
-func (x *ProcessListResponse_Process) GetRunner() *ProcessListResponse_ProcessRunner {
-	if x != nil {
-		return x.Runner
-	}
-	return nil
+// Deprecated: Use ResumeCronJobResponse.ProtoReflect.Descriptor instead.
+func (*ResumeCronJobResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{77}
 }
 
-type ListConfigResponse_Config struct {
+type DeployRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RefPath string `protobuf:"bytes,1,opt,name=refPath,proto3" json:"refPath,omitempty"`
-	Value   []byte `protobuf:"bytes,2,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	DeploymentKey string `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
 }
 
-func (x *ListConfigResponse_Config) Reset() {
-	*x = ListConfigResponse_Config{}
+func (x *DeployRequest) Reset() {
+	*x = DeployRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4356,13 +4841,13 @@ func (x *ListConfigResponse_Config) Reset() {
 	}
 }
 
-func (x *ListConfigResponse_Config) String() string {
+func (x *DeployRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListConfigResponse_Config) ProtoMessage() {}
+func (*DeployRequest) ProtoMessage() {}
 
-func (x *ListConfigResponse_Config) ProtoReflect() protoreflect.Message {
+func (x *DeployRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4374,36 +4859,26 @@ func (x *ListConfigResponse_Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListConfigResponse_Config.ProtoReflect.Descriptor instead.
-func (*ListConfigResponse_Config) Descriptor() ([]byte, []int) {
-	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{49, 0}
+// Deprecated: Use DeployRequest.ProtoReflect.Descriptor instead.
+func (*DeployRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *ListConfigResponse_Config) GetRefPath() string {
+func (x *DeployRequest) GetDeploymentKey() string {
 	if x != nil {
-		return x.RefPath
+		return x.DeploymentKey
 	}
 	return ""
 }
 
-func (x *ListConfigResponse_Config) GetValue() []byte {
-	if x != nil {
-		return x.Value
-	}
-	return nil
-}
-
-type ListSecretsResponse_Secret struct {
+type DeployResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	RefPath string `protobuf:"bytes,1,opt,name=refPath,proto3" json:"refPath,omitempty"`
-	Value   []byte `protobuf:"bytes,2,opt,name=value,proto3,oneof" json:"value,omitempty"`
 }
 
-func (x *ListSecretsResponse_Secret) Reset() {
-	*x = ListSecretsResponse_Secret{}
+func (x *DeployResponse) Reset() {
+	*x = DeployResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4411,13 +4886,13 @@ func (x *ListSecretsResponse_Secret) Reset() {
 	}
 }
 
-func (x *ListSecretsResponse_Secret) String() string {
+func (x *DeployResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSecretsResponse_Secret) ProtoMessage() {}
+func (*DeployResponse) ProtoMessage() {}
 
-func (x *ListSecretsResponse_Secret) ProtoReflect() protoreflect.Message {
+func (x *DeployResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4429,26 +4904,1917 @@ func (x *ListSecretsResponse_Secret) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSecretsResponse_Secret.ProtoReflect.Descriptor instead.
-func (*ListSecretsResponse_Secret) Descriptor() ([]byte, []int) {
-	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{57, 0}
+// Deprecated: Use DeployResponse.ProtoReflect.Descriptor instead.
+func (*DeployResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *ListSecretsResponse_Secret) GetRefPath() string {
-	if x != nil {
-		return x.RefPath
-	}
-	return ""
+type TerminateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeploymentKey string `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
 }
 
-func (x *ListSecretsResponse_Secret) GetValue() []byte {
-	if x != nil {
-		return x.Value
+func (x *TerminateRequest) Reset() {
+	*x = TerminateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-var File_xyz_block_ftl_v1_ftl_proto protoreflect.FileDescriptor
+func (x *TerminateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateRequest) ProtoMessage() {}
+
+func (x *TerminateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateRequest.ProtoReflect.Descriptor instead.
+func (*TerminateRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *TerminateRequest) GetDeploymentKey() string {
+	if x != nil {
+		return x.DeploymentKey
+	}
+	return ""
+}
+
+type ReserveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeploymentKey string `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
+}
+
+func (x *ReserveRequest) Reset() {
+	*x = ReserveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReserveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveRequest) ProtoMessage() {}
+
+func (x *ReserveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveRequest.ProtoReflect.Descriptor instead.
+func (*ReserveRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ReserveRequest) GetDeploymentKey() string {
+	if x != nil {
+		return x.DeploymentKey
+	}
+	return ""
+}
+
+type ReserveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReserveResponse) Reset() {
+	*x = ReserveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReserveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveResponse) ProtoMessage() {}
+
+func (x *ReserveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveResponse.ProtoReflect.Descriptor instead.
+func (*ReserveResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{82}
+}
+
+type ConfigRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module *string `protobuf:"bytes,1,opt,name=module,proto3,oneof" json:"module,omitempty"`
+	Name   string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ConfigRef) Reset() {
+	*x = ConfigRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigRef) ProtoMessage() {}
+
+func (x *ConfigRef) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigRef.ProtoReflect.Descriptor instead.
+func (*ConfigRef) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ConfigRef) GetModule() string {
+	if x != nil && x.Module != nil {
+		return *x.Module
+	}
+	return ""
+}
+
+func (x *ConfigRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module        *string         `protobuf:"bytes,1,opt,name=module,proto3,oneof" json:"module,omitempty"`
+	IncludeValues *bool           `protobuf:"varint,2,opt,name=include_values,json=includeValues,proto3,oneof" json:"include_values,omitempty"`
+	Provider      *ConfigProvider `protobuf:"varint,3,opt,name=provider,proto3,enum=xyz.block.ftl.v1.ConfigProvider,oneof" json:"provider,omitempty"`
+}
+
+func (x *ListConfigRequest) Reset() {
+	*x = ListConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigRequest) ProtoMessage() {}
+
+func (x *ListConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigRequest.ProtoReflect.Descriptor instead.
+func (*ListConfigRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListConfigRequest) GetModule() string {
+	if x != nil && x.Module != nil {
+		return *x.Module
+	}
+	return ""
+}
+
+func (x *ListConfigRequest) GetIncludeValues() bool {
+	if x != nil && x.IncludeValues != nil {
+		return *x.IncludeValues
+	}
+	return false
+}
+
+func (x *ListConfigRequest) GetProvider() ConfigProvider {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
+	}
+	return ConfigProvider_CONFIG_INLINE
+}
+
+type ListConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Configs []*ListConfigResponse_Config `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+}
+
+func (x *ListConfigResponse) Reset() {
+	*x = ListConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigResponse) ProtoMessage() {}
+
+func (x *ListConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigResponse.ProtoReflect.Descriptor instead.
+func (*ListConfigResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListConfigResponse) GetConfigs() []*ListConfigResponse_Config {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type GetConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ref *ConfigRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+}
+
+func (x *GetConfigRequest) Reset() {
+	*x = GetConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigRequest) ProtoMessage() {}
+
+func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetConfigRequest) GetRef() *ConfigRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+type GetConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *GetConfigResponse) Reset() {
+	*x = GetConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigResponse) ProtoMessage() {}
+
+func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetConfigResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type SetConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider *ConfigProvider `protobuf:"varint,1,opt,name=provider,proto3,enum=xyz.block.ftl.v1.ConfigProvider,oneof" json:"provider,omitempty"`
+	Ref      *ConfigRef      `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	Value    []byte          `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SetConfigRequest) Reset() {
+	*x = SetConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConfigRequest) ProtoMessage() {}
+
+func (x *SetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SetConfigRequest) GetProvider() ConfigProvider {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
+	}
+	return ConfigProvider_CONFIG_INLINE
+}
+
+func (x *SetConfigRequest) GetRef() *ConfigRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *SetConfigRequest) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type SetConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetConfigResponse) Reset() {
+	*x = SetConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConfigResponse) ProtoMessage() {}
+
+func (x *SetConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConfigResponse.ProtoReflect.Descriptor instead.
+func (*SetConfigResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{89}
+}
+
+type UnsetConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider *ConfigProvider `protobuf:"varint,1,opt,name=provider,proto3,enum=xyz.block.ftl.v1.ConfigProvider,oneof" json:"provider,omitempty"`
+	Ref      *ConfigRef      `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+}
+
+func (x *UnsetConfigRequest) Reset() {
+	*x = UnsetConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsetConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsetConfigRequest) ProtoMessage() {}
+
+func (x *UnsetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsetConfigRequest.ProtoReflect.Descriptor instead.
+func (*UnsetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *UnsetConfigRequest) GetProvider() ConfigProvider {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
+	}
+	return ConfigProvider_CONFIG_INLINE
+}
+
+func (x *UnsetConfigRequest) GetRef() *ConfigRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+type UnsetConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnsetConfigResponse) Reset() {
+	*x = UnsetConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsetConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsetConfigResponse) ProtoMessage() {}
+
+func (x *UnsetConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsetConfigResponse.ProtoReflect.Descriptor instead.
+func (*UnsetConfigResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{91}
+}
+
+type ListSecretsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module        *string         `protobuf:"bytes,1,opt,name=module,proto3,oneof" json:"module,omitempty"`
+	IncludeValues *bool           `protobuf:"varint,2,opt,name=include_values,json=includeValues,proto3,oneof" json:"include_values,omitempty"`
+	Provider      *SecretProvider `protobuf:"varint,3,opt,name=provider,proto3,enum=xyz.block.ftl.v1.SecretProvider,oneof" json:"provider,omitempty"`
+}
+
+func (x *ListSecretsRequest) Reset() {
+	*x = ListSecretsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsRequest) ProtoMessage() {}
+
+func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListSecretsRequest) GetModule() string {
+	if x != nil && x.Module != nil {
+		return *x.Module
+	}
+	return ""
+}
+
+func (x *ListSecretsRequest) GetIncludeValues() bool {
+	if x != nil && x.IncludeValues != nil {
+		return *x.IncludeValues
+	}
+	return false
+}
+
+func (x *ListSecretsRequest) GetProvider() SecretProvider {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
+	}
+	return SecretProvider_SECRET_INLINE
+}
+
+type ListSecretsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secrets []*ListSecretsResponse_Secret `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+}
+
+func (x *ListSecretsResponse) Reset() {
+	*x = ListSecretsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSecretsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsResponse) ProtoMessage() {}
+
+func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsResponse.ProtoReflect.Descriptor instead.
+func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListSecretsResponse) GetSecrets() []*ListSecretsResponse_Secret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+type GetSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ref *ConfigRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+}
+
+func (x *GetSecretRequest) Reset() {
+	*x = GetSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretRequest) ProtoMessage() {}
+
+func (x *GetSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetSecretRequest) GetRef() *ConfigRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+type GetSecretResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *GetSecretResponse) Reset() {
+	*x = GetSecretResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretResponse) ProtoMessage() {}
+
+func (x *GetSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretResponse.ProtoReflect.Descriptor instead.
+func (*GetSecretResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetSecretResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type SetSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider *SecretProvider `protobuf:"varint,1,opt,name=provider,proto3,enum=xyz.block.ftl.v1.SecretProvider,oneof" json:"provider,omitempty"`
+	Ref      *ConfigRef      `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	Value    []byte          `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SetSecretRequest) Reset() {
+	*x = SetSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSecretRequest) ProtoMessage() {}
+
+func (x *SetSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSecretRequest.ProtoReflect.Descriptor instead.
+func (*SetSecretRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SetSecretRequest) GetProvider() SecretProvider {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
+	}
+	return SecretProvider_SECRET_INLINE
+}
+
+func (x *SetSecretRequest) GetRef() *ConfigRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *SetSecretRequest) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type SetSecretResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetSecretResponse) Reset() {
+	*x = SetSecretResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSecretResponse) ProtoMessage() {}
+
+func (x *SetSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSecretResponse.ProtoReflect.Descriptor instead.
+func (*SetSecretResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{97}
+}
+
+type UnsetSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider *SecretProvider `protobuf:"varint,1,opt,name=provider,proto3,enum=xyz.block.ftl.v1.SecretProvider,oneof" json:"provider,omitempty"`
+	Ref      *ConfigRef      `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+}
+
+func (x *UnsetSecretRequest) Reset() {
+	*x = UnsetSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsetSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsetSecretRequest) ProtoMessage() {}
+
+func (x *UnsetSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsetSecretRequest.ProtoReflect.Descriptor instead.
+func (*UnsetSecretRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *UnsetSecretRequest) GetProvider() SecretProvider {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
+	}
+	return SecretProvider_SECRET_INLINE
+}
+
+func (x *UnsetSecretRequest) GetRef() *ConfigRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+type UnsetSecretResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnsetSecretResponse) Reset() {
+	*x = UnsetSecretResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsetSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsetSecretResponse) ProtoMessage() {}
+
+func (x *UnsetSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsetSecretResponse.ProtoReflect.Descriptor instead.
+func (*UnsetSecretResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{99}
+}
+
+type ModuleContextResponse_Ref struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module *string `protobuf:"bytes,1,opt,name=module,proto3,oneof" json:"module,omitempty"`
+	Name   string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ModuleContextResponse_Ref) Reset() {
+	*x = ModuleContextResponse_Ref{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModuleContextResponse_Ref) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleContextResponse_Ref) ProtoMessage() {}
+
+func (x *ModuleContextResponse_Ref) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleContextResponse_Ref.ProtoReflect.Descriptor instead.
+func (*ModuleContextResponse_Ref) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *ModuleContextResponse_Ref) GetModule() string {
+	if x != nil && x.Module != nil {
+		return *x.Module
+	}
+	return ""
+}
+
+func (x *ModuleContextResponse_Ref) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ModuleContextResponse_DSN struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type ModuleContextResponse_DBType `protobuf:"varint,2,opt,name=type,proto3,enum=xyz.block.ftl.v1.ModuleContextResponse_DBType" json:"type,omitempty"`
+	Dsn  string                       `protobuf:"bytes,3,opt,name=dsn,proto3" json:"dsn,omitempty"`
+}
+
+func (x *ModuleContextResponse_DSN) Reset() {
+	*x = ModuleContextResponse_DSN{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModuleContextResponse_DSN) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleContextResponse_DSN) ProtoMessage() {}
+
+func (x *ModuleContextResponse_DSN) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleContextResponse_DSN.ProtoReflect.Descriptor instead.
+func (*ModuleContextResponse_DSN) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *ModuleContextResponse_DSN) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ModuleContextResponse_DSN) GetType() ModuleContextResponse_DBType {
+	if x != nil {
+		return x.Type
+	}
+	return ModuleContextResponse_POSTGRES
+}
+
+func (x *ModuleContextResponse_DSN) GetDsn() string {
+	if x != nil {
+		return x.Dsn
+	}
+	return ""
+}
+
+type Metadata_Pair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Metadata_Pair) Reset() {
+	*x = Metadata_Pair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Metadata_Pair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metadata_Pair) ProtoMessage() {}
+
+func (x *Metadata_Pair) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metadata_Pair.ProtoReflect.Descriptor instead.
+func (*Metadata_Pair) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *Metadata_Pair) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Metadata_Pair) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type CallResponse_Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string  `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Stack   *string `protobuf:"bytes,2,opt,name=stack,proto3,oneof" json:"stack,omitempty"` // TODO: Richer error type.
+}
+
+func (x *CallResponse_Error) Reset() {
+	*x = CallResponse_Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CallResponse_Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallResponse_Error) ProtoMessage() {}
+
+func (x *CallResponse_Error) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallResponse_Error.ProtoReflect.Descriptor instead.
+func (*CallResponse_Error) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *CallResponse_Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CallResponse_Error) GetStack() string {
+	if x != nil && x.Stack != nil {
+		return *x.Stack
+	}
+	return ""
+}
+
+type StatusResponse_Controller struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Version  string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *StatusResponse_Controller) Reset() {
+	*x = StatusResponse_Controller{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse_Controller) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse_Controller) ProtoMessage() {}
+
+func (x *StatusResponse_Controller) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse_Controller.ProtoReflect.Descriptor instead.
+func (*StatusResponse_Controller) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{44, 0}
+}
+
+func (x *StatusResponse_Controller) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StatusResponse_Controller) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *StatusResponse_Controller) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type StatusResponse_Runner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key        string           `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Languages  []string         `protobuf:"bytes,2,rep,name=languages,proto3" json:"languages,omitempty"`
+	Endpoint   string           `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	State      RunnerState      `protobuf:"varint,4,opt,name=state,proto3,enum=xyz.block.ftl.v1.RunnerState" json:"state,omitempty"`
+	Deployment *string          `protobuf:"bytes,5,opt,name=deployment,proto3,oneof" json:"deployment,omitempty"`
+	Labels     *structpb.Struct `protobuf:"bytes,6,opt,name=labels,proto3" json:"labels,omitempty"`
+}
+
+func (x *StatusResponse_Runner) Reset() {
+	*x = StatusResponse_Runner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse_Runner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse_Runner) ProtoMessage() {}
+
+func (x *StatusResponse_Runner) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse_Runner.ProtoReflect.Descriptor instead.
+func (*StatusResponse_Runner) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{44, 1}
+}
+
+func (x *StatusResponse_Runner) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StatusResponse_Runner) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *StatusResponse_Runner) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *StatusResponse_Runner) GetState() RunnerState {
+	if x != nil {
+		return x.State
+	}
+	return RunnerState_RUNNER_IDLE
+}
+
+func (x *StatusResponse_Runner) GetDeployment() string {
+	if x != nil && x.Deployment != nil {
+		return *x.Deployment
+	}
+	return ""
+}
+
+func (x *StatusResponse_Runner) GetLabels() *structpb.Struct {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type StatusResponse_Deployment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key         string           `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Language    string           `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	Name        string           `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	MinReplicas int32            `protobuf:"varint,4,opt,name=min_replicas,json=minReplicas,proto3" json:"min_replicas,omitempty"`
+	Replicas    int32            `protobuf:"varint,7,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	Labels      *structpb.Struct `protobuf:"bytes,5,opt,name=labels,proto3" json:"labels,omitempty"`
+	Schema      *schema.Module   `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (x *StatusResponse_Deployment) Reset() {
+	*x = StatusResponse_Deployment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse_Deployment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse_Deployment) ProtoMessage() {}
+
+func (x *StatusResponse_Deployment) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse_Deployment.ProtoReflect.Descriptor instead.
+func (*StatusResponse_Deployment) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{44, 2}
+}
+
+func (x *StatusResponse_Deployment) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StatusResponse_Deployment) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *StatusResponse_Deployment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatusResponse_Deployment) GetMinReplicas() int32 {
+	if x != nil {
+		return x.MinReplicas
+	}
+	return 0
+}
+
+func (x *StatusResponse_Deployment) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *StatusResponse_Deployment) GetLabels() *structpb.Struct {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *StatusResponse_Deployment) GetSchema() *schema.Module {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+type StatusResponse_IngressRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeploymentKey string      `protobuf:"bytes,1,opt,name=deployment_key,json=deploymentKey,proto3" json:"deployment_key,omitempty"`
+	Verb          *schema.Ref `protobuf:"bytes,2,opt,name=verb,proto3" json:"verb,omitempty"`
+	Method        string      `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Path          string      `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *StatusResponse_IngressRoute) Reset() {
+	*x = StatusResponse_IngressRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse_IngressRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse_IngressRoute) ProtoMessage() {}
+
+func (x *StatusResponse_IngressRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse_IngressRoute.ProtoReflect.Descriptor instead.
+func (*StatusResponse_IngressRoute) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{44, 3}
+}
+
+func (x *StatusResponse_IngressRoute) GetDeploymentKey() string {
+	if x != nil {
+		return x.DeploymentKey
+	}
+	return ""
+}
+
+func (x *StatusResponse_IngressRoute) GetVerb() *schema.Ref {
+	if x != nil {
+		return x.Verb
+	}
+	return nil
+}
+
+func (x *StatusResponse_IngressRoute) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *StatusResponse_IngressRoute) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type StatusResponse_Route struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module     string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Runner     string `protobuf:"bytes,2,opt,name=runner,proto3" json:"runner,omitempty"`
+	Deployment string `protobuf:"bytes,3,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	Endpoint   string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+}
+
+func (x *StatusResponse_Route) Reset() {
+	*x = StatusResponse_Route{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse_Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse_Route) ProtoMessage() {}
+
+func (x *StatusResponse_Route) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse_Route.ProtoReflect.Descriptor instead.
+func (*StatusResponse_Route) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{44, 4}
+}
+
+func (x *StatusResponse_Route) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *StatusResponse_Route) GetRunner() string {
+	if x != nil {
+		return x.Runner
+	}
+	return ""
+}
+
+func (x *StatusResponse_Route) GetDeployment() string {
+	if x != nil {
+		return x.Deployment
+	}
+	return ""
+}
+
+func (x *StatusResponse_Route) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+type StatusResponse_CircuitBreaker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Verb                *schema.Ref         `protobuf:"bytes,1,opt,name=verb,proto3" json:"verb,omitempty"`
+	State               CircuitBreakerState `protobuf:"varint,2,opt,name=state,proto3,enum=xyz.block.ftl.v1.CircuitBreakerState" json:"state,omitempty"`
+	ConsecutiveFailures int32               `protobuf:"varint,3,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+}
+
+func (x *StatusResponse_CircuitBreaker) Reset() {
+	*x = StatusResponse_CircuitBreaker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse_CircuitBreaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse_CircuitBreaker) ProtoMessage() {}
+
+func (x *StatusResponse_CircuitBreaker) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse_CircuitBreaker.ProtoReflect.Descriptor instead.
+func (*StatusResponse_CircuitBreaker) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{44, 5}
+}
+
+func (x *StatusResponse_CircuitBreaker) GetVerb() *schema.Ref {
+	if x != nil {
+		return x.Verb
+	}
+	return nil
+}
+
+func (x *StatusResponse_CircuitBreaker) GetState() CircuitBreakerState {
+	if x != nil {
+		return x.State
+	}
+	return CircuitBreakerState_CIRCUIT_BREAKER_CLOSED
+}
+
+func (x *StatusResponse_CircuitBreaker) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+type ProcessListResponse_ProcessRunner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string           `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Endpoint string           `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Labels   *structpb.Struct `protobuf:"bytes,3,opt,name=labels,proto3" json:"labels,omitempty"`
+}
+
+func (x *ProcessListResponse_ProcessRunner) Reset() {
+	*x = ProcessListResponse_ProcessRunner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessListResponse_ProcessRunner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessListResponse_ProcessRunner) ProtoMessage() {}
+
+func (x *ProcessListResponse_ProcessRunner) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessListResponse_ProcessRunner.ProtoReflect.Descriptor instead.
+func (*ProcessListResponse_ProcessRunner) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{46, 0}
+}
+
+func (x *ProcessListResponse_ProcessRunner) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ProcessListResponse_ProcessRunner) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *ProcessListResponse_ProcessRunner) GetLabels() *structpb.Struct {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type ProcessListResponse_Process struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deployment  string                             `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	MinReplicas int32                              `protobuf:"varint,2,opt,name=min_replicas,json=minReplicas,proto3" json:"min_replicas,omitempty"`
+	Labels      *structpb.Struct                   `protobuf:"bytes,3,opt,name=labels,proto3" json:"labels,omitempty"`
+	Runner      *ProcessListResponse_ProcessRunner `protobuf:"bytes,4,opt,name=runner,proto3,oneof" json:"runner,omitempty"`
+}
+
+func (x *ProcessListResponse_Process) Reset() {
+	*x = ProcessListResponse_Process{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessListResponse_Process) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessListResponse_Process) ProtoMessage() {}
+
+func (x *ProcessListResponse_Process) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessListResponse_Process.ProtoReflect.Descriptor instead.
+func (*ProcessListResponse_Process) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{46, 1}
+}
+
+func (x *ProcessListResponse_Process) GetDeployment() string {
+	if x != nil {
+		return x.Deployment
+	}
+	return ""
+}
+
+func (x *ProcessListResponse_Process) GetMinReplicas() int32 {
+	if x != nil {
+		return x.MinReplicas
+	}
+	return 0
+}
+
+func (x *ProcessListResponse_Process) GetLabels() *structpb.Struct {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ProcessListResponse_Process) GetRunner() *ProcessListResponse_ProcessRunner {
+	if x != nil {
+		return x.Runner
+	}
+	return nil
+}
+
+type ListConfigResponse_Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefPath string `protobuf:"bytes,1,opt,name=refPath,proto3" json:"refPath,omitempty"`
+	Value   []byte `protobuf:"bytes,2,opt,name=value,proto3,oneof" json:"value,omitempty"`
+}
+
+func (x *ListConfigResponse_Config) Reset() {
+	*x = ListConfigResponse_Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConfigResponse_Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigResponse_Config) ProtoMessage() {}
+
+func (x *ListConfigResponse_Config) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigResponse_Config.ProtoReflect.Descriptor instead.
+func (*ListConfigResponse_Config) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{85, 0}
+}
+
+func (x *ListConfigResponse_Config) GetRefPath() string {
+	if x != nil {
+		return x.RefPath
+	}
+	return ""
+}
+
+func (x *ListConfigResponse_Config) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type ListSecretsResponse_Secret struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefPath string `protobuf:"bytes,1,opt,name=refPath,proto3" json:"refPath,omitempty"`
+	Value   []byte `protobuf:"bytes,2,opt,name=value,proto3,oneof" json:"value,omitempty"`
+}
+
+func (x *ListSecretsResponse_Secret) Reset() {
+	*x = ListSecretsResponse_Secret{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSecretsResponse_Secret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsResponse_Secret) ProtoMessage() {}
+
+func (x *ListSecretsResponse_Secret) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_ftl_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsResponse_Secret.ProtoReflect.Descriptor instead.
+func (*ListSecretsResponse_Secret) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP(), []int{93, 0}
+}
+
+func (x *ListSecretsResponse_Secret) GetRefPath() string {
+	if x != nil {
+		return x.RefPath
+	}
+	return ""
+}
+
+func (x *ListSecretsResponse_Secret) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+var File_xyz_block_ftl_v1_ftl_proto protoreflect.FileDescriptor
 
 var file_xyz_block_ftl_v1_ftl_proto_rawDesc = []byte{
 	0x0a, 0x1a, 0x78, 0x79, 0x7a, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x66, 0x74, 0x6c, 0x2f,
@@ -4515,7 +6881,7 @@ var file_xyz_block_ftl_v1_ftl_proto_rawDesc = []byte{
 	0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x2e, 0x0a, 0x04, 0x50, 0x61, 0x69, 0x72,
 	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
 	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x0b, 0x43, 0x61, 0x6c,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xcd, 0x01, 0x0a, 0x0b, 0x43, 0x61, 0x6c,
 	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
 	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x79, 0x7a,
 	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
@@ -4524,48 +6890,108 @@ var file_xyz_block_ftl_v1_ftl_proto_rawDesc = []byte{
 	0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76,
 	0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x04, 0x76, 0x65,
 	0x72, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0xb6, 0x01, 0x0a, 0x0c, 0x43, 0x61, 0x6c, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x3c, 0x0a,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x78,
+	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x2c, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0xb6, 0x01, 0x0a, 0x0c, 0x43, 0x61, 0x6c,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12,
+	0x3c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x46, 0x0a,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x19, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x73, 0x74, 0x61, 0x63, 0x6b, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x6c, 0x0a, 0x13, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22,
+	0x16, 0x0a, 0x14, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64,
+	0x46, 0x53, 0x4d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x03, 0x66, 0x73, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78,
 	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x46, 0x0a, 0x05, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74,
-	0x61, 0x63, 0x6b, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x6c, 0x0a, 0x13, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x16, 0x0a,
-	0x14, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x53,
-	0x4d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a,
-	0x03, 0x66, 0x73, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x03, 0x66, 0x73, 0x6d, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78, 0x79, 0x7a,
 	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x03, 0x66, 0x73, 0x6d, 0x12, 0x1a, 0x0a,
-	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f,
-	0x64, 0x79, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x53, 0x4d, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x13, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x32, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x05,
-	0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c,
-	0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65,
-	0x72, 0x22, 0x16, 0x0a, 0x14, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x68, 0x65, 0x6d, 0x61, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x62, 0x6f, 0x64, 0x79, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x53, 0x4d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xad, 0x01, 0x0a,
+	0x16, 0x53, 0x65, 0x74, 0x4e, 0x65, 0x78, 0x74, 0x46, 0x53, 0x4d, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x66, 0x73, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52,
+	0x65, 0x66, 0x52, 0x03, 0x66, 0x73, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
+	0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x19, 0x0a, 0x17,
+	0x53, 0x65, 0x74, 0x4e, 0x65, 0x78, 0x74, 0x46, 0x53, 0x4d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x32, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x05, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12,
+	0x28, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x16, 0x0a, 0x14, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x9b, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x6d, 0x69, 0x6e,
+	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x42, 0x61,
+	0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x3a, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x63,
+	0x6b, 0x6f, 0x66, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66,
+	0x66, 0x22, 0xfd, 0x01, 0x0a, 0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x61,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x76, 0x65, 0x72,
+	0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x04, 0x76, 0x65, 0x72, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12,
+	0x3d, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38,
+	0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x00, 0x52, 0x05,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6b, 0x65,
+	0x79, 0x22, 0x46, 0x0a, 0x14, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x61, 0x6c,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x22, 0x6e, 0x0a, 0x1b, 0x53, 0x65, 0x6e,
+	0x64, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x22, 0x1e, 0x0a, 0x1c, 0x53, 0x65, 0x6e,
+	0x64, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74,
 	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a,
 	0x11, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01,
@@ -4732,7 +7158,7 @@ var file_xyz_block_ftl_v1_ftl_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x72, 0x22, 0x1e, 0x0a, 0x1c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x70,
 	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0xd3, 0x09, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0xe4, 0x0b, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72,
 	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x78,
 	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
@@ -4756,371 +7182,765 @@ var file_xyz_block_ftl_v1_ftl_proto_rawDesc = []byte{
 	0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
 	0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76,
 	0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x54,
-	0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a,
-	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x1a, 0xee, 0x01, 0x0a, 0x06, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x78, 0x79, 0x7a,
-	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75,
-	0x6e, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x23, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x5a,
+	0x0a, 0x10, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x0f, 0x63, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x1a, 0x54, 0x0a, 0x0a, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x1a, 0xee, 0x01, 0x0a, 0x06, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0a,
+	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x1a, 0xf7, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
+	0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x1a, 0x93, 0x01, 0x0a, 0x0c,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x4b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x04, 0x76, 0x65, 0x72, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x52,
+	0x04, 0x76, 0x65, 0x72, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x1a, 0x73, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x1a, 0xb2, 0x01, 0x0a, 0x0e, 0x43, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x04, 0x76, 0x65, 0x72,
+	0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x04, 0x76, 0x65, 0x72, 0x62, 0x12, 0x3b, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x78, 0x79, 0x7a,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xaf, 0x03, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x09, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x78,
+	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x6e, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0xf7, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x69,
-	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0xda, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70,
 	0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x1a,
-	0x93, 0x01, 0x0a, 0x0c, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x04, 0x76, 0x65, 0x72, 0x62, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x50, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x72,
+	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x22, 0x5c, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x40, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
 	0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
-	0x52, 0x65, 0x66, 0x52, 0x04, 0x76, 0x65, 0x72, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x1a, 0x73, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x1e,
-	0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0xaf, 0x03, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x78, 0x79,
-	0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x6e, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0xda, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x50, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x72, 0x75,
-	0x6e, 0x6e, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x75, 0x6e, 0x6e,
-	0x65, 0x72, 0x22, 0x5c, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40,
-	0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x52, 0x65, 0x66, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcf,
+	0x01, 0x0a, 0x17, 0x53, 0x65, 0x65, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x0c,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x09,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x22, 0x56, 0x0a, 0x18, 0x53, 0x65, 0x65, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x72, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0xa6, 0x02, 0x0a, 0x0a, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x4b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
+	0x52, 0x65, 0x66, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x22, 0xaf, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0c,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
+	0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66,
+	0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x5a, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
+	0x0a, 0x0c, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x52, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x22,
+	0x8c, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0c,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
+	0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66,
+	0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x31,
+	0x0a, 0x19, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x8b, 0x01, 0x0a, 0x17, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
+	0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65,
+	0x66, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x20, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x88, 0x01,
+	0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x22,
+	0x30, 0x0a, 0x18, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0xdf, 0x03, 0x0a, 0x0b, 0x46, 0x53, 0x4d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x2e, 0x0a, 0x03, 0x66, 0x73, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x03, 0x66, 0x73,
+	0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x53, 0x4d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x46, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52,
+	0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x4e, 0x0a, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79,
+	0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x48, 0x01, 0x52, 0x10, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x14, 0x0a,
+	0x12, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x22, 0xf4, 0x01, 0x0a, 0x14, 0x46, 0x53, 0x4d, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x32, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79,
+	0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xf6, 0x01, 0x0a, 0x17, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x53, 0x4d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x66, 0x73, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65,
+	0x66, 0x52, 0x03, 0x66, 0x73, 0x6d, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x53, 0x4d, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x37, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x48, 0x01, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x57, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x53, 0x4d, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3b, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
+	0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x53, 0x4d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x63, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x46, 0x53, 0x4d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x66, 0x73, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
+	0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66,
+	0x52, 0x03, 0x66, 0x73, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x22, 0x95, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x53, 0x4d, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x08,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x53, 0x4d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x53, 0x4d, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xb7, 0x02, 0x0a, 0x0d, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x30, 0x0a, 0x04, 0x76,
+	0x65, 0x72, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x04, 0x76, 0x65, 0x72, 0x62, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x11, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x80, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x35, 0x0a, 0x04, 0x76, 0x65, 0x72, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52,
+	0x04, 0x76, 0x65, 0x72, 0x62, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x76, 0x65, 0x72, 0x62, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x53, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x43, 0x61, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0x60, 0x0a, 0x1a, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x61,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x76, 0x65, 0x72,
+	0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x04, 0x76, 0x65, 0x72, 0x62, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1d, 0x0a,
+	0x1b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbb, 0x03, 0x0a,
+	0x07, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x12, 0x30, 0x0a, 0x04, 0x76, 0x65, 0x72, 0x62,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x2e, 0x52, 0x65, 0x66, 0x52, 0x04, 0x76, 0x65, 0x72, 0x62, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x65,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61,
+	0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3c,
+	0x0a, 0x09, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52,
+	0x08, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d,
+	0x69, 0x73, 0x73, 0x65, 0x64, 0x52, 0x75, 0x6e, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x45, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x6a, 0x6f, 0x62,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x6f, 0x6e, 0x4a,
+	0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x49, 0x0a, 0x15, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x30, 0x0a, 0x04, 0x76, 0x65, 0x72, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x04, 0x76,
+	0x65, 0x72, 0x62, 0x22, 0x18, 0x0a, 0x16, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x72,
+	0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a,
+	0x13, 0x50, 0x61, 0x75, 0x73, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x76, 0x65, 0x72, 0x62, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
+	0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66,
+	0x52, 0x04, 0x76, 0x65, 0x72, 0x62, 0x22, 0x16, 0x0a, 0x14, 0x50, 0x61, 0x75, 0x73, 0x65, 0x43,
+	0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48,
+	0x0a, 0x14, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x76, 0x65, 0x72, 0x62, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
 	0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52,
-	0x65, 0x66, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a,
-	0x0d, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
-	0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x10, 0x54, 0x65, 0x72, 0x6d, 0x69,
-	0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64,
-	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b,
-	0x65, 0x79, 0x22, 0x37, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x52,
-	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47,
-	0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x66, 0x12, 0x1b, 0x0a, 0x06, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07,
-	0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
-	0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x01, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x02, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x22, 0xa4, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x78,
-	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x73, 0x1a, 0x47, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07,
-	0x72, 0x65, 0x66, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72,
-	0x65, 0x66, 0x50, 0x61, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01,
-	0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x41, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x78,
-	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x22, 0x29,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x10, 0x53, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41,
-	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x20, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x88, 0x01,
-	0x01, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x12, 0x55, 0x6e, 0x73,
-	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x41, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x20, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x88,
-	0x01, 0x01, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65,
-	0x66, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x15,
-	0x0a, 0x13, 0x55, 0x6e, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x01, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x02, 0x52, 0x08, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x22, 0xa6, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x07, 0x73,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x78,
+	0x65, 0x66, 0x52, 0x04, 0x76, 0x65, 0x72, 0x62, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x36, 0x0a, 0x0d, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x10, 0x54,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x37, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x22,
+	0x11, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x47, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x66, 0x12,
+	0x1b, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2a,
+	0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x78,
 	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x73, 0x1a, 0x47, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x72, 0x65, 0x66, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x72, 0x65, 0x66, 0x50, 0x61, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x88,
-	0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x41, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x22,
-	0x29, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x10, 0x53,
-	0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x41, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x20, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x88,
-	0x01, 0x01, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65,
-	0x66, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x12, 0x55, 0x6e,
-	0x73, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x41, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x20, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
-	0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x02,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0xa4, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x45, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x1a, 0x47, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x65, 0x66, 0x50, 0x61, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x41, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1b, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c,
 	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72,
-	0x65, 0x66, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22,
-	0x15, 0x0a, 0x13, 0x55, 0x6e, 0x73, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x5c, 0x0a, 0x14, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14,
-	0x0a, 0x10, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x44, 0x44,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45,
-	0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
-	0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
-	0x45, 0x44, 0x10, 0x02, 0x2a, 0x59, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x55, 0x4e, 0x4e, 0x45, 0x52, 0x5f, 0x49, 0x44,
-	0x4c, 0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x55, 0x4e, 0x4e, 0x45, 0x52, 0x5f, 0x52,
-	0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x55, 0x4e,
-	0x4e, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0f,
-	0x0a, 0x0b, 0x52, 0x55, 0x4e, 0x4e, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x10, 0x03, 0x2a,
-	0x44, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x49, 0x4e, 0x4c, 0x49,
-	0x4e, 0x45, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x45,
-	0x4e, 0x56, 0x41, 0x52, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
-	0x5f, 0x44, 0x42, 0x10, 0x02, 0x2a, 0x69, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45, 0x43, 0x52, 0x45,
-	0x54, 0x5f, 0x49, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45,
-	0x43, 0x52, 0x45, 0x54, 0x5f, 0x45, 0x4e, 0x56, 0x41, 0x52, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
-	0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10,
-	0x02, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x4f, 0x50, 0x10, 0x03,
-	0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x41, 0x53, 0x4d, 0x10, 0x04,
-	0x32, 0xa8, 0x04, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x4a, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x65, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x12, 0x26, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x30, 0x01, 0x12, 0x61, 0x0a, 0x0c, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x65,
-	0x61, 0x73, 0x65, 0x12, 0x25, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
-	0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x65,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x78, 0x79, 0x7a,
-	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x5d, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x53,
-	0x4d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x53,
-	0x4d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
-	0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x53, 0x4d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x78,
+	0x65, 0x66, 0x22, 0x29, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa7, 0x01,
+	0x0a, 0x10, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x41, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
+	0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x66, 0x52,
+	0x03, 0x72, 0x65, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x93, 0x01, 0x0a,
+	0x12, 0x55, 0x6e, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x66,
+	0x52, 0x03, 0x72, 0x65, 0x66, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x6e, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a,
+	0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x78, 0x79,
+	0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x02, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0xa6, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x46, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x07,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x1a, 0x47, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x66, 0x50, 0x61, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x41, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x66, 0x52, 0x03,
+	0x72, 0x65, 0x66, 0x22, 0x29, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa7,
+	0x01, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x66,
+	0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x93, 0x01,
+	0x0a, 0x12, 0x55, 0x6e, 0x73, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x6e, 0x73, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x5c, 0x0a, 0x14, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x45, 0x50, 0x4c,
+	0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x16, 0x0a, 0x12, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x59, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x6e,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x55, 0x4e, 0x4e, 0x45,
+	0x52, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x55, 0x4e, 0x4e,
+	0x45, 0x52, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a,
+	0x0f, 0x52, 0x55, 0x4e, 0x4e, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x55, 0x4e, 0x4e, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x41,
+	0x44, 0x10, 0x03, 0x2a, 0x6a, 0x0a, 0x13, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x49,
+	0x52, 0x43, 0x55, 0x49, 0x54, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x45, 0x52, 0x5f, 0x43, 0x4c,
+	0x4f, 0x53, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x49, 0x52, 0x43, 0x55, 0x49,
+	0x54, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01,
+	0x12, 0x1d, 0x0a, 0x19, 0x43, 0x49, 0x52, 0x43, 0x55, 0x49, 0x54, 0x5f, 0x42, 0x52, 0x45, 0x41,
+	0x4b, 0x45, 0x52, 0x5f, 0x48, 0x41, 0x4c, 0x46, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x02, 0x2a,
+	0xb1, 0x01, 0x0a, 0x09, 0x46, 0x53, 0x4d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
+	0x12, 0x46, 0x53, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x53, 0x4d, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x15, 0x0a, 0x11, 0x46, 0x53, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x53, 0x4d, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x45, 0x4e, 0x53, 0x41, 0x54, 0x49, 0x4e,
+	0x47, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x53, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x45, 0x4e, 0x53, 0x41, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12,
+	0x22, 0x0a, 0x1e, 0x46, 0x53, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f,
+	0x4d, 0x50, 0x45, 0x4e, 0x53, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x05, 0x2a, 0x44, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f,
+	0x49, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4f, 0x4e, 0x46,
+	0x49, 0x47, 0x5f, 0x45, 0x4e, 0x56, 0x41, 0x52, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f,
+	0x4e, 0x46, 0x49, 0x47, 0x5f, 0x44, 0x42, 0x10, 0x02, 0x2a, 0x69, 0x0a, 0x0e, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x11, 0x0a, 0x0d, 0x53,
+	0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x49, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x10,
+	0x0a, 0x0c, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x45, 0x4e, 0x56, 0x41, 0x52, 0x10, 0x01,
+	0x12, 0x13, 0x0a, 0x0f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x43, 0x48,
+	0x41, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f,
+	0x4f, 0x50, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x41,
+	0x53, 0x4d, 0x10, 0x04, 0x32, 0xb5, 0x07, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x62, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x78,
+	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x78, 0x79,
+	0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01,
+	0x12, 0x65, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x26, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x78,
 	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x04, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x1d, 0x2e, 0x78,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x61, 0x0a, 0x0c, 0x41, 0x63, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x25, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x5d, 0x0a, 0x0c, 0x53, 0x65,
+	0x6e, 0x64, 0x46, 0x53, 0x4d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x78, 0x79, 0x7a,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x46, 0x53, 0x4d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x53, 0x4d, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0f, 0x53, 0x65, 0x74,
+	0x4e, 0x65, 0x78, 0x74, 0x46, 0x53, 0x4d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x78,
 	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x78, 0x79,
+	0x53, 0x65, 0x74, 0x4e, 0x65, 0x78, 0x74, 0x46, 0x53, 0x4d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x65, 0x78,
+	0x74, 0x46, 0x53, 0x4d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5d, 0x0a, 0x0c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x25, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5d, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x6c,
+	0x12, 0x25, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x75, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x57,
+	0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x57, 0x65,
+	0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x04, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x1d,
+	0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a,
+	0x0a, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d, 0x2e, 0x78, 0x79,
 	0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe4, 0x0b, 0x0a, 0x11,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x4a, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x78, 0x79, 0x7a, 0x2e,
+	0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x78, 0x79, 0x7a,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x32, 0xe3, 0x15, 0x0a,
+	0x11, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x4a, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x78, 0x79, 0x7a,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x78, 0x79, 0x7a, 0x2e,
 	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x5a, 0x0a,
-	0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x78,
-	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
-	0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x1f, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
-	0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x5a,
+	0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x2e,
+	0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
 	0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74,
-	0x65, 0x66, 0x61, 0x63, 0x74, 0x44, 0x69, 0x66, 0x66, 0x73, 0x12, 0x29, 0x2e, 0x78, 0x79, 0x7a,
-	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x72, 0x74, 0x65, 0x66, 0x61, 0x63, 0x74, 0x44, 0x69, 0x66, 0x66, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x65,
-	0x66, 0x61, 0x63, 0x74, 0x44, 0x69, 0x66, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x63, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x72, 0x74, 0x65, 0x66,
-	0x61, 0x63, 0x74, 0x12, 0x27, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
-	0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x72, 0x74,
-	0x65, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x78,
-	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x72, 0x74, 0x65, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x2e, 0x78, 0x79, 0x7a,
-	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44,
-	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x60, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x26, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
-	0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x78, 0x79, 0x7a,
-	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x41, 0x72, 0x74, 0x65, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x2f, 0x2e,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x72,
+	0x74, 0x65, 0x66, 0x61, 0x63, 0x74, 0x44, 0x69, 0x66, 0x66, 0x73, 0x12, 0x29, 0x2e, 0x78, 0x79,
+	0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x72, 0x74, 0x65, 0x66, 0x61, 0x63, 0x74, 0x44, 0x69, 0x66, 0x66, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74,
+	0x65, 0x66, 0x61, 0x63, 0x74, 0x44, 0x69, 0x66, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x63, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x72, 0x74, 0x65,
+	0x66, 0x61, 0x63, 0x74, 0x12, 0x27, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x72,
+	0x74, 0x65, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
 	0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x72,
-	0x74, 0x65, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x72, 0x74, 0x65, 0x66, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x2e, 0x78, 0x79,
+	0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x60, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x78, 0x79,
+	0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x72, 0x74, 0x65, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x2f,
 	0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76,
 	0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41,
-	0x72, 0x74, 0x65, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x30, 0x01, 0x12, 0x65, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x75,
-	0x6e, 0x6e, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
-	0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x5d, 0x0a, 0x0c, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x12, 0x25, 0x2e, 0x78, 0x79, 0x7a, 0x2e,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x12, 0x26, 0x2e, 0x78, 0x79, 0x7a, 0x2e,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x27, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x65, 0x70, 0x6c,
-	0x6f, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x14, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x6f,
-	0x67, 0x73, 0x12, 0x2d, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
+	0x72, 0x74, 0x65, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x30, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x41, 0x72, 0x74, 0x65, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x30, 0x01, 0x12, 0x65, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x5d, 0x0a, 0x0c, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x12, 0x25, 0x2e, 0x78, 0x79, 0x7a,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0d, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x12, 0x26, 0x2e, 0x78, 0x79, 0x7a,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
+	0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x14, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
+	0x6f, 0x67, 0x73, 0x12, 0x2d, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
 	0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x70, 0x6c,
-	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2e, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x28, 0x01, 0x12, 0x54, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x12, 0x22, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0a, 0x50, 0x75, 0x6c,
-	0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x23, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x78,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x28, 0x01, 0x12, 0x54, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x12, 0x22, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0a, 0x50, 0x75,
+	0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x23, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x6c, 0x6c,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x6c, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x78, 0x79, 0x7a,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x53, 0x65, 0x65, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x65, 0x6b, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
+	0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x65, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b,
+	0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x28, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x78, 0x79,
+	0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x6c, 0x0a, 0x11, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x2a, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x78,
 	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x75, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x30, 0x01, 0x12, 0x6c, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x78, 0x79, 0x7a, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x50, 0x75, 0x72,
+	0x67, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x29, 0x2e,
+	0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67,
+	0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x53, 0x4d, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x53, 0x4d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x53, 0x4d, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x03, 0x90, 0x02, 0x01, 0x12, 0x68, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x53, 0x4d, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x53, 0x4d,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x53, 0x4d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x74,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43,
+	0x61, 0x6c, 0x6c, 0x73, 0x12, 0x2b, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x03, 0x90, 0x02, 0x01, 0x12, 0x72, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x2c, 0x2e, 0x78, 0x79,
+	0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x61,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x78, 0x79, 0x7a, 0x2e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x25, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x63, 0x0a, 0x0e,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x12, 0x27,
+	0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5d, 0x0a, 0x0c, 0x50, 0x61, 0x75, 0x73, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f,
+	0x62, 0x12, 0x25, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x60, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f,
+	0x62, 0x12, 0x26, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x78, 0x79, 0x7a, 0x2e,
 	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x75, 0x6d, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x32, 0xd2, 0x02, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x78,
 	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
@@ -5212,232 +8032,351 @@ func file_xyz_block_ftl_v1_ftl_proto_rawDescGZIP() []byte {
 	return file_xyz_block_ftl_v1_ftl_proto_rawDescData
 }
 
-var file_xyz_block_ftl_v1_ftl_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_xyz_block_ftl_v1_ftl_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
+var file_xyz_block_ftl_v1_ftl_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_xyz_block_ftl_v1_ftl_proto_msgTypes = make([]protoimpl.MessageInfo, 117)
 var file_xyz_block_ftl_v1_ftl_proto_goTypes = []any{
 	(DeploymentChangeType)(0),                 // 0: xyz.block.ftl.v1.DeploymentChangeType
 	(RunnerState)(0),                          // 1: xyz.block.ftl.v1.RunnerState
-	(ConfigProvider)(0),                       // 2: xyz.block.ftl.v1.ConfigProvider
-	(SecretProvider)(0),                       // 3: xyz.block.ftl.v1.SecretProvider
-	(ModuleContextResponse_DBType)(0),         // 4: xyz.block.ftl.v1.ModuleContextResponse.DBType
-	(*PingRequest)(nil),                       // 5: xyz.block.ftl.v1.PingRequest
-	(*PingResponse)(nil),                      // 6: xyz.block.ftl.v1.PingResponse
-	(*ModuleContextRequest)(nil),              // 7: xyz.block.ftl.v1.ModuleContextRequest
-	(*ModuleContextResponse)(nil),             // 8: xyz.block.ftl.v1.ModuleContextResponse
-	(*Metadata)(nil),                          // 9: xyz.block.ftl.v1.Metadata
-	(*CallRequest)(nil),                       // 10: xyz.block.ftl.v1.CallRequest
-	(*CallResponse)(nil),                      // 11: xyz.block.ftl.v1.CallResponse
-	(*AcquireLeaseRequest)(nil),               // 12: xyz.block.ftl.v1.AcquireLeaseRequest
-	(*AcquireLeaseResponse)(nil),              // 13: xyz.block.ftl.v1.AcquireLeaseResponse
-	(*SendFSMEventRequest)(nil),               // 14: xyz.block.ftl.v1.SendFSMEventRequest
-	(*SendFSMEventResponse)(nil),              // 15: xyz.block.ftl.v1.SendFSMEventResponse
-	(*PublishEventRequest)(nil),               // 16: xyz.block.ftl.v1.PublishEventRequest
-	(*PublishEventResponse)(nil),              // 17: xyz.block.ftl.v1.PublishEventResponse
-	(*GetSchemaRequest)(nil),                  // 18: xyz.block.ftl.v1.GetSchemaRequest
-	(*GetSchemaResponse)(nil),                 // 19: xyz.block.ftl.v1.GetSchemaResponse
-	(*PullSchemaRequest)(nil),                 // 20: xyz.block.ftl.v1.PullSchemaRequest
-	(*PullSchemaResponse)(nil),                // 21: xyz.block.ftl.v1.PullSchemaResponse
-	(*GetArtefactDiffsRequest)(nil),           // 22: xyz.block.ftl.v1.GetArtefactDiffsRequest
-	(*GetArtefactDiffsResponse)(nil),          // 23: xyz.block.ftl.v1.GetArtefactDiffsResponse
-	(*UploadArtefactRequest)(nil),             // 24: xyz.block.ftl.v1.UploadArtefactRequest
-	(*UploadArtefactResponse)(nil),            // 25: xyz.block.ftl.v1.UploadArtefactResponse
-	(*DeploymentArtefact)(nil),                // 26: xyz.block.ftl.v1.DeploymentArtefact
-	(*CreateDeploymentRequest)(nil),           // 27: xyz.block.ftl.v1.CreateDeploymentRequest
-	(*CreateDeploymentResponse)(nil),          // 28: xyz.block.ftl.v1.CreateDeploymentResponse
-	(*GetDeploymentArtefactsRequest)(nil),     // 29: xyz.block.ftl.v1.GetDeploymentArtefactsRequest
-	(*GetDeploymentArtefactsResponse)(nil),    // 30: xyz.block.ftl.v1.GetDeploymentArtefactsResponse
-	(*GetDeploymentRequest)(nil),              // 31: xyz.block.ftl.v1.GetDeploymentRequest
-	(*GetDeploymentResponse)(nil),             // 32: xyz.block.ftl.v1.GetDeploymentResponse
-	(*RegisterRunnerRequest)(nil),             // 33: xyz.block.ftl.v1.RegisterRunnerRequest
-	(*RegisterRunnerResponse)(nil),            // 34: xyz.block.ftl.v1.RegisterRunnerResponse
-	(*UpdateDeployRequest)(nil),               // 35: xyz.block.ftl.v1.UpdateDeployRequest
-	(*UpdateDeployResponse)(nil),              // 36: xyz.block.ftl.v1.UpdateDeployResponse
-	(*ReplaceDeployRequest)(nil),              // 37: xyz.block.ftl.v1.ReplaceDeployRequest
-	(*ReplaceDeployResponse)(nil),             // 38: xyz.block.ftl.v1.ReplaceDeployResponse
-	(*StreamDeploymentLogsRequest)(nil),       // 39: xyz.block.ftl.v1.StreamDeploymentLogsRequest
-	(*StreamDeploymentLogsResponse)(nil),      // 40: xyz.block.ftl.v1.StreamDeploymentLogsResponse
-	(*StatusRequest)(nil),                     // 41: xyz.block.ftl.v1.StatusRequest
-	(*StatusResponse)(nil),                    // 42: xyz.block.ftl.v1.StatusResponse
-	(*ProcessListRequest)(nil),                // 43: xyz.block.ftl.v1.ProcessListRequest
-	(*ProcessListResponse)(nil),               // 44: xyz.block.ftl.v1.ProcessListResponse
-	(*ResetSubscriptionRequest)(nil),          // 45: xyz.block.ftl.v1.ResetSubscriptionRequest
-	(*ResetSubscriptionResponse)(nil),         // 46: xyz.block.ftl.v1.ResetSubscriptionResponse
-	(*DeployRequest)(nil),                     // 47: xyz.block.ftl.v1.DeployRequest
-	(*DeployResponse)(nil),                    // 48: xyz.block.ftl.v1.DeployResponse
-	(*TerminateRequest)(nil),                  // 49: xyz.block.ftl.v1.TerminateRequest
-	(*ReserveRequest)(nil),                    // 50: xyz.block.ftl.v1.ReserveRequest
-	(*ReserveResponse)(nil),                   // 51: xyz.block.ftl.v1.ReserveResponse
-	(*ConfigRef)(nil),                         // 52: xyz.block.ftl.v1.ConfigRef
-	(*ListConfigRequest)(nil),                 // 53: xyz.block.ftl.v1.ListConfigRequest
-	(*ListConfigResponse)(nil),                // 54: xyz.block.ftl.v1.ListConfigResponse
-	(*GetConfigRequest)(nil),                  // 55: xyz.block.ftl.v1.GetConfigRequest
-	(*GetConfigResponse)(nil),                 // 56: xyz.block.ftl.v1.GetConfigResponse
-	(*SetConfigRequest)(nil),                  // 57: xyz.block.ftl.v1.SetConfigRequest
-	(*SetConfigResponse)(nil),                 // 58: xyz.block.ftl.v1.SetConfigResponse
-	(*UnsetConfigRequest)(nil),                // 59: xyz.block.ftl.v1.UnsetConfigRequest
-	(*UnsetConfigResponse)(nil),               // 60: xyz.block.ftl.v1.UnsetConfigResponse
-	(*ListSecretsRequest)(nil),                // 61: xyz.block.ftl.v1.ListSecretsRequest
-	(*ListSecretsResponse)(nil),               // 62: xyz.block.ftl.v1.ListSecretsResponse
-	(*GetSecretRequest)(nil),                  // 63: xyz.block.ftl.v1.GetSecretRequest
-	(*GetSecretResponse)(nil),                 // 64: xyz.block.ftl.v1.GetSecretResponse
-	(*SetSecretRequest)(nil),                  // 65: xyz.block.ftl.v1.SetSecretRequest
-	(*SetSecretResponse)(nil),                 // 66: xyz.block.ftl.v1.SetSecretResponse
-	(*UnsetSecretRequest)(nil),                // 67: xyz.block.ftl.v1.UnsetSecretRequest
-	(*UnsetSecretResponse)(nil),               // 68: xyz.block.ftl.v1.UnsetSecretResponse
-	(*ModuleContextResponse_Ref)(nil),         // 69: xyz.block.ftl.v1.ModuleContextResponse.Ref
-	(*ModuleContextResponse_DSN)(nil),         // 70: xyz.block.ftl.v1.ModuleContextResponse.DSN
-	nil,                                       // 71: xyz.block.ftl.v1.ModuleContextResponse.ConfigsEntry
-	nil,                                       // 72: xyz.block.ftl.v1.ModuleContextResponse.SecretsEntry
-	(*Metadata_Pair)(nil),                     // 73: xyz.block.ftl.v1.Metadata.Pair
-	(*CallResponse_Error)(nil),                // 74: xyz.block.ftl.v1.CallResponse.Error
-	nil,                                       // 75: xyz.block.ftl.v1.StreamDeploymentLogsRequest.AttributesEntry
-	(*StatusResponse_Controller)(nil),         // 76: xyz.block.ftl.v1.StatusResponse.Controller
-	(*StatusResponse_Runner)(nil),             // 77: xyz.block.ftl.v1.StatusResponse.Runner
-	(*StatusResponse_Deployment)(nil),         // 78: xyz.block.ftl.v1.StatusResponse.Deployment
-	(*StatusResponse_IngressRoute)(nil),       // 79: xyz.block.ftl.v1.StatusResponse.IngressRoute
-	(*StatusResponse_Route)(nil),              // 80: xyz.block.ftl.v1.StatusResponse.Route
-	(*ProcessListResponse_ProcessRunner)(nil), // 81: xyz.block.ftl.v1.ProcessListResponse.ProcessRunner
-	(*ProcessListResponse_Process)(nil),       // 82: xyz.block.ftl.v1.ProcessListResponse.Process
-	(*ListConfigResponse_Config)(nil),         // 83: xyz.block.ftl.v1.ListConfigResponse.Config
-	(*ListSecretsResponse_Secret)(nil),        // 84: xyz.block.ftl.v1.ListSecretsResponse.Secret
-	(*schema.Ref)(nil),                        // 85: xyz.block.ftl.v1.schema.Ref
-	(*durationpb.Duration)(nil),               // 86: google.protobuf.Duration
-	(*schema.Type)(nil),                       // 87: xyz.block.ftl.v1.schema.Type
-	(*schema.Schema)(nil),                     // 88: xyz.block.ftl.v1.schema.Schema
-	(*schema.Module)(nil),                     // 89: xyz.block.ftl.v1.schema.Module
-	(*structpb.Struct)(nil),                   // 90: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil),             // 91: google.protobuf.Timestamp
+	(CircuitBreakerState)(0),                  // 2: xyz.block.ftl.v1.CircuitBreakerState
+	(FSMStatus)(0),                            // 3: xyz.block.ftl.v1.FSMStatus
+	(ConfigProvider)(0),                       // 4: xyz.block.ftl.v1.ConfigProvider
+	(SecretProvider)(0),                       // 5: xyz.block.ftl.v1.SecretProvider
+	(ModuleContextResponse_DBType)(0),         // 6: xyz.block.ftl.v1.ModuleContextResponse.DBType
+	(*PingRequest)(nil),                       // 7: xyz.block.ftl.v1.PingRequest
+	(*PingResponse)(nil),                      // 8: xyz.block.ftl.v1.PingResponse
+	(*ModuleContextRequest)(nil),              // 9: xyz.block.ftl.v1.ModuleContextRequest
+	(*ModuleContextResponse)(nil),             // 10: xyz.block.ftl.v1.ModuleContextResponse
+	(*Metadata)(nil),                          // 11: xyz.block.ftl.v1.Metadata
+	(*CallRequest)(nil),                       // 12: xyz.block.ftl.v1.CallRequest
+	(*CallResponse)(nil),                      // 13: xyz.block.ftl.v1.CallResponse
+	(*AcquireLeaseRequest)(nil),               // 14: xyz.block.ftl.v1.AcquireLeaseRequest
+	(*AcquireLeaseResponse)(nil),              // 15: xyz.block.ftl.v1.AcquireLeaseResponse
+	(*SendFSMEventRequest)(nil),               // 16: xyz.block.ftl.v1.SendFSMEventRequest
+	(*SendFSMEventResponse)(nil),              // 17: xyz.block.ftl.v1.SendFSMEventResponse
+	(*SetNextFSMEventRequest)(nil),            // 18: xyz.block.ftl.v1.SetNextFSMEventRequest
+	(*SetNextFSMEventResponse)(nil),           // 19: xyz.block.ftl.v1.SetNextFSMEventResponse
+	(*PublishEventRequest)(nil),               // 20: xyz.block.ftl.v1.PublishEventRequest
+	(*PublishEventResponse)(nil),              // 21: xyz.block.ftl.v1.PublishEventResponse
+	(*RetryPolicy)(nil),                       // 22: xyz.block.ftl.v1.RetryPolicy
+	(*ScheduleCallRequest)(nil),               // 23: xyz.block.ftl.v1.ScheduleCallRequest
+	(*ScheduleCallResponse)(nil),              // 24: xyz.block.ftl.v1.ScheduleCallResponse
+	(*SendWebSocketMessageRequest)(nil),       // 25: xyz.block.ftl.v1.SendWebSocketMessageRequest
+	(*SendWebSocketMessageResponse)(nil),      // 26: xyz.block.ftl.v1.SendWebSocketMessageResponse
+	(*GetSchemaRequest)(nil),                  // 27: xyz.block.ftl.v1.GetSchemaRequest
+	(*GetSchemaResponse)(nil),                 // 28: xyz.block.ftl.v1.GetSchemaResponse
+	(*PullSchemaRequest)(nil),                 // 29: xyz.block.ftl.v1.PullSchemaRequest
+	(*PullSchemaResponse)(nil),                // 30: xyz.block.ftl.v1.PullSchemaResponse
+	(*GetArtefactDiffsRequest)(nil),           // 31: xyz.block.ftl.v1.GetArtefactDiffsRequest
+	(*GetArtefactDiffsResponse)(nil),          // 32: xyz.block.ftl.v1.GetArtefactDiffsResponse
+	(*UploadArtefactRequest)(nil),             // 33: xyz.block.ftl.v1.UploadArtefactRequest
+	(*UploadArtefactResponse)(nil),            // 34: xyz.block.ftl.v1.UploadArtefactResponse
+	(*DeploymentArtefact)(nil),                // 35: xyz.block.ftl.v1.DeploymentArtefact
+	(*CreateDeploymentRequest)(nil),           // 36: xyz.block.ftl.v1.CreateDeploymentRequest
+	(*CreateDeploymentResponse)(nil),          // 37: xyz.block.ftl.v1.CreateDeploymentResponse
+	(*GetDeploymentArtefactsRequest)(nil),     // 38: xyz.block.ftl.v1.GetDeploymentArtefactsRequest
+	(*GetDeploymentArtefactsResponse)(nil),    // 39: xyz.block.ftl.v1.GetDeploymentArtefactsResponse
+	(*GetDeploymentRequest)(nil),              // 40: xyz.block.ftl.v1.GetDeploymentRequest
+	(*GetDeploymentResponse)(nil),             // 41: xyz.block.ftl.v1.GetDeploymentResponse
+	(*RegisterRunnerRequest)(nil),             // 42: xyz.block.ftl.v1.RegisterRunnerRequest
+	(*RegisterRunnerResponse)(nil),            // 43: xyz.block.ftl.v1.RegisterRunnerResponse
+	(*UpdateDeployRequest)(nil),               // 44: xyz.block.ftl.v1.UpdateDeployRequest
+	(*UpdateDeployResponse)(nil),              // 45: xyz.block.ftl.v1.UpdateDeployResponse
+	(*ReplaceDeployRequest)(nil),              // 46: xyz.block.ftl.v1.ReplaceDeployRequest
+	(*ReplaceDeployResponse)(nil),             // 47: xyz.block.ftl.v1.ReplaceDeployResponse
+	(*StreamDeploymentLogsRequest)(nil),       // 48: xyz.block.ftl.v1.StreamDeploymentLogsRequest
+	(*StreamDeploymentLogsResponse)(nil),      // 49: xyz.block.ftl.v1.StreamDeploymentLogsResponse
+	(*StatusRequest)(nil),                     // 50: xyz.block.ftl.v1.StatusRequest
+	(*StatusResponse)(nil),                    // 51: xyz.block.ftl.v1.StatusResponse
+	(*ProcessListRequest)(nil),                // 52: xyz.block.ftl.v1.ProcessListRequest
+	(*ProcessListResponse)(nil),               // 53: xyz.block.ftl.v1.ProcessListResponse
+	(*ResetSubscriptionRequest)(nil),          // 54: xyz.block.ftl.v1.ResetSubscriptionRequest
+	(*ResetSubscriptionResponse)(nil),         // 55: xyz.block.ftl.v1.ResetSubscriptionResponse
+	(*SeekSubscriptionRequest)(nil),           // 56: xyz.block.ftl.v1.SeekSubscriptionRequest
+	(*SeekSubscriptionResponse)(nil),          // 57: xyz.block.ftl.v1.SeekSubscriptionResponse
+	(*DeadLetter)(nil),                        // 58: xyz.block.ftl.v1.DeadLetter
+	(*ListDeadLettersRequest)(nil),            // 59: xyz.block.ftl.v1.ListDeadLettersRequest
+	(*ListDeadLettersResponse)(nil),           // 60: xyz.block.ftl.v1.ListDeadLettersResponse
+	(*ReplayDeadLettersRequest)(nil),          // 61: xyz.block.ftl.v1.ReplayDeadLettersRequest
+	(*ReplayDeadLettersResponse)(nil),         // 62: xyz.block.ftl.v1.ReplayDeadLettersResponse
+	(*PurgeDeadLettersRequest)(nil),           // 63: xyz.block.ftl.v1.PurgeDeadLettersRequest
+	(*PurgeDeadLettersResponse)(nil),          // 64: xyz.block.ftl.v1.PurgeDeadLettersResponse
+	(*FSMInstance)(nil),                       // 65: xyz.block.ftl.v1.FSMInstance
+	(*FSMTransitionAttempt)(nil),              // 66: xyz.block.ftl.v1.FSMTransitionAttempt
+	(*ListFSMInstancesRequest)(nil),           // 67: xyz.block.ftl.v1.ListFSMInstancesRequest
+	(*ListFSMInstancesResponse)(nil),          // 68: xyz.block.ftl.v1.ListFSMInstancesResponse
+	(*GetFSMInstanceRequest)(nil),             // 69: xyz.block.ftl.v1.GetFSMInstanceRequest
+	(*GetFSMInstanceResponse)(nil),            // 70: xyz.block.ftl.v1.GetFSMInstanceResponse
+	(*ScheduledCall)(nil),                     // 71: xyz.block.ftl.v1.ScheduledCall
+	(*ListScheduledCallsRequest)(nil),         // 72: xyz.block.ftl.v1.ListScheduledCallsRequest
+	(*ListScheduledCallsResponse)(nil),        // 73: xyz.block.ftl.v1.ListScheduledCallsResponse
+	(*CancelScheduledCallRequest)(nil),        // 74: xyz.block.ftl.v1.CancelScheduledCallRequest
+	(*CancelScheduledCallResponse)(nil),       // 75: xyz.block.ftl.v1.CancelScheduledCallResponse
+	(*CronJob)(nil),                           // 76: xyz.block.ftl.v1.CronJob
+	(*ListCronJobsRequest)(nil),               // 77: xyz.block.ftl.v1.ListCronJobsRequest
+	(*ListCronJobsResponse)(nil),              // 78: xyz.block.ftl.v1.ListCronJobsResponse
+	(*TriggerCronJobRequest)(nil),             // 79: xyz.block.ftl.v1.TriggerCronJobRequest
+	(*TriggerCronJobResponse)(nil),            // 80: xyz.block.ftl.v1.TriggerCronJobResponse
+	(*PauseCronJobRequest)(nil),               // 81: xyz.block.ftl.v1.PauseCronJobRequest
+	(*PauseCronJobResponse)(nil),              // 82: xyz.block.ftl.v1.PauseCronJobResponse
+	(*ResumeCronJobRequest)(nil),              // 83: xyz.block.ftl.v1.ResumeCronJobRequest
+	(*ResumeCronJobResponse)(nil),             // 84: xyz.block.ftl.v1.ResumeCronJobResponse
+	(*DeployRequest)(nil),                     // 85: xyz.block.ftl.v1.DeployRequest
+	(*DeployResponse)(nil),                    // 86: xyz.block.ftl.v1.DeployResponse
+	(*TerminateRequest)(nil),                  // 87: xyz.block.ftl.v1.TerminateRequest
+	(*ReserveRequest)(nil),                    // 88: xyz.block.ftl.v1.ReserveRequest
+	(*ReserveResponse)(nil),                   // 89: xyz.block.ftl.v1.ReserveResponse
+	(*ConfigRef)(nil),                         // 90: xyz.block.ftl.v1.ConfigRef
+	(*ListConfigRequest)(nil),                 // 91: xyz.block.ftl.v1.ListConfigRequest
+	(*ListConfigResponse)(nil),                // 92: xyz.block.ftl.v1.ListConfigResponse
+	(*GetConfigRequest)(nil),                  // 93: xyz.block.ftl.v1.GetConfigRequest
+	(*GetConfigResponse)(nil),                 // 94: xyz.block.ftl.v1.GetConfigResponse
+	(*SetConfigRequest)(nil),                  // 95: xyz.block.ftl.v1.SetConfigRequest
+	(*SetConfigResponse)(nil),                 // 96: xyz.block.ftl.v1.SetConfigResponse
+	(*UnsetConfigRequest)(nil),                // 97: xyz.block.ftl.v1.UnsetConfigRequest
+	(*UnsetConfigResponse)(nil),               // 98: xyz.block.ftl.v1.UnsetConfigResponse
+	(*ListSecretsRequest)(nil),                // 99: xyz.block.ftl.v1.ListSecretsRequest
+	(*ListSecretsResponse)(nil),               // 100: xyz.block.ftl.v1.ListSecretsResponse
+	(*GetSecretRequest)(nil),                  // 101: xyz.block.ftl.v1.GetSecretRequest
+	(*GetSecretResponse)(nil),                 // 102: xyz.block.ftl.v1.GetSecretResponse
+	(*SetSecretRequest)(nil),                  // 103: xyz.block.ftl.v1.SetSecretRequest
+	(*SetSecretResponse)(nil),                 // 104: xyz.block.ftl.v1.SetSecretResponse
+	(*UnsetSecretRequest)(nil),                // 105: xyz.block.ftl.v1.UnsetSecretRequest
+	(*UnsetSecretResponse)(nil),               // 106: xyz.block.ftl.v1.UnsetSecretResponse
+	(*ModuleContextResponse_Ref)(nil),         // 107: xyz.block.ftl.v1.ModuleContextResponse.Ref
+	(*ModuleContextResponse_DSN)(nil),         // 108: xyz.block.ftl.v1.ModuleContextResponse.DSN
+	nil,                                       // 109: xyz.block.ftl.v1.ModuleContextResponse.ConfigsEntry
+	nil,                                       // 110: xyz.block.ftl.v1.ModuleContextResponse.SecretsEntry
+	(*Metadata_Pair)(nil),                     // 111: xyz.block.ftl.v1.Metadata.Pair
+	(*CallResponse_Error)(nil),                // 112: xyz.block.ftl.v1.CallResponse.Error
+	nil,                                       // 113: xyz.block.ftl.v1.StreamDeploymentLogsRequest.AttributesEntry
+	(*StatusResponse_Controller)(nil),         // 114: xyz.block.ftl.v1.StatusResponse.Controller
+	(*StatusResponse_Runner)(nil),             // 115: xyz.block.ftl.v1.StatusResponse.Runner
+	(*StatusResponse_Deployment)(nil),         // 116: xyz.block.ftl.v1.StatusResponse.Deployment
+	(*StatusResponse_IngressRoute)(nil),       // 117: xyz.block.ftl.v1.StatusResponse.IngressRoute
+	(*StatusResponse_Route)(nil),              // 118: xyz.block.ftl.v1.StatusResponse.Route
+	(*StatusResponse_CircuitBreaker)(nil),     // 119: xyz.block.ftl.v1.StatusResponse.CircuitBreaker
+	(*ProcessListResponse_ProcessRunner)(nil), // 120: xyz.block.ftl.v1.ProcessListResponse.ProcessRunner
+	(*ProcessListResponse_Process)(nil),       // 121: xyz.block.ftl.v1.ProcessListResponse.Process
+	(*ListConfigResponse_Config)(nil),         // 122: xyz.block.ftl.v1.ListConfigResponse.Config
+	(*ListSecretsResponse_Secret)(nil),        // 123: xyz.block.ftl.v1.ListSecretsResponse.Secret
+	(*schema.Ref)(nil),                        // 124: xyz.block.ftl.v1.schema.Ref
+	(*durationpb.Duration)(nil),               // 125: google.protobuf.Duration
+	(*schema.Type)(nil),                       // 126: xyz.block.ftl.v1.schema.Type
+	(*timestamppb.Timestamp)(nil),             // 127: google.protobuf.Timestamp
+	(*schema.Schema)(nil),                     // 128: xyz.block.ftl.v1.schema.Schema
+	(*schema.Module)(nil),                     // 129: xyz.block.ftl.v1.schema.Module
+	(*structpb.Struct)(nil),                   // 130: google.protobuf.Struct
 }
 var file_xyz_block_ftl_v1_ftl_proto_depIdxs = []int32{
-	71, // 0: xyz.block.ftl.v1.ModuleContextResponse.configs:type_name -> xyz.block.ftl.v1.ModuleContextResponse.ConfigsEntry
-	72, // 1: xyz.block.ftl.v1.ModuleContextResponse.secrets:type_name -> xyz.block.ftl.v1.ModuleContextResponse.SecretsEntry
-	70, // 2: xyz.block.ftl.v1.ModuleContextResponse.databases:type_name -> xyz.block.ftl.v1.ModuleContextResponse.DSN
-	73, // 3: xyz.block.ftl.v1.Metadata.values:type_name -> xyz.block.ftl.v1.Metadata.Pair
-	9,  // 4: xyz.block.ftl.v1.CallRequest.metadata:type_name -> xyz.block.ftl.v1.Metadata
-	85, // 5: xyz.block.ftl.v1.CallRequest.verb:type_name -> xyz.block.ftl.v1.schema.Ref
-	74, // 6: xyz.block.ftl.v1.CallResponse.error:type_name -> xyz.block.ftl.v1.CallResponse.Error
-	86, // 7: xyz.block.ftl.v1.AcquireLeaseRequest.ttl:type_name -> google.protobuf.Duration
-	85, // 8: xyz.block.ftl.v1.SendFSMEventRequest.fsm:type_name -> xyz.block.ftl.v1.schema.Ref
-	87, // 9: xyz.block.ftl.v1.SendFSMEventRequest.event:type_name -> xyz.block.ftl.v1.schema.Type
-	85, // 10: xyz.block.ftl.v1.PublishEventRequest.topic:type_name -> xyz.block.ftl.v1.schema.Ref
-	88, // 11: xyz.block.ftl.v1.GetSchemaResponse.schema:type_name -> xyz.block.ftl.v1.schema.Schema
-	89, // 12: xyz.block.ftl.v1.PullSchemaResponse.schema:type_name -> xyz.block.ftl.v1.schema.Module
-	0,  // 13: xyz.block.ftl.v1.PullSchemaResponse.change_type:type_name -> xyz.block.ftl.v1.DeploymentChangeType
-	26, // 14: xyz.block.ftl.v1.GetArtefactDiffsResponse.client_artefacts:type_name -> xyz.block.ftl.v1.DeploymentArtefact
-	89, // 15: xyz.block.ftl.v1.CreateDeploymentRequest.schema:type_name -> xyz.block.ftl.v1.schema.Module
-	26, // 16: xyz.block.ftl.v1.CreateDeploymentRequest.artefacts:type_name -> xyz.block.ftl.v1.DeploymentArtefact
-	90, // 17: xyz.block.ftl.v1.CreateDeploymentRequest.labels:type_name -> google.protobuf.Struct
-	26, // 18: xyz.block.ftl.v1.GetDeploymentArtefactsRequest.have_artefacts:type_name -> xyz.block.ftl.v1.DeploymentArtefact
-	26, // 19: xyz.block.ftl.v1.GetDeploymentArtefactsResponse.artefact:type_name -> xyz.block.ftl.v1.DeploymentArtefact
-	89, // 20: xyz.block.ftl.v1.GetDeploymentResponse.schema:type_name -> xyz.block.ftl.v1.schema.Module
-	26, // 21: xyz.block.ftl.v1.GetDeploymentResponse.artefacts:type_name -> xyz.block.ftl.v1.DeploymentArtefact
-	1,  // 22: xyz.block.ftl.v1.RegisterRunnerRequest.state:type_name -> xyz.block.ftl.v1.RunnerState
-	90, // 23: xyz.block.ftl.v1.RegisterRunnerRequest.labels:type_name -> google.protobuf.Struct
-	91, // 24: xyz.block.ftl.v1.StreamDeploymentLogsRequest.time_stamp:type_name -> google.protobuf.Timestamp
-	75, // 25: xyz.block.ftl.v1.StreamDeploymentLogsRequest.attributes:type_name -> xyz.block.ftl.v1.StreamDeploymentLogsRequest.AttributesEntry
-	76, // 26: xyz.block.ftl.v1.StatusResponse.controllers:type_name -> xyz.block.ftl.v1.StatusResponse.Controller
-	77, // 27: xyz.block.ftl.v1.StatusResponse.runners:type_name -> xyz.block.ftl.v1.StatusResponse.Runner
-	78, // 28: xyz.block.ftl.v1.StatusResponse.deployments:type_name -> xyz.block.ftl.v1.StatusResponse.Deployment
-	79, // 29: xyz.block.ftl.v1.StatusResponse.ingress_routes:type_name -> xyz.block.ftl.v1.StatusResponse.IngressRoute
-	80, // 30: xyz.block.ftl.v1.StatusResponse.routes:type_name -> xyz.block.ftl.v1.StatusResponse.Route
-	82, // 31: xyz.block.ftl.v1.ProcessListResponse.processes:type_name -> xyz.block.ftl.v1.ProcessListResponse.Process
-	85, // 32: xyz.block.ftl.v1.ResetSubscriptionRequest.subscription:type_name -> xyz.block.ftl.v1.schema.Ref
-	2,  // 33: xyz.block.ftl.v1.ListConfigRequest.provider:type_name -> xyz.block.ftl.v1.ConfigProvider
-	83, // 34: xyz.block.ftl.v1.ListConfigResponse.configs:type_name -> xyz.block.ftl.v1.ListConfigResponse.Config
-	52, // 35: xyz.block.ftl.v1.GetConfigRequest.ref:type_name -> xyz.block.ftl.v1.ConfigRef
-	2,  // 36: xyz.block.ftl.v1.SetConfigRequest.provider:type_name -> xyz.block.ftl.v1.ConfigProvider
-	52, // 37: xyz.block.ftl.v1.SetConfigRequest.ref:type_name -> xyz.block.ftl.v1.ConfigRef
-	2,  // 38: xyz.block.ftl.v1.UnsetConfigRequest.provider:type_name -> xyz.block.ftl.v1.ConfigProvider
-	52, // 39: xyz.block.ftl.v1.UnsetConfigRequest.ref:type_name -> xyz.block.ftl.v1.ConfigRef
-	3,  // 40: xyz.block.ftl.v1.ListSecretsRequest.provider:type_name -> xyz.block.ftl.v1.SecretProvider
-	84, // 41: xyz.block.ftl.v1.ListSecretsResponse.secrets:type_name -> xyz.block.ftl.v1.ListSecretsResponse.Secret
-	52, // 42: xyz.block.ftl.v1.GetSecretRequest.ref:type_name -> xyz.block.ftl.v1.ConfigRef
-	3,  // 43: xyz.block.ftl.v1.SetSecretRequest.provider:type_name -> xyz.block.ftl.v1.SecretProvider
-	52, // 44: xyz.block.ftl.v1.SetSecretRequest.ref:type_name -> xyz.block.ftl.v1.ConfigRef
-	3,  // 45: xyz.block.ftl.v1.UnsetSecretRequest.provider:type_name -> xyz.block.ftl.v1.SecretProvider
-	52, // 46: xyz.block.ftl.v1.UnsetSecretRequest.ref:type_name -> xyz.block.ftl.v1.ConfigRef
-	4,  // 47: xyz.block.ftl.v1.ModuleContextResponse.DSN.type:type_name -> xyz.block.ftl.v1.ModuleContextResponse.DBType
-	1,  // 48: xyz.block.ftl.v1.StatusResponse.Runner.state:type_name -> xyz.block.ftl.v1.RunnerState
-	90, // 49: xyz.block.ftl.v1.StatusResponse.Runner.labels:type_name -> google.protobuf.Struct
-	90, // 50: xyz.block.ftl.v1.StatusResponse.Deployment.labels:type_name -> google.protobuf.Struct
-	89, // 51: xyz.block.ftl.v1.StatusResponse.Deployment.schema:type_name -> xyz.block.ftl.v1.schema.Module
-	85, // 52: xyz.block.ftl.v1.StatusResponse.IngressRoute.verb:type_name -> xyz.block.ftl.v1.schema.Ref
-	90, // 53: xyz.block.ftl.v1.ProcessListResponse.ProcessRunner.labels:type_name -> google.protobuf.Struct
-	90, // 54: xyz.block.ftl.v1.ProcessListResponse.Process.labels:type_name -> google.protobuf.Struct
-	81, // 55: xyz.block.ftl.v1.ProcessListResponse.Process.runner:type_name -> xyz.block.ftl.v1.ProcessListResponse.ProcessRunner
-	5,  // 56: xyz.block.ftl.v1.VerbService.Ping:input_type -> xyz.block.ftl.v1.PingRequest
-	7,  // 57: xyz.block.ftl.v1.VerbService.GetModuleContext:input_type -> xyz.block.ftl.v1.ModuleContextRequest
-	12, // 58: xyz.block.ftl.v1.VerbService.AcquireLease:input_type -> xyz.block.ftl.v1.AcquireLeaseRequest
-	14, // 59: xyz.block.ftl.v1.VerbService.SendFSMEvent:input_type -> xyz.block.ftl.v1.SendFSMEventRequest
-	16, // 60: xyz.block.ftl.v1.VerbService.PublishEvent:input_type -> xyz.block.ftl.v1.PublishEventRequest
-	10, // 61: xyz.block.ftl.v1.VerbService.Call:input_type -> xyz.block.ftl.v1.CallRequest
-	5,  // 62: xyz.block.ftl.v1.ControllerService.Ping:input_type -> xyz.block.ftl.v1.PingRequest
-	43, // 63: xyz.block.ftl.v1.ControllerService.ProcessList:input_type -> xyz.block.ftl.v1.ProcessListRequest
-	41, // 64: xyz.block.ftl.v1.ControllerService.Status:input_type -> xyz.block.ftl.v1.StatusRequest
-	22, // 65: xyz.block.ftl.v1.ControllerService.GetArtefactDiffs:input_type -> xyz.block.ftl.v1.GetArtefactDiffsRequest
-	24, // 66: xyz.block.ftl.v1.ControllerService.UploadArtefact:input_type -> xyz.block.ftl.v1.UploadArtefactRequest
-	27, // 67: xyz.block.ftl.v1.ControllerService.CreateDeployment:input_type -> xyz.block.ftl.v1.CreateDeploymentRequest
-	31, // 68: xyz.block.ftl.v1.ControllerService.GetDeployment:input_type -> xyz.block.ftl.v1.GetDeploymentRequest
-	29, // 69: xyz.block.ftl.v1.ControllerService.GetDeploymentArtefacts:input_type -> xyz.block.ftl.v1.GetDeploymentArtefactsRequest
-	33, // 70: xyz.block.ftl.v1.ControllerService.RegisterRunner:input_type -> xyz.block.ftl.v1.RegisterRunnerRequest
-	35, // 71: xyz.block.ftl.v1.ControllerService.UpdateDeploy:input_type -> xyz.block.ftl.v1.UpdateDeployRequest
-	37, // 72: xyz.block.ftl.v1.ControllerService.ReplaceDeploy:input_type -> xyz.block.ftl.v1.ReplaceDeployRequest
-	39, // 73: xyz.block.ftl.v1.ControllerService.StreamDeploymentLogs:input_type -> xyz.block.ftl.v1.StreamDeploymentLogsRequest
-	18, // 74: xyz.block.ftl.v1.ControllerService.GetSchema:input_type -> xyz.block.ftl.v1.GetSchemaRequest
-	20, // 75: xyz.block.ftl.v1.ControllerService.PullSchema:input_type -> xyz.block.ftl.v1.PullSchemaRequest
-	45, // 76: xyz.block.ftl.v1.ControllerService.ResetSubscription:input_type -> xyz.block.ftl.v1.ResetSubscriptionRequest
-	5,  // 77: xyz.block.ftl.v1.RunnerService.Ping:input_type -> xyz.block.ftl.v1.PingRequest
-	50, // 78: xyz.block.ftl.v1.RunnerService.Reserve:input_type -> xyz.block.ftl.v1.ReserveRequest
-	47, // 79: xyz.block.ftl.v1.RunnerService.Deploy:input_type -> xyz.block.ftl.v1.DeployRequest
-	49, // 80: xyz.block.ftl.v1.RunnerService.Terminate:input_type -> xyz.block.ftl.v1.TerminateRequest
-	5,  // 81: xyz.block.ftl.v1.AdminService.Ping:input_type -> xyz.block.ftl.v1.PingRequest
-	53, // 82: xyz.block.ftl.v1.AdminService.ConfigList:input_type -> xyz.block.ftl.v1.ListConfigRequest
-	55, // 83: xyz.block.ftl.v1.AdminService.ConfigGet:input_type -> xyz.block.ftl.v1.GetConfigRequest
-	57, // 84: xyz.block.ftl.v1.AdminService.ConfigSet:input_type -> xyz.block.ftl.v1.SetConfigRequest
-	59, // 85: xyz.block.ftl.v1.AdminService.ConfigUnset:input_type -> xyz.block.ftl.v1.UnsetConfigRequest
-	61, // 86: xyz.block.ftl.v1.AdminService.SecretsList:input_type -> xyz.block.ftl.v1.ListSecretsRequest
-	63, // 87: xyz.block.ftl.v1.AdminService.SecretGet:input_type -> xyz.block.ftl.v1.GetSecretRequest
-	65, // 88: xyz.block.ftl.v1.AdminService.SecretSet:input_type -> xyz.block.ftl.v1.SetSecretRequest
-	67, // 89: xyz.block.ftl.v1.AdminService.SecretUnset:input_type -> xyz.block.ftl.v1.UnsetSecretRequest
-	6,  // 90: xyz.block.ftl.v1.VerbService.Ping:output_type -> xyz.block.ftl.v1.PingResponse
-	8,  // 91: xyz.block.ftl.v1.VerbService.GetModuleContext:output_type -> xyz.block.ftl.v1.ModuleContextResponse
-	13, // 92: xyz.block.ftl.v1.VerbService.AcquireLease:output_type -> xyz.block.ftl.v1.AcquireLeaseResponse
-	15, // 93: xyz.block.ftl.v1.VerbService.SendFSMEvent:output_type -> xyz.block.ftl.v1.SendFSMEventResponse
-	17, // 94: xyz.block.ftl.v1.VerbService.PublishEvent:output_type -> xyz.block.ftl.v1.PublishEventResponse
-	11, // 95: xyz.block.ftl.v1.VerbService.Call:output_type -> xyz.block.ftl.v1.CallResponse
-	6,  // 96: xyz.block.ftl.v1.ControllerService.Ping:output_type -> xyz.block.ftl.v1.PingResponse
-	44, // 97: xyz.block.ftl.v1.ControllerService.ProcessList:output_type -> xyz.block.ftl.v1.ProcessListResponse
-	42, // 98: xyz.block.ftl.v1.ControllerService.Status:output_type -> xyz.block.ftl.v1.StatusResponse
-	23, // 99: xyz.block.ftl.v1.ControllerService.GetArtefactDiffs:output_type -> xyz.block.ftl.v1.GetArtefactDiffsResponse
-	25, // 100: xyz.block.ftl.v1.ControllerService.UploadArtefact:output_type -> xyz.block.ftl.v1.UploadArtefactResponse
-	28, // 101: xyz.block.ftl.v1.ControllerService.CreateDeployment:output_type -> xyz.block.ftl.v1.CreateDeploymentResponse
-	32, // 102: xyz.block.ftl.v1.ControllerService.GetDeployment:output_type -> xyz.block.ftl.v1.GetDeploymentResponse
-	30, // 103: xyz.block.ftl.v1.ControllerService.GetDeploymentArtefacts:output_type -> xyz.block.ftl.v1.GetDeploymentArtefactsResponse
-	34, // 104: xyz.block.ftl.v1.ControllerService.RegisterRunner:output_type -> xyz.block.ftl.v1.RegisterRunnerResponse
-	36, // 105: xyz.block.ftl.v1.ControllerService.UpdateDeploy:output_type -> xyz.block.ftl.v1.UpdateDeployResponse
-	38, // 106: xyz.block.ftl.v1.ControllerService.ReplaceDeploy:output_type -> xyz.block.ftl.v1.ReplaceDeployResponse
-	40, // 107: xyz.block.ftl.v1.ControllerService.StreamDeploymentLogs:output_type -> xyz.block.ftl.v1.StreamDeploymentLogsResponse
-	19, // 108: xyz.block.ftl.v1.ControllerService.GetSchema:output_type -> xyz.block.ftl.v1.GetSchemaResponse
-	21, // 109: xyz.block.ftl.v1.ControllerService.PullSchema:output_type -> xyz.block.ftl.v1.PullSchemaResponse
-	46, // 110: xyz.block.ftl.v1.ControllerService.ResetSubscription:output_type -> xyz.block.ftl.v1.ResetSubscriptionResponse
-	6,  // 111: xyz.block.ftl.v1.RunnerService.Ping:output_type -> xyz.block.ftl.v1.PingResponse
-	51, // 112: xyz.block.ftl.v1.RunnerService.Reserve:output_type -> xyz.block.ftl.v1.ReserveResponse
-	48, // 113: xyz.block.ftl.v1.RunnerService.Deploy:output_type -> xyz.block.ftl.v1.DeployResponse
-	33, // 114: xyz.block.ftl.v1.RunnerService.Terminate:output_type -> xyz.block.ftl.v1.RegisterRunnerRequest
-	6,  // 115: xyz.block.ftl.v1.AdminService.Ping:output_type -> xyz.block.ftl.v1.PingResponse
-	54, // 116: xyz.block.ftl.v1.AdminService.ConfigList:output_type -> xyz.block.ftl.v1.ListConfigResponse
-	56, // 117: xyz.block.ftl.v1.AdminService.ConfigGet:output_type -> xyz.block.ftl.v1.GetConfigResponse
-	58, // 118: xyz.block.ftl.v1.AdminService.ConfigSet:output_type -> xyz.block.ftl.v1.SetConfigResponse
-	60, // 119: xyz.block.ftl.v1.AdminService.ConfigUnset:output_type -> xyz.block.ftl.v1.UnsetConfigResponse
-	62, // 120: xyz.block.ftl.v1.AdminService.SecretsList:output_type -> xyz.block.ftl.v1.ListSecretsResponse
-	64, // 121: xyz.block.ftl.v1.AdminService.SecretGet:output_type -> xyz.block.ftl.v1.GetSecretResponse
-	66, // 122: xyz.block.ftl.v1.AdminService.SecretSet:output_type -> xyz.block.ftl.v1.SetSecretResponse
-	68, // 123: xyz.block.ftl.v1.AdminService.SecretUnset:output_type -> xyz.block.ftl.v1.UnsetSecretResponse
-	90, // [90:124] is the sub-list for method output_type
-	56, // [56:90] is the sub-list for method input_type
-	56, // [56:56] is the sub-list for extension type_name
-	56, // [56:56] is the sub-list for extension extendee
-	0,  // [0:56] is the sub-list for field type_name
+	109, // 0: xyz.block.ftl.v1.ModuleContextResponse.configs:type_name -> xyz.block.ftl.v1.ModuleContextResponse.ConfigsEntry
+	110, // 1: xyz.block.ftl.v1.ModuleContextResponse.secrets:type_name -> xyz.block.ftl.v1.ModuleContextResponse.SecretsEntry
+	108, // 2: xyz.block.ftl.v1.ModuleContextResponse.databases:type_name -> xyz.block.ftl.v1.ModuleContextResponse.DSN
+	111, // 3: xyz.block.ftl.v1.Metadata.values:type_name -> xyz.block.ftl.v1.Metadata.Pair
+	11,  // 4: xyz.block.ftl.v1.CallRequest.metadata:type_name -> xyz.block.ftl.v1.Metadata
+	124, // 5: xyz.block.ftl.v1.CallRequest.verb:type_name -> xyz.block.ftl.v1.schema.Ref
+	112, // 6: xyz.block.ftl.v1.CallResponse.error:type_name -> xyz.block.ftl.v1.CallResponse.Error
+	125, // 7: xyz.block.ftl.v1.AcquireLeaseRequest.ttl:type_name -> google.protobuf.Duration
+	124, // 8: xyz.block.ftl.v1.SendFSMEventRequest.fsm:type_name -> xyz.block.ftl.v1.schema.Ref
+	126, // 9: xyz.block.ftl.v1.SendFSMEventRequest.event:type_name -> xyz.block.ftl.v1.schema.Type
+	124, // 10: xyz.block.ftl.v1.SetNextFSMEventRequest.fsm:type_name -> xyz.block.ftl.v1.schema.Ref
+	126, // 11: xyz.block.ftl.v1.SetNextFSMEventRequest.event:type_name -> xyz.block.ftl.v1.schema.Type
+	124, // 12: xyz.block.ftl.v1.PublishEventRequest.topic:type_name -> xyz.block.ftl.v1.schema.Ref
+	125, // 13: xyz.block.ftl.v1.RetryPolicy.min_backoff:type_name -> google.protobuf.Duration
+	125, // 14: xyz.block.ftl.v1.RetryPolicy.max_backoff:type_name -> google.protobuf.Duration
+	124, // 15: xyz.block.ftl.v1.ScheduleCallRequest.verb:type_name -> xyz.block.ftl.v1.schema.Ref
+	127, // 16: xyz.block.ftl.v1.ScheduleCallRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	22,  // 17: xyz.block.ftl.v1.ScheduleCallRequest.retry:type_name -> xyz.block.ftl.v1.RetryPolicy
+	128, // 18: xyz.block.ftl.v1.GetSchemaResponse.schema:type_name -> xyz.block.ftl.v1.schema.Schema
+	129, // 19: xyz.block.ftl.v1.PullSchemaResponse.schema:type_name -> xyz.block.ftl.v1.schema.Module
+	0,   // 20: xyz.block.ftl.v1.PullSchemaResponse.change_type:type_name -> xyz.block.ftl.v1.DeploymentChangeType
+	35,  // 21: xyz.block.ftl.v1.GetArtefactDiffsResponse.client_artefacts:type_name -> xyz.block.ftl.v1.DeploymentArtefact
+	129, // 22: xyz.block.ftl.v1.CreateDeploymentRequest.schema:type_name -> xyz.block.ftl.v1.schema.Module
+	35,  // 23: xyz.block.ftl.v1.CreateDeploymentRequest.artefacts:type_name -> xyz.block.ftl.v1.DeploymentArtefact
+	130, // 24: xyz.block.ftl.v1.CreateDeploymentRequest.labels:type_name -> google.protobuf.Struct
+	35,  // 25: xyz.block.ftl.v1.GetDeploymentArtefactsRequest.have_artefacts:type_name -> xyz.block.ftl.v1.DeploymentArtefact
+	35,  // 26: xyz.block.ftl.v1.GetDeploymentArtefactsResponse.artefact:type_name -> xyz.block.ftl.v1.DeploymentArtefact
+	129, // 27: xyz.block.ftl.v1.GetDeploymentResponse.schema:type_name -> xyz.block.ftl.v1.schema.Module
+	35,  // 28: xyz.block.ftl.v1.GetDeploymentResponse.artefacts:type_name -> xyz.block.ftl.v1.DeploymentArtefact
+	1,   // 29: xyz.block.ftl.v1.RegisterRunnerRequest.state:type_name -> xyz.block.ftl.v1.RunnerState
+	130, // 30: xyz.block.ftl.v1.RegisterRunnerRequest.labels:type_name -> google.protobuf.Struct
+	127, // 31: xyz.block.ftl.v1.StreamDeploymentLogsRequest.time_stamp:type_name -> google.protobuf.Timestamp
+	113, // 32: xyz.block.ftl.v1.StreamDeploymentLogsRequest.attributes:type_name -> xyz.block.ftl.v1.StreamDeploymentLogsRequest.AttributesEntry
+	114, // 33: xyz.block.ftl.v1.StatusResponse.controllers:type_name -> xyz.block.ftl.v1.StatusResponse.Controller
+	115, // 34: xyz.block.ftl.v1.StatusResponse.runners:type_name -> xyz.block.ftl.v1.StatusResponse.Runner
+	116, // 35: xyz.block.ftl.v1.StatusResponse.deployments:type_name -> xyz.block.ftl.v1.StatusResponse.Deployment
+	117, // 36: xyz.block.ftl.v1.StatusResponse.ingress_routes:type_name -> xyz.block.ftl.v1.StatusResponse.IngressRoute
+	118, // 37: xyz.block.ftl.v1.StatusResponse.routes:type_name -> xyz.block.ftl.v1.StatusResponse.Route
+	119, // 38: xyz.block.ftl.v1.StatusResponse.circuit_breakers:type_name -> xyz.block.ftl.v1.StatusResponse.CircuitBreaker
+	121, // 39: xyz.block.ftl.v1.ProcessListResponse.processes:type_name -> xyz.block.ftl.v1.ProcessListResponse.Process
+	124, // 40: xyz.block.ftl.v1.ResetSubscriptionRequest.subscription:type_name -> xyz.block.ftl.v1.schema.Ref
+	124, // 41: xyz.block.ftl.v1.SeekSubscriptionRequest.subscription:type_name -> xyz.block.ftl.v1.schema.Ref
+	127, // 42: xyz.block.ftl.v1.SeekSubscriptionRequest.time:type_name -> google.protobuf.Timestamp
+	124, // 43: xyz.block.ftl.v1.DeadLetter.subscription:type_name -> xyz.block.ftl.v1.schema.Ref
+	124, // 44: xyz.block.ftl.v1.DeadLetter.topic:type_name -> xyz.block.ftl.v1.schema.Ref
+	127, // 45: xyz.block.ftl.v1.DeadLetter.created_at:type_name -> google.protobuf.Timestamp
+	124, // 46: xyz.block.ftl.v1.ListDeadLettersRequest.subscription:type_name -> xyz.block.ftl.v1.schema.Ref
+	58,  // 47: xyz.block.ftl.v1.ListDeadLettersResponse.dead_letters:type_name -> xyz.block.ftl.v1.DeadLetter
+	124, // 48: xyz.block.ftl.v1.ReplayDeadLettersRequest.subscription:type_name -> xyz.block.ftl.v1.schema.Ref
+	124, // 49: xyz.block.ftl.v1.PurgeDeadLettersRequest.subscription:type_name -> xyz.block.ftl.v1.schema.Ref
+	124, // 50: xyz.block.ftl.v1.FSMInstance.fsm:type_name -> xyz.block.ftl.v1.schema.Ref
+	3,   // 51: xyz.block.ftl.v1.FSMInstance.status:type_name -> xyz.block.ftl.v1.FSMStatus
+	124, // 52: xyz.block.ftl.v1.FSMInstance.current_state:type_name -> xyz.block.ftl.v1.schema.Ref
+	124, // 53: xyz.block.ftl.v1.FSMInstance.destination_state:type_name -> xyz.block.ftl.v1.schema.Ref
+	127, // 54: xyz.block.ftl.v1.FSMInstance.created_at:type_name -> google.protobuf.Timestamp
+	127, // 55: xyz.block.ftl.v1.FSMInstance.updated_at:type_name -> google.protobuf.Timestamp
+	124, // 56: xyz.block.ftl.v1.FSMTransitionAttempt.state:type_name -> xyz.block.ftl.v1.schema.Ref
+	127, // 57: xyz.block.ftl.v1.FSMTransitionAttempt.created_at:type_name -> google.protobuf.Timestamp
+	124, // 58: xyz.block.ftl.v1.ListFSMInstancesRequest.fsm:type_name -> xyz.block.ftl.v1.schema.Ref
+	3,   // 59: xyz.block.ftl.v1.ListFSMInstancesRequest.status:type_name -> xyz.block.ftl.v1.FSMStatus
+	124, // 60: xyz.block.ftl.v1.ListFSMInstancesRequest.state:type_name -> xyz.block.ftl.v1.schema.Ref
+	65,  // 61: xyz.block.ftl.v1.ListFSMInstancesResponse.instances:type_name -> xyz.block.ftl.v1.FSMInstance
+	124, // 62: xyz.block.ftl.v1.GetFSMInstanceRequest.fsm:type_name -> xyz.block.ftl.v1.schema.Ref
+	65,  // 63: xyz.block.ftl.v1.GetFSMInstanceResponse.instance:type_name -> xyz.block.ftl.v1.FSMInstance
+	66,  // 64: xyz.block.ftl.v1.GetFSMInstanceResponse.history:type_name -> xyz.block.ftl.v1.FSMTransitionAttempt
+	124, // 65: xyz.block.ftl.v1.ScheduledCall.verb:type_name -> xyz.block.ftl.v1.schema.Ref
+	127, // 66: xyz.block.ftl.v1.ScheduledCall.scheduled_at:type_name -> google.protobuf.Timestamp
+	127, // 67: xyz.block.ftl.v1.ScheduledCall.created_at:type_name -> google.protobuf.Timestamp
+	124, // 68: xyz.block.ftl.v1.ListScheduledCallsRequest.verb:type_name -> xyz.block.ftl.v1.schema.Ref
+	71,  // 69: xyz.block.ftl.v1.ListScheduledCallsResponse.calls:type_name -> xyz.block.ftl.v1.ScheduledCall
+	124, // 70: xyz.block.ftl.v1.CancelScheduledCallRequest.verb:type_name -> xyz.block.ftl.v1.schema.Ref
+	124, // 71: xyz.block.ftl.v1.CronJob.verb:type_name -> xyz.block.ftl.v1.schema.Ref
+	127, // 72: xyz.block.ftl.v1.CronJob.next_execution:type_name -> google.protobuf.Timestamp
+	127, // 73: xyz.block.ftl.v1.CronJob.last_execution:type_name -> google.protobuf.Timestamp
+	127, // 74: xyz.block.ftl.v1.CronJob.paused_at:type_name -> google.protobuf.Timestamp
+	76,  // 75: xyz.block.ftl.v1.ListCronJobsResponse.jobs:type_name -> xyz.block.ftl.v1.CronJob
+	124, // 76: xyz.block.ftl.v1.TriggerCronJobRequest.verb:type_name -> xyz.block.ftl.v1.schema.Ref
+	124, // 77: xyz.block.ftl.v1.PauseCronJobRequest.verb:type_name -> xyz.block.ftl.v1.schema.Ref
+	124, // 78: xyz.block.ftl.v1.ResumeCronJobRequest.verb:type_name -> xyz.block.ftl.v1.schema.Ref
+	4,   // 79: xyz.block.ftl.v1.ListConfigRequest.provider:type_name -> xyz.block.ftl.v1.ConfigProvider
+	122, // 80: xyz.block.ftl.v1.ListConfigResponse.configs:type_name -> xyz.block.ftl.v1.ListConfigResponse.Config
+	90,  // 81: xyz.block.ftl.v1.GetConfigRequest.ref:type_name -> xyz.block.ftl.v1.ConfigRef
+	4,   // 82: xyz.block.ftl.v1.SetConfigRequest.provider:type_name -> xyz.block.ftl.v1.ConfigProvider
+	90,  // 83: xyz.block.ftl.v1.SetConfigRequest.ref:type_name -> xyz.block.ftl.v1.ConfigRef
+	4,   // 84: xyz.block.ftl.v1.UnsetConfigRequest.provider:type_name -> xyz.block.ftl.v1.ConfigProvider
+	90,  // 85: xyz.block.ftl.v1.UnsetConfigRequest.ref:type_name -> xyz.block.ftl.v1.ConfigRef
+	5,   // 86: xyz.block.ftl.v1.ListSecretsRequest.provider:type_name -> xyz.block.ftl.v1.SecretProvider
+	123, // 87: xyz.block.ftl.v1.ListSecretsResponse.secrets:type_name -> xyz.block.ftl.v1.ListSecretsResponse.Secret
+	90,  // 88: xyz.block.ftl.v1.GetSecretRequest.ref:type_name -> xyz.block.ftl.v1.ConfigRef
+	5,   // 89: xyz.block.ftl.v1.SetSecretRequest.provider:type_name -> xyz.block.ftl.v1.SecretProvider
+	90,  // 90: xyz.block.ftl.v1.SetSecretRequest.ref:type_name -> xyz.block.ftl.v1.ConfigRef
+	5,   // 91: xyz.block.ftl.v1.UnsetSecretRequest.provider:type_name -> xyz.block.ftl.v1.SecretProvider
+	90,  // 92: xyz.block.ftl.v1.UnsetSecretRequest.ref:type_name -> xyz.block.ftl.v1.ConfigRef
+	6,   // 93: xyz.block.ftl.v1.ModuleContextResponse.DSN.type:type_name -> xyz.block.ftl.v1.ModuleContextResponse.DBType
+	1,   // 94: xyz.block.ftl.v1.StatusResponse.Runner.state:type_name -> xyz.block.ftl.v1.RunnerState
+	130, // 95: xyz.block.ftl.v1.StatusResponse.Runner.labels:type_name -> google.protobuf.Struct
+	130, // 96: xyz.block.ftl.v1.StatusResponse.Deployment.labels:type_name -> google.protobuf.Struct
+	129, // 97: xyz.block.ftl.v1.StatusResponse.Deployment.schema:type_name -> xyz.block.ftl.v1.schema.Module
+	124, // 98: xyz.block.ftl.v1.StatusResponse.IngressRoute.verb:type_name -> xyz.block.ftl.v1.schema.Ref
+	124, // 99: xyz.block.ftl.v1.StatusResponse.CircuitBreaker.verb:type_name -> xyz.block.ftl.v1.schema.Ref
+	2,   // 100: xyz.block.ftl.v1.StatusResponse.CircuitBreaker.state:type_name -> xyz.block.ftl.v1.CircuitBreakerState
+	130, // 101: xyz.block.ftl.v1.ProcessListResponse.ProcessRunner.labels:type_name -> google.protobuf.Struct
+	130, // 102: xyz.block.ftl.v1.ProcessListResponse.Process.labels:type_name -> google.protobuf.Struct
+	120, // 103: xyz.block.ftl.v1.ProcessListResponse.Process.runner:type_name -> xyz.block.ftl.v1.ProcessListResponse.ProcessRunner
+	7,   // 104: xyz.block.ftl.v1.VerbService.Ping:input_type -> xyz.block.ftl.v1.PingRequest
+	9,   // 105: xyz.block.ftl.v1.VerbService.GetModuleContext:input_type -> xyz.block.ftl.v1.ModuleContextRequest
+	14,  // 106: xyz.block.ftl.v1.VerbService.AcquireLease:input_type -> xyz.block.ftl.v1.AcquireLeaseRequest
+	16,  // 107: xyz.block.ftl.v1.VerbService.SendFSMEvent:input_type -> xyz.block.ftl.v1.SendFSMEventRequest
+	18,  // 108: xyz.block.ftl.v1.VerbService.SetNextFSMEvent:input_type -> xyz.block.ftl.v1.SetNextFSMEventRequest
+	20,  // 109: xyz.block.ftl.v1.VerbService.PublishEvent:input_type -> xyz.block.ftl.v1.PublishEventRequest
+	23,  // 110: xyz.block.ftl.v1.VerbService.ScheduleCall:input_type -> xyz.block.ftl.v1.ScheduleCallRequest
+	25,  // 111: xyz.block.ftl.v1.VerbService.SendWebSocketMessage:input_type -> xyz.block.ftl.v1.SendWebSocketMessageRequest
+	12,  // 112: xyz.block.ftl.v1.VerbService.Call:input_type -> xyz.block.ftl.v1.CallRequest
+	12,  // 113: xyz.block.ftl.v1.VerbService.CallStream:input_type -> xyz.block.ftl.v1.CallRequest
+	7,   // 114: xyz.block.ftl.v1.ControllerService.Ping:input_type -> xyz.block.ftl.v1.PingRequest
+	52,  // 115: xyz.block.ftl.v1.ControllerService.ProcessList:input_type -> xyz.block.ftl.v1.ProcessListRequest
+	50,  // 116: xyz.block.ftl.v1.ControllerService.Status:input_type -> xyz.block.ftl.v1.StatusRequest
+	31,  // 117: xyz.block.ftl.v1.ControllerService.GetArtefactDiffs:input_type -> xyz.block.ftl.v1.GetArtefactDiffsRequest
+	33,  // 118: xyz.block.ftl.v1.ControllerService.UploadArtefact:input_type -> xyz.block.ftl.v1.UploadArtefactRequest
+	36,  // 119: xyz.block.ftl.v1.ControllerService.CreateDeployment:input_type -> xyz.block.ftl.v1.CreateDeploymentRequest
+	40,  // 120: xyz.block.ftl.v1.ControllerService.GetDeployment:input_type -> xyz.block.ftl.v1.GetDeploymentRequest
+	38,  // 121: xyz.block.ftl.v1.ControllerService.GetDeploymentArtefacts:input_type -> xyz.block.ftl.v1.GetDeploymentArtefactsRequest
+	42,  // 122: xyz.block.ftl.v1.ControllerService.RegisterRunner:input_type -> xyz.block.ftl.v1.RegisterRunnerRequest
+	44,  // 123: xyz.block.ftl.v1.ControllerService.UpdateDeploy:input_type -> xyz.block.ftl.v1.UpdateDeployRequest
+	46,  // 124: xyz.block.ftl.v1.ControllerService.ReplaceDeploy:input_type -> xyz.block.ftl.v1.ReplaceDeployRequest
+	48,  // 125: xyz.block.ftl.v1.ControllerService.StreamDeploymentLogs:input_type -> xyz.block.ftl.v1.StreamDeploymentLogsRequest
+	27,  // 126: xyz.block.ftl.v1.ControllerService.GetSchema:input_type -> xyz.block.ftl.v1.GetSchemaRequest
+	29,  // 127: xyz.block.ftl.v1.ControllerService.PullSchema:input_type -> xyz.block.ftl.v1.PullSchemaRequest
+	54,  // 128: xyz.block.ftl.v1.ControllerService.ResetSubscription:input_type -> xyz.block.ftl.v1.ResetSubscriptionRequest
+	56,  // 129: xyz.block.ftl.v1.ControllerService.SeekSubscription:input_type -> xyz.block.ftl.v1.SeekSubscriptionRequest
+	59,  // 130: xyz.block.ftl.v1.ControllerService.ListDeadLetters:input_type -> xyz.block.ftl.v1.ListDeadLettersRequest
+	61,  // 131: xyz.block.ftl.v1.ControllerService.ReplayDeadLetters:input_type -> xyz.block.ftl.v1.ReplayDeadLettersRequest
+	63,  // 132: xyz.block.ftl.v1.ControllerService.PurgeDeadLetters:input_type -> xyz.block.ftl.v1.PurgeDeadLettersRequest
+	67,  // 133: xyz.block.ftl.v1.ControllerService.ListFSMInstances:input_type -> xyz.block.ftl.v1.ListFSMInstancesRequest
+	69,  // 134: xyz.block.ftl.v1.ControllerService.GetFSMInstance:input_type -> xyz.block.ftl.v1.GetFSMInstanceRequest
+	72,  // 135: xyz.block.ftl.v1.ControllerService.ListScheduledCalls:input_type -> xyz.block.ftl.v1.ListScheduledCallsRequest
+	74,  // 136: xyz.block.ftl.v1.ControllerService.CancelScheduledCall:input_type -> xyz.block.ftl.v1.CancelScheduledCallRequest
+	77,  // 137: xyz.block.ftl.v1.ControllerService.ListCronJobs:input_type -> xyz.block.ftl.v1.ListCronJobsRequest
+	79,  // 138: xyz.block.ftl.v1.ControllerService.TriggerCronJob:input_type -> xyz.block.ftl.v1.TriggerCronJobRequest
+	81,  // 139: xyz.block.ftl.v1.ControllerService.PauseCronJob:input_type -> xyz.block.ftl.v1.PauseCronJobRequest
+	83,  // 140: xyz.block.ftl.v1.ControllerService.ResumeCronJob:input_type -> xyz.block.ftl.v1.ResumeCronJobRequest
+	7,   // 141: xyz.block.ftl.v1.RunnerService.Ping:input_type -> xyz.block.ftl.v1.PingRequest
+	88,  // 142: xyz.block.ftl.v1.RunnerService.Reserve:input_type -> xyz.block.ftl.v1.ReserveRequest
+	85,  // 143: xyz.block.ftl.v1.RunnerService.Deploy:input_type -> xyz.block.ftl.v1.DeployRequest
+	87,  // 144: xyz.block.ftl.v1.RunnerService.Terminate:input_type -> xyz.block.ftl.v1.TerminateRequest
+	7,   // 145: xyz.block.ftl.v1.AdminService.Ping:input_type -> xyz.block.ftl.v1.PingRequest
+	91,  // 146: xyz.block.ftl.v1.AdminService.ConfigList:input_type -> xyz.block.ftl.v1.ListConfigRequest
+	93,  // 147: xyz.block.ftl.v1.AdminService.ConfigGet:input_type -> xyz.block.ftl.v1.GetConfigRequest
+	95,  // 148: xyz.block.ftl.v1.AdminService.ConfigSet:input_type -> xyz.block.ftl.v1.SetConfigRequest
+	97,  // 149: xyz.block.ftl.v1.AdminService.ConfigUnset:input_type -> xyz.block.ftl.v1.UnsetConfigRequest
+	99,  // 150: xyz.block.ftl.v1.AdminService.SecretsList:input_type -> xyz.block.ftl.v1.ListSecretsRequest
+	101, // 151: xyz.block.ftl.v1.AdminService.SecretGet:input_type -> xyz.block.ftl.v1.GetSecretRequest
+	103, // 152: xyz.block.ftl.v1.AdminService.SecretSet:input_type -> xyz.block.ftl.v1.SetSecretRequest
+	105, // 153: xyz.block.ftl.v1.AdminService.SecretUnset:input_type -> xyz.block.ftl.v1.UnsetSecretRequest
+	8,   // 154: xyz.block.ftl.v1.VerbService.Ping:output_type -> xyz.block.ftl.v1.PingResponse
+	10,  // 155: xyz.block.ftl.v1.VerbService.GetModuleContext:output_type -> xyz.block.ftl.v1.ModuleContextResponse
+	15,  // 156: xyz.block.ftl.v1.VerbService.AcquireLease:output_type -> xyz.block.ftl.v1.AcquireLeaseResponse
+	17,  // 157: xyz.block.ftl.v1.VerbService.SendFSMEvent:output_type -> xyz.block.ftl.v1.SendFSMEventResponse
+	19,  // 158: xyz.block.ftl.v1.VerbService.SetNextFSMEvent:output_type -> xyz.block.ftl.v1.SetNextFSMEventResponse
+	21,  // 159: xyz.block.ftl.v1.VerbService.PublishEvent:output_type -> xyz.block.ftl.v1.PublishEventResponse
+	24,  // 160: xyz.block.ftl.v1.VerbService.ScheduleCall:output_type -> xyz.block.ftl.v1.ScheduleCallResponse
+	26,  // 161: xyz.block.ftl.v1.VerbService.SendWebSocketMessage:output_type -> xyz.block.ftl.v1.SendWebSocketMessageResponse
+	13,  // 162: xyz.block.ftl.v1.VerbService.Call:output_type -> xyz.block.ftl.v1.CallResponse
+	13,  // 163: xyz.block.ftl.v1.VerbService.CallStream:output_type -> xyz.block.ftl.v1.CallResponse
+	8,   // 164: xyz.block.ftl.v1.ControllerService.Ping:output_type -> xyz.block.ftl.v1.PingResponse
+	53,  // 165: xyz.block.ftl.v1.ControllerService.ProcessList:output_type -> xyz.block.ftl.v1.ProcessListResponse
+	51,  // 166: xyz.block.ftl.v1.ControllerService.Status:output_type -> xyz.block.ftl.v1.StatusResponse
+	32,  // 167: xyz.block.ftl.v1.ControllerService.GetArtefactDiffs:output_type -> xyz.block.ftl.v1.GetArtefactDiffsResponse
+	34,  // 168: xyz.block.ftl.v1.ControllerService.UploadArtefact:output_type -> xyz.block.ftl.v1.UploadArtefactResponse
+	37,  // 169: xyz.block.ftl.v1.ControllerService.CreateDeployment:output_type -> xyz.block.ftl.v1.CreateDeploymentResponse
+	41,  // 170: xyz.block.ftl.v1.ControllerService.GetDeployment:output_type -> xyz.block.ftl.v1.GetDeploymentResponse
+	39,  // 171: xyz.block.ftl.v1.ControllerService.GetDeploymentArtefacts:output_type -> xyz.block.ftl.v1.GetDeploymentArtefactsResponse
+	43,  // 172: xyz.block.ftl.v1.ControllerService.RegisterRunner:output_type -> xyz.block.ftl.v1.RegisterRunnerResponse
+	45,  // 173: xyz.block.ftl.v1.ControllerService.UpdateDeploy:output_type -> xyz.block.ftl.v1.UpdateDeployResponse
+	47,  // 174: xyz.block.ftl.v1.ControllerService.ReplaceDeploy:output_type -> xyz.block.ftl.v1.ReplaceDeployResponse
+	49,  // 175: xyz.block.ftl.v1.ControllerService.StreamDeploymentLogs:output_type -> xyz.block.ftl.v1.StreamDeploymentLogsResponse
+	28,  // 176: xyz.block.ftl.v1.ControllerService.GetSchema:output_type -> xyz.block.ftl.v1.GetSchemaResponse
+	30,  // 177: xyz.block.ftl.v1.ControllerService.PullSchema:output_type -> xyz.block.ftl.v1.PullSchemaResponse
+	55,  // 178: xyz.block.ftl.v1.ControllerService.ResetSubscription:output_type -> xyz.block.ftl.v1.ResetSubscriptionResponse
+	57,  // 179: xyz.block.ftl.v1.ControllerService.SeekSubscription:output_type -> xyz.block.ftl.v1.SeekSubscriptionResponse
+	60,  // 180: xyz.block.ftl.v1.ControllerService.ListDeadLetters:output_type -> xyz.block.ftl.v1.ListDeadLettersResponse
+	62,  // 181: xyz.block.ftl.v1.ControllerService.ReplayDeadLetters:output_type -> xyz.block.ftl.v1.ReplayDeadLettersResponse
+	64,  // 182: xyz.block.ftl.v1.ControllerService.PurgeDeadLetters:output_type -> xyz.block.ftl.v1.PurgeDeadLettersResponse
+	68,  // 183: xyz.block.ftl.v1.ControllerService.ListFSMInstances:output_type -> xyz.block.ftl.v1.ListFSMInstancesResponse
+	70,  // 184: xyz.block.ftl.v1.ControllerService.GetFSMInstance:output_type -> xyz.block.ftl.v1.GetFSMInstanceResponse
+	73,  // 185: xyz.block.ftl.v1.ControllerService.ListScheduledCalls:output_type -> xyz.block.ftl.v1.ListScheduledCallsResponse
+	75,  // 186: xyz.block.ftl.v1.ControllerService.CancelScheduledCall:output_type -> xyz.block.ftl.v1.CancelScheduledCallResponse
+	78,  // 187: xyz.block.ftl.v1.ControllerService.ListCronJobs:output_type -> xyz.block.ftl.v1.ListCronJobsResponse
+	80,  // 188: xyz.block.ftl.v1.ControllerService.TriggerCronJob:output_type -> xyz.block.ftl.v1.TriggerCronJobResponse
+	82,  // 189: xyz.block.ftl.v1.ControllerService.PauseCronJob:output_type -> xyz.block.ftl.v1.PauseCronJobResponse
+	84,  // 190: xyz.block.ftl.v1.ControllerService.ResumeCronJob:output_type -> xyz.block.ftl.v1.ResumeCronJobResponse
+	8,   // 191: xyz.block.ftl.v1.RunnerService.Ping:output_type -> xyz.block.ftl.v1.PingResponse
+	89,  // 192: xyz.block.ftl.v1.RunnerService.Reserve:output_type -> xyz.block.ftl.v1.ReserveResponse
+	86,  // 193: xyz.block.ftl.v1.RunnerService.Deploy:output_type -> xyz.block.ftl.v1.DeployResponse
+	42,  // 194: xyz.block.ftl.v1.RunnerService.Terminate:output_type -> xyz.block.ftl.v1.RegisterRunnerRequest
+	8,   // 195: xyz.block.ftl.v1.AdminService.Ping:output_type -> xyz.block.ftl.v1.PingResponse
+	92,  // 196: xyz.block.ftl.v1.AdminService.ConfigList:output_type -> xyz.block.ftl.v1.ListConfigResponse
+	94,  // 197: xyz.block.ftl.v1.AdminService.ConfigGet:output_type -> xyz.block.ftl.v1.GetConfigResponse
+	96,  // 198: xyz.block.ftl.v1.AdminService.ConfigSet:output_type -> xyz.block.ftl.v1.SetConfigResponse
+	98,  // 199: xyz.block.ftl.v1.AdminService.ConfigUnset:output_type -> xyz.block.ftl.v1.UnsetConfigResponse
+	100, // 200: xyz.block.ftl.v1.AdminService.SecretsList:output_type -> xyz.block.ftl.v1.ListSecretsResponse
+	102, // 201: xyz.block.ftl.v1.AdminService.SecretGet:output_type -> xyz.block.ftl.v1.GetSecretResponse
+	104, // 202: xyz.block.ftl.v1.AdminService.SecretSet:output_type -> xyz.block.ftl.v1.SetSecretResponse
+	106, // 203: xyz.block.ftl.v1.AdminService.SecretUnset:output_type -> xyz.block.ftl.v1.UnsetSecretResponse
+	154, // [154:204] is the sub-list for method output_type
+	104, // [104:154] is the sub-list for method input_type
+	104, // [104:104] is the sub-list for extension type_name
+	104, // [104:104] is the sub-list for extension extendee
+	0,   // [0:104] is the sub-list for field type_name
 }
 
 func init() { file_xyz_block_ftl_v1_ftl_proto_init() }
@@ -5458,8 +8397,368 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*PingResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*PingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ModuleContextRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ModuleContextResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Metadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*CallRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*CallResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*AcquireLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*AcquireLeaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*SendFSMEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*SendFSMEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*SetNextFSMEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*SetNextFSMEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*PublishEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*PublishEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*RetryPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*ScheduleCallRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ScheduleCallResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*SendWebSocketMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*SendWebSocketMessageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSchemaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSchemaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*PullSchemaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*PullSchemaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*GetArtefactDiffsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*GetArtefactDiffsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*UploadArtefactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*UploadArtefactResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*DeploymentArtefact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateDeploymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateDeploymentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDeploymentArtefactsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5470,8 +8769,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*ModuleContextRequest); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDeploymentArtefactsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5482,8 +8781,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*ModuleContextResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDeploymentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5494,8 +8793,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*Metadata); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDeploymentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5506,8 +8805,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*CallRequest); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterRunnerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5518,8 +8817,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*CallResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterRunnerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5530,8 +8829,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*AcquireLeaseRequest); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateDeployRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5542,8 +8841,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*AcquireLeaseResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateDeployResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5554,8 +8853,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*SendFSMEventRequest); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*ReplaceDeployRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5566,8 +8865,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*SendFSMEventResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*ReplaceDeployResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5578,8 +8877,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*PublishEventRequest); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*StreamDeploymentLogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5590,8 +8889,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*PublishEventResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*StreamDeploymentLogsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5602,8 +8901,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*GetSchemaRequest); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*StatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5614,8 +8913,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*GetSchemaResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*StatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5626,8 +8925,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*PullSchemaRequest); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*ProcessListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5638,8 +8937,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*PullSchemaResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*ProcessListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5650,8 +8949,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*GetArtefactDiffsRequest); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*ResetSubscriptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5662,8 +8961,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*GetArtefactDiffsResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*ResetSubscriptionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5674,8 +8973,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*UploadArtefactRequest); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*SeekSubscriptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5686,8 +8985,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*UploadArtefactResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*SeekSubscriptionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5698,8 +8997,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*DeploymentArtefact); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*DeadLetter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5710,8 +9009,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateDeploymentRequest); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDeadLettersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5722,8 +9021,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateDeploymentResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDeadLettersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5734,8 +9033,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*GetDeploymentArtefactsRequest); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*ReplayDeadLettersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5746,8 +9045,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*GetDeploymentArtefactsResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*ReplayDeadLettersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5758,8 +9057,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*GetDeploymentRequest); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*PurgeDeadLettersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5770,8 +9069,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*GetDeploymentResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*PurgeDeadLettersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5782,8 +9081,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*RegisterRunnerRequest); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*FSMInstance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5794,8 +9093,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*RegisterRunnerResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*FSMTransitionAttempt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5806,8 +9105,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateDeployRequest); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*ListFSMInstancesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5818,8 +9117,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateDeployResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*ListFSMInstancesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5830,8 +9129,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[32].Exporter = func(v any, i int) any {
-			switch v := v.(*ReplaceDeployRequest); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*GetFSMInstanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5842,8 +9141,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[33].Exporter = func(v any, i int) any {
-			switch v := v.(*ReplaceDeployResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*GetFSMInstanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5854,8 +9153,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[34].Exporter = func(v any, i int) any {
-			switch v := v.(*StreamDeploymentLogsRequest); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*ScheduledCall); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5866,8 +9165,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[35].Exporter = func(v any, i int) any {
-			switch v := v.(*StreamDeploymentLogsResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*ListScheduledCallsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5878,8 +9177,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[36].Exporter = func(v any, i int) any {
-			switch v := v.(*StatusRequest); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*ListScheduledCallsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5890,8 +9189,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[37].Exporter = func(v any, i int) any {
-			switch v := v.(*StatusResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelScheduledCallRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5902,8 +9201,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[38].Exporter = func(v any, i int) any {
-			switch v := v.(*ProcessListRequest); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelScheduledCallResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5914,8 +9213,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[39].Exporter = func(v any, i int) any {
-			switch v := v.(*ProcessListResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*CronJob); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5926,8 +9225,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[40].Exporter = func(v any, i int) any {
-			switch v := v.(*ResetSubscriptionRequest); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCronJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5938,8 +9237,8 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[41].Exporter = func(v any, i int) any {
-			switch v := v.(*ResetSubscriptionResponse); i {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCronJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5950,7 +9249,79 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[42].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*TriggerCronJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*TriggerCronJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*PauseCronJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*PauseCronJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*ResumeCronJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*ResumeCronJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[78].Exporter = func(v any, i int) any {
 			switch v := v.(*DeployRequest); i {
 			case 0:
 				return &v.state
@@ -5962,7 +9333,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[43].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[79].Exporter = func(v any, i int) any {
 			switch v := v.(*DeployResponse); i {
 			case 0:
 				return &v.state
@@ -5974,7 +9345,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[44].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[80].Exporter = func(v any, i int) any {
 			switch v := v.(*TerminateRequest); i {
 			case 0:
 				return &v.state
@@ -5986,7 +9357,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[45].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[81].Exporter = func(v any, i int) any {
 			switch v := v.(*ReserveRequest); i {
 			case 0:
 				return &v.state
@@ -5998,7 +9369,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[46].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[82].Exporter = func(v any, i int) any {
 			switch v := v.(*ReserveResponse); i {
 			case 0:
 				return &v.state
@@ -6010,7 +9381,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[47].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[83].Exporter = func(v any, i int) any {
 			switch v := v.(*ConfigRef); i {
 			case 0:
 				return &v.state
@@ -6022,7 +9393,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[48].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[84].Exporter = func(v any, i int) any {
 			switch v := v.(*ListConfigRequest); i {
 			case 0:
 				return &v.state
@@ -6034,7 +9405,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[49].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[85].Exporter = func(v any, i int) any {
 			switch v := v.(*ListConfigResponse); i {
 			case 0:
 				return &v.state
@@ -6046,7 +9417,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[50].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[86].Exporter = func(v any, i int) any {
 			switch v := v.(*GetConfigRequest); i {
 			case 0:
 				return &v.state
@@ -6058,7 +9429,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[51].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[87].Exporter = func(v any, i int) any {
 			switch v := v.(*GetConfigResponse); i {
 			case 0:
 				return &v.state
@@ -6070,7 +9441,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[52].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[88].Exporter = func(v any, i int) any {
 			switch v := v.(*SetConfigRequest); i {
 			case 0:
 				return &v.state
@@ -6082,7 +9453,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[53].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[89].Exporter = func(v any, i int) any {
 			switch v := v.(*SetConfigResponse); i {
 			case 0:
 				return &v.state
@@ -6094,7 +9465,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[54].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[90].Exporter = func(v any, i int) any {
 			switch v := v.(*UnsetConfigRequest); i {
 			case 0:
 				return &v.state
@@ -6106,7 +9477,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[55].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[91].Exporter = func(v any, i int) any {
 			switch v := v.(*UnsetConfigResponse); i {
 			case 0:
 				return &v.state
@@ -6118,7 +9489,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[56].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[92].Exporter = func(v any, i int) any {
 			switch v := v.(*ListSecretsRequest); i {
 			case 0:
 				return &v.state
@@ -6130,7 +9501,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[57].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[93].Exporter = func(v any, i int) any {
 			switch v := v.(*ListSecretsResponse); i {
 			case 0:
 				return &v.state
@@ -6142,7 +9513,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[58].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[94].Exporter = func(v any, i int) any {
 			switch v := v.(*GetSecretRequest); i {
 			case 0:
 				return &v.state
@@ -6154,7 +9525,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[59].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[95].Exporter = func(v any, i int) any {
 			switch v := v.(*GetSecretResponse); i {
 			case 0:
 				return &v.state
@@ -6166,7 +9537,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[60].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[96].Exporter = func(v any, i int) any {
 			switch v := v.(*SetSecretRequest); i {
 			case 0:
 				return &v.state
@@ -6178,7 +9549,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[61].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[97].Exporter = func(v any, i int) any {
 			switch v := v.(*SetSecretResponse); i {
 			case 0:
 				return &v.state
@@ -6190,7 +9561,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[62].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[98].Exporter = func(v any, i int) any {
 			switch v := v.(*UnsetSecretRequest); i {
 			case 0:
 				return &v.state
@@ -6202,7 +9573,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[63].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[99].Exporter = func(v any, i int) any {
 			switch v := v.(*UnsetSecretResponse); i {
 			case 0:
 				return &v.state
@@ -6214,7 +9585,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[64].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[100].Exporter = func(v any, i int) any {
 			switch v := v.(*ModuleContextResponse_Ref); i {
 			case 0:
 				return &v.state
@@ -6226,7 +9597,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[65].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[101].Exporter = func(v any, i int) any {
 			switch v := v.(*ModuleContextResponse_DSN); i {
 			case 0:
 				return &v.state
@@ -6238,7 +9609,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[68].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[104].Exporter = func(v any, i int) any {
 			switch v := v.(*Metadata_Pair); i {
 			case 0:
 				return &v.state
@@ -6250,7 +9621,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[69].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[105].Exporter = func(v any, i int) any {
 			switch v := v.(*CallResponse_Error); i {
 			case 0:
 				return &v.state
@@ -6262,7 +9633,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[71].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[107].Exporter = func(v any, i int) any {
 			switch v := v.(*StatusResponse_Controller); i {
 			case 0:
 				return &v.state
@@ -6274,7 +9645,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[72].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[108].Exporter = func(v any, i int) any {
 			switch v := v.(*StatusResponse_Runner); i {
 			case 0:
 				return &v.state
@@ -6286,7 +9657,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[73].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[109].Exporter = func(v any, i int) any {
 			switch v := v.(*StatusResponse_Deployment); i {
 			case 0:
 				return &v.state
@@ -6298,7 +9669,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[74].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[110].Exporter = func(v any, i int) any {
 			switch v := v.(*StatusResponse_IngressRoute); i {
 			case 0:
 				return &v.state
@@ -6310,7 +9681,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[75].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[111].Exporter = func(v any, i int) any {
 			switch v := v.(*StatusResponse_Route); i {
 			case 0:
 				return &v.state
@@ -6322,7 +9693,19 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[76].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[112].Exporter = func(v any, i int) any {
+			switch v := v.(*StatusResponse_CircuitBreaker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[113].Exporter = func(v any, i int) any {
 			switch v := v.(*ProcessListResponse_ProcessRunner); i {
 			case 0:
 				return &v.state
@@ -6334,7 +9717,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[77].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[114].Exporter = func(v any, i int) any {
 			switch v := v.(*ProcessListResponse_Process); i {
 			case 0:
 				return &v.state
@@ -6346,7 +9729,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[78].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[115].Exporter = func(v any, i int) any {
 			switch v := v.(*ListConfigResponse_Config); i {
 			case 0:
 				return &v.state
@@ -6358,7 +9741,7 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_ftl_proto_msgTypes[79].Exporter = func(v any, i int) any {
+		file_xyz_block_ftl_v1_ftl_proto_msgTypes[116].Exporter = func(v any, i int) any {
 			switch v := v.(*ListSecretsResponse_Secret); i {
 			case 0:
 				return &v.state
@@ -6372,35 +9755,51 @@ func file_xyz_block_ftl_v1_ftl_proto_init() {
 		}
 	}
 	file_xyz_block_ftl_v1_ftl_proto_msgTypes[1].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[5].OneofWrappers = []any{}
 	file_xyz_block_ftl_v1_ftl_proto_msgTypes[6].OneofWrappers = []any{
 		(*CallResponse_Body)(nil),
 		(*CallResponse_Error_)(nil),
 	}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[13].OneofWrappers = []any{}
 	file_xyz_block_ftl_v1_ftl_proto_msgTypes[16].OneofWrappers = []any{}
-	file_xyz_block_ftl_v1_ftl_proto_msgTypes[22].OneofWrappers = []any{}
 	file_xyz_block_ftl_v1_ftl_proto_msgTypes[23].OneofWrappers = []any{}
-	file_xyz_block_ftl_v1_ftl_proto_msgTypes[28].OneofWrappers = []any{}
-	file_xyz_block_ftl_v1_ftl_proto_msgTypes[34].OneofWrappers = []any{}
-	file_xyz_block_ftl_v1_ftl_proto_msgTypes[47].OneofWrappers = []any{}
-	file_xyz_block_ftl_v1_ftl_proto_msgTypes[48].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[29].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[30].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[35].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[41].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[49].OneofWrappers = []any{
+		(*SeekSubscriptionRequest_EventKey)(nil),
+		(*SeekSubscriptionRequest_Time)(nil),
+	}
 	file_xyz_block_ftl_v1_ftl_proto_msgTypes[52].OneofWrappers = []any{}
 	file_xyz_block_ftl_v1_ftl_proto_msgTypes[54].OneofWrappers = []any{}
 	file_xyz_block_ftl_v1_ftl_proto_msgTypes[56].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[58].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[59].OneofWrappers = []any{}
 	file_xyz_block_ftl_v1_ftl_proto_msgTypes[60].OneofWrappers = []any{}
-	file_xyz_block_ftl_v1_ftl_proto_msgTypes[62].OneofWrappers = []any{}
 	file_xyz_block_ftl_v1_ftl_proto_msgTypes[64].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[65].OneofWrappers = []any{}
 	file_xyz_block_ftl_v1_ftl_proto_msgTypes[69].OneofWrappers = []any{}
-	file_xyz_block_ftl_v1_ftl_proto_msgTypes[72].OneofWrappers = []any{}
-	file_xyz_block_ftl_v1_ftl_proto_msgTypes[77].OneofWrappers = []any{}
-	file_xyz_block_ftl_v1_ftl_proto_msgTypes[78].OneofWrappers = []any{}
-	file_xyz_block_ftl_v1_ftl_proto_msgTypes[79].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[83].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[84].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[88].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[90].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[92].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[96].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[98].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[100].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[105].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[108].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[114].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[115].OneofWrappers = []any{}
+	file_xyz_block_ftl_v1_ftl_proto_msgTypes[116].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_xyz_block_ftl_v1_ftl_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   80,
+			NumEnums:      7,
+			NumMessages:   117,
 			NumExtensions: 0,
 			NumServices:   4,
 		},
@@ -50,11 +50,22 @@ const (
 	// VerbServiceSendFSMEventProcedure is the fully-qualified name of the VerbService's SendFSMEvent
 	// RPC.
 	VerbServiceSendFSMEventProcedure = "/xyz.block.ftl.v1.VerbService/SendFSMEvent"
+	// VerbServiceSetNextFSMEventProcedure is the fully-qualified name of the VerbService's
+	// SetNextFSMEvent RPC.
+	VerbServiceSetNextFSMEventProcedure = "/xyz.block.ftl.v1.VerbService/SetNextFSMEvent"
 	// VerbServicePublishEventProcedure is the fully-qualified name of the VerbService's PublishEvent
 	// RPC.
 	VerbServicePublishEventProcedure = "/xyz.block.ftl.v1.VerbService/PublishEvent"
+	// VerbServiceScheduleCallProcedure is the fully-qualified name of the VerbService's ScheduleCall
+	// RPC.
+	VerbServiceScheduleCallProcedure = "/xyz.block.ftl.v1.VerbService/ScheduleCall"
+	// VerbServiceSendWebSocketMessageProcedure is the fully-qualified name of the VerbService's
+	// SendWebSocketMessage RPC.
+	VerbServiceSendWebSocketMessageProcedure = "/xyz.block.ftl.v1.VerbService/SendWebSocketMessage"
 	// VerbServiceCallProcedure is the fully-qualified name of the VerbService's Call RPC.
 	VerbServiceCallProcedure = "/xyz.block.ftl.v1.VerbService/Call"
+	// VerbServiceCallStreamProcedure is the fully-qualified name of the VerbService's CallStream RPC.
+	VerbServiceCallStreamProcedure = "/xyz.block.ftl.v1.VerbService/CallStream"
 	// ControllerServicePingProcedure is the fully-qualified name of the ControllerService's Ping RPC.
 	ControllerServicePingProcedure = "/xyz.block.ftl.v1.ControllerService/Ping"
 	// ControllerServiceProcessListProcedure is the fully-qualified name of the ControllerService's
@@ -99,6 +110,42 @@ const (
 	// ControllerServiceResetSubscriptionProcedure is the fully-qualified name of the
 	// ControllerService's ResetSubscription RPC.
 	ControllerServiceResetSubscriptionProcedure = "/xyz.block.ftl.v1.ControllerService/ResetSubscription"
+	// ControllerServiceSeekSubscriptionProcedure is the fully-qualified name of the ControllerService's
+	// SeekSubscription RPC.
+	ControllerServiceSeekSubscriptionProcedure = "/xyz.block.ftl.v1.ControllerService/SeekSubscription"
+	// ControllerServiceListDeadLettersProcedure is the fully-qualified name of the ControllerService's
+	// ListDeadLetters RPC.
+	ControllerServiceListDeadLettersProcedure = "/xyz.block.ftl.v1.ControllerService/ListDeadLetters"
+	// ControllerServiceReplayDeadLettersProcedure is the fully-qualified name of the
+	// ControllerService's ReplayDeadLetters RPC.
+	ControllerServiceReplayDeadLettersProcedure = "/xyz.block.ftl.v1.ControllerService/ReplayDeadLetters"
+	// ControllerServicePurgeDeadLettersProcedure is the fully-qualified name of the ControllerService's
+	// PurgeDeadLetters RPC.
+	ControllerServicePurgeDeadLettersProcedure = "/xyz.block.ftl.v1.ControllerService/PurgeDeadLetters"
+	// ControllerServiceListFSMInstancesProcedure is the fully-qualified name of the ControllerService's
+	// ListFSMInstances RPC.
+	ControllerServiceListFSMInstancesProcedure = "/xyz.block.ftl.v1.ControllerService/ListFSMInstances"
+	// ControllerServiceGetFSMInstanceProcedure is the fully-qualified name of the ControllerService's
+	// GetFSMInstance RPC.
+	ControllerServiceGetFSMInstanceProcedure = "/xyz.block.ftl.v1.ControllerService/GetFSMInstance"
+	// ControllerServiceListScheduledCallsProcedure is the fully-qualified name of the
+	// ControllerService's ListScheduledCalls RPC.
+	ControllerServiceListScheduledCallsProcedure = "/xyz.block.ftl.v1.ControllerService/ListScheduledCalls"
+	// ControllerServiceCancelScheduledCallProcedure is the fully-qualified name of the
+	// ControllerService's CancelScheduledCall RPC.
+	ControllerServiceCancelScheduledCallProcedure = "/xyz.block.ftl.v1.ControllerService/CancelScheduledCall"
+	// ControllerServiceListCronJobsProcedure is the fully-qualified name of the ControllerService's
+	// ListCronJobs RPC.
+	ControllerServiceListCronJobsProcedure = "/xyz.block.ftl.v1.ControllerService/ListCronJobs"
+	// ControllerServiceTriggerCronJobProcedure is the fully-qualified name of the ControllerService's
+	// TriggerCronJob RPC.
+	ControllerServiceTriggerCronJobProcedure = "/xyz.block.ftl.v1.ControllerService/TriggerCronJob"
+	// ControllerServicePauseCronJobProcedure is the fully-qualified name of the ControllerService's
+	// PauseCronJob RPC.
+	ControllerServicePauseCronJobProcedure = "/xyz.block.ftl.v1.ControllerService/PauseCronJob"
+	// ControllerServiceResumeCronJobProcedure is the fully-qualified name of the ControllerService's
+	// ResumeCronJob RPC.
+	ControllerServiceResumeCronJobProcedure = "/xyz.block.ftl.v1.ControllerService/ResumeCronJob"
 	// RunnerServicePingProcedure is the fully-qualified name of the RunnerService's Ping RPC.
 	RunnerServicePingProcedure = "/xyz.block.ftl.v1.RunnerService/Ping"
 	// RunnerServiceReserveProcedure is the fully-qualified name of the RunnerService's Reserve RPC.
@@ -142,10 +189,24 @@ type VerbServiceClient interface {
 	AcquireLease(context.Context) *connect.BidiStreamForClient[v1.AcquireLeaseRequest, v1.AcquireLeaseResponse]
 	// Send an event to an FSM.
 	SendFSMEvent(context.Context, *connect.Request[v1.SendFSMEventRequest]) (*connect.Response[v1.SendFSMEventResponse], error)
+	// Set the event that an FSM instance will be sent when the transition it is
+	// executing completes.
+	SetNextFSMEvent(context.Context, *connect.Request[v1.SetNextFSMEventRequest]) (*connect.Response[v1.SetNextFSMEventResponse], error)
 	// Publish an event to a topic.
 	PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[v1.PublishEventResponse], error)
+	// Durably schedule an asynchronous call to a Verb.
+	ScheduleCall(context.Context, *connect.Request[v1.ScheduleCallRequest]) (*connect.Response[v1.ScheduleCallResponse], error)
+	// Send a message to a client connected to a WebSocket ingress verb.
+	//
+	// The connection may be held by any controller in the cluster.
+	SendWebSocketMessage(context.Context, *connect.Request[v1.SendWebSocketMessageRequest]) (*connect.Response[v1.SendWebSocketMessageResponse], error)
 	// Issue a synchronous call to a Verb.
 	Call(context.Context, *connect.Request[v1.CallRequest]) (*connect.Response[v1.CallResponse], error)
+	// Issue a call to a streaming Verb.
+	//
+	// Each response carries a single message from the stream. If the Verb
+	// fails, the final response is an error.
+	CallStream(context.Context, *connect.Request[v1.CallRequest]) (*connect.ServerStreamForClient[v1.CallResponse], error)
 }
 
 // NewVerbServiceClient constructs a client for the xyz.block.ftl.v1.VerbService service. By
@@ -179,27 +240,51 @@ func NewVerbServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			baseURL+VerbServiceSendFSMEventProcedure,
 			opts...,
 		),
+		setNextFSMEvent: connect.NewClient[v1.SetNextFSMEventRequest, v1.SetNextFSMEventResponse](
+			httpClient,
+			baseURL+VerbServiceSetNextFSMEventProcedure,
+			opts...,
+		),
 		publishEvent: connect.NewClient[v1.PublishEventRequest, v1.PublishEventResponse](
 			httpClient,
 			baseURL+VerbServicePublishEventProcedure,
 			opts...,
 		),
+		scheduleCall: connect.NewClient[v1.ScheduleCallRequest, v1.ScheduleCallResponse](
+			httpClient,
+			baseURL+VerbServiceScheduleCallProcedure,
+			opts...,
+		),
+		sendWebSocketMessage: connect.NewClient[v1.SendWebSocketMessageRequest, v1.SendWebSocketMessageResponse](
+			httpClient,
+			baseURL+VerbServiceSendWebSocketMessageProcedure,
+			opts...,
+		),
 		call: connect.NewClient[v1.CallRequest, v1.CallResponse](
 			httpClient,
 			baseURL+VerbServiceCallProcedure,
 			opts...,
 		),
+		callStream: connect.NewClient[v1.CallRequest, v1.CallResponse](
+			httpClient,
+			baseURL+VerbServiceCallStreamProcedure,
+			opts...,
+		),
 	}
 }
 
 // verbServiceClient implements VerbServiceClient.
 type verbServiceClient struct {
-	ping             *connect.Client[v1.PingRequest, v1.PingResponse]
-	getModuleContext *connect.Client[v1.ModuleContextRequest, v1.ModuleContextResponse]
-	acquireLease     *connect.Client[v1.AcquireLeaseRequest, v1.AcquireLeaseResponse]
-	sendFSMEvent     *connect.Client[v1.SendFSMEventRequest, v1.SendFSMEventResponse]
-	publishEvent     *connect.Client[v1.PublishEventRequest, v1.PublishEventResponse]
-	call             *connect.Client[v1.CallRequest, v1.CallResponse]
+	ping                 *connect.Client[v1.PingRequest, v1.PingResponse]
+	getModuleContext     *connect.Client[v1.ModuleContextRequest, v1.ModuleContextResponse]
+	acquireLease         *connect.Client[v1.AcquireLeaseRequest, v1.AcquireLeaseResponse]
+	sendFSMEvent         *connect.Client[v1.SendFSMEventRequest, v1.SendFSMEventResponse]
+	setNextFSMEvent      *connect.Client[v1.SetNextFSMEventRequest, v1.SetNextFSMEventResponse]
+	publishEvent         *connect.Client[v1.PublishEventRequest, v1.PublishEventResponse]
+	scheduleCall         *connect.Client[v1.ScheduleCallRequest, v1.ScheduleCallResponse]
+	sendWebSocketMessage *connect.Client[v1.SendWebSocketMessageRequest, v1.SendWebSocketMessageResponse]
+	call                 *connect.Client[v1.CallRequest, v1.CallResponse]
+	callStream           *connect.Client[v1.CallRequest, v1.CallResponse]
 }
 
 // Ping calls xyz.block.ftl.v1.VerbService.Ping.
@@ -222,16 +307,36 @@ func (c *verbServiceClient) SendFSMEvent(ctx context.Context, req *connect.Reque
 	return c.sendFSMEvent.CallUnary(ctx, req)
 }
 
+// SetNextFSMEvent calls xyz.block.ftl.v1.VerbService.SetNextFSMEvent.
+func (c *verbServiceClient) SetNextFSMEvent(ctx context.Context, req *connect.Request[v1.SetNextFSMEventRequest]) (*connect.Response[v1.SetNextFSMEventResponse], error) {
+	return c.setNextFSMEvent.CallUnary(ctx, req)
+}
+
 // PublishEvent calls xyz.block.ftl.v1.VerbService.PublishEvent.
 func (c *verbServiceClient) PublishEvent(ctx context.Context, req *connect.Request[v1.PublishEventRequest]) (*connect.Response[v1.PublishEventResponse], error) {
 	return c.publishEvent.CallUnary(ctx, req)
 }
 
+// ScheduleCall calls xyz.block.ftl.v1.VerbService.ScheduleCall.
+func (c *verbServiceClient) ScheduleCall(ctx context.Context, req *connect.Request[v1.ScheduleCallRequest]) (*connect.Response[v1.ScheduleCallResponse], error) {
+	return c.scheduleCall.CallUnary(ctx, req)
+}
+
+// SendWebSocketMessage calls xyz.block.ftl.v1.VerbService.SendWebSocketMessage.
+func (c *verbServiceClient) SendWebSocketMessage(ctx context.Context, req *connect.Request[v1.SendWebSocketMessageRequest]) (*connect.Response[v1.SendWebSocketMessageResponse], error) {
+	return c.sendWebSocketMessage.CallUnary(ctx, req)
+}
+
 // Call calls xyz.block.ftl.v1.VerbService.Call.
 func (c *verbServiceClient) Call(ctx context.Context, req *connect.Request[v1.CallRequest]) (*connect.Response[v1.CallResponse], error) {
 	return c.call.CallUnary(ctx, req)
 }
 
+// CallStream calls xyz.block.ftl.v1.VerbService.CallStream.
+func (c *verbServiceClient) CallStream(ctx context.Context, req *connect.Request[v1.CallRequest]) (*connect.ServerStreamForClient[v1.CallResponse], error) {
+	return c.callStream.CallServerStream(ctx, req)
+}
+
 // VerbServiceHandler is an implementation of the xyz.block.ftl.v1.VerbService service.
 type VerbServiceHandler interface {
 	// Ping service for readiness.
@@ -244,10 +349,24 @@ type VerbServiceHandler interface {
 	AcquireLease(context.Context, *connect.BidiStream[v1.AcquireLeaseRequest, v1.AcquireLeaseResponse]) error
 	// Send an event to an FSM.
 	SendFSMEvent(context.Context, *connect.Request[v1.SendFSMEventRequest]) (*connect.Response[v1.SendFSMEventResponse], error)
+	// Set the event that an FSM instance will be sent when the transition it is
+	// executing completes.
+	SetNextFSMEvent(context.Context, *connect.Request[v1.SetNextFSMEventRequest]) (*connect.Response[v1.SetNextFSMEventResponse], error)
 	// Publish an event to a topic.
 	PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[v1.PublishEventResponse], error)
+	// Durably schedule an asynchronous call to a Verb.
+	ScheduleCall(context.Context, *connect.Request[v1.ScheduleCallRequest]) (*connect.Response[v1.ScheduleCallResponse], error)
+	// Send a message to a client connected to a WebSocket ingress verb.
+	//
+	// The connection may be held by any controller in the cluster.
+	SendWebSocketMessage(context.Context, *connect.Request[v1.SendWebSocketMessageRequest]) (*connect.Response[v1.SendWebSocketMessageResponse], error)
 	// Issue a synchronous call to a Verb.
 	Call(context.Context, *connect.Request[v1.CallRequest]) (*connect.Response[v1.CallResponse], error)
+	// Issue a call to a streaming Verb.
+	//
+	// Each response carries a single message from the stream. If the Verb
+	// fails, the final response is an error.
+	CallStream(context.Context, *connect.Request[v1.CallRequest], *connect.ServerStream[v1.CallResponse]) error
 }
 
 // NewVerbServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -277,16 +396,36 @@ func NewVerbServiceHandler(svc VerbServiceHandler, opts ...connect.HandlerOption
 		svc.SendFSMEvent,
 		opts...,
 	)
+	verbServiceSetNextFSMEventHandler := connect.NewUnaryHandler(
+		VerbServiceSetNextFSMEventProcedure,
+		svc.SetNextFSMEvent,
+		opts...,
+	)
 	verbServicePublishEventHandler := connect.NewUnaryHandler(
 		VerbServicePublishEventProcedure,
 		svc.PublishEvent,
 		opts...,
 	)
+	verbServiceScheduleCallHandler := connect.NewUnaryHandler(
+		VerbServiceScheduleCallProcedure,
+		svc.ScheduleCall,
+		opts...,
+	)
+	verbServiceSendWebSocketMessageHandler := connect.NewUnaryHandler(
+		VerbServiceSendWebSocketMessageProcedure,
+		svc.SendWebSocketMessage,
+		opts...,
+	)
 	verbServiceCallHandler := connect.NewUnaryHandler(
 		VerbServiceCallProcedure,
 		svc.Call,
 		opts...,
 	)
+	verbServiceCallStreamHandler := connect.NewServerStreamHandler(
+		VerbServiceCallStreamProcedure,
+		svc.CallStream,
+		opts...,
+	)
 	return "/xyz.block.ftl.v1.VerbService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case VerbServicePingProcedure:
@@ -297,10 +436,18 @@ func NewVerbServiceHandler(svc VerbServiceHandler, opts ...connect.HandlerOption
 			verbServiceAcquireLeaseHandler.ServeHTTP(w, r)
 		case VerbServiceSendFSMEventProcedure:
 			verbServiceSendFSMEventHandler.ServeHTTP(w, r)
+		case VerbServiceSetNextFSMEventProcedure:
+			verbServiceSetNextFSMEventHandler.ServeHTTP(w, r)
 		case VerbServicePublishEventProcedure:
 			verbServicePublishEventHandler.ServeHTTP(w, r)
+		case VerbServiceScheduleCallProcedure:
+			verbServiceScheduleCallHandler.ServeHTTP(w, r)
+		case VerbServiceSendWebSocketMessageProcedure:
+			verbServiceSendWebSocketMessageHandler.ServeHTTP(w, r)
 		case VerbServiceCallProcedure:
 			verbServiceCallHandler.ServeHTTP(w, r)
+		case VerbServiceCallStreamProcedure:
+			verbServiceCallStreamHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -326,14 +473,30 @@ func (UnimplementedVerbServiceHandler) SendFSMEvent(context.Context, *connect.Re
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.SendFSMEvent is not implemented"))
 }
 
+func (UnimplementedVerbServiceHandler) SetNextFSMEvent(context.Context, *connect.Request[v1.SetNextFSMEventRequest]) (*connect.Response[v1.SetNextFSMEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.SetNextFSMEvent is not implemented"))
+}
+
 func (UnimplementedVerbServiceHandler) PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[v1.PublishEventResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.PublishEvent is not implemented"))
 }
 
+func (UnimplementedVerbServiceHandler) ScheduleCall(context.Context, *connect.Request[v1.ScheduleCallRequest]) (*connect.Response[v1.ScheduleCallResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.ScheduleCall is not implemented"))
+}
+
+func (UnimplementedVerbServiceHandler) SendWebSocketMessage(context.Context, *connect.Request[v1.SendWebSocketMessageRequest]) (*connect.Response[v1.SendWebSocketMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.SendWebSocketMessage is not implemented"))
+}
+
 func (UnimplementedVerbServiceHandler) Call(context.Context, *connect.Request[v1.CallRequest]) (*connect.Response[v1.CallResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.Call is not implemented"))
 }
 
+func (UnimplementedVerbServiceHandler) CallStream(context.Context, *connect.Request[v1.CallRequest], *connect.ServerStream[v1.CallResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.CallStream is not implemented"))
+}
+
 // ControllerServiceClient is a client for the xyz.block.ftl.v1.ControllerService service.
 type ControllerServiceClient interface {
 	// Ping service for readiness.
@@ -377,6 +540,30 @@ type ControllerServiceClient interface {
 	PullSchema(context.Context, *connect.Request[v1.PullSchemaRequest]) (*connect.ServerStreamForClient[v1.PullSchemaResponse], error)
 	// Reset the cursor for a subscription to the head of its topic.
 	ResetSubscription(context.Context, *connect.Request[v1.ResetSubscriptionRequest]) (*connect.Response[v1.ResetSubscriptionResponse], error)
+	// Move the cursor for a subscription to an event or point in time.
+	SeekSubscription(context.Context, *connect.Request[v1.SeekSubscriptionRequest]) (*connect.Response[v1.SeekSubscriptionResponse], error)
+	// List events that a subscription permanently failed to consume.
+	ListDeadLetters(context.Context, *connect.Request[v1.ListDeadLettersRequest]) (*connect.Response[v1.ListDeadLettersResponse], error)
+	// Schedule dead lettered events to be consumed by their subscription again.
+	ReplayDeadLetters(context.Context, *connect.Request[v1.ReplayDeadLettersRequest]) (*connect.Response[v1.ReplayDeadLettersResponse], error)
+	// Discard dead lettered events.
+	PurgeDeadLetters(context.Context, *connect.Request[v1.PurgeDeadLettersRequest]) (*connect.Response[v1.PurgeDeadLettersResponse], error)
+	// List instances of an FSM.
+	ListFSMInstances(context.Context, *connect.Request[v1.ListFSMInstancesRequest]) (*connect.Response[v1.ListFSMInstancesResponse], error)
+	// Get an FSM instance and its transition history.
+	GetFSMInstance(context.Context, *connect.Request[v1.GetFSMInstanceRequest]) (*connect.Response[v1.GetFSMInstanceResponse], error)
+	// List scheduled calls that have not yet completed.
+	ListScheduledCalls(context.Context, *connect.Request[v1.ListScheduledCallsRequest]) (*connect.Response[v1.ListScheduledCallsResponse], error)
+	// Cancel a scheduled call that is not yet executing.
+	CancelScheduledCall(context.Context, *connect.Request[v1.CancelScheduledCallRequest]) (*connect.Response[v1.CancelScheduledCallResponse], error)
+	// List the cron jobs of active deployments.
+	ListCronJobs(context.Context, *connect.Request[v1.ListCronJobsRequest]) (*connect.Response[v1.ListCronJobsResponse], error)
+	// Execute a cron job immediately, even if it is paused.
+	TriggerCronJob(context.Context, *connect.Request[v1.TriggerCronJobRequest]) (*connect.Response[v1.TriggerCronJobResponse], error)
+	// Stop a cron job from being scheduled, including in future deployments of its module.
+	PauseCronJob(context.Context, *connect.Request[v1.PauseCronJobRequest]) (*connect.Response[v1.PauseCronJobResponse], error)
+	// Allow a paused cron job to be scheduled again.
+	ResumeCronJob(context.Context, *connect.Request[v1.ResumeCronJobRequest]) (*connect.Response[v1.ResumeCronJobResponse], error)
 }
 
 // NewControllerServiceClient constructs a client for the xyz.block.ftl.v1.ControllerService
@@ -465,6 +652,71 @@ func NewControllerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			baseURL+ControllerServiceResetSubscriptionProcedure,
 			opts...,
 		),
+		seekSubscription: connect.NewClient[v1.SeekSubscriptionRequest, v1.SeekSubscriptionResponse](
+			httpClient,
+			baseURL+ControllerServiceSeekSubscriptionProcedure,
+			opts...,
+		),
+		listDeadLetters: connect.NewClient[v1.ListDeadLettersRequest, v1.ListDeadLettersResponse](
+			httpClient,
+			baseURL+ControllerServiceListDeadLettersProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		replayDeadLetters: connect.NewClient[v1.ReplayDeadLettersRequest, v1.ReplayDeadLettersResponse](
+			httpClient,
+			baseURL+ControllerServiceReplayDeadLettersProcedure,
+			opts...,
+		),
+		purgeDeadLetters: connect.NewClient[v1.PurgeDeadLettersRequest, v1.PurgeDeadLettersResponse](
+			httpClient,
+			baseURL+ControllerServicePurgeDeadLettersProcedure,
+			opts...,
+		),
+		listFSMInstances: connect.NewClient[v1.ListFSMInstancesRequest, v1.ListFSMInstancesResponse](
+			httpClient,
+			baseURL+ControllerServiceListFSMInstancesProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getFSMInstance: connect.NewClient[v1.GetFSMInstanceRequest, v1.GetFSMInstanceResponse](
+			httpClient,
+			baseURL+ControllerServiceGetFSMInstanceProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listScheduledCalls: connect.NewClient[v1.ListScheduledCallsRequest, v1.ListScheduledCallsResponse](
+			httpClient,
+			baseURL+ControllerServiceListScheduledCallsProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		cancelScheduledCall: connect.NewClient[v1.CancelScheduledCallRequest, v1.CancelScheduledCallResponse](
+			httpClient,
+			baseURL+ControllerServiceCancelScheduledCallProcedure,
+			opts...,
+		),
+		listCronJobs: connect.NewClient[v1.ListCronJobsRequest, v1.ListCronJobsResponse](
+			httpClient,
+			baseURL+ControllerServiceListCronJobsProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		triggerCronJob: connect.NewClient[v1.TriggerCronJobRequest, v1.TriggerCronJobResponse](
+			httpClient,
+			baseURL+ControllerServiceTriggerCronJobProcedure,
+			opts...,
+		),
+		pauseCronJob: connect.NewClient[v1.PauseCronJobRequest, v1.PauseCronJobResponse](
+			httpClient,
+			baseURL+ControllerServicePauseCronJobProcedure,
+			opts...,
+		),
+		resumeCronJob: connect.NewClient[v1.ResumeCronJobRequest, v1.ResumeCronJobResponse](
+			httpClient,
+			baseURL+ControllerServiceResumeCronJobProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -485,6 +737,18 @@ type controllerServiceClient struct {
 	getSchema              *connect.Client[v1.GetSchemaRequest, v1.GetSchemaResponse]
 	pullSchema             *connect.Client[v1.PullSchemaRequest, v1.PullSchemaResponse]
 	resetSubscription      *connect.Client[v1.ResetSubscriptionRequest, v1.ResetSubscriptionResponse]
+	seekSubscription       *connect.Client[v1.SeekSubscriptionRequest, v1.SeekSubscriptionResponse]
+	listDeadLetters        *connect.Client[v1.ListDeadLettersRequest, v1.ListDeadLettersResponse]
+	replayDeadLetters      *connect.Client[v1.ReplayDeadLettersRequest, v1.ReplayDeadLettersResponse]
+	purgeDeadLetters       *connect.Client[v1.PurgeDeadLettersRequest, v1.PurgeDeadLettersResponse]
+	listFSMInstances       *connect.Client[v1.ListFSMInstancesRequest, v1.ListFSMInstancesResponse]
+	getFSMInstance         *connect.Client[v1.GetFSMInstanceRequest, v1.GetFSMInstanceResponse]
+	listScheduledCalls     *connect.Client[v1.ListScheduledCallsRequest, v1.ListScheduledCallsResponse]
+	cancelScheduledCall    *connect.Client[v1.CancelScheduledCallRequest, v1.CancelScheduledCallResponse]
+	listCronJobs           *connect.Client[v1.ListCronJobsRequest, v1.ListCronJobsResponse]
+	triggerCronJob         *connect.Client[v1.TriggerCronJobRequest, v1.TriggerCronJobResponse]
+	pauseCronJob           *connect.Client[v1.PauseCronJobRequest, v1.PauseCronJobResponse]
+	resumeCronJob          *connect.Client[v1.ResumeCronJobRequest, v1.ResumeCronJobResponse]
 }
 
 // Ping calls xyz.block.ftl.v1.ControllerService.Ping.
@@ -562,6 +826,66 @@ func (c *controllerServiceClient) ResetSubscription(ctx context.Context, req *co
 	return c.resetSubscription.CallUnary(ctx, req)
 }
 
+// SeekSubscription calls xyz.block.ftl.v1.ControllerService.SeekSubscription.
+func (c *controllerServiceClient) SeekSubscription(ctx context.Context, req *connect.Request[v1.SeekSubscriptionRequest]) (*connect.Response[v1.SeekSubscriptionResponse], error) {
+	return c.seekSubscription.CallUnary(ctx, req)
+}
+
+// ListDeadLetters calls xyz.block.ftl.v1.ControllerService.ListDeadLetters.
+func (c *controllerServiceClient) ListDeadLetters(ctx context.Context, req *connect.Request[v1.ListDeadLettersRequest]) (*connect.Response[v1.ListDeadLettersResponse], error) {
+	return c.listDeadLetters.CallUnary(ctx, req)
+}
+
+// ReplayDeadLetters calls xyz.block.ftl.v1.ControllerService.ReplayDeadLetters.
+func (c *controllerServiceClient) ReplayDeadLetters(ctx context.Context, req *connect.Request[v1.ReplayDeadLettersRequest]) (*connect.Response[v1.ReplayDeadLettersResponse], error) {
+	return c.replayDeadLetters.CallUnary(ctx, req)
+}
+
+// PurgeDeadLetters calls xyz.block.ftl.v1.ControllerService.PurgeDeadLetters.
+func (c *controllerServiceClient) PurgeDeadLetters(ctx context.Context, req *connect.Request[v1.PurgeDeadLettersRequest]) (*connect.Response[v1.PurgeDeadLettersResponse], error) {
+	return c.purgeDeadLetters.CallUnary(ctx, req)
+}
+
+// ListFSMInstances calls xyz.block.ftl.v1.ControllerService.ListFSMInstances.
+func (c *controllerServiceClient) ListFSMInstances(ctx context.Context, req *connect.Request[v1.ListFSMInstancesRequest]) (*connect.Response[v1.ListFSMInstancesResponse], error) {
+	return c.listFSMInstances.CallUnary(ctx, req)
+}
+
+// GetFSMInstance calls xyz.block.ftl.v1.ControllerService.GetFSMInstance.
+func (c *controllerServiceClient) GetFSMInstance(ctx context.Context, req *connect.Request[v1.GetFSMInstanceRequest]) (*connect.Response[v1.GetFSMInstanceResponse], error) {
+	return c.getFSMInstance.CallUnary(ctx, req)
+}
+
+// ListScheduledCalls calls xyz.block.ftl.v1.ControllerService.ListScheduledCalls.
+func (c *controllerServiceClient) ListScheduledCalls(ctx context.Context, req *connect.Request[v1.ListScheduledCallsRequest]) (*connect.Response[v1.ListScheduledCallsResponse], error) {
+	return c.listScheduledCalls.CallUnary(ctx, req)
+}
+
+// CancelScheduledCall calls xyz.block.ftl.v1.ControllerService.CancelScheduledCall.
+func (c *controllerServiceClient) CancelScheduledCall(ctx context.Context, req *connect.Request[v1.CancelScheduledCallRequest]) (*connect.Response[v1.CancelScheduledCallResponse], error) {
+	return c.cancelScheduledCall.CallUnary(ctx, req)
+}
+
+// ListCronJobs calls xyz.block.ftl.v1.ControllerService.ListCronJobs.
+func (c *controllerServiceClient) ListCronJobs(ctx context.Context, req *connect.Request[v1.ListCronJobsRequest]) (*connect.Response[v1.ListCronJobsResponse], error) {
+	return c.listCronJobs.CallUnary(ctx, req)
+}
+
+// TriggerCronJob calls xyz.block.ftl.v1.ControllerService.TriggerCronJob.
+func (c *controllerServiceClient) TriggerCronJob(ctx context.Context, req *connect.Request[v1.TriggerCronJobRequest]) (*connect.Response[v1.TriggerCronJobResponse], error) {
+	return c.triggerCronJob.CallUnary(ctx, req)
+}
+
+// PauseCronJob calls xyz.block.ftl.v1.ControllerService.PauseCronJob.
+func (c *controllerServiceClient) PauseCronJob(ctx context.Context, req *connect.Request[v1.PauseCronJobRequest]) (*connect.Response[v1.PauseCronJobResponse], error) {
+	return c.pauseCronJob.CallUnary(ctx, req)
+}
+
+// ResumeCronJob calls xyz.block.ftl.v1.ControllerService.ResumeCronJob.
+func (c *controllerServiceClient) ResumeCronJob(ctx context.Context, req *connect.Request[v1.ResumeCronJobRequest]) (*connect.Response[v1.ResumeCronJobResponse], error) {
+	return c.resumeCronJob.CallUnary(ctx, req)
+}
+
 // ControllerServiceHandler is an implementation of the xyz.block.ftl.v1.ControllerService service.
 type ControllerServiceHandler interface {
 	// Ping service for readiness.
@@ -605,6 +929,30 @@ type ControllerServiceHandler interface {
 	PullSchema(context.Context, *connect.Request[v1.PullSchemaRequest], *connect.ServerStream[v1.PullSchemaResponse]) error
 	// Reset the cursor for a subscription to the head of its topic.
 	ResetSubscription(context.Context, *connect.Request[v1.ResetSubscriptionRequest]) (*connect.Response[v1.ResetSubscriptionResponse], error)
+	// Move the cursor for a subscription to an event or point in time.
+	SeekSubscription(context.Context, *connect.Request[v1.SeekSubscriptionRequest]) (*connect.Response[v1.SeekSubscriptionResponse], error)
+	// List events that a subscription permanently failed to consume.
+	ListDeadLetters(context.Context, *connect.Request[v1.ListDeadLettersRequest]) (*connect.Response[v1.ListDeadLettersResponse], error)
+	// Schedule dead lettered events to be consumed by their subscription again.
+	ReplayDeadLetters(context.Context, *connect.Request[v1.ReplayDeadLettersRequest]) (*connect.Response[v1.ReplayDeadLettersResponse], error)
+	// Discard dead lettered events.
+	PurgeDeadLetters(context.Context, *connect.Request[v1.PurgeDeadLettersRequest]) (*connect.Response[v1.PurgeDeadLettersResponse], error)
+	// List instances of an FSM.
+	ListFSMInstances(context.Context, *connect.Request[v1.ListFSMInstancesRequest]) (*connect.Response[v1.ListFSMInstancesResponse], error)
+	// Get an FSM instance and its transition history.
+	GetFSMInstance(context.Context, *connect.Request[v1.GetFSMInstanceRequest]) (*connect.Response[v1.GetFSMInstanceResponse], error)
+	// List scheduled calls that have not yet completed.
+	ListScheduledCalls(context.Context, *connect.Request[v1.ListScheduledCallsRequest]) (*connect.Response[v1.ListScheduledCallsResponse], error)
+	// Cancel a scheduled call that is not yet executing.
+	CancelScheduledCall(context.Context, *connect.Request[v1.CancelScheduledCallRequest]) (*connect.Response[v1.CancelScheduledCallResponse], error)
+	// List the cron jobs of active deployments.
+	ListCronJobs(context.Context, *connect.Request[v1.ListCronJobsRequest]) (*connect.Response[v1.ListCronJobsResponse], error)
+	// Execute a cron job immediately, even if it is paused.
+	TriggerCronJob(context.Context, *connect.Request[v1.TriggerCronJobRequest]) (*connect.Response[v1.TriggerCronJobResponse], error)
+	// Stop a cron job from being scheduled, including in future deployments of its module.
+	PauseCronJob(context.Context, *connect.Request[v1.PauseCronJobRequest]) (*connect.Response[v1.PauseCronJobResponse], error)
+	// Allow a paused cron job to be scheduled again.
+	ResumeCronJob(context.Context, *connect.Request[v1.ResumeCronJobRequest]) (*connect.Response[v1.ResumeCronJobResponse], error)
 }
 
 // NewControllerServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -689,6 +1037,71 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 		svc.ResetSubscription,
 		opts...,
 	)
+	controllerServiceSeekSubscriptionHandler := connect.NewUnaryHandler(
+		ControllerServiceSeekSubscriptionProcedure,
+		svc.SeekSubscription,
+		opts...,
+	)
+	controllerServiceListDeadLettersHandler := connect.NewUnaryHandler(
+		ControllerServiceListDeadLettersProcedure,
+		svc.ListDeadLetters,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceReplayDeadLettersHandler := connect.NewUnaryHandler(
+		ControllerServiceReplayDeadLettersProcedure,
+		svc.ReplayDeadLetters,
+		opts...,
+	)
+	controllerServicePurgeDeadLettersHandler := connect.NewUnaryHandler(
+		ControllerServicePurgeDeadLettersProcedure,
+		svc.PurgeDeadLetters,
+		opts...,
+	)
+	controllerServiceListFSMInstancesHandler := connect.NewUnaryHandler(
+		ControllerServiceListFSMInstancesProcedure,
+		svc.ListFSMInstances,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceGetFSMInstanceHandler := connect.NewUnaryHandler(
+		ControllerServiceGetFSMInstanceProcedure,
+		svc.GetFSMInstance,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceListScheduledCallsHandler := connect.NewUnaryHandler(
+		ControllerServiceListScheduledCallsProcedure,
+		svc.ListScheduledCalls,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceCancelScheduledCallHandler := connect.NewUnaryHandler(
+		ControllerServiceCancelScheduledCallProcedure,
+		svc.CancelScheduledCall,
+		opts...,
+	)
+	controllerServiceListCronJobsHandler := connect.NewUnaryHandler(
+		ControllerServiceListCronJobsProcedure,
+		svc.ListCronJobs,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceTriggerCronJobHandler := connect.NewUnaryHandler(
+		ControllerServiceTriggerCronJobProcedure,
+		svc.TriggerCronJob,
+		opts...,
+	)
+	controllerServicePauseCronJobHandler := connect.NewUnaryHandler(
+		ControllerServicePauseCronJobProcedure,
+		svc.PauseCronJob,
+		opts...,
+	)
+	controllerServiceResumeCronJobHandler := connect.NewUnaryHandler(
+		ControllerServiceResumeCronJobProcedure,
+		svc.ResumeCronJob,
+		opts...,
+	)
 	return "/xyz.block.ftl.v1.ControllerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ControllerServicePingProcedure:
@@ -721,6 +1134,30 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 			controllerServicePullSchemaHandler.ServeHTTP(w, r)
 		case ControllerServiceResetSubscriptionProcedure:
 			controllerServiceResetSubscriptionHandler.ServeHTTP(w, r)
+		case ControllerServiceSeekSubscriptionProcedure:
+			controllerServiceSeekSubscriptionHandler.ServeHTTP(w, r)
+		case ControllerServiceListDeadLettersProcedure:
+			controllerServiceListDeadLettersHandler.ServeHTTP(w, r)
+		case ControllerServiceReplayDeadLettersProcedure:
+			controllerServiceReplayDeadLettersHandler.ServeHTTP(w, r)
+		case ControllerServicePurgeDeadLettersProcedure:
+			controllerServicePurgeDeadLettersHandler.ServeHTTP(w, r)
+		case ControllerServiceListFSMInstancesProcedure:
+			controllerServiceListFSMInstancesHandler.ServeHTTP(w, r)
+		case ControllerServiceGetFSMInstanceProcedure:
+			controllerServiceGetFSMInstanceHandler.ServeHTTP(w, r)
+		case ControllerServiceListScheduledCallsProcedure:
+			controllerServiceListScheduledCallsHandler.ServeHTTP(w, r)
+		case ControllerServiceCancelScheduledCallProcedure:
+			controllerServiceCancelScheduledCallHandler.ServeHTTP(w, r)
+		case ControllerServiceListCronJobsProcedure:
+			controllerServiceListCronJobsHandler.ServeHTTP(w, r)
+		case ControllerServiceTriggerCronJobProcedure:
+			controllerServiceTriggerCronJobHandler.ServeHTTP(w, r)
+		case ControllerServicePauseCronJobProcedure:
+			controllerServicePauseCronJobHandler.ServeHTTP(w, r)
+		case ControllerServiceResumeCronJobProcedure:
+			controllerServiceResumeCronJobHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -790,6 +1227,54 @@ func (UnimplementedControllerServiceHandler) ResetSubscription(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ResetSubscription is not implemented"))
 }
 
+func (UnimplementedControllerServiceHandler) SeekSubscription(context.Context, *connect.Request[v1.SeekSubscriptionRequest]) (*connect.Response[v1.SeekSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.SeekSubscription is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ListDeadLetters(context.Context, *connect.Request[v1.ListDeadLettersRequest]) (*connect.Response[v1.ListDeadLettersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ListDeadLetters is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ReplayDeadLetters(context.Context, *connect.Request[v1.ReplayDeadLettersRequest]) (*connect.Response[v1.ReplayDeadLettersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ReplayDeadLetters is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) PurgeDeadLetters(context.Context, *connect.Request[v1.PurgeDeadLettersRequest]) (*connect.Response[v1.PurgeDeadLettersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.PurgeDeadLetters is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ListFSMInstances(context.Context, *connect.Request[v1.ListFSMInstancesRequest]) (*connect.Response[v1.ListFSMInstancesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ListFSMInstances is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) GetFSMInstance(context.Context, *connect.Request[v1.GetFSMInstanceRequest]) (*connect.Response[v1.GetFSMInstanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.GetFSMInstance is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ListScheduledCalls(context.Context, *connect.Request[v1.ListScheduledCallsRequest]) (*connect.Response[v1.ListScheduledCallsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ListScheduledCalls is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) CancelScheduledCall(context.Context, *connect.Request[v1.CancelScheduledCallRequest]) (*connect.Response[v1.CancelScheduledCallResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.CancelScheduledCall is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ListCronJobs(context.Context, *connect.Request[v1.ListCronJobsRequest]) (*connect.Response[v1.ListCronJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ListCronJobs is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) TriggerCronJob(context.Context, *connect.Request[v1.TriggerCronJobRequest]) (*connect.Response[v1.TriggerCronJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.TriggerCronJob is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) PauseCronJob(context.Context, *connect.Request[v1.PauseCronJobRequest]) (*connect.Response[v1.PauseCronJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.PauseCronJob is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ResumeCronJob(context.Context, *connect.Request[v1.ResumeCronJobRequest]) (*connect.Response[v1.ResumeCronJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ResumeCronJob is not implemented"))
+}
+
 // RunnerServiceClient is a client for the xyz.block.ftl.v1.RunnerService service.
 type RunnerServiceClient interface {
 	Ping(context.Context, *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error)
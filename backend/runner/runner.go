@@ -211,13 +211,47 @@ func (s *Service) Call(ctx context.Context, req *connect.Request[ftlv1.CallReque
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnavailable, errors.New("no deployment"))
 	}
+	// The deadline of the call chain is applied to ctx by the RPC interceptors.
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil, connect.NewError(connect.CodeDeadlineExceeded, fmt.Errorf("deadline exceeded before calling %s.%s", req.Msg.Verb.Module, req.Msg.Verb.Name))
+	}
 	response, err := deployment.plugin.Client.Call(ctx, req)
 	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, connect.NewError(connect.CodeDeadlineExceeded, err)
+		}
 		return nil, connect.NewError(connect.CodeOf(err), err)
 	}
 	return connect.NewResponse(response.Msg), nil
 }
 
+func (s *Service) CallStream(ctx context.Context, req *connect.Request[ftlv1.CallRequest], stream *connect.ServerStream[ftlv1.CallResponse]) error {
+	deployment, ok := s.deployment.Load().Get()
+	if !ok {
+		return connect.NewError(connect.CodeUnavailable, errors.New("no deployment"))
+	}
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return connect.NewError(connect.CodeDeadlineExceeded, fmt.Errorf("deadline exceeded before calling %s.%s", req.Msg.Verb.Module, req.Msg.Verb.Name))
+	}
+	responses, err := deployment.plugin.Client.CallStream(ctx, req)
+	if err != nil {
+		return connect.NewError(connect.CodeOf(err), err)
+	}
+	defer responses.Close()
+	for responses.Receive() {
+		if err := stream.Send(responses.Msg()); err != nil {
+			return fmt.Errorf("failed to send response: %w", err)
+		}
+	}
+	if err := responses.Err(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return connect.NewError(connect.CodeDeadlineExceeded, err)
+		}
+		return connect.NewError(connect.CodeOf(err), err)
+	}
+	return nil
+}
+
 func (s *Service) Reserve(ctx context.Context, c *connect.Request[ftlv1.ReserveRequest]) (*connect.Response[ftlv1.ReserveResponse], error) {
 	if !s.state.CompareAndSwap(ftlv1.RunnerState_RUNNER_IDLE, ftlv1.RunnerState_RUNNER_RESERVED) {
 		return nil, fmt.Errorf("can only reserve from IDLE state, not %s", s.state.Load())
@@ -241,10 +275,22 @@ func (s *Service) SendFSMEvent(context.Context, *connect.Request[ftlv1.SendFSMEv
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("FSM events must be sent to the controller"))
 }
 
+func (s *Service) SetNextFSMEvent(context.Context, *connect.Request[ftlv1.SetNextFSMEventRequest]) (*connect.Response[ftlv1.SetNextFSMEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("FSM events must be sent to the controller"))
+}
+
 func (s *Service) PublishEvent(context.Context, *connect.Request[ftlv1.PublishEventRequest]) (*connect.Response[ftlv1.PublishEventResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("topic events must be sent to the controller"))
 }
 
+func (s *Service) ScheduleCall(context.Context, *connect.Request[ftlv1.ScheduleCallRequest]) (*connect.Response[ftlv1.ScheduleCallResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("calls must be scheduled with the controller"))
+}
+
+func (s *Service) SendWebSocketMessage(context.Context, *connect.Request[ftlv1.SendWebSocketMessageRequest]) (*connect.Response[ftlv1.SendWebSocketMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("WebSocket messages must be sent to the controller"))
+}
+
 func (s *Service) Deploy(ctx context.Context, req *connect.Request[ftlv1.DeployRequest]) (response *connect.Response[ftlv1.DeployResponse], err error) {
 	if err, ok := s.registrationFailure.Load().Get(); ok {
 		observability.Deployment.Failure(ctx, optional.None[string]())
@@ -14,6 +14,18 @@ builtin module builtin {
     query {String: [String]}
     headers {String: [String]}
     body Body
+    // The authenticated caller, if the verb declares +auth.
+    principal builtin.Principal?
+  }
+
+  // Principal is the authenticated caller of an HTTP ingress verb.
+  export data Principal {
+    // The auth scheme that authenticated the caller: "jwt", "apikey" or "mtls".
+    scheme String
+    // The JWT "sub" claim, API key name or client certificate subject.
+    subject String
+    // The JWT claims, or client certificate details.
+    claims {String: Any}
   }
 
   // HTTP response structure used for HTTP ingress verbs.
@@ -25,6 +37,23 @@ builtin module builtin {
     error Error?
   }
 
+  // WebSocketMessage is a message received by a WebSocket ingress verb.
+  export data WebSocketMessage<Body> {
+    // Identifies the connection the message was received on, for sending
+    // messages back to the client.
+    connectionId String
+    body Body
+  }
+
+  // File is a file uploaded in a multipart/form-data HTTP request body, or a
+  // raw HTTP request body.
+  export data File {
+    // The file name supplied by the client, if any.
+    name String
+    contentType String
+    content Bytes
+  }
+
   export data Empty {}
 
   // CatchRequest is a request structure for catch verbs.
@@ -32,6 +61,16 @@ builtin module builtin {
     request Req
     error String
   }
+
+  // FailedEvent is published to a subscription's dead letter topic when an
+  // event permanently fails to be consumed.
+  export data FailedEvent<Event> {
+    event Event
+    // The error returned by the last attempt.
+    error String
+    // The number of times the subscriber was called with the event.
+    attempts Int
+  }
 }
 `
 
@@ -123,7 +123,8 @@ func (d *Data) Monomorphise(ref *Ref) (*Data, error) {
 			*MetadataIngress, *MetadataCronJob, *MetadataAlias, *Module,
 			*Schema, *String, *Time, Type, *TypeParameter, *Unit, *Verb, *Enum,
 			*EnumVariant, Value, *IntValue, *StringValue, *TypeValue, Symbol,
-			Named, *FSM, *FSMTransition, *TypeAlias, *Topic, *Subscription, *MetadataSubscriber, *MetadataTypeMap:
+			Named, *FSM, *FSMTransition, *TypeAlias, *Topic, *Subscription, *MetadataSubscriber, *MetadataTypeMap,
+			*MetadataTimeout, *MetadataRateLimit, *MetadataConcurrency, *MetadataQueue, *MetadataMigrate, *MetadataCompensate, *MetadataAuth, *MetadataRecursive:
 		}
 		return next()
 	})
@@ -0,0 +1,336 @@
+// Package diagram renders the topology of a schema as Graphviz DOT or Mermaid
+// diagrams.
+package diagram
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/duration"
+)
+
+// Kind is a kind of relationship to include in a diagram.
+type Kind string
+
+const (
+	// KindFSM renders the states and transitions of each FSM.
+	KindFSM Kind = "fsm"
+	// KindCalls renders the calls between verbs.
+	KindCalls Kind = "calls"
+	// KindPubSub renders topics, their subscriptions and subscriber verbs.
+	KindPubSub Kind = "pubsub"
+)
+
+// Kinds is every kind of diagram.
+var Kinds = []Kind{KindFSM, KindCalls, KindPubSub}
+
+// Format is an output format for a diagram.
+type Format string
+
+const (
+	FormatDOT     Format = "dot"
+	FormatMermaid Format = "mermaid"
+)
+
+type shape int
+
+const (
+	shapeVerb shape = iota
+	shapeTopic
+	shapeSubscription
+	shapeState
+	shapeStartState
+	shapeTerminalState
+)
+
+type node struct {
+	id    string
+	label string
+	shape shape
+}
+
+type edge struct {
+	from   string
+	to     string
+	label  string
+	dashed bool
+}
+
+type cluster struct {
+	id    string
+	label string
+	nodes []node
+}
+
+// Diagram is a graph of the relationships between the declarations of a schema.
+type Diagram struct {
+	clusters []*cluster
+	edges    []edge
+
+	clusterIndex map[string]*cluster
+	nodeIndex    map[string]bool
+}
+
+// Build a diagram of the given kinds of relationships in a schema.
+//
+// If [modules] is empty every module is included, otherwise only declarations
+// in those modules are included, along with anything they refer to directly.
+// If [kinds] is empty every kind is included.
+func Build(sch *schema.Schema, kinds []Kind, modules []string) *Diagram {
+	if len(kinds) == 0 {
+		kinds = Kinds
+	}
+	d := &Diagram{
+		clusterIndex: map[string]*cluster{},
+		nodeIndex:    map[string]bool{},
+	}
+	for _, module := range sch.Modules {
+		if module.Builtin || (len(modules) > 0 && !slices.Contains(modules, module.Name)) {
+			continue
+		}
+		for _, kind := range kinds {
+			switch kind {
+			case KindFSM:
+				d.addFSMs(module)
+			case KindCalls:
+				d.addCalls(module)
+			case KindPubSub:
+				d.addPubSub(module)
+			}
+		}
+	}
+	sort.SliceStable(d.clusters, func(i, j int) bool { return d.clusters[i].id < d.clusters[j].id })
+	for _, c := range d.clusters {
+		sort.SliceStable(c.nodes, func(i, j int) bool { return c.nodes[i].id < c.nodes[j].id })
+	}
+	sort.SliceStable(d.edges, func(i, j int) bool {
+		if d.edges[i].from != d.edges[j].from {
+			return d.edges[i].from < d.edges[j].from
+		}
+		return d.edges[i].to < d.edges[j].to
+	})
+	return d
+}
+
+func (d *Diagram) addFSMs(module *schema.Module) {
+	for _, decl := range module.Decls {
+		fsm, ok := decl.(*schema.FSM)
+		if !ok {
+			continue
+		}
+		ref := schema.RefKey{Module: module.Name, Name: fsm.Name}
+		clusterID := "fsm:" + ref.String()
+		stateID := func(state *schema.Ref) string {
+			return clusterID + ":" + qualify(module.Name, state).String()
+		}
+		terminal := map[string]bool{}
+		for _, state := range fsm.TerminalStates() {
+			terminal[stateID(state)] = true
+		}
+		addState := func(state *schema.Ref, shape shape) {
+			if terminal[stateID(state)] {
+				shape = shapeTerminalState
+			}
+			d.addNode(clusterID, "fsm "+ref.String(), node{id: stateID(state), label: state.Name, shape: shape})
+		}
+		for _, start := range fsm.Start {
+			addState(start, shapeStartState)
+		}
+		for _, transition := range fsm.Transitions {
+			addState(transition.From, shapeState)
+			addState(transition.To, shapeState)
+			e := edge{from: stateID(transition.From), to: stateID(transition.To)}
+			if timeout, ok := transition.Timeout(); ok {
+				e.label = "after " + duration.Format(timeout)
+				e.dashed = true
+			}
+			d.edges = append(d.edges, e)
+		}
+	}
+}
+
+func (d *Diagram) addCalls(module *schema.Module) {
+	for _, decl := range module.Decls {
+		verb, ok := decl.(*schema.Verb)
+		if !ok {
+			continue
+		}
+		from := d.addModuleNode(schema.RefKey{Module: module.Name, Name: verb.Name}, shapeVerb)
+		for _, md := range verb.Metadata {
+			calls, ok := md.(*schema.MetadataCalls)
+			if !ok {
+				continue
+			}
+			for _, callee := range calls.Calls {
+				to := d.addModuleNode(qualify(module.Name, callee), shapeVerb)
+				d.edges = append(d.edges, edge{from: from, to: to})
+			}
+		}
+	}
+}
+
+func (d *Diagram) addPubSub(module *schema.Module) {
+	for _, decl := range module.Decls {
+		switch decl := decl.(type) {
+		case *schema.Topic:
+			d.addModuleNode(schema.RefKey{Module: module.Name, Name: decl.Name}, shapeTopic)
+
+		case *schema.Subscription:
+			from := d.addModuleNode(qualify(module.Name, decl.Topic), shapeTopic)
+			to := d.addModuleNode(schema.RefKey{Module: module.Name, Name: decl.Name}, shapeSubscription)
+			d.edges = append(d.edges, edge{from: from, to: to})
+
+		case *schema.Verb:
+			for _, md := range decl.Metadata {
+				subscriber, ok := md.(*schema.MetadataSubscriber)
+				if !ok {
+					continue
+				}
+				from := d.addModuleNode(schema.RefKey{Module: module.Name, Name: subscriber.Name}, shapeSubscription)
+				to := d.addModuleNode(schema.RefKey{Module: module.Name, Name: decl.Name}, shapeVerb)
+				d.edges = append(d.edges, edge{from: from, to: to})
+			}
+
+		default:
+		}
+	}
+}
+
+// addModuleNode adds a node for a declaration to the cluster of its module.
+//
+// Node IDs are prefixed with the kind of declaration, as verbs and
+// subscriptions may share a name.
+func (d *Diagram) addModuleNode(ref schema.RefKey, shape shape) string {
+	id := declPrefixes[shape] + ":" + ref.String()
+	d.addNode("module:"+ref.Module, "module "+ref.Module, node{id: id, label: ref.Name, shape: shape})
+	return id
+}
+
+var declPrefixes = map[shape]string{
+	shapeVerb:         "verb",
+	shapeTopic:        "topic",
+	shapeSubscription: "subscription",
+}
+
+// addNode adds a node to a cluster, creating the cluster if necessary. Nodes
+// that have already been added are ignored.
+func (d *Diagram) addNode(clusterID, clusterLabel string, n node) {
+	if d.nodeIndex[n.id] {
+		return
+	}
+	d.nodeIndex[n.id] = true
+	c, ok := d.clusterIndex[clusterID]
+	if !ok {
+		c = &cluster{id: clusterID, label: clusterLabel}
+		d.clusterIndex[clusterID] = c
+		d.clusters = append(d.clusters, c)
+	}
+	c.nodes = append(c.nodes, n)
+}
+
+// Render the diagram in the given format.
+func (d *Diagram) Render(format Format) (string, error) {
+	switch format {
+	case FormatDOT:
+		return d.DOT(), nil
+	case FormatMermaid:
+		return d.Mermaid(), nil
+	default:
+		return "", fmt.Errorf("unsupported diagram format %q", format)
+	}
+}
+
+// DOT renders the diagram in the Graphviz DOT language.
+func (d *Diagram) DOT() string {
+	w := &strings.Builder{}
+	fmt.Fprintln(w, "digraph schema {")
+	fmt.Fprintln(w, "  rankdir=LR;")
+	for _, c := range d.clusters {
+		fmt.Fprintf(w, "  subgraph %q {\n", "cluster_"+c.id)
+		fmt.Fprintf(w, "    label=%q;\n", c.label)
+		for _, n := range c.nodes {
+			fmt.Fprintf(w, "    %q [label=%q, %s];\n", n.id, n.label, dotShapes[n.shape])
+		}
+		fmt.Fprintln(w, "  }")
+	}
+	for _, e := range d.edges {
+		var attrs []string
+		if e.label != "" {
+			attrs = append(attrs, fmt.Sprintf("label=%q", e.label))
+		}
+		if e.dashed {
+			attrs = append(attrs, "style=dashed")
+		}
+		if len(attrs) == 0 {
+			fmt.Fprintf(w, "  %q -> %q;\n", e.from, e.to)
+		} else {
+			fmt.Fprintf(w, "  %q -> %q [%s];\n", e.from, e.to, strings.Join(attrs, ", "))
+		}
+	}
+	fmt.Fprintln(w, "}")
+	return w.String()
+}
+
+var dotShapes = map[shape]string{
+	shapeVerb:          "shape=box",
+	shapeTopic:         "shape=cylinder",
+	shapeSubscription:  "shape=cds",
+	shapeState:         "shape=box, style=rounded",
+	shapeStartState:    "shape=box, style=\"rounded,bold\"",
+	shapeTerminalState: "shape=box, style=rounded, peripheries=2",
+}
+
+// Mermaid renders the diagram as a Mermaid flowchart.
+func (d *Diagram) Mermaid() string {
+	w := &strings.Builder{}
+	fmt.Fprintln(w, "flowchart LR")
+	for _, c := range d.clusters {
+		fmt.Fprintf(w, "  subgraph %s[%q]\n", mermaidID(c.id), c.label)
+		for _, n := range c.nodes {
+			open, closing := mermaidShapes[n.shape][0], mermaidShapes[n.shape][1]
+			fmt.Fprintf(w, "    %s%s%q%s\n", mermaidID(n.id), open, n.label, closing)
+		}
+		fmt.Fprintln(w, "  end")
+	}
+	for _, e := range d.edges {
+		arrow := "-->"
+		if e.dashed {
+			arrow = "-.->"
+		}
+		if e.label != "" {
+			fmt.Fprintf(w, "  %s %s|%q| %s\n", mermaidID(e.from), arrow, e.label, mermaidID(e.to))
+		} else {
+			fmt.Fprintf(w, "  %s %s %s\n", mermaidID(e.from), arrow, mermaidID(e.to))
+		}
+	}
+	return w.String()
+}
+
+var mermaidShapes = map[shape][2]string{
+	shapeVerb:          {"[", "]"},
+	shapeTopic:         {"[(", ")]"},
+	shapeSubscription:  {">", "]"},
+	shapeState:         {"(", ")"},
+	shapeStartState:    {"([", "])"},
+	shapeTerminalState: {"((", "))"},
+}
+
+var mermaidIDReplacer = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// Mermaid IDs can't contain punctuation.
+func mermaidID(id string) string {
+	return mermaidIDReplacer.ReplaceAllString(id, "_")
+}
+
+// qualify returns the key of a reference, defaulting to the given module.
+func qualify(module string, ref *schema.Ref) schema.RefKey {
+	key := ref.ToRefKey()
+	if key.Module == "" {
+		key.Module = module
+	}
+	return key
+}
@@ -0,0 +1,108 @@
+package diagram
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+const testSchema = `
+module payments {
+  fsm payment {
+    start payments.invoiced
+    transition payments.invoiced to payments.paid
+    transition payments.invoiced to payments.expired +timeout 30m
+  }
+
+  export data Invoice {}
+  data Payment {}
+
+  export topic invoices payments.Invoice
+  subscription notify payments.invoices
+
+  export verb invoiced(payments.Invoice) Unit
+    +calls payments.notify
+  verb paid(payments.Payment) Unit
+  verb expired(payments.Invoice) Unit
+  verb notify(payments.Invoice) Unit
+    +subscribe notify
+}
+
+module billing {
+  verb bill(Unit) Unit
+    +calls payments.invoiced
+}
+`
+
+func TestDOT(t *testing.T) {
+	sch, err := schema.ParseString("", testSchema)
+	assert.NoError(t, err)
+	actual, err := Build(sch, nil, nil).Render(FormatDOT)
+	assert.NoError(t, err)
+	expected := `digraph schema {
+  rankdir=LR;
+  subgraph "cluster_fsm:payments.payment" {
+    label="fsm payments.payment";
+    "fsm:payments.payment:payments.expired" [label="expired", shape=box, style=rounded, peripheries=2];
+    "fsm:payments.payment:payments.invoiced" [label="invoiced", shape=box, style="rounded,bold"];
+    "fsm:payments.payment:payments.paid" [label="paid", shape=box, style=rounded, peripheries=2];
+  }
+  subgraph "cluster_module:billing" {
+    label="module billing";
+    "verb:billing.bill" [label="bill", shape=box];
+  }
+  subgraph "cluster_module:payments" {
+    label="module payments";
+    "subscription:payments.notify" [label="notify", shape=cds];
+    "topic:payments.invoices" [label="invoices", shape=cylinder];
+    "verb:payments.expired" [label="expired", shape=box];
+    "verb:payments.invoiced" [label="invoiced", shape=box];
+    "verb:payments.notify" [label="notify", shape=box];
+    "verb:payments.paid" [label="paid", shape=box];
+  }
+  "fsm:payments.payment:payments.invoiced" -> "fsm:payments.payment:payments.expired" [label="after 30m", style=dashed];
+  "fsm:payments.payment:payments.invoiced" -> "fsm:payments.payment:payments.paid";
+  "subscription:payments.notify" -> "verb:payments.notify";
+  "topic:payments.invoices" -> "subscription:payments.notify";
+  "verb:billing.bill" -> "verb:payments.invoiced";
+  "verb:payments.invoiced" -> "verb:payments.notify";
+}
+`
+	assert.Equal(t, expected, actual)
+}
+
+func TestMermaid(t *testing.T) {
+	sch, err := schema.ParseString("", testSchema)
+	assert.NoError(t, err)
+	actual, err := Build(sch, []Kind{KindFSM}, nil).Render(FormatMermaid)
+	assert.NoError(t, err)
+	expected := `flowchart LR
+  subgraph fsm_payments_payment["fsm payments.payment"]
+    fsm_payments_payment_payments_expired(("expired"))
+    fsm_payments_payment_payments_invoiced(["invoiced"])
+    fsm_payments_payment_payments_paid(("paid"))
+  end
+  fsm_payments_payment_payments_invoiced -.->|"after 30m"| fsm_payments_payment_payments_expired
+  fsm_payments_payment_payments_invoiced --> fsm_payments_payment_payments_paid
+`
+	assert.Equal(t, expected, actual)
+}
+
+func TestFilterModules(t *testing.T) {
+	sch, err := schema.ParseString("", testSchema)
+	assert.NoError(t, err)
+	actual, err := Build(sch, nil, []string{"billing"}).Render(FormatMermaid)
+	assert.NoError(t, err)
+	expected := `flowchart LR
+  subgraph module_billing["module billing"]
+    verb_billing_bill["bill"]
+  end
+  subgraph module_payments["module payments"]
+    verb_payments_invoiced["invoiced"]
+  end
+  verb_billing_bill --> verb_payments_invoiced
+`
+	assert.Equal(t, expected, actual)
+}
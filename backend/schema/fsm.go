@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
+	"github.com/alecthomas/types/optional"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
@@ -59,6 +61,68 @@ func (f *FSM) TerminalStates() []*Ref {
 	return out
 
 }
+
+// QueueParams returns the maximum number of events that can be queued for an
+// instance of the FSM, and whether duplicate events are dropped.
+func (f *FSM) QueueParams() (depth int, dedupe bool) {
+	if md, ok := slices.FindVariant[*MetadataQueue](f.Metadata); ok {
+		return md.Depth, md.Dedupe
+	}
+	return DefaultFSMQueueDepth, false
+}
+
+// HasState returns true if the given state is a start state of the FSM or the
+// source or destination of one of its transitions.
+func (f *FSM) HasState(state RefKey) bool {
+	for _, s := range f.Start {
+		if s.ToRefKey() == state {
+			return true
+		}
+	}
+	for _, t := range f.Transitions {
+		if t.From.ToRefKey() == state || t.To.ToRefKey() == state {
+			return true
+		}
+	}
+	return false
+}
+
+// MigratedState returns the state that instances in a removed state should be
+// moved to, if the FSM declares a +migrate for it.
+func (f *FSM) MigratedState(state RefKey) optional.Option[*Ref] {
+	for _, md := range f.Metadata {
+		if migrate, ok := md.(*MetadataMigrate); ok && migrate.From.ToRefKey() == state {
+			return optional.Some(migrate.To)
+		}
+	}
+	return optional.None[*Ref]()
+}
+
+// Compensation returns the verb that undoes the work of the given state, if
+// the FSM declares a +compensate for it.
+func (f *FSM) Compensation(state RefKey) optional.Option[*Ref] {
+	for _, md := range f.Metadata {
+		if compensate, ok := md.(*MetadataCompensate); ok && compensate.State.ToRefKey() == state {
+			return optional.Some(compensate.Verb)
+		}
+	}
+	return optional.None[*Ref]()
+}
+
+// TimeoutTransition returns the timeout transition out of the given state, if
+// there is one.
+func (f *FSM) TimeoutTransition(state RefKey) (transition *FSMTransition, timeout time.Duration, ok bool) {
+	for _, t := range f.Transitions {
+		if t.From.ToRefKey() != state {
+			continue
+		}
+		if timeout, ok := t.Timeout(); ok {
+			return t, timeout, true
+		}
+	}
+	return nil, 0, false
+}
+
 func (f *FSM) GetName() string    { return f.Name }
 func (f *FSM) IsExported() bool   { return false }
 func (f *FSM) Position() Position { return f.Pos }
@@ -115,16 +179,18 @@ func (f *FSM) schemaChildren() []Node {
 type FSMTransition struct {
 	Pos Position `parser:"" protobuf:"1,optional"`
 
-	Comments []string `parser:"@Comment*" protobuf:"2"`
-	From     *Ref     `parser:"@@" protobuf:"3,optional"`
-	To       *Ref     `parser:"'to' @@" protobuf:"4"`
+	Comments []string   `parser:"@Comment*" protobuf:"2"`
+	From     *Ref       `parser:"@@" protobuf:"3,optional"`
+	To       *Ref       `parser:"'to' @@" protobuf:"4"`
+	Metadata []Metadata `parser:"@@*" protobuf:"5"`
 }
 
 func FSMTransitionFromProto(pb *schemapb.FSMTransition) *FSMTransition {
 	return &FSMTransition{
-		Pos:  posFromProto(pb.Pos),
-		From: RefFromProto(pb.From),
-		To:   RefFromProto(pb.To),
+		Pos:      posFromProto(pb.Pos),
+		From:     RefFromProto(pb.From),
+		To:       RefFromProto(pb.To),
+		Metadata: metadataListToSchema(pb.Metadata),
 	}
 }
 
@@ -133,15 +199,43 @@ var _ Node = (*FSMTransition)(nil)
 func (f *FSMTransition) Position() Position { return f.Pos }
 
 func (f *FSMTransition) String() string {
-	return fmt.Sprintf("%s to %s", f.From, f.To)
+	w := &strings.Builder{}
+	fmt.Fprintf(w, "%s to %s", f.From, f.To)
+	for _, md := range f.Metadata {
+		fmt.Fprintf(w, " %s", md)
+	}
+	return w.String()
+}
+
+// Timeout returns how long an instance must stay in the source state before
+// this transition is taken, if it is a timeout transition.
+//
+// Timeout transitions are never triggered by events.
+func (f *FSMTransition) Timeout() (time.Duration, bool) {
+	md, ok := slices.FindVariant[*MetadataTimeout](f.Metadata)
+	if !ok {
+		return 0, false
+	}
+	timeout, err := md.Timeout()
+	if err != nil {
+		return 0, false
+	}
+	return timeout, true
 }
 
 func (f *FSMTransition) ToProto() protoreflect.ProtoMessage {
 	return &schemapb.FSMTransition{
-		Pos:  posToProto(f.Pos),
-		From: f.From.ToProto().(*schemapb.Ref), //nolint: forcetypeassert
-		To:   f.To.ToProto().(*schemapb.Ref),   //nolint: forcetypeassert
+		Pos:      posToProto(f.Pos),
+		From:     f.From.ToProto().(*schemapb.Ref), //nolint: forcetypeassert
+		To:       f.To.ToProto().(*schemapb.Ref),   //nolint: forcetypeassert
+		Metadata: metadataListToProto(f.Metadata),
 	}
 }
 
-func (f *FSMTransition) schemaChildren() []Node { return nil }
+func (f *FSMTransition) schemaChildren() []Node {
+	out := make([]Node, 0, len(f.Metadata))
+	for _, m := range f.Metadata {
+		out = append(out, m)
+	}
+	return out
+}
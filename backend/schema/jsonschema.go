@@ -2,6 +2,7 @@ package schema
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/swaggest/jsonschema-go"
@@ -20,46 +21,125 @@ func RequestResponseToJSONSchema(sch *Schema, ref Ref) (*jsonschema.Schema, erro
 	}
 
 	// Encode root, and collect all data types reachable from the root.
-	refs := map[RefKey]*Ref{}
-	root := nodeToJSSchema(symbol, refs)
-	if len(refs) == 0 {
+	enc := &jsSchemaEncoder{refs: map[string]*Ref{}, refPrefix: "#/definitions/", definitionName: jsDefinitionName}
+	root := enc.encode(symbol)
+	if len(enc.refs) == 0 {
 		return root, nil
 	}
 
 	// Resolve and encode all types reachable from the root.
-	root.Definitions = map[string]jsonschema.SchemaOrBool{}
-	for _, r := range refs {
-		decl, ok := sch.Resolve(r).Get()
-		if !ok {
-			return nil, fmt.Errorf("unknown ref %s", r)
-		}
-		switch n := decl.(type) {
-		case *Data:
-			if len(r.TypeParameters) > 0 {
-				monomorphisedData, err := n.Monomorphise(r)
-				if err != nil {
-					return nil, err
-				}
+	definitions, err := enc.definitions(sch)
+	if err != nil {
+		return nil, err
+	}
+	root.Definitions = definitions
+	return root, nil
+}
+
+// JSONSchemaComponents encodes FTL types as JSON Schema for embedding in a
+// larger document, such as an OpenAPI specification.
+//
+// References are emitted as "$ref"s to shared definitions under a common
+// prefix, and property names use JSON aliases where present, matching the
+// wire format used by HTTP ingress.
+type JSONSchemaComponents struct {
+	sch *Schema
+	enc *jsSchemaEncoder
+}
+
+// NewJSONSchemaComponents creates a new JSONSchemaComponents that references
+// definitions under refPrefix, eg. "#/components/schemas/".
+func NewJSONSchemaComponents(sch *Schema, refPrefix string) *JSONSchemaComponents {
+	return &JSONSchemaComponents{
+		sch: sch,
+		enc: &jsSchemaEncoder{
+			refs:           map[string]*Ref{},
+			refPrefix:      refPrefix,
+			definitionName: ComponentName,
+			jsonAliases:    true,
+		},
+	}
+}
+
+// Schema encodes a type, collecting any referenced declarations.
+func (j *JSONSchemaComponents) Schema(typ Type) *jsonschema.Schema {
+	return j.enc.encode(typ)
+}
+
+// Definitions returns the encoded definitions of all declarations reachable
+// from the types encoded so far, keyed by ComponentName.
+func (j *JSONSchemaComponents) Definitions() (map[string]jsonschema.SchemaOrBool, error) {
+	return j.enc.definitions(j.sch)
+}
+
+var componentNameInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// ComponentName returns a name for a reference that is safe to use as an
+// OpenAPI component key.
+//
+// Type parameters are appended to the name, eg. "foo.Generic[String, Int]"
+// becomes "foo.Generic_String_Int".
+func ComponentName(ref *Ref) string {
+	name := jsDefinitionName(ref)
+	return strings.Trim(componentNameInvalidChars.ReplaceAllString(name, "_"), "_")
+}
+
+func jsDefinitionName(ref *Ref) string {
+	if len(ref.TypeParameters) > 0 {
+		return fmt.Sprintf("%s.%s", ref.Module, refName(ref))
+	}
+	return ref.String()
+}
 
-				ref := fmt.Sprintf("%s.%s", r.Module, refName(r))
-				root.Definitions[ref] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(monomorphisedData, refs)}
-			} else {
-				root.Definitions[r.String()] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(n, refs)}
+type jsSchemaEncoder struct {
+	// refs collects all references encountered during encoding, keyed by definition name.
+	refs           map[string]*Ref
+	refPrefix      string
+	definitionName func(ref *Ref) string
+	// jsonAliases uses JSON aliases rather than field names for properties.
+	jsonAliases bool
+}
+
+// definitions resolves and encodes all types reachable from the types encoded so far.
+func (e *jsSchemaEncoder) definitions(sch *Schema) (map[string]jsonschema.SchemaOrBool, error) {
+	definitions := map[string]jsonschema.SchemaOrBool{}
+	done := map[string]bool{}
+	for len(done) < len(e.refs) {
+		for name, r := range e.refs {
+			if done[name] {
+				continue
 			}
-		case *Enum:
-			root.Definitions[r.String()] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(n, refs)}
+			done[name] = true
+			decl, ok := sch.Resolve(r).Get()
+			if !ok {
+				return nil, fmt.Errorf("unknown ref %s", r)
+			}
+			switch n := decl.(type) {
+			case *Data:
+				if len(r.TypeParameters) > 0 {
+					monomorphisedData, err := n.Monomorphise(r)
+					if err != nil {
+						return nil, err
+					}
+					definitions[name] = jsonschema.SchemaOrBool{TypeObject: e.encode(monomorphisedData)}
+				} else {
+					definitions[name] = jsonschema.SchemaOrBool{TypeObject: e.encode(n)}
+				}
+			case *Enum:
+				definitions[name] = jsonschema.SchemaOrBool{TypeObject: e.encode(n)}
 
-		case *TypeAlias:
-			root.Definitions[r.String()] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(n.Type, refs)}
+			case *TypeAlias:
+				definitions[name] = jsonschema.SchemaOrBool{TypeObject: e.encode(n.Type)}
 
-		case *Config, *Database, *Secret, *Verb, *FSM, *Topic, *Subscription:
-			return nil, fmt.Errorf("reference to unsupported node type %T", decl)
+			case *Config, *Database, *Secret, *Verb, *FSM, *Topic, *Subscription:
+				return nil, fmt.Errorf("reference to unsupported node type %T", decl)
+			}
 		}
 	}
-	return root, nil
+	return definitions, nil
 }
 
-func nodeToJSSchema(node Node, refs map[RefKey]*Ref) *jsonschema.Schema {
+func (e *jsSchemaEncoder) encode(node Node) *jsonschema.Schema {
 	switch node := node.(type) {
 	case *Any:
 		return &jsonschema.Schema{}
@@ -77,12 +157,16 @@ func nodeToJSSchema(node Node, refs map[RefKey]*Ref) *jsonschema.Schema {
 			AdditionalProperties: jsBool(false),
 		}
 		for _, field := range node.Fields {
-			jsField := nodeToJSSchema(field.Type, refs)
+			name := field.Name
+			if jsonAlias, ok := field.Alias(AliasKindJSON).Get(); ok && e.jsonAliases {
+				name = jsonAlias
+			}
+			jsField := e.encode(field.Type)
 			jsField.Description = jsComments(field.Comments)
 			if _, ok := field.Type.(*Optional); !ok {
-				schema.Required = append(schema.Required, field.Name)
+				schema.Required = append(schema.Required, name)
 			}
-			schema.Properties[field.Name] = jsonschema.SchemaOrBool{TypeObject: jsField}
+			schema.Properties[name] = jsonschema.SchemaOrBool{TypeObject: jsField}
 		}
 		return schema
 
@@ -109,7 +193,7 @@ func nodeToJSSchema(node Node, refs map[RefKey]*Ref) *jsonschema.Schema {
 				AdditionalProperties: jsBool(false),
 			}
 			variantSch.Properties["name"] = jsonschema.SchemaOrBool{TypeObject: &jsonschema.Schema{Type: &jsonschema.Type{SimpleTypes: &str}}}
-			variantSch.Properties["value"] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(v.Value.(*TypeValue).schemaValueType(), refs)} //nolint:forcetypeassert
+			variantSch.Properties["value"] = jsonschema.SchemaOrBool{TypeObject: e.encode(v.Value.(*TypeValue).schemaValueType())} //nolint:forcetypeassert
 			variants = append(variants, jsonschema.SchemaOrBool{TypeObject: variantSch})
 		}
 		return schema.WithOneOf(variants...)
@@ -151,7 +235,7 @@ func nodeToJSSchema(node Node, refs map[RefKey]*Ref) *jsonschema.Schema {
 			Type: &jsonschema.Type{SimpleTypes: &st},
 			Items: &jsonschema.Items{
 				SchemaOrBool: &jsonschema.SchemaOrBool{
-					TypeObject: nodeToJSSchema(node.Element, refs),
+					TypeObject: e.encode(node.Element),
 				},
 			},
 		}
@@ -161,25 +245,20 @@ func nodeToJSSchema(node Node, refs map[RefKey]*Ref) *jsonschema.Schema {
 		// JSON schema generic map of key type to value type
 		return &jsonschema.Schema{
 			Type:                 &jsonschema.Type{SimpleTypes: &st},
-			PropertyNames:        &jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(node.Key, refs)},
-			AdditionalProperties: &jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(node.Value, refs)},
+			PropertyNames:        &jsonschema.SchemaOrBool{TypeObject: e.encode(node.Key)},
+			AdditionalProperties: &jsonschema.SchemaOrBool{TypeObject: e.encode(node.Value)},
 		}
 
 	case *Ref:
-		var ref string
-		if len(node.TypeParameters) > 0 {
-			name := refName(node)
-			ref = fmt.Sprintf("#/definitions/%s.%s", node.Module, name)
-		} else {
-			ref = fmt.Sprintf("#/definitions/%s", node.String())
-		}
-		refs[node.ToRefKey()] = node
+		name := e.definitionName(node)
+		ref := e.refPrefix + name
+		e.refs[name] = node
 		return &jsonschema.Schema{Ref: &ref}
 
 	case *Optional:
 		null := jsonschema.Null
 		return &jsonschema.Schema{AnyOf: []jsonschema.SchemaOrBool{
-			{TypeObject: nodeToJSSchema(node.Type, refs)},
+			{TypeObject: e.encode(node.Type)},
 			{TypeObject: &jsonschema.Schema{Type: &jsonschema.Type{SimpleTypes: &null}}},
 		}}
 
@@ -187,13 +266,14 @@ func nodeToJSSchema(node Node, refs map[RefKey]*Ref) *jsonschema.Schema {
 		return &jsonschema.Schema{}
 
 	case *TypeAlias:
-		return nodeToJSSchema(node.Type, refs)
+		return e.encode(node.Type)
 
 	case Decl, *Field, Metadata, *MetadataCalls, *MetadataDatabases, *MetadataIngress,
 		*MetadataAlias, IngressPathComponent, *IngressPathLiteral, *IngressPathParameter, *Module,
 		*Schema, Type, *Database, *Verb, *EnumVariant, *MetadataCronJob, Value,
 		*StringValue, *IntValue, *TypeValue, *Config, *Secret, Symbol, Named,
-		*FSM, *FSMTransition, *MetadataRetry, *Topic, *Subscription, *MetadataSubscriber, *MetadataTypeMap:
+		*FSM, *FSMTransition, *MetadataRetry, *Topic, *Subscription, *MetadataSubscriber, *MetadataTypeMap,
+		*MetadataTimeout, *MetadataRateLimit, *MetadataConcurrency, *MetadataQueue, *MetadataMigrate, *MetadataCompensate, *MetadataAuth, *MetadataRecursive:
 		panic(fmt.Sprintf("unsupported node type %T", node))
 
 	default:
@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// Authentication schemes supported by MetadataAuth.
+const (
+	AuthSchemeJWT    = "jwt"
+	AuthSchemeAPIKey = "apikey"
+	AuthSchemeMTLS   = "mtls"
+)
+
+// DefaultAPIKeyHeader is the header API keys are read from if none is specified.
+const DefaultAPIKeyHeader = "X-API-Key"
+
+// MetadataAuth requires HTTP ingress requests to a verb to be authenticated
+// before the verb is called, eg.
+//
+//	+auth jwt issuer "https://auth.example.com" audience "api" jwks "https://auth.example.com/.well-known/jwks.json"
+//	+auth jwt issuer "https://auth.example.com" secret example.jwtKey
+//	+auth apikey header "X-API-Key" secret example.apiKeys
+//	+auth mtls
+//
+// If a verb declares more than one, a request must satisfy any one of them.
+type MetadataAuth struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Scheme   string `parser:"'+' 'auth' @('jwt' | 'apikey' | 'mtls')" protobuf:"2"`
+	Issuer   string `parser:"('issuer' @String)?" protobuf:"3"`
+	Audience string `parser:"('audience' @String)?" protobuf:"4"`
+	JWKS     string `parser:"('jwks' @String)?" protobuf:"5"`
+	Header   string `parser:"('header' @String)?" protobuf:"6"`
+	Secret   *Ref   `parser:"('secret' @@)?" protobuf:"7,optional"`
+}
+
+var _ Metadata = (*MetadataAuth)(nil)
+
+func (*MetadataAuth) schemaMetadata()      {}
+func (m *MetadataAuth) Position() Position { return m.Pos }
+
+func (m *MetadataAuth) schemaChildren() []Node {
+	if m.Secret == nil {
+		return nil
+	}
+	return []Node{m.Secret}
+}
+
+func (m *MetadataAuth) String() string {
+	components := []string{"+auth", m.Scheme}
+	if m.Issuer != "" {
+		components = append(components, fmt.Sprintf("issuer %q", m.Issuer))
+	}
+	if m.Audience != "" {
+		components = append(components, fmt.Sprintf("audience %q", m.Audience))
+	}
+	if m.JWKS != "" {
+		components = append(components, fmt.Sprintf("jwks %q", m.JWKS))
+	}
+	if m.Header != "" {
+		components = append(components, fmt.Sprintf("header %q", m.Header))
+	}
+	if m.Secret != nil {
+		components = append(components, fmt.Sprintf("secret %s", m.Secret))
+	}
+	return strings.Join(components, " ")
+}
+
+func (m *MetadataAuth) ToProto() proto.Message {
+	var secret *schemapb.Ref
+	if m.Secret != nil {
+		secret = m.Secret.ToProto().(*schemapb.Ref) //nolint:forcetypeassert
+	}
+	return &schemapb.MetadataAuth{
+		Pos:      posToProto(m.Pos),
+		Scheme:   m.Scheme,
+		Issuer:   m.Issuer,
+		Audience: m.Audience,
+		Jwks:     m.JWKS,
+		Header:   m.Header,
+		Secret:   secret,
+	}
+}
+
+// APIKeyHeader returns the header API keys are read from.
+func (m *MetadataAuth) APIKeyHeader() string {
+	if m.Header == "" {
+		return DefaultAPIKeyHeader
+	}
+	return m.Header
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// MetadataCompensate declares the verb that undoes the work of an FSM state.
+//
+// If an instance of the FSM fails, the compensations of the states it
+// completed are called in reverse order, each with the event that moved the
+// instance into the state.
+type MetadataCompensate struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	State *Ref `parser:"'+' 'compensate' @@" protobuf:"2"`
+	Verb  *Ref `parser:"'with' @@" protobuf:"3"`
+}
+
+var _ Metadata = (*MetadataCompensate)(nil)
+
+func (*MetadataCompensate) schemaMetadata()          {}
+func (m *MetadataCompensate) schemaChildren() []Node { return []Node{m.State, m.Verb} }
+func (m *MetadataCompensate) Position() Position     { return m.Pos }
+func (m *MetadataCompensate) String() string {
+	return fmt.Sprintf("+compensate %s with %s", m.State, m.Verb)
+}
+
+func (m *MetadataCompensate) ToProto() proto.Message {
+	return &schemapb.MetadataCompensate{
+		Pos:   posToProto(m.Pos),
+		State: m.State.ToProto().(*schemapb.Ref), //nolint: forcetypeassert
+		Verb:  m.Verb.ToProto().(*schemapb.Ref),  //nolint: forcetypeassert
+	}
+}
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// MetadataConcurrency limits the number of concurrent calls to a verb across
+// the cluster.
+type MetadataConcurrency struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Limit int `parser:"'+' 'concurrency' @Number" protobuf:"2"`
+}
+
+var _ Metadata = (*MetadataConcurrency)(nil)
+
+func (*MetadataConcurrency) schemaMetadata()          {}
+func (m *MetadataConcurrency) schemaChildren() []Node { return nil }
+func (m *MetadataConcurrency) Position() Position     { return m.Pos }
+func (m *MetadataConcurrency) String() string {
+	return fmt.Sprintf("+concurrency %d", m.Limit)
+}
+
+func (m *MetadataConcurrency) ToProto() proto.Message {
+	return &schemapb.MetadataConcurrency{
+		Pos:   posToProto(m.Pos),
+		Limit: int64(m.Limit),
+	}
+}
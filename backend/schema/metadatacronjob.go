@@ -11,14 +11,27 @@ import (
 type MetadataCronJob struct {
 	Pos Position `parser:"" protobuf:"1,optional"`
 
-	Cron string `parser:"'+' 'cron' Whitespace @(' ' | ~EOL)+" protobuf:"2"`
+	Cron string `parser:"'+' 'cron' Whitespace @(~(EOL | ' ' | 'tz' | 'missed') | ' ' (?= ~(EOL | 'tz' | 'missed')))+" protobuf:"2"`
+	// Timezone is the IANA timezone the cron expression is evaluated in, eg.
+	// "Europe/Berlin". Defaults to UTC.
+	Timezone string `parser:"(' '? 'tz' Whitespace @(Ident ((?! Whitespace) ('/' | '-' | '+' | Ident | Number))*))?" protobuf:"3"`
+	// Missed is the policy for executions that were missed, eg. because no
+	// controller was running: "skip", "once" (the default) or "all".
+	Missed string `parser:"(' '? 'missed' Whitespace @('skip' | 'once' | 'all'))?" protobuf:"4"`
 }
 
 var _ Metadata = (*MetadataCronJob)(nil)
 
 func (m *MetadataCronJob) Position() Position { return m.Pos }
 func (m *MetadataCronJob) String() string {
-	return fmt.Sprintf("+cron %s", m.Cron)
+	out := fmt.Sprintf("+cron %s", m.Cron)
+	if m.Timezone != "" {
+		out += " tz " + m.Timezone
+	}
+	if m.Missed != "" {
+		out += " missed " + m.Missed
+	}
+	return out
 }
 
 func (m *MetadataCronJob) schemaChildren() []Node {
@@ -29,7 +42,9 @@ func (*MetadataCronJob) schemaMetadata() {}
 
 func (m *MetadataCronJob) ToProto() proto.Message {
 	return &schemapb.MetadataCronJob{
-		Pos:  posToProto(m.Pos),
-		Cron: m.Cron,
+		Pos:      posToProto(m.Pos),
+		Cron:     m.Cron,
+		Timezone: m.Timezone,
+		Missed:   m.Missed,
 	}
 }
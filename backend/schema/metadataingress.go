@@ -12,8 +12,8 @@ import (
 type MetadataIngress struct {
 	Pos Position `parser:"" protobuf:"1,optional"`
 
-	Type   string                 `parser:"'+' 'ingress' @('http')?" protobuf:"2"`
-	Method string                 `parser:"@('GET' | 'POST' | 'PUT' | 'DELETE')" protobuf:"3"`
+	Type   string                 `parser:"'+' 'ingress' @('http' | 'ws')?" protobuf:"2"`
+	Method string                 `parser:"@('GET' | 'POST' | 'PUT' | 'DELETE')?" protobuf:"3"`
 	Path   []IngressPathComponent `parser:"('/' @@)+" protobuf:"4"`
 }
 
@@ -30,9 +30,22 @@ func (m *MetadataIngress) String() string {
 			path[i] = fmt.Sprintf("{%s}", v.Name)
 		}
 	}
+	if m.Method == "" {
+		return fmt.Sprintf("+ingress %s /%s", m.Type, strings.Join(path, "/"))
+	}
 	return fmt.Sprintf("+ingress %s %s /%s", m.Type, strings.ToUpper(m.Method), strings.Join(path, "/"))
 }
 
+// RouteMethod returns the HTTP method the ingress is routed by.
+//
+// WebSocket connections are established with a GET request.
+func (m *MetadataIngress) RouteMethod() string {
+	if m.Type == "ws" {
+		return "GET"
+	}
+	return m.Method
+}
+
 func (m *MetadataIngress) schemaChildren() []Node {
 	out := make([]Node, 0, len(m.Path))
 	for _, ref := range m.Path {
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// MetadataMigrate maps a state that has been removed from an FSM to a state
+// that still exists.
+//
+// Running instances of the FSM in the removed state are moved to the new state
+// when the deployment declaring the mapping is activated.
+type MetadataMigrate struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	From *Ref `parser:"'+' 'migrate' @@" protobuf:"2"`
+	To   *Ref `parser:"'to' @@" protobuf:"3"`
+}
+
+var _ Metadata = (*MetadataMigrate)(nil)
+
+func (*MetadataMigrate) schemaMetadata() {}
+
+// The removed state can't be resolved, so neither state is visited.
+func (m *MetadataMigrate) schemaChildren() []Node { return nil }
+func (m *MetadataMigrate) Position() Position     { return m.Pos }
+func (m *MetadataMigrate) String() string {
+	return fmt.Sprintf("+migrate %s to %s", m.From, m.To)
+}
+
+func (m *MetadataMigrate) ToProto() proto.Message {
+	return &schemapb.MetadataMigrate{
+		Pos:  posToProto(m.Pos),
+		From: m.From.ToProto().(*schemapb.Ref), //nolint: forcetypeassert
+		To:   m.To.ToProto().(*schemapb.Ref),   //nolint: forcetypeassert
+	}
+}
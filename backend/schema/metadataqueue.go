@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// DefaultFSMQueueDepth is the maximum number of events queued for an FSM
+// instance if the FSM does not declare a +queue.
+const DefaultFSMQueueDepth = 100
+
+// MetadataQueue configures the queue of events waiting for an FSM instance to
+// finish its active transition.
+type MetadataQueue struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Depth  int  `parser:"'+' 'queue' @Number" protobuf:"2"`
+	Dedupe bool `parser:"@'dedupe'?" protobuf:"3"`
+}
+
+var _ Metadata = (*MetadataQueue)(nil)
+
+func (*MetadataQueue) schemaMetadata()          {}
+func (m *MetadataQueue) schemaChildren() []Node { return nil }
+func (m *MetadataQueue) Position() Position     { return m.Pos }
+func (m *MetadataQueue) String() string {
+	if m.Dedupe {
+		return fmt.Sprintf("+queue %d dedupe", m.Depth)
+	}
+	return fmt.Sprintf("+queue %d", m.Depth)
+}
+
+func (m *MetadataQueue) ToProto() proto.Message {
+	return &schemapb.MetadataQueue{
+		Pos:    posToProto(m.Pos),
+		Depth:  int64(m.Depth),
+		Dedupe: m.Dedupe,
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/internal/duration"
+)
+
+// MetadataRateLimit limits the rate of calls to a verb across the cluster,
+// eg. "+ratelimit 100/1m" allows at most 100 calls per minute.
+type MetadataRateLimit struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Limit  int    `parser:"'+' 'ratelimit' @Number '/'" protobuf:"2"`
+	Period string `parser:"@(Number (?! Whitespace) Ident)" protobuf:"3"`
+}
+
+var _ Metadata = (*MetadataRateLimit)(nil)
+
+func (*MetadataRateLimit) schemaMetadata()          {}
+func (m *MetadataRateLimit) schemaChildren() []Node { return nil }
+func (m *MetadataRateLimit) Position() Position     { return m.Pos }
+func (m *MetadataRateLimit) String() string {
+	return fmt.Sprintf("+ratelimit %d/%s", m.Limit, m.Period)
+}
+
+func (m *MetadataRateLimit) ToProto() proto.Message {
+	return &schemapb.MetadataRateLimit{
+		Pos:    posToProto(m.Pos),
+		Limit:  int64(m.Limit),
+		Period: m.Period,
+	}
+}
+
+// PeriodDuration returns the parsed period over which Limit calls are allowed.
+func (m *MetadataRateLimit) PeriodDuration() (time.Duration, error) {
+	if m.Limit <= 0 {
+		return 0, fmt.Errorf("rate limit must be positive")
+	}
+	dur, err := duration.Parse(m.Period)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse rate limit period: %w", err)
+	}
+	if dur <= 0 {
+		return 0, fmt.Errorf("rate limit period must be positive")
+	}
+	return dur, nil
+}
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// MetadataRecursive allows a verb to take part in call cycles.
+//
+// A call that would form a cycle is rejected unless every verb in the cycle
+// is recursive.
+type MetadataRecursive struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Recursive bool `parser:"'+' @'recursive'" protobuf:"-"`
+}
+
+var _ Metadata = (*MetadataRecursive)(nil)
+
+func (*MetadataRecursive) schemaMetadata()          {}
+func (m *MetadataRecursive) schemaChildren() []Node { return nil }
+func (m *MetadataRecursive) Position() Position     { return m.Pos }
+func (m *MetadataRecursive) String() string         { return "+recursive" }
+
+func (m *MetadataRecursive) ToProto() proto.Message {
+	return &schemapb.MetadataRecursive{
+		Pos: posToProto(m.Pos),
+	}
+}
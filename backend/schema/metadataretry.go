@@ -148,3 +148,14 @@ func RetryParamsForFSMTransition(fsm *FSM, verb *Verb) (RetryParams, error) {
 
 	return retryMetadata.RetryParams()
 }
+
+// RetryParamsForVerb returns the retry count, min and max backoff for
+// asynchronous calls to a verb, from the verb's retry metadata.
+func RetryParamsForVerb(verb *Verb) (RetryParams, error) {
+	retryMetadata, ok := slices.FindVariant[*MetadataRetry](verb.Metadata)
+	if !ok {
+		// no retry
+		return RetryParams{}, nil
+	}
+	return retryMetadata.RetryParams()
+}
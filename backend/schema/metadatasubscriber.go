@@ -2,16 +2,28 @@ package schema
 
 import (
 	"fmt"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 
 	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/internal/duration"
 )
 
+// DefaultBatchWait is how long to wait for a batch of events to fill if the
+// subscriber does not specify a wait.
+const DefaultBatchWait = time.Second
+
 type MetadataSubscriber struct {
 	Pos Position `parser:"" protobuf:"1,optional"`
 
 	Name string `parser:"'+' 'subscribe' @Ident" protobuf:"2"`
+	// BatchSize is the maximum number of events delivered to the subscriber in
+	// each call. If set the subscriber's request is an array of events.
+	BatchSize *int `parser:"('batch' @Number" protobuf:"3,optional"`
+	// BatchWait is the maximum time to wait for a batch to fill before
+	// delivering a partial batch.
+	BatchWait string `parser:"@(Number (?! Whitespace) Ident)?)?" protobuf:"4"`
 }
 
 var _ Metadata = (*MetadataRetry)(nil)
@@ -20,12 +32,51 @@ func (*MetadataSubscriber) schemaMetadata()          {}
 func (m *MetadataSubscriber) schemaChildren() []Node { return nil }
 func (m *MetadataSubscriber) Position() Position     { return m.Pos }
 func (m *MetadataSubscriber) String() string {
-	return fmt.Sprintf("+subscribe %v", m.Name)
+	out := fmt.Sprintf("+subscribe %v", m.Name)
+	if m.BatchSize != nil {
+		out += fmt.Sprintf(" batch %d", *m.BatchSize)
+		if m.BatchWait != "" {
+			out += " " + m.BatchWait
+		}
+	}
+	return out
 }
 
 func (m *MetadataSubscriber) ToProto() proto.Message {
+	var batchSize *int64
+	if m.BatchSize != nil {
+		batchSize = proto.Int64(int64(*m.BatchSize))
+	}
 	return &schemapb.MetadataSubscriber{
-		Pos:  posToProto(m.Pos),
-		Name: m.Name,
+		Pos:       posToProto(m.Pos),
+		Name:      m.Name,
+		BatchSize: batchSize,
+		BatchWait: m.BatchWait,
+	}
+}
+
+// IsBatch returns true if events are delivered to the subscriber in batches.
+func (m *MetadataSubscriber) IsBatch() bool {
+	return m.BatchSize != nil
+}
+
+// BatchParams returns the maximum size of each batch of events delivered to
+// the subscriber, and how long to wait for a batch to fill.
+//
+// Subscribers that do not consume batches receive one event at a time.
+func (m *MetadataSubscriber) BatchParams() (size int, wait time.Duration, err error) {
+	if m.BatchSize == nil {
+		return 1, 0, nil
+	}
+	if *m.BatchSize <= 0 {
+		return 0, 0, fmt.Errorf("batch size must be positive")
+	}
+	if m.BatchWait == "" {
+		return *m.BatchSize, DefaultBatchWait, nil
+	}
+	wait, err = duration.Parse(m.BatchWait)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not parse batch wait: %w", err)
 	}
+	return *m.BatchSize, wait, nil
 }
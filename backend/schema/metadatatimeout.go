@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/internal/duration"
+)
+
+// MetadataTimeout sets the maximum duration of a call to a verb, including
+// any downstream calls it makes.
+type MetadataTimeout struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Duration string `parser:"'+' 'timeout' @(Number (?! Whitespace) Ident)" protobuf:"2"`
+}
+
+var _ Metadata = (*MetadataTimeout)(nil)
+
+func (*MetadataTimeout) schemaMetadata()          {}
+func (m *MetadataTimeout) schemaChildren() []Node { return nil }
+func (m *MetadataTimeout) Position() Position     { return m.Pos }
+func (m *MetadataTimeout) String() string {
+	return fmt.Sprintf("+timeout %s", m.Duration)
+}
+
+func (m *MetadataTimeout) ToProto() proto.Message {
+	return &schemapb.MetadataTimeout{
+		Pos:      posToProto(m.Pos),
+		Duration: m.Duration,
+	}
+}
+
+// Timeout returns the parsed timeout duration.
+func (m *MetadataTimeout) Timeout() (time.Duration, error) {
+	dur, err := duration.Parse(m.Duration)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse timeout: %w", err)
+	}
+	if dur <= 0 {
+		return 0, fmt.Errorf("timeout must be positive")
+	}
+	return dur, nil
+}
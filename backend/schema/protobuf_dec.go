@@ -141,8 +141,10 @@ func metadataToSchema(s *schemapb.Metadata) Metadata {
 
 	case *schemapb.Metadata_CronJob:
 		return &MetadataCronJob{
-			Pos:  posFromProto(s.CronJob.Pos),
-			Cron: s.CronJob.Cron,
+			Pos:      posFromProto(s.CronJob.Pos),
+			Cron:     s.CronJob.Cron,
+			Timezone: s.CronJob.Timezone,
+			Missed:   s.CronJob.Missed,
 		}
 
 	case *schemapb.Metadata_Alias:
@@ -171,9 +173,16 @@ func metadataToSchema(s *schemapb.Metadata) Metadata {
 		}
 
 	case *schemapb.Metadata_Subscriber:
+		var batchSize *int
+		if s.Subscriber.BatchSize != nil {
+			size := int(*s.Subscriber.BatchSize)
+			batchSize = &size
+		}
 		return &MetadataSubscriber{
-			Pos:  posFromProto(s.Subscriber.Pos),
-			Name: s.Subscriber.Name,
+			Pos:       posFromProto(s.Subscriber.Pos),
+			Name:      s.Subscriber.Name,
+			BatchSize: batchSize,
+			BatchWait: s.Subscriber.BatchWait,
 		}
 
 	case *schemapb.Metadata_TypeMap:
@@ -183,6 +192,66 @@ func metadataToSchema(s *schemapb.Metadata) Metadata {
 			NativeName: s.TypeMap.NativeName,
 		}
 
+	case *schemapb.Metadata_Timeout:
+		return &MetadataTimeout{
+			Pos:      posFromProto(s.Timeout.Pos),
+			Duration: s.Timeout.Duration,
+		}
+
+	case *schemapb.Metadata_RateLimit:
+		return &MetadataRateLimit{
+			Pos:    posFromProto(s.RateLimit.Pos),
+			Limit:  int(s.RateLimit.Limit),
+			Period: s.RateLimit.Period,
+		}
+
+	case *schemapb.Metadata_Concurrency:
+		return &MetadataConcurrency{
+			Pos:   posFromProto(s.Concurrency.Pos),
+			Limit: int(s.Concurrency.Limit),
+		}
+
+	case *schemapb.Metadata_Queue:
+		return &MetadataQueue{
+			Pos:    posFromProto(s.Queue.Pos),
+			Depth:  int(s.Queue.Depth),
+			Dedupe: s.Queue.Dedupe,
+		}
+
+	case *schemapb.Metadata_Migrate:
+		return &MetadataMigrate{
+			Pos:  posFromProto(s.Migrate.Pos),
+			From: RefFromProto(s.Migrate.From),
+			To:   RefFromProto(s.Migrate.To),
+		}
+
+	case *schemapb.Metadata_Compensate:
+		return &MetadataCompensate{
+			Pos:   posFromProto(s.Compensate.Pos),
+			State: RefFromProto(s.Compensate.State),
+			Verb:  RefFromProto(s.Compensate.Verb),
+		}
+
+	case *schemapb.Metadata_Auth:
+		var secret *Ref
+		if s.Auth.Secret != nil {
+			secret = RefFromProto(s.Auth.Secret)
+		}
+		return &MetadataAuth{
+			Pos:      posFromProto(s.Auth.Pos),
+			Scheme:   s.Auth.Scheme,
+			Issuer:   s.Auth.Issuer,
+			Audience: s.Auth.Audience,
+			JWKS:     s.Auth.Jwks,
+			Header:   s.Auth.Header,
+			Secret:   secret,
+		}
+
+	case *schemapb.Metadata_Recursive:
+		return &MetadataRecursive{
+			Pos: posFromProto(s.Recursive.Pos),
+		}
+
 	default:
 		panic(fmt.Sprintf("unhandled metadata type: %T", s))
 	}
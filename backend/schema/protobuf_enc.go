@@ -102,6 +102,30 @@ func metadataListToProto(nodes []Metadata) []*schemapb.Metadata {
 		case *MetadataTypeMap:
 			v = &schemapb.Metadata_TypeMap{TypeMap: n.ToProto().(*schemapb.MetadataTypeMap)}
 
+		case *MetadataTimeout:
+			v = &schemapb.Metadata_Timeout{Timeout: n.ToProto().(*schemapb.MetadataTimeout)}
+
+		case *MetadataRateLimit:
+			v = &schemapb.Metadata_RateLimit{RateLimit: n.ToProto().(*schemapb.MetadataRateLimit)}
+
+		case *MetadataConcurrency:
+			v = &schemapb.Metadata_Concurrency{Concurrency: n.ToProto().(*schemapb.MetadataConcurrency)}
+
+		case *MetadataQueue:
+			v = &schemapb.Metadata_Queue{Queue: n.ToProto().(*schemapb.MetadataQueue)}
+
+		case *MetadataMigrate:
+			v = &schemapb.Metadata_Migrate{Migrate: n.ToProto().(*schemapb.MetadataMigrate)}
+
+		case *MetadataCompensate:
+			v = &schemapb.Metadata_Compensate{Compensate: n.ToProto().(*schemapb.MetadataCompensate)}
+
+		case *MetadataAuth:
+			v = &schemapb.Metadata_Auth{Auth: n.ToProto().(*schemapb.MetadataAuth)}
+
+		case *MetadataRecursive:
+			v = &schemapb.Metadata_Recursive{Recursive: n.ToProto().(*schemapb.MetadataRecursive)}
+
 		default:
 			panic(fmt.Sprintf("unhandled metadata type %T", n))
 		}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/alecthomas/assert/v2"
 
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
 	"github.com/TBD54566975/ftl/internal/errors"
 	"github.com/TBD54566975/ftl/internal/slices"
 )
@@ -238,6 +239,7 @@ func TestParserRoundTrip(t *testing.T) {
 //nolint:maintidx
 func TestParsing(t *testing.T) {
 	ten := 10
+	batchSize := 100
 	tests := []struct {
 		name     string
 		input    string
@@ -532,7 +534,7 @@ func TestParsing(t *testing.T) {
 				module test {
 					export topic topicA test.eventA
 				
-					topic topicB test.eventB
+					topic topicB test.eventB partitions 4
 
 					subscription subA1 test.topicA
 
@@ -540,6 +542,8 @@ func TestParsing(t *testing.T) {
 
 					subscription subB test.topicB
 
+					subscription subBatch test.topicB
+
 					export data eventA {
 					}
 
@@ -561,6 +565,9 @@ func TestParsing(t *testing.T) {
 					verb catchesA(builtin.CatchRequest<test.eventA>) Unit
 
 					verb catchesB(builtin.CatchRequest<test.eventB>) Unit
+
+					verb consumesBatchB([test.eventB]) Unit
+						+subscribe subBatch batch 100 5s
 				}
 			`,
 			expected: &Schema{
@@ -581,6 +588,7 @@ func TestParsing(t *testing.T) {
 								Module: "test",
 								Name:   "eventB",
 							},
+							Partitions: 4,
 						},
 						&Subscription{
 							Name: "subA1",
@@ -603,6 +611,13 @@ func TestParsing(t *testing.T) {
 								Name:   "topicB",
 							},
 						},
+						&Subscription{
+							Name: "subBatch",
+							Topic: &Ref{
+								Module: "test",
+								Name:   "topicB",
+							},
+						},
 						&Data{
 							Export: true,
 							Name:   "eventA",
@@ -680,6 +695,25 @@ func TestParsing(t *testing.T) {
 								},
 							},
 						},
+						&Verb{
+							Name: "consumesBatchB",
+							Request: &Array{
+								Element: &Ref{
+									Module: "test",
+									Name:   "eventB",
+								},
+							},
+							Response: &Unit{
+								Unit: true,
+							},
+							Metadata: []Metadata{
+								&MetadataSubscriber{
+									Name:      "subBatch",
+									BatchSize: &batchSize,
+									BatchWait: "5s",
+								},
+							},
+						},
 						&Verb{
 							Name: "consumesBothASubs",
 							Request: &Ref{
@@ -739,6 +773,10 @@ func TestParsing(t *testing.T) {
 						+cron */10 * * * * * *
 					verb C(Unit) Unit
 						+cron 12h
+					verb D(Unit) Unit
+						+cron 0 9 * * 1-5 tz Europe/Berlin missed once
+					verb E(Unit) Unit
+						+cron Mon missed all
 				}
 			`,
 			expected: &Schema{
@@ -775,6 +813,29 @@ func TestParsing(t *testing.T) {
 								},
 							},
 						},
+						&Verb{
+							Name:     "D",
+							Request:  &Unit{Unit: true},
+							Response: &Unit{Unit: true},
+							Metadata: []Metadata{
+								&MetadataCronJob{
+									Cron:     "0 9 * * 1-5",
+									Timezone: "Europe/Berlin",
+									Missed:   "once",
+								},
+							},
+						},
+						&Verb{
+							Name:     "E",
+							Request:  &Unit{Unit: true},
+							Response: &Unit{Unit: true},
+							Metadata: []Metadata{
+								&MetadataCronJob{
+									Cron:   "Mon",
+									Missed: "all",
+								},
+							},
+						},
 					},
 				}},
 			},
@@ -1113,3 +1174,233 @@ func TestParseTypeMap(t *testing.T) {
 	actual = Normalise(actual)
 	assert.Equal(t, testSchema.Modules[4], actual, assert.Exclude[Position]())
 }
+
+func TestParseTimeout(t *testing.T) {
+	input := `
+	module test {
+	  verb slow(Unit) Unit
+	    +timeout 1m30s
+	}
+	`
+	actual, err := ParseModuleString("", input)
+	assert.NoError(t, err)
+	verb, ok := actual.Decls[0].(*Verb)
+	assert.True(t, ok)
+	timeout, ok := verb.GetMetadataTimeout().Get()
+	assert.True(t, ok)
+	duration, err := timeout.Timeout()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute+time.Second*30, duration)
+	assert.Equal(t, "+timeout 1m30s", timeout.String())
+}
+
+func TestParseLimits(t *testing.T) {
+	input := `
+	module test {
+	  verb limited(Unit) Unit
+	    +ratelimit 100/1m
+	    +concurrency 10
+	}
+	`
+	actual, err := ParseModuleString("", input)
+	assert.NoError(t, err)
+	verb, ok := actual.Decls[0].(*Verb)
+	assert.True(t, ok)
+	rateLimit, ok := verb.GetMetadataRateLimit().Get()
+	assert.True(t, ok)
+	period, err := rateLimit.PeriodDuration()
+	assert.NoError(t, err)
+	assert.Equal(t, 100, rateLimit.Limit)
+	assert.Equal(t, time.Minute, period)
+	assert.Equal(t, "+ratelimit 100/1m", rateLimit.String())
+	concurrency, ok := verb.GetMetadataConcurrency().Get()
+	assert.True(t, ok)
+	assert.Equal(t, 10, concurrency.Limit)
+	assert.Equal(t, "+concurrency 10", concurrency.String())
+}
+
+func TestParseFSMQueue(t *testing.T) {
+	input := `
+	module test {
+	  fsm payment
+	    +queue 10 dedupe
+	  {
+	    start test.created
+	  }
+
+	  verb created(Unit) Unit
+	}
+	`
+	actual, err := ParseModuleString("", input)
+	assert.NoError(t, err)
+	fsm, ok := actual.Decls[0].(*FSM)
+	assert.True(t, ok)
+	depth, dedupe := fsm.QueueParams()
+	assert.Equal(t, 10, depth)
+	assert.True(t, dedupe)
+	assert.Equal(t, "+queue 10 dedupe", fsm.Metadata[0].String())
+
+	depth, dedupe = (&FSM{}).QueueParams()
+	assert.Equal(t, DefaultFSMQueueDepth, depth)
+	assert.False(t, dedupe)
+}
+
+func TestParseFSMMigrate(t *testing.T) {
+	input := `
+	module test {
+	  fsm payment
+	    +migrate test.created to test.invoiced
+	  {
+	    start test.invoiced
+	  }
+
+	  verb invoiced(Unit) Unit
+	}
+	`
+	actual, err := ParseModuleString("", input)
+	assert.NoError(t, err)
+	fsm, ok := actual.Decls[0].(*FSM)
+	assert.True(t, ok)
+	assert.Equal(t, "+migrate test.created to test.invoiced", fsm.Metadata[0].String())
+	assert.True(t, fsm.HasState(RefKey{Module: "test", Name: "invoiced"}))
+	assert.False(t, fsm.HasState(RefKey{Module: "test", Name: "created"}))
+
+	to, ok := fsm.MigratedState(RefKey{Module: "test", Name: "created"}).Get()
+	assert.True(t, ok)
+	assert.Equal(t, "test.invoiced", to.String())
+	_, ok = fsm.MigratedState(RefKey{Module: "test", Name: "invoiced"}).Get()
+	assert.False(t, ok)
+}
+
+func TestParseFSMCompensate(t *testing.T) {
+	input := `
+	module test {
+	  fsm payment
+	    +compensate test.charged with test.refund
+	  {
+	    start test.charged
+	  }
+
+	  verb charged(Unit) Unit
+	  verb refund(Unit) Unit
+	}
+	`
+	actual, err := ParseModuleString("", input)
+	assert.NoError(t, err)
+	fsm, ok := actual.Decls[0].(*FSM)
+	assert.True(t, ok)
+	assert.Equal(t, "+compensate test.charged with test.refund", fsm.Metadata[0].String())
+
+	verb, ok := fsm.Compensation(RefKey{Module: "test", Name: "charged"}).Get()
+	assert.True(t, ok)
+	assert.Equal(t, "test.refund", verb.String())
+	_, ok = fsm.Compensation(RefKey{Module: "test", Name: "refund"}).Get()
+	assert.False(t, ok)
+}
+
+func TestParseFSMTimeout(t *testing.T) {
+	input := `
+	module test {
+	  fsm payment {
+	    start test.invoiced
+	    transition test.invoiced to test.paid
+	    transition test.invoiced to test.expired +timeout 30m
+	  }
+
+	  data Invoice {}
+	  data Payment {}
+
+	  verb invoiced(test.Invoice) Unit
+	  verb paid(test.Payment) Unit
+	  verb expired(test.Invoice) Unit
+	}
+	`
+	actual, err := ParseModuleString("", input)
+	assert.NoError(t, err)
+	fsm, ok := actual.Decls[0].(*FSM)
+	assert.True(t, ok)
+	assert.Equal(t, "test.invoiced to test.expired +timeout 30m", fsm.Transitions[1].String())
+
+	_, ok = fsm.Transitions[0].Timeout()
+	assert.False(t, ok)
+
+	transition, timeout, ok := fsm.TimeoutTransition(RefKey{Module: "test", Name: "invoiced"})
+	assert.True(t, ok)
+	assert.Equal(t, fsm.Transitions[1], transition)
+	assert.Equal(t, 30*time.Minute, timeout)
+
+	_, _, ok = fsm.TimeoutTransition(RefKey{Module: "test", Name: "paid"})
+	assert.False(t, ok)
+}
+
+func TestParseAuth(t *testing.T) {
+	input := `
+	module test {
+	  secret apiKeys [String]
+
+	  export verb protected(HttpRequest<Unit>) HttpResponse<Unit, Unit>
+	    +ingress http GET /protected
+	    +auth jwt issuer "https://auth.example.com" audience "api" jwks "https://auth.example.com/jwks.json"
+	    +auth apikey secret apiKeys
+	}
+	`
+	actual, err := ParseModuleString("", input)
+	assert.NoError(t, err)
+	verb, ok := actual.Decls[1].(*Verb)
+	assert.True(t, ok)
+	auth := verb.GetMetadataAuth()
+	assert.Equal(t, 2, len(auth))
+	assert.Equal(t, `+auth jwt issuer "https://auth.example.com" audience "api" jwks "https://auth.example.com/jwks.json"`, auth[0].String())
+	assert.Equal(t, "+auth apikey secret test.apiKeys", auth[1].String())
+	assert.Equal(t, DefaultAPIKeyHeader, auth[1].APIKeyHeader())
+
+	decoded, err := ModuleFromProto(actual.ToProto().(*schemapb.Module)) //nolint:forcetypeassert
+	assert.NoError(t, err)
+	assert.Equal(t, actual.String(), decoded.String())
+}
+
+func TestParseStream(t *testing.T) {
+	input := `
+	module test {
+	  data Progress {
+	    percent Int
+	  }
+
+	  export verb progress(HttpRequest<Unit>) stream test.Progress
+	    +ingress http GET /progress
+	}
+	`
+	actual, err := ParseModuleString("", input)
+	assert.NoError(t, err)
+	verb, ok := actual.Decls[1].(*Verb)
+	assert.True(t, ok)
+	assert.True(t, verb.Stream)
+	assert.Equal(t, VerbKindStream, verb.Kind())
+	assert.Contains(t, verb.String(), "verb progress(builtin.HttpRequest<Unit>) stream test.Progress")
+
+	decoded, err := ModuleFromProto(actual.ToProto().(*schemapb.Module)) //nolint:forcetypeassert
+	assert.NoError(t, err)
+	assert.Equal(t, actual.String(), decoded.String())
+}
+
+func TestParseWebSocketIngress(t *testing.T) {
+	input := `
+	module test {
+	  export verb chat(builtin.WebSocketMessage<String>) Unit
+	    +ingress ws /chat
+	}
+	`
+	actual, err := ParseModuleString("", input)
+	assert.NoError(t, err)
+	verb, ok := actual.Decls[0].(*Verb)
+	assert.True(t, ok)
+	ingress, ok := verb.GetMetadataIngress().Get()
+	assert.True(t, ok)
+	assert.Equal(t, "ws", ingress.Type)
+	assert.Equal(t, "GET", ingress.RouteMethod())
+	assert.Equal(t, "+ingress ws /chat", ingress.String())
+
+	decoded, err := ModuleFromProto(actual.ToProto().(*schemapb.Module)) //nolint:forcetypeassert
+	assert.NoError(t, err)
+	assert.Equal(t, actual.String(), decoded.String())
+}
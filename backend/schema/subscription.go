@@ -15,6 +15,9 @@ type Subscription struct {
 	Comments []string `parser:"@Comment*" protobuf:"2"`
 	Name     string   `parser:"'subscription' @Ident" protobuf:"3"`
 	Topic    *Ref     `parser:"@@" protobuf:"4"`
+	// DeadLetter is the topic that events are published to once they have
+	// permanently failed to be consumed by the subscription.
+	DeadLetter *Ref `parser:"('deadletter' @@)?" protobuf:"5,optional"`
 }
 
 var _ Decl = (*Subscription)(nil)
@@ -24,6 +27,9 @@ func (s *Subscription) Position() Position { return s.Pos }
 func (*Subscription) schemaDecl()          {}
 func (*Subscription) schemaSymbol()        {}
 func (s *Subscription) schemaChildren() []Node {
+	if s.DeadLetter != nil {
+		return []Node{s.Topic, s.DeadLetter}
+	}
 	return []Node{s.Topic}
 }
 
@@ -34,25 +40,38 @@ func (s *Subscription) String() string {
 	w := &strings.Builder{}
 	fmt.Fprint(w, EncodeComments(s.Comments))
 	fmt.Fprintf(w, "subscription %s %v", s.Name, s.Topic)
+	if s.DeadLetter != nil {
+		fmt.Fprintf(w, " deadletter %v", s.DeadLetter)
+	}
 	return w.String()
 }
 
 func (s *Subscription) ToProto() proto.Message {
+	var deadLetter *schemapb.Ref
+	if s.DeadLetter != nil {
+		deadLetter = s.DeadLetter.ToProto().(*schemapb.Ref) //nolint: forcetypeassert
+	}
 	return &schemapb.Subscription{
 		Pos: posToProto(s.Pos),
 
-		Name:     s.Name,
-		Topic:    s.Topic.ToProto().(*schemapb.Ref), //nolint: forcetypeassert
-		Comments: s.Comments,
+		Name:       s.Name,
+		Topic:      s.Topic.ToProto().(*schemapb.Ref), //nolint: forcetypeassert
+		DeadLetter: deadLetter,
+		Comments:   s.Comments,
 	}
 }
 
 func SubscriptionFromProto(s *schemapb.Subscription) *Subscription {
+	var deadLetter *Ref
+	if s.DeadLetter != nil {
+		deadLetter = RefFromProto(s.DeadLetter)
+	}
 	return &Subscription{
 		Pos: posFromProto(s.Pos),
 
-		Name:     s.Name,
-		Topic:    RefFromProto(s.Topic),
-		Comments: s.Comments,
+		Name:       s.Name,
+		Topic:      RefFromProto(s.Topic),
+		DeadLetter: deadLetter,
+		Comments:   s.Comments,
 	}
 }
@@ -16,6 +16,9 @@ type Topic struct {
 	Export   bool     `parser:"@'export'?" protobuf:"3"`
 	Name     string   `parser:"'topic' @Ident" protobuf:"4"`
 	Event    Type     `parser:"@@" protobuf:"5"`
+	// Partitions is the number of partitions events are distributed across by
+	// their partition key. Zero is equivalent to a single partition.
+	Partitions int `parser:"('partitions' @Number)?" protobuf:"6"`
 }
 
 var _ Decl = (*Topic)(nil)
@@ -41,17 +44,26 @@ func (t *Topic) String() string {
 		fmt.Fprint(w, "export ")
 	}
 	fmt.Fprintf(w, "topic %s %s", t.Name, t.Event)
+	if t.Partitions > 0 {
+		fmt.Fprintf(w, " partitions %d", t.Partitions)
+	}
 	return w.String()
 }
 
+// PartitionCount returns the number of partitions in the topic.
+func (t *Topic) PartitionCount() int {
+	return max(t.Partitions, 1)
+}
+
 func (t *Topic) ToProto() proto.Message {
 	return &schemapb.Topic{
 		Pos: posToProto(t.Pos),
 
-		Name:     t.Name,
-		Export:   t.Export,
-		Event:    TypeToProto(t.Event),
-		Comments: t.Comments,
+		Name:       t.Name,
+		Export:     t.Export,
+		Event:      TypeToProto(t.Event),
+		Partitions: int64(t.Partitions),
+		Comments:   t.Comments,
 	}
 }
 
@@ -59,9 +71,10 @@ func TopicFromProto(t *schemapb.Topic) *Topic {
 	return &Topic{
 		Pos: posFromProto(t.Pos),
 
-		Name:     t.Name,
-		Export:   t.Export,
-		Event:    TypeFromProto(t.Event),
-		Comments: t.Comments,
+		Name:       t.Name,
+		Export:     t.Export,
+		Event:      TypeFromProto(t.Event),
+		Partitions: int(t.Partitions),
+		Comments:   t.Comments,
 	}
 }
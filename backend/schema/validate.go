@@ -3,6 +3,7 @@ package schema
 
 import (
 	"fmt"
+	"net/url"
 	"reflect"
 	"regexp"
 	"slices"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/alecthomas/participle/v2"
 	"github.com/alecthomas/types/optional"
+	"golang.org/x/exp/maps"
 
 	"github.com/TBD54566975/ftl/internal/cron"
 	"github.com/TBD54566975/ftl/internal/errors"
@@ -153,7 +155,7 @@ func ValidateModuleInSchema(schema *Schema, m optional.Option[*Module]) (*Schema
 
 					case *MetadataIngress:
 						// Check for duplicate ingress keys
-						key := md.Method + " "
+						key := md.RouteMethod() + " "
 						for _, path := range md.Path {
 							switch path := path.(type) {
 							case *IngressPathLiteral:
@@ -171,7 +173,9 @@ func ValidateModuleInSchema(schema *Schema, m optional.Option[*Module]) (*Schema
 					case *MetadataRetry:
 						validateRetries(module, md, optional.Some(n.Request), scopes, optional.Some(schema))
 
-					case *MetadataCronJob, *MetadataCalls, *MetadataDatabases, *MetadataAlias, *MetadataTypeMap:
+					case *MetadataCronJob, *MetadataCalls, *MetadataDatabases, *MetadataAlias, *MetadataTypeMap, *MetadataTimeout,
+						*MetadataRateLimit, *MetadataConcurrency, *MetadataQueue, *MetadataMigrate, *MetadataCompensate, *MetadataAuth,
+						*MetadataRecursive:
 					}
 				}
 
@@ -208,6 +212,59 @@ func ValidateModuleInSchema(schema *Schema, m optional.Option[*Module]) (*Schema
 						merr = append(merr, errorf(start, "start state %q must be a sink but is %s", start, sym.Kind()))
 					}
 				}
+				timeouts := map[RefKey]bool{}
+				for _, transition := range n.Transitions {
+					if _, ok := islices.FindVariant[*MetadataTimeout](transition.Metadata); !ok {
+						continue
+					}
+					if timeouts[transition.From.ToRefKey()] {
+						merr = append(merr, errorf(transition, "state %q has more than one timeout transition", transition.From))
+					}
+					timeouts[transition.From.ToRefKey()] = true
+				}
+				migrated := map[RefKey]bool{}
+				for _, md := range n.Metadata {
+					migrate, ok := md.(*MetadataMigrate)
+					if !ok {
+						continue
+					}
+					if n.HasState(migrate.From.ToRefKey()) {
+						merr = append(merr, errorf(migrate, "fsm %s: can not migrate %q as it is still a state of the FSM", n.Name, migrate.From))
+					}
+					if !n.HasState(migrate.To.ToRefKey()) {
+						merr = append(merr, errorf(migrate, "fsm %s: can not migrate to %q as it is not a state of the FSM", n.Name, migrate.To))
+					}
+					if migrated[migrate.From.ToRefKey()] {
+						merr = append(merr, errorf(migrate, "fsm %s: state %q is migrated more than once", n.Name, migrate.From))
+					}
+					migrated[migrate.From.ToRefKey()] = true
+				}
+				compensated := map[RefKey]bool{}
+				for _, md := range n.Metadata {
+					compensate, ok := md.(*MetadataCompensate)
+					if !ok {
+						continue
+					}
+					if !n.HasState(compensate.State.ToRefKey()) {
+						merr = append(merr, errorf(compensate, "fsm %s: can not compensate %q as it is not a state of the FSM", n.Name, compensate.State))
+					}
+					if compensated[compensate.State.ToRefKey()] {
+						merr = append(merr, errorf(compensate, "fsm %s: state %q has more than one compensation", n.Name, compensate.State))
+					}
+					compensated[compensate.State.ToRefKey()] = true
+					// Unknown references are reported when the refs are visited.
+					state, _ := ResolveAs[*Verb](scopes, *compensate.State)
+					if verb, decl := ResolveAs[*Verb](scopes, *compensate.Verb); decl == nil {
+						continue
+					} else if verb == nil {
+						merr = append(merr, errorf(compensate.Verb, "compensation %q is not a verb", compensate.Verb))
+					} else if verb.Kind() != VerbKindSink {
+						merr = append(merr, errorf(compensate.Verb, "compensation %q must be a sink but is %s", compensate.Verb, verb.Kind()))
+					} else if state != nil && !state.Request.Equal(verb.Request) {
+						merr = append(merr, errorf(compensate.Verb, "compensation %s: request %s must match request %s of state %s",
+							compensate.Verb, verb.Request, state.Request, compensate.State))
+					}
+				}
 
 			case *FSMTransition:
 				if sym, decl := ResolveAs[*Verb](scopes, *n.From); decl == nil {
@@ -222,6 +279,24 @@ func ValidateModuleInSchema(schema *Schema, m optional.Option[*Module]) (*Schema
 				} else if sym.Kind() != VerbKindSink {
 					merr = append(merr, errorf(n.To, "destination state %q must be a sink but is %s", n.To, sym.Kind()))
 				}
+				for _, md := range n.Metadata {
+					timeout, ok := md.(*MetadataTimeout)
+					if !ok {
+						merr = append(merr, errorf(md, "metadata %q is not valid on FSM transitions", strings.TrimSpace(md.String())))
+						continue
+					}
+					if _, err := timeout.Timeout(); err != nil {
+						merr = append(merr, errorf(md, "transition %s to %s: %v", n.From, n.To, err))
+					}
+					// A timeout transition passes the event that entered the source
+					// state on to the destination state.
+					from, _ := ResolveAs[*Verb](scopes, *n.From)
+					to, _ := ResolveAs[*Verb](scopes, *n.To)
+					if from != nil && to != nil && !from.Request.Equal(to.Request) {
+						merr = append(merr, errorf(md, "timeout transition %s to %s: destination request %s must match source request %s",
+							n.From, n.To, to.Request, from.Request))
+					}
+				}
 
 			case *Array, *Bool, *Bytes, *Data, *Database, Decl, *Field, *Float,
 				IngressPathComponent, *IngressPathLiteral, *IngressPathParameter,
@@ -229,7 +304,9 @@ func ValidateModuleInSchema(schema *Schema, m optional.Option[*Module]) (*Schema
 				*MetadataIngress, *MetadataAlias, *Module, *Optional, *Schema, *TypeAlias,
 				*String, *Time, Type, *Unit, *Any, *TypeParameter, *EnumVariant, *MetadataRetry,
 				Value, *IntValue, *StringValue, *TypeValue, *Config, *Secret, Symbol, Named,
-				*MetadataSubscriber, *Subscription, *Topic, *MetadataTypeMap:
+				*MetadataSubscriber, *Subscription, *Topic, *MetadataTypeMap, *MetadataTimeout,
+				*MetadataRateLimit, *MetadataConcurrency, *MetadataQueue, *MetadataMigrate, *MetadataCompensate,
+				*MetadataAuth, *MetadataRecursive:
 			}
 			return next()
 		})
@@ -332,6 +409,9 @@ func ValidateModule(module *Module) error {
 				suberrs := validateRetries(module, retry, optional.None[Type](), scopes, optional.None[*Schema]())
 				merr = append(merr, suberrs...)
 			}
+			if queue, ok := islices.FindVariant[*MetadataQueue](n.Metadata); ok && queue.Depth <= 0 {
+				merr = append(merr, errorf(queue, "fsm %s: queue depth must be positive", n.Name))
+			}
 
 		case *Verb:
 			merr = append(merr, validateVerbMetadata(scopes, module, n)...)
@@ -357,6 +437,9 @@ func ValidateModule(module *Module) error {
 			if !ValidateName(n.Name) || !unicode.IsLower(rune(n.Name[0])) {
 				merr = append(merr, errorf(n, "invalid name: must consist of only letters, numbers and underscores, and start with a lowercase letter."))
 			}
+			if n.Partitions < 0 {
+				merr = append(merr, errorf(n, "topic %s must have at least one partition", n.Name))
+			}
 
 		case *Subscription:
 			if !ValidateName(n.Name) {
@@ -375,12 +458,21 @@ func ValidateModule(module *Module) error {
 				n.Catch.Module = module.Name
 			}
 
+		case *MetadataMigrate:
+			for _, ref := range []*Ref{n.From, n.To} {
+				if ref.Module == "" {
+					ref.Module = module.Name
+				}
+			}
+
 		case *Array, *Bool, *Database, *Float, *Int,
 			*Time, *Map, *Module, *Schema, *String, *Bytes,
 			*MetadataCalls, *MetadataDatabases, *MetadataIngress, *MetadataCronJob, *MetadataAlias,
 			IngressPathComponent, *IngressPathLiteral, *IngressPathParameter, *Optional,
 			*Unit, *Any, *TypeParameter, *Enum, *EnumVariant, *IntValue, *StringValue, *TypeValue,
-			*Config, *FSMTransition, *Secret, *MetadataSubscriber, *MetadataTypeMap:
+			*Config, *FSMTransition, *Secret, *MetadataSubscriber, *MetadataTypeMap, *MetadataTimeout,
+			*MetadataRateLimit, *MetadataConcurrency, *MetadataQueue, *MetadataCompensate, *MetadataAuth,
+			*MetadataRecursive:
 
 		case Named, Symbol, Type, Metadata, Value, Decl: // Union types.
 		}
@@ -527,16 +619,100 @@ func dfsForDependencyCycle(imports map[string][]string, vertexStates map[depende
 	return nil
 }
 
+// WarnCallCycles returns a warning for each cycle formed by the "+calls"
+// metadata of verbs in the given module, or in all modules if none is given.
+//
+// Recursion may be intentional so cycles are not errors, but at runtime calls
+// that form a cycle are rejected unless every verb in it is marked
+// "+recursive", so only those cycles are reported.
+func WarnCallCycles(schema *Schema, m optional.Option[*Module]) []*Error {
+	calls := map[RefKey][]RefKey{}
+	verbs := map[RefKey]*Verb{}
+	for _, module := range schema.Modules {
+		for _, verb := range module.Verbs() {
+			key := RefKey{Module: module.Name, Name: verb.Name}
+			verbs[key] = verb
+			for _, md := range verb.Metadata {
+				if md, ok := md.(*MetadataCalls); ok {
+					for _, call := range md.Calls {
+						callee := call.ToRefKey()
+						if callee.Module == "" {
+							callee.Module = module.Name
+						}
+						calls[key] = append(calls[key], callee)
+					}
+				}
+			}
+		}
+	}
+	keys := maps.Keys(verbs)
+	sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+
+	states := map[RefKey]dependencyVertexState{}
+	seen := map[string]bool{}
+	var stack []RefKey
+	var out []*Error
+	var visit func(key RefKey)
+	visit = func(key RefKey) {
+		switch states[key] {
+		case notExplored:
+			states[key] = exploring
+			stack = append(stack, key)
+			for _, callee := range calls[key] {
+				visit(callee)
+			}
+			stack = stack[:len(stack)-1]
+			states[key] = fullyExplored
+
+		case exploring:
+			start := slices.Index(stack, key)
+			cycle := append(slices.Clone(stack[start:]), key)
+			// Report each cycle once, regardless of where it was entered.
+			members := islices.Map(cycle[:len(cycle)-1], RefKey.String)
+			first := slices.Index(members, slices.Min(members))
+			id := strings.Join(append(members[first:], members[:first]...), " -> ")
+			if seen[id] {
+				return
+			}
+			seen[id] = true
+			if module, ok := m.Get(); ok && !slices.ContainsFunc(cycle, func(k RefKey) bool { return k.Module == module.Name }) {
+				return
+			}
+			verb := verbs[cycle[0]]
+			if verb == nil {
+				return
+			}
+			if !slices.ContainsFunc(cycle, func(k RefKey) bool { return verbs[k] == nil || !verbs[k].IsRecursive() }) {
+				return
+			}
+			path := islices.Map(cycle, RefKey.String)
+			out = append(out, Warnf(verb.Pos, verb.Pos.Column, "verb call cycle detected: %s (calls will be rejected unless every verb in the cycle is +recursive)", strings.Join(path, " -> ")))
+
+		case fullyExplored:
+		}
+	}
+	for _, key := range keys {
+		visit(key)
+	}
+	return out
+}
+
 func errorf(pos interface{ Position() Position }, format string, args ...interface{}) error {
 	return Errorf(pos.Position(), pos.Position().Column, format, args...)
 }
 
 func validateVerbMetadata(scopes Scopes, module *Module, n *Verb) (merr []error) {
+	if n.Stream {
+		if _, ok := n.Response.(*Unit); ok {
+			merr = append(merr, errorf(n, "streaming verb %s must have a response type", n.Name))
+		}
+	}
+
 	// Validate metadata
 	metadataTypes := map[reflect.Type]bool{}
 	for _, md := range n.Metadata {
 		reflected := reflect.TypeOf(md)
-		if _, allowsMultiple := md.(*MetadataSubscriber); !allowsMultiple {
+		if !allowsMultipleMetadata(md) {
 			if _, seen := metadataTypes[reflected]; seen {
 				merr = append(merr, errorf(md, "verb can not have multiple instances of %s", strings.ToLower(strings.TrimPrefix(reflected.String(), "*schema.Metadata"))))
 				continue
@@ -546,10 +722,20 @@ func validateVerbMetadata(scopes Scopes, module *Module, n *Verb) (merr []error)
 
 		switch md := md.(type) {
 		case *MetadataIngress:
+			if md.Type == "ws" {
+				merr = append(merr, validateWebSocketIngress(scopes, module, n, md)...)
+				break
+			}
+			if md.Method == "" {
+				merr = append(merr, errorf(md, "ingress verb %s: http ingress requires a method", n.Name))
+			}
 			reqBodyType, reqBody, errs := validateIngressRequestOrResponse(scopes, module, n, "request", n.Request)
 			merr = append(merr, errs...)
-			_, _, errs = validateIngressRequestOrResponse(scopes, module, n, "response", n.Response)
-			merr = append(merr, errs...)
+			// Streaming verbs write their responses directly to the HTTP response body.
+			if !n.Stream {
+				_, _, errs = validateIngressRequestOrResponse(scopes, module, n, "response", n.Response)
+				merr = append(merr, errs...)
+			}
 
 			// Validate path
 			for _, path := range md.Path {
@@ -570,6 +756,12 @@ func validateVerbMetadata(scopes Scopes, module *Module, n *Verb) (merr []error)
 			if err != nil {
 				merr = append(merr, errorf(md, "verb %s: invalid cron expression %q: %v", n.Name, md.Cron, err))
 			}
+			if _, err := cron.LoadTimezone(md.Timezone); err != nil {
+				merr = append(merr, errorf(md, "verb %s: %v", n.Name, err))
+			}
+			if _, err := cron.ParseMissedRunPolicy(md.Missed); err != nil {
+				merr = append(merr, errorf(md, "verb %s: %v", n.Name, err))
+			}
 			if _, ok := n.Request.(*Unit); !ok {
 				merr = append(merr, errorf(md, "verb %s: cron job can not have a request type", n.Name))
 			}
@@ -606,14 +798,126 @@ func validateVerbMetadata(scopes Scopes, module *Module, n *Verb) (merr []error)
 		case *MetadataSubscriber:
 			subErrs := validateVerbSubscriptions(module, n, md, scopes, optional.None[*Schema]())
 			merr = append(merr, subErrs...)
-		case *MetadataCalls, *MetadataDatabases, *MetadataAlias, *MetadataTypeMap:
+		case *MetadataTimeout:
+			if _, err := md.Timeout(); err != nil {
+				merr = append(merr, errorf(md, "verb %s: %v", n.Name, err))
+			}
+		case *MetadataRateLimit:
+			if _, err := md.PeriodDuration(); err != nil {
+				merr = append(merr, errorf(md, "verb %s: %v", n.Name, err))
+			}
+		case *MetadataConcurrency:
+			if md.Limit <= 0 {
+				merr = append(merr, errorf(md, "verb %s: concurrency limit must be positive", n.Name))
+			}
+		case *MetadataQueue:
+			merr = append(merr, errorf(md, "verb %s: queues can only be added to FSMs", n.Name))
+		case *MetadataMigrate:
+			merr = append(merr, errorf(md, "verb %s: state migrations can only be added to FSMs", n.Name))
+		case *MetadataCompensate:
+			merr = append(merr, errorf(md, "verb %s: compensations can only be added to FSMs", n.Name))
+		case *MetadataAuth:
+			merr = append(merr, validateAuth(scopes, module, n, md)...)
+		case *MetadataCalls, *MetadataDatabases, *MetadataAlias, *MetadataTypeMap, *MetadataRecursive:
 		}
 	}
 	return
 }
 
+func allowsMultipleMetadata(md Metadata) bool {
+	switch md.(type) {
+	case *MetadataSubscriber, *MetadataAuth:
+		return true
+	default:
+		return false
+	}
+}
+
+func validateAuth(scopes Scopes, module *Module, n *Verb, md *MetadataAuth) (merr []error) {
+	if ingress, ok := n.GetMetadataIngress().Get(); !ok || ingress.Type != "http" {
+		merr = append(merr, errorf(md, "verb %s: auth can only be added to HTTP ingress verbs", n.Name))
+	}
+	disallowed := map[string]bool{}
+	switch md.Scheme {
+	case AuthSchemeJWT:
+		disallowed["header"] = md.Header != ""
+		if (md.JWKS == "") == (md.Secret == nil) {
+			merr = append(merr, errorf(md, "verb %s: jwt auth requires exactly one of jwks or secret", n.Name))
+		}
+		if md.JWKS != "" {
+			if u, err := url.Parse(md.JWKS); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+				merr = append(merr, errorf(md, "verb %s: invalid jwks URL %q", n.Name, md.JWKS))
+			}
+		}
+
+	case AuthSchemeAPIKey:
+		disallowed["issuer"] = md.Issuer != ""
+		disallowed["audience"] = md.Audience != ""
+		disallowed["jwks"] = md.JWKS != ""
+		if md.Secret == nil {
+			merr = append(merr, errorf(md, "verb %s: apikey auth requires a secret", n.Name))
+		}
+
+	case AuthSchemeMTLS:
+		disallowed["issuer"] = md.Issuer != ""
+		disallowed["audience"] = md.Audience != ""
+		disallowed["jwks"] = md.JWKS != ""
+		disallowed["header"] = md.Header != ""
+		disallowed["secret"] = md.Secret != nil
+	}
+	for _, option := range []string{"issuer", "audience", "jwks", "header", "secret"} {
+		if disallowed[option] {
+			merr = append(merr, errorf(md, "verb %s: %s auth does not support %s", n.Name, md.Scheme, option))
+		}
+	}
+	if md.Secret == nil || md.Scheme == AuthSchemeMTLS {
+		return merr
+	}
+
+	if md.Secret.Module == "" {
+		md.Secret.Module = module.Name
+	}
+	if md.Secret.Module != module.Name {
+		merr = append(merr, errorf(md.Secret, "verb %s: auth secret %s must be declared in module %s", n.Name, md.Secret, module.Name))
+		return merr
+	}
+	secret, _ := ResolveAs[*Secret](scopes, *md.Secret)
+	if secret == nil {
+		merr = append(merr, errorf(md.Secret, "verb %s: auth secret %s must be a secret", n.Name, md.Secret))
+		return merr
+	}
+	switch md.Scheme {
+	case AuthSchemeJWT:
+		if _, ok := secret.Type.(*String); !ok {
+			merr = append(merr, errorf(md.Secret, "verb %s: jwt auth secret %s must be of type String, not %s", n.Name, md.Secret, secret.Type))
+		}
+
+	case AuthSchemeAPIKey:
+		if !isAPIKeySecretType(secret.Type) {
+			merr = append(merr, errorf(md.Secret, "verb %s: apikey auth secret %s must be of type String, [String] or {String: String}, not %s", n.Name, md.Secret, secret.Type))
+		}
+	}
+	return merr
+}
+
+func isAPIKeySecretType(t Type) bool {
+	switch t := t.(type) {
+	case *String:
+		return true
+	case *Array:
+		_, ok := t.Element.(*String)
+		return ok
+	case *Map:
+		_, keyOk := t.Key.(*String)
+		_, valueOk := t.Value.(*String)
+		return keyOk && valueOk
+	default:
+		return false
+	}
+}
+
 func validateIngressRequestOrResponse(scopes Scopes, module *Module, n *Verb, reqOrResp string, r Type) (fieldType Type, body Symbol, merr []error) {
-	data, err := resolveValidIngressReqResp(scopes, reqOrResp, optional.None[*ModuleDecl](), r, nil)
+	data, err := resolveValidIngressReqResp(scopes, "Http"+strings.Title(reqOrResp), optional.None[*ModuleDecl](), r, nil)
 	if err != nil {
 		merr = append(merr, errorf(r, "ingress verb %s: %s type %s: %v", n.Name, reqOrResp, r, err))
 		return
@@ -623,7 +927,39 @@ func validateIngressRequestOrResponse(scopes Scopes, module *Module, n *Verb, re
 		merr = append(merr, errorf(r, "ingress verb %s: %s type %s must be builtin.HttpRequest", n.Name, reqOrResp, r))
 		return
 	}
+	return validateIngressBody(scopes, module, n, reqOrResp, r, resp)
+}
 
+// validateWebSocketIngress validates a verb with "+ingress ws".
+func validateWebSocketIngress(scopes Scopes, module *Module, n *Verb, md *MetadataIngress) (merr []error) {
+	if md.Method != "" {
+		merr = append(merr, errorf(md, "ingress verb %s: ws ingress can not have a method", n.Name))
+	}
+	for _, path := range md.Path {
+		if param, ok := path.(*IngressPathParameter); ok {
+			merr = append(merr, errorf(param, "ingress verb %s: ws ingress can not have path parameters", n.Name))
+		}
+	}
+	if _, ok := n.Response.(*Unit); !ok {
+		merr = append(merr, errorf(n.Response, "ingress verb %s: ws ingress verb can not have a response type", n.Name))
+	}
+	data, err := resolveValidIngressReqResp(scopes, "WebSocketMessage", optional.None[*ModuleDecl](), n.Request, nil)
+	if err != nil {
+		merr = append(merr, errorf(n.Request, "ingress verb %s: request type %s: %v", n.Name, n.Request, err))
+		return
+	}
+	msg, ok := data.Get()
+	if !ok {
+		merr = append(merr, errorf(n.Request, "ingress verb %s: request type %s must be builtin.WebSocketMessage", n.Name, n.Request))
+		return
+	}
+	_, _, errs := validateIngressBody(scopes, module, n, "request", n.Request, msg)
+	return append(merr, errs...)
+}
+
+// validateIngressBody validates the type of the body field of a monomorphised
+// builtin ingress request or response.
+func validateIngressBody(scopes Scopes, module *Module, n *Verb, reqOrResp string, r Type, resp *Data) (fieldType Type, body Symbol, merr []error) {
 	scopes = scopes.PushScope(resp.Scope())
 	fieldType = resp.FieldByName("body").Type
 	if opt, ok := fieldType.(*Optional); ok {
@@ -642,19 +978,18 @@ func validateIngressRequestOrResponse(scopes Scopes, module *Module, n *Verb, re
 		return
 	}
 	body = bodySym.Symbol
-	err = validatePayloadType(bodySym.Symbol, r, n, reqOrResp)
-	if err != nil {
+	if err := validatePayloadType(bodySym.Symbol, r, n, reqOrResp); err != nil {
 		merr = append(merr, err)
 	}
 	return
 }
 
-func resolveValidIngressReqResp(scopes Scopes, reqOrResp string, moduleDecl optional.Option[*ModuleDecl], n Node, parent Node) (optional.Option[*Data], error) {
+func resolveValidIngressReqResp(scopes Scopes, builtinName string, moduleDecl optional.Option[*ModuleDecl], n Node, parent Node) (optional.Option[*Data], error) {
 	switch t := n.(type) {
 	case *Ref:
 		m := scopes.Resolve(*t)
 		sym := m.Symbol
-		return resolveValidIngressReqResp(scopes, reqOrResp, optional.Some(m), sym, n)
+		return resolveValidIngressReqResp(scopes, builtinName, optional.Some(m), sym, n)
 	case *Data:
 		md, ok := moduleDecl.Get()
 		if !ok {
@@ -666,7 +1001,7 @@ func resolveValidIngressReqResp(scopes Scopes, reqOrResp string, moduleDecl opti
 			return optional.None[*Data](), nil
 		}
 
-		if parent == nil || m.Name != "builtin" || t.Name != "Http"+strings.Title(reqOrResp) {
+		if parent == nil || m.Name != "builtin" || t.Name != builtinName {
 			return optional.None[*Data](), nil
 		}
 
@@ -682,7 +1017,7 @@ func resolveValidIngressReqResp(scopes Scopes, reqOrResp string, moduleDecl opti
 
 		return optional.Some(result), nil
 	case *TypeAlias:
-		return resolveValidIngressReqResp(scopes, reqOrResp, moduleDecl, t.Type, t)
+		return resolveValidIngressReqResp(scopes, builtinName, moduleDecl, t.Type, t)
 	default:
 		return optional.None[*Data](), nil
 	}
@@ -737,15 +1072,66 @@ func validateVerbSubscriptions(module *Module, v *Verb, md *MetadataSubscriber,
 		return
 	}
 
-	if !v.Request.Equal(topic.Event) {
+	if md.IsBatch() {
+		if _, _, err := md.BatchParams(); err != nil {
+			merr = append(merr, errorf(md, "verb %s: %v", v.Name, err))
+		}
+		if !v.Request.Equal(&Array{Element: topic.Event}) {
+			merr = append(merr, errorf(md, "verb %s: request type %v must be an array of the subscription's event type %v to consume batches", v.Name, v.Request, topic.Event))
+		}
+	} else if !v.Request.Equal(topic.Event) {
 		merr = append(merr, errorf(md, "verb %s: request type %v differs from subscription's event type %v", v.Name, v.Request, topic.Event))
 	}
+	// Events are delivered to a random subscriber, so every subscriber must consume the same batches.
+	for _, decl := range module.Decls {
+		other, ok := decl.(*Verb)
+		if !ok || other.Name <= v.Name {
+			// Only report each pair of subscribers once.
+			continue
+		}
+		for _, otherMd := range other.Metadata {
+			if otherMd, ok := otherMd.(*MetadataSubscriber); ok && otherMd.Name == md.Name && !sameBatching(md, otherMd) {
+				merr = append(merr, errorf(md, "verb %s: subscribers %s and %s of subscription %q must use the same batching", v.Name, v.Name, other.Name, md.Name))
+			}
+		}
+	}
 	if _, ok := v.Response.(*Unit); !ok {
 		merr = append(merr, errorf(md, "verb %s: must be a sink to subscribe but found response type %v", v.Name, v.Response))
 	}
+
+	if subscription.DeadLetter == nil {
+		return merr
+	}
+	deadLetterDecl := scopes.Resolve(*subscription.DeadLetter)
+	if deadLetterDecl == nil {
+		if subscription.DeadLetter.Module != "" && subscription.DeadLetter.Module != module.Name && !schema.Ok() {
+			return merr
+		}
+		merr = append(merr, errorf(subscription.DeadLetter, "could not resolve dead letter topic %q for subscription %q", subscription.DeadLetter, subscription.Name))
+		return merr
+	}
+	deadLetter, ok := deadLetterDecl.Symbol.(*Topic)
+	if !ok {
+		merr = append(merr, errorf(subscription.DeadLetter, "expected dead letter topic but found %T for %q", deadLetterDecl.Symbol, subscription.DeadLetter))
+		return merr
+	}
+	failedEvent := &Ref{Module: "builtin", Name: "FailedEvent", TypeParameters: []Type{topic.Event}}
+	if !deadLetter.Event.Equal(failedEvent) {
+		merr = append(merr, errorf(subscription.DeadLetter, "dead letter topic %q event type %v must be %v", subscription.DeadLetter, deadLetter.Event, failedEvent))
+	}
+	if subscription.DeadLetter.ToRefKey() == subscription.Topic.ToRefKey() {
+		merr = append(merr, errorf(subscription.DeadLetter, "subscription %q cannot use its own topic as a dead letter topic", subscription.Name))
+	}
 	return merr
 }
 
+func sameBatching(a, b *MetadataSubscriber) bool {
+	if a.IsBatch() != b.IsBatch() {
+		return false
+	}
+	return !a.IsBatch() || (*a.BatchSize == *b.BatchSize && a.BatchWait == b.BatchWait)
+}
+
 func validateRetries(module *Module, retry *MetadataRetry, requestType optional.Option[Type], scopes Scopes, schema optional.Option[*Schema]) (merr []error) {
 	// Validate count
 	if retry.Count != nil && *retry.Count <= 0 {
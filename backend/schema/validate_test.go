@@ -162,6 +162,19 @@ func TestValidate(t *testing.T) {
 				"5:7-7: verb can not have multiple instances of cronjob",
 			},
 		},
+		{name: "CronTimezone",
+			schema: `
+				module one {
+					verb valid(Unit) Unit
+						+cron 0 9 * * 1-5 tz Europe/Berlin
+					verb invalid(Unit) Unit
+						+cron 0 9 * * 1-5 tz Europe/Atlantis
+				}
+			`,
+			errs: []string{
+				"6:7-7: verb invalid: invalid timezone \"Europe/Atlantis\": unknown time zone Europe/Atlantis",
+			},
+		},
 		{name: "DoubleIngress",
 			schema: `
 				module one {
@@ -423,6 +436,194 @@ func TestValidate(t *testing.T) {
 				`7:5-5: invalid name: must consist of only letters, numbers and underscores, and start with a lowercase letter.`,
 			},
 		},
+		{name: "PubSubDeadLetter",
+			schema: `
+			module test {
+				topic topicA test.eventA
+				topic topicB test.eventB
+				topic failedA builtin.FailedEvent<test.eventA>
+
+				subscription goodSub test.topicA deadletter test.failedA
+				subscription wrongType test.topicA deadletter test.topicB
+				subscription sameTopic test.topicA deadletter test.topicA
+				subscription notATopic test.topicA deadletter test.eventA
+
+				data eventA {
+				}
+
+				data eventB {
+				}
+
+				verb consumeGood(test.eventA) Unit
+					+subscribe goodSub
+
+				verb consumeWrongType(test.eventA) Unit
+					+subscribe wrongType
+
+				verb consumeSameTopic(test.eventA) Unit
+					+subscribe sameTopic
+
+				verb consumeNotATopic(test.eventA) Unit
+					+subscribe notATopic
+			}
+			`,
+			errs: []string{
+				`10:51-51: expected dead letter topic but found *schema.Data for "test.eventA"`,
+				`8:51-51: dead letter topic "test.topicB" event type test.eventB must be builtin.FailedEvent<test.eventA>`,
+				`9:51-51: dead letter topic "test.topicA" event type test.eventA must be builtin.FailedEvent<test.eventA>`,
+				`9:51-51: subscription "sameTopic" cannot use its own topic as a dead letter topic`,
+			},
+		},
+		{name: "PubSubBatch",
+			schema: `
+			module test {
+				topic topicA test.eventA
+
+				subscription goodSub test.topicA
+				subscription notArray test.topicA
+				subscription mixed test.topicA
+
+				data eventA {
+				}
+
+				verb consumeGood([test.eventA]) Unit
+					+subscribe goodSub batch 100 5s
+
+				verb consumeNotArray(test.eventA) Unit
+					+subscribe notArray batch 100
+
+				verb consumeMixedA([test.eventA]) Unit
+					+subscribe mixed batch 10 1s
+
+				verb consumeMixedB([test.eventA]) Unit
+					+subscribe mixed batch 20 1s
+			}
+			`,
+			errs: []string{
+				`16:6-6: verb consumeNotArray: request type test.eventA must be an array of the subscription's event type test.eventA to consume batches`,
+				`19:6-6: verb consumeMixedA: subscribers consumeMixedA and consumeMixedB of subscription "mixed" must use the same batching`,
+			},
+		},
+		{name: "FSMQueue",
+			schema: `
+			module test {
+				fsm good
+					+queue 10 dedupe
+				{
+					start test.start
+				}
+
+				fsm empty
+					+queue 0
+				{
+					start test.start
+				}
+
+				verb start(Empty) Unit
+					+queue 10
+			}
+			`,
+			errs: []string{
+				`10:6-6: fsm empty: queue depth must be positive`,
+				`16:6-6: verb start: queues can only be added to FSMs`,
+			},
+		},
+		{name: "FSMTimeout",
+			schema: `
+			module test {
+				data Invoice {}
+				data Payment {}
+
+				fsm invoice {
+					start test.invoiced
+					transition test.invoiced to test.paid
+					transition test.invoiced to test.expired +timeout 30m
+					transition test.invoiced to test.cancelled +timeout 1h
+					transition test.paid to test.refunded +timeout 0s
+					transition test.paid to test.reminded +timeout 1d
+					transition test.paid to test.expired +retry 10
+				}
+
+				verb invoiced(test.Invoice) Unit
+				verb paid(test.Payment) Unit
+				verb expired(test.Invoice) Unit
+				verb cancelled(test.Invoice) Unit
+				verb refunded(test.Payment) Unit
+				verb reminded(test.Invoice) Unit
+			}
+			`,
+			errs: []string{
+				`10:17-17: state "test.invoiced" has more than one timeout transition`,
+				`11:44-44: transition test.paid to test.refunded: timeout must be positive`,
+				`12:17-17: state "test.paid" has more than one timeout transition`,
+				`12:44-44: timeout transition test.paid to test.reminded: destination request test.Invoice must match source request test.Payment`,
+				`13:43-43: metadata "+retry 10" is not valid on FSM transitions`,
+			},
+		},
+		{name: "FSMMigrate",
+			schema: `
+			module test {
+				fsm invoice
+					+migrate test.created to test.invoiced
+					+migrate pending to test.paid
+					+migrate test.created to test.paid
+					+migrate test.invoiced to test.paid
+					+migrate test.old to test.missing
+				{
+					start test.invoiced
+					transition test.invoiced to test.paid
+				}
+
+				verb invoiced(Empty) Unit
+				verb paid(Empty) Unit
+					+migrate test.old to test.paid
+			}
+			`,
+			errs: []string{
+				`16:6-6: verb paid: state migrations can only be added to FSMs`,
+				`6:6-6: fsm invoice: state "test.created" is migrated more than once`,
+				`7:6-6: fsm invoice: can not migrate "test.invoiced" as it is still a state of the FSM`,
+				`8:6-6: fsm invoice: can not migrate to "test.missing" as it is not a state of the FSM`,
+			},
+		},
+		{name: "FSMCompensate",
+			schema: `
+			module test {
+				data Charge {}
+
+				fsm payment
+					+compensate test.charged with test.refund
+					+compensate test.charged with test.refund
+					+compensate test.refund with test.refund
+					+compensate test.shipped with test.cancel
+					+compensate test.created with test.lookup
+					+compensate test.delivered with test.Charge
+				{
+					start test.created
+					transition test.created to test.charged
+					transition test.charged to test.shipped
+					transition test.shipped to test.delivered
+				}
+
+				verb created(test.Charge) Unit
+				verb charged(test.Charge) Unit
+				verb shipped(test.Charge) Unit
+				verb delivered(test.Charge) Unit
+				verb refund(test.Charge) Unit
+				verb cancel(Empty) Unit
+				verb lookup(test.Charge) test.Charge
+					+compensate test.created with test.cancel
+			}
+			`,
+			errs: []string{
+				`10:36-36: compensation "test.lookup" must be a sink but is verb`,
+				`11:38-38: compensation "test.Charge" is not a verb`,
+				`26:6-6: verb lookup: compensations can only be added to FSMs`,
+				`7:6-6: fsm payment: state "test.charged" has more than one compensation`,
+				`8:6-6: fsm payment: can not compensate "test.refund" as it is not a state of the FSM`,
+				`9:36-36: compensation test.cancel: request builtin.Empty must match request test.Charge of state test.shipped`,
+			},
+		},
 		{
 			name: "PubSubCatch",
 			schema: `
@@ -485,6 +686,112 @@ func TestValidate(t *testing.T) {
 				"50:5-5: expected catch to be a verb",
 			},
 		},
+		{
+			name: "Auth",
+			schema: `
+			module test {
+				secret jwtKey String
+				secret apiKeys {String: String}
+				secret count Int
+				config issuer String
+
+				export verb jwt(HttpRequest<Unit>) HttpResponse<Unit, Unit>
+					+ingress http GET /jwt
+					+auth jwt issuer "https://auth.example.com" jwks "https://auth.example.com/jwks.json"
+					+auth mtls
+
+				export verb apikey(HttpRequest<Unit>) HttpResponse<Unit, Unit>
+					+ingress http GET /apikey
+					+auth apikey header "X-Token" secret test.apiKeys
+
+				export verb noIngress(Unit) Unit
+					+auth mtls
+
+				export verb invalid(HttpRequest<Unit>) HttpResponse<Unit, Unit>
+					+ingress http GET /invalid
+					+auth jwt issuer "a" jwks "https://example.com" secret test.jwtKey
+					+auth jwt jwks "file:///etc/jwks.json"
+					+auth apikey issuer "a"
+					+auth mtls header "X-Client"
+					+auth apikey secret test.count
+					+auth jwt secret test.issuer
+			}
+			`,
+			errs: []string{
+				`18:6-6: verb noIngress: auth can only be added to HTTP ingress verbs`,
+				`22:6-6: verb invalid: jwt auth requires exactly one of jwks or secret`,
+				`23:6-6: verb invalid: invalid jwks URL "file:///etc/jwks.json"`,
+				`24:6-6: verb invalid: apikey auth does not support issuer`,
+				`24:6-6: verb invalid: apikey auth requires a secret`,
+				`25:6-6: verb invalid: mtls auth does not support header`,
+				`26:26-26: verb invalid: apikey auth secret test.count must be of type String, [String] or {String: String}, not Int`,
+				`27:23-23: verb invalid: auth secret test.issuer must be a secret`,
+			},
+		},
+		{
+			name: "Stream",
+			schema: `
+			module test {
+				data Progress {
+					percent Int
+				}
+
+				topic updates test.Progress
+				subscription progressUpdates test.updates
+
+				export verb progress(HttpRequest<Unit>) stream test.Progress
+					+ingress http GET /progress
+
+				verb empty(Unit) stream Unit
+
+				verb subscriber(test.Progress) stream String
+					+subscribe progressUpdates
+			}
+			`,
+			errs: []string{
+				`13:5-5: streaming verb empty must have a response type`,
+				`16:6-6: verb subscriber: must be a sink to subscribe but found response type String`,
+			},
+		},
+		{
+			name: "WebSocketIngress",
+			schema: `
+			module test {
+				data Chat {
+					text String
+				}
+
+				export verb chat(builtin.WebSocketMessage<test.Chat>) Unit
+					+ingress ws /chat
+
+				export verb withMethod(builtin.WebSocketMessage<String>) Unit
+					+ingress ws GET /method
+
+				export verb withParameter(builtin.WebSocketMessage<String>) Unit
+					+ingress ws /rooms/{room}
+
+				export verb withResponse(builtin.WebSocketMessage<String>) String
+					+ingress ws /response
+
+				export verb withHttpRequest(HttpRequest<String>) Unit
+					+ingress ws /http
+
+				export verb withoutMethod(HttpRequest<String>) HttpResponse<String, String>
+					+ingress http /method
+
+				export verb duplicate(HttpRequest<Unit>) HttpResponse<String, String>
+					+ingress http GET /chat
+			}
+			`,
+			errs: []string{
+				`11:6-6: ingress verb withMethod: ws ingress can not have a method`,
+				`14:25-25: ingress verb withParameter: ws ingress can not have path parameters`,
+				`16:64-64: ingress verb withResponse: ws ingress verb can not have a response type`,
+				`19:33-33: ingress verb withHttpRequest: request type HttpRequest<String> must be builtin.WebSocketMessage`,
+				`23:6-6: ingress verb withoutMethod: http ingress requires a method`,
+				`26:6-6: duplicate http ingress GET /chat for 7:5:"chat" and 25:5:"duplicate"`,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -554,3 +861,43 @@ func TestValidateModuleWithSchema(t *testing.T) {
 		})
 	}
 }
+
+func TestWarnCallCycles(t *testing.T) {
+	sch, err := ParseString("", `
+		module one {
+			export verb a(Empty) Empty
+				+calls one.b
+			verb b(Empty) Empty
+				+calls one.c
+			verb c(Empty) Empty
+				+calls one.a
+			verb d(Empty) Empty
+				+calls one.d
+			verb e(Empty) Empty
+				+calls one.a
+			verb f(Empty) Empty
+				+calls one.g
+				+recursive
+			verb g(Empty) Empty
+				+calls one.f
+				+recursive
+			verb h(Empty) Empty
+				+calls one.h
+				+recursive
+		}
+		module two {
+			verb f(Empty) Empty
+				+calls one.a
+		}
+	`)
+	assert.NoError(t, err)
+	warnings := slices.Map(WarnCallCycles(sch, optional.None[*Module]()), func(e *Error) string { return e.Error() })
+	assert.Equal(t, []string{
+		`3:4-4: verb call cycle detected: one.a -> one.b -> one.c -> one.a (calls will be rejected unless every verb in the cycle is +recursive)`,
+		`9:4-4: verb call cycle detected: one.d -> one.d (calls will be rejected unless every verb in the cycle is +recursive)`,
+	}, warnings)
+
+	two, ok := slices.Find(sch.Modules, func(m *Module) bool { return m.Name == "two" })
+	assert.True(t, ok)
+	assert.Equal(t, 0, len(WarnCallCycles(sch, optional.Some(two))))
+}
@@ -18,6 +18,7 @@ type Verb struct {
 	Export   bool       `parser:"@'export'?" protobuf:"3"`
 	Name     string     `parser:"'verb' @Ident" protobuf:"4"`
 	Request  Type       `parser:"'(' @@ ')'" protobuf:"5"`
+	Stream   bool       `parser:"@'stream'?" protobuf:"8"`
 	Response Type       `parser:"@@" protobuf:"6"`
 	Metadata []Metadata `parser:"@@*" protobuf:"7"`
 }
@@ -25,7 +26,7 @@ type Verb struct {
 var _ Decl = (*Verb)(nil)
 var _ Symbol = (*Verb)(nil)
 
-// VerbKind is the kind of Verb: verb, sink, source or empty.
+// VerbKind is the kind of Verb: verb, sink, source, empty or stream.
 type VerbKind string
 
 const (
@@ -37,10 +38,15 @@ const (
 	VerbKindSource VerbKind = "source"
 	// VerbKindEmpty is a verb that takes unit and returns unit.
 	VerbKindEmpty VerbKind = "empty"
+	// VerbKindStream is a verb that takes an input and returns a stream of outputs.
+	VerbKindStream VerbKind = "stream"
 )
 
 // Kind returns the kind of Verb this is.
 func (v *Verb) Kind() VerbKind {
+	if v.Stream {
+		return VerbKindStream
+	}
 	_, inIsUnit := v.Request.(*Unit)
 	_, outIsUnit := v.Response.(*Unit)
 	switch {
@@ -84,7 +90,11 @@ func (v *Verb) String() string {
 	if v.Export {
 		fmt.Fprint(w, "export ")
 	}
-	fmt.Fprintf(w, "verb %s(%s) %s", v.Name, v.Request, v.Response)
+	fmt.Fprintf(w, "verb %s(%s) ", v.Name, v.Request)
+	if v.Stream {
+		fmt.Fprint(w, "stream ")
+	}
+	fmt.Fprint(w, v.Response)
 	fmt.Fprint(w, indent(encodeMetadata(v.Metadata)))
 	return w.String()
 }
@@ -112,6 +122,44 @@ func (v *Verb) GetMetadataCronJob() optional.Option[*MetadataCronJob] {
 	return optional.None[*MetadataCronJob]()
 }
 
+func (v *Verb) GetMetadataTimeout() optional.Option[*MetadataTimeout] {
+	if m, ok := slices.FindVariant[*MetadataTimeout](v.Metadata); ok {
+		return optional.Some(m)
+	}
+	return optional.None[*MetadataTimeout]()
+}
+
+func (v *Verb) GetMetadataRateLimit() optional.Option[*MetadataRateLimit] {
+	if m, ok := slices.FindVariant[*MetadataRateLimit](v.Metadata); ok {
+		return optional.Some(m)
+	}
+	return optional.None[*MetadataRateLimit]()
+}
+
+// GetMetadataAuth returns all the auth requirements of the verb, any one of which must be satisfied.
+func (v *Verb) GetMetadataAuth() []*MetadataAuth {
+	var out []*MetadataAuth
+	for _, md := range v.Metadata {
+		if md, ok := md.(*MetadataAuth); ok {
+			out = append(out, md)
+		}
+	}
+	return out
+}
+
+func (v *Verb) GetMetadataConcurrency() optional.Option[*MetadataConcurrency] {
+	if m, ok := slices.FindVariant[*MetadataConcurrency](v.Metadata); ok {
+		return optional.Some(m)
+	}
+	return optional.None[*MetadataConcurrency]()
+}
+
+// IsRecursive returns true if the verb is allowed to take part in call cycles.
+func (v *Verb) IsRecursive() bool {
+	_, ok := slices.FindVariant[*MetadataRecursive](v.Metadata)
+	return ok
+}
+
 func (v *Verb) ToProto() proto.Message {
 	return &schemapb.Verb{
 		Pos:      posToProto(v.Pos),
@@ -121,6 +169,7 @@ func (v *Verb) ToProto() proto.Message {
 		Request:  TypeToProto(v.Request),
 		Response: TypeToProto(v.Response),
 		Metadata: metadataListToProto(v.Metadata),
+		Stream:   v.Stream,
 	}
 }
 
@@ -133,5 +182,6 @@ func VerbFromProto(s *schemapb.Verb) *Verb {
 		Request:  TypeFromProto(s.Request),
 		Response: TypeFromProto(s.Response),
 		Metadata: metadataListToSchema(s.Metadata),
+		Stream:   s.Stream,
 	}
 }
@@ -264,6 +264,17 @@ data class HttpRequest<Body>(
   val query: Map<String, List<String>>,
   val headers: Map<String, List<String>>,
   val body: Body,
+  val principal: ftl.builtin.Principal? = null,
+)
+
+/**
+ * Principal is the authenticated caller of an HTTP ingress verb.
+ */
+@Data
+data class Principal(
+  val scheme: String,
+  val subject: String,
+  val claims: Map<String, Any>,
 )
 
 /**
@@ -22,9 +22,10 @@ import (
 )
 
 type callCmd struct {
-	Wait    time.Duration  `short:"w" help:"Wait up to this elapsed time for the FTL cluster to become available." default:"1m"`
-	Verb    reflection.Ref `arg:"" required:"" help:"Full path of Verb to call."`
-	Request string         `arg:"" optional:"" help:"JSON5 request payload." default:"{}"`
+	Wait           time.Duration  `short:"w" help:"Wait up to this elapsed time for the FTL cluster to become available." default:"1m"`
+	IdempotencyKey string         `help:"Replay the response of any previous call to the Verb with this key, rather than calling it again."`
+	Verb           reflection.Ref `arg:"" required:"" help:"Full path of Verb to call."`
+	Request        string         `arg:"" optional:"" help:"JSON5 request payload." default:"{}"`
 }
 
 func (c *callCmd) Run(ctx context.Context, client ftlv1connect.VerbServiceClient, ctlCli ftlv1connect.ControllerServiceClient) error {
@@ -48,10 +49,14 @@ func (c *callCmd) Run(ctx context.Context, client ftlv1connect.VerbServiceClient
 	logger.Debugf("Calling %s", c.Verb)
 
 	// otherwise, we have a match so call the verb
-	resp, err := client.Call(ctx, connect.NewRequest(&ftlv1.CallRequest{
+	req := &ftlv1.CallRequest{
 		Verb: c.Verb.ToProto(),
 		Body: requestJSON,
-	}))
+	}
+	if c.IdempotencyKey != "" {
+		req.IdempotencyKey = &c.IdempotencyKey
+	}
+	resp, err := client.Call(ctx, connect.NewRequest(req))
 
 	if cerr := new(connect.Error); errors.As(err, &cerr) && cerr.Code() == connect.CodeNotFound {
 		suggestions, err := c.findSuggestions(ctx, ctlCli)
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+)
+
+type cronCmd struct {
+	List    listCronJobsCmd   `cmd:"" help:"List cron jobs."`
+	Trigger triggerCronJobCmd `cmd:"" help:"Execute a cron job immediately, even if it is paused."`
+	Pause   pauseCronJobCmd   `cmd:"" help:"Stop a cron job from being scheduled until it is resumed."`
+	Resume  resumeCronJobCmd  `cmd:"" help:"Resume a paused cron job."`
+}
+
+type listCronJobsCmd struct{}
+
+func (l *listCronJobsCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.ListCronJobs(ctx, connect.NewRequest(&ftlv1.ListCronJobsRequest{}))
+	if err != nil {
+		return fmt.Errorf("failed to list cron jobs: %w", err)
+	}
+	format := "%-30s %-20s %-20s %-8s %-10s %-20s %-20s\n"
+	fmt.Printf(format, "VERB", "SCHEDULE", "TIMEZONE", "MISSED", "STATE", "NEXT", "LAST")
+	for _, job := range resp.Msg.Jobs {
+		state := job.State
+		next := job.NextExecution.AsTime().Local().Format(time.DateTime)
+		if job.PausedAt != nil {
+			state = "paused"
+			next = "-"
+		}
+		last := "-"
+		if job.LastExecution != nil {
+			last = job.LastExecution.AsTime().Local().Format(time.DateTime)
+		}
+		fmt.Printf(format, refString(job.Verb), job.Schedule, job.Timezone, job.MissedRuns, state, next, last)
+	}
+	return nil
+}
+
+type triggerCronJobCmd struct {
+	Verb reflection.Ref `arg:"" required:"" help:"Full path of the cron job's verb."`
+}
+
+func (t *triggerCronJobCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	_, err := client.TriggerCronJob(ctx, connect.NewRequest(&ftlv1.TriggerCronJobRequest{Verb: t.Verb.ToProto()}))
+	if err != nil {
+		return fmt.Errorf("failed to trigger cron job: %w", err)
+	}
+	return nil
+}
+
+type pauseCronJobCmd struct {
+	Verb reflection.Ref `arg:"" required:"" help:"Full path of the cron job's verb."`
+}
+
+func (p *pauseCronJobCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	_, err := client.PauseCronJob(ctx, connect.NewRequest(&ftlv1.PauseCronJobRequest{Verb: p.Verb.ToProto()}))
+	if err != nil {
+		return fmt.Errorf("failed to pause cron job: %w", err)
+	}
+	return nil
+}
+
+type resumeCronJobCmd struct {
+	Verb reflection.Ref `arg:"" required:"" help:"Full path of the cron job's verb."`
+}
+
+func (r *resumeCronJobCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	_, err := client.ResumeCronJob(ctx, connect.NewRequest(&ftlv1.ResumeCronJobRequest{Verb: r.Verb.ToProto()}))
+	if err != nil {
+		return fmt.Errorf("failed to resume cron job: %w", err)
+	}
+	return nil
+}
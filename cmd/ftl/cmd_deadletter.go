@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+)
+
+type deadLetterCmd struct {
+	List    listDeadLettersCmd   `cmd:"" help:"List dead lettered events for a subscription."`
+	Inspect inspectDeadLetterCmd `cmd:"" help:"Show a dead lettered event, including its payload."`
+	Replay  replayDeadLettersCmd `cmd:"" help:"Schedule dead lettered events to be consumed by their subscription again."`
+	Purge   purgeDeadLettersCmd  `cmd:"" help:"Discard dead lettered events."`
+}
+
+type listDeadLettersCmd struct {
+	Subscription reflection.Ref `arg:"" required:"" help:"Full path of subscription."`
+	Limit        int32          `help:"Maximum number of events to list." default:"100"`
+}
+
+func (l *listDeadLettersCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.ListDeadLetters(ctx, connect.NewRequest(&ftlv1.ListDeadLettersRequest{
+		Subscription: l.Subscription.ToProto(),
+		Limit:        &l.Limit,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to list dead letters: %w", err)
+	}
+	format := "%-50s %-20s %-8s %s\n"
+	fmt.Printf(format, "EVENT", "CREATED", "ATTEMPTS", "ERROR")
+	for _, deadLetter := range resp.Msg.DeadLetters {
+		fmt.Printf(format, deadLetter.EventKey, deadLetter.CreatedAt.AsTime().Local().Format(time.DateTime), fmt.Sprint(deadLetter.Attempts), deadLetter.Error)
+	}
+	return nil
+}
+
+type inspectDeadLetterCmd struct {
+	Subscription reflection.Ref `arg:"" required:"" help:"Full path of subscription."`
+	Event        string         `arg:"" required:"" help:"Key of the dead lettered event."`
+}
+
+func (i *inspectDeadLetterCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.ListDeadLetters(ctx, connect.NewRequest(&ftlv1.ListDeadLettersRequest{
+		Subscription: i.Subscription.ToProto(),
+		EventKey:     &i.Event,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to inspect dead letter: %w", err)
+	}
+	if len(resp.Msg.DeadLetters) == 0 {
+		return fmt.Errorf("dead letter %q not found for %s", i.Event, i.Subscription)
+	}
+	deadLetter := resp.Msg.DeadLetters[0]
+	out := map[string]any{
+		"event":        deadLetter.EventKey,
+		"subscription": fmt.Sprintf("%s.%s", deadLetter.Subscription.Module, deadLetter.Subscription.Name),
+		"topic":        fmt.Sprintf("%s.%s", deadLetter.Topic.Module, deadLetter.Topic.Name),
+		"error":        deadLetter.Error,
+		"attempts":     deadLetter.Attempts,
+		"createdAt":    deadLetter.CreatedAt.AsTime(),
+		"payload":      json.RawMessage(deadLetter.Payload),
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(out); err != nil {
+		return fmt.Errorf("failed to encode dead letter: %w", err)
+	}
+	return nil
+}
+
+type replayDeadLettersCmd struct {
+	Subscription reflection.Ref `arg:"" required:"" help:"Full path of subscription."`
+	Event        string         `help:"Only replay this event."`
+}
+
+func (r *replayDeadLettersCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	req := &ftlv1.ReplayDeadLettersRequest{Subscription: r.Subscription.ToProto()}
+	if r.Event != "" {
+		req.EventKey = &r.Event
+	}
+	resp, err := client.ReplayDeadLetters(ctx, connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("failed to replay dead letters: %w", err)
+	}
+	fmt.Printf("Replaying %d event(s)\n", resp.Msg.Count)
+	return nil
+}
+
+type purgeDeadLettersCmd struct {
+	Subscription reflection.Ref `arg:"" required:"" help:"Full path of subscription."`
+	Event        string         `help:"Only purge this event."`
+}
+
+func (p *purgeDeadLettersCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	req := &ftlv1.PurgeDeadLettersRequest{Subscription: p.Subscription.ToProto()}
+	if p.Event != "" {
+		req.EventKey = &p.Event
+	}
+	resp, err := client.PurgeDeadLetters(ctx, connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("failed to purge dead letters: %w", err)
+	}
+	fmt.Printf("Purged %d event(s)\n", resp.Msg.Count)
+	return nil
+}
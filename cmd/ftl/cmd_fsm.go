@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/titanous/json5"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+)
+
+type fsmCmd struct {
+	List    listFSMInstancesCmd   `cmd:"" help:"List instances of an FSM."`
+	Get     getFSMInstanceCmd     `cmd:"" help:"Show the state of an FSM instance."`
+	History fsmInstanceHistoryCmd `cmd:"" help:"Show the transition history of an FSM instance."`
+	Send    sendFSMEventCmd       `cmd:"" help:"Send an event to an FSM instance."`
+}
+
+type listFSMInstancesCmd struct {
+	FSM    reflection.Ref `arg:"" required:"" help:"Full path of FSM."`
+	Status string         `help:"Only list instances with this status: running, completed, failed, compensating, compensated or compensation_failed."`
+	State  reflection.Ref `help:"Only list instances in this state."`
+	Limit  int32          `help:"Maximum number of instances to list." default:"100"`
+}
+
+func (l *listFSMInstancesCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	req := &ftlv1.ListFSMInstancesRequest{
+		Fsm:   l.FSM.ToProto(),
+		Limit: &l.Limit,
+	}
+	if l.Status != "" {
+		value, ok := ftlv1.FSMStatus_value["FSM_STATUS_"+strings.ToUpper(l.Status)]
+		if !ok {
+			return fmt.Errorf("invalid status %q", l.Status)
+		}
+		status := ftlv1.FSMStatus(value)
+		req.Status = &status
+	}
+	if l.State.Name != "" {
+		req.State = l.State.ToProto()
+	}
+	resp, err := client.ListFSMInstances(ctx, connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("failed to list fsm instances: %w", err)
+	}
+	format := "%-30s %-19s %-30s %-30s %-6s %s\n"
+	fmt.Printf(format, "INSTANCE", "STATUS", "STATE", "DESTINATION", "QUEUED", "UPDATED")
+	for _, instance := range resp.Msg.Instances {
+		fmt.Printf(format, instance.Key, fsmStatusString(instance.Status), refString(instance.CurrentState),
+			refString(instance.DestinationState), fmt.Sprint(instance.QueuedEvents),
+			instance.UpdatedAt.AsTime().Local().Format(time.DateTime))
+	}
+	return nil
+}
+
+type getFSMInstanceCmd struct {
+	FSM      reflection.Ref `arg:"" required:"" help:"Full path of FSM."`
+	Instance string         `arg:"" required:"" help:"Key of the FSM instance."`
+}
+
+func (g *getFSMInstanceCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.GetFSMInstance(ctx, connect.NewRequest(&ftlv1.GetFSMInstanceRequest{
+		Fsm:      g.FSM.ToProto(),
+		Instance: g.Instance,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to get fsm instance: %w", err)
+	}
+	instance := resp.Msg.Instance
+	out := map[string]any{
+		"fsm":          refString(instance.Fsm),
+		"instance":     instance.Key,
+		"status":       fsmStatusString(instance.Status),
+		"state":        refString(instance.CurrentState),
+		"destination":  refString(instance.DestinationState),
+		"queuedEvents": instance.QueuedEvents,
+		"createdAt":    instance.CreatedAt.AsTime(),
+		"updatedAt":    instance.UpdatedAt.AsTime(),
+	}
+	// Include the most recent error to explain a failed or stuck instance.
+	for _, attempt := range resp.Msg.History {
+		if attempt.Error != nil {
+			out["lastError"] = *attempt.Error
+		}
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(out); err != nil {
+		return fmt.Errorf("failed to encode fsm instance: %w", err)
+	}
+	return nil
+}
+
+type fsmInstanceHistoryCmd struct {
+	FSM      reflection.Ref `arg:"" required:"" help:"Full path of FSM."`
+	Instance string         `arg:"" required:"" help:"Key of the FSM instance."`
+	Events   bool           `help:"Include the event that triggered each transition."`
+}
+
+func (h *fsmInstanceHistoryCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.GetFSMInstance(ctx, connect.NewRequest(&ftlv1.GetFSMInstanceRequest{
+		Fsm:      h.FSM.ToProto(),
+		Instance: h.Instance,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to get fsm instance: %w", err)
+	}
+	format := "%-20s %-30s %-10s %s\n"
+	fmt.Printf(format, "CREATED", "STATE", "STATUS", "ERROR")
+	for _, attempt := range resp.Msg.History {
+		state := refString(attempt.State)
+		if attempt.Catching {
+			state += " (catch)"
+		}
+		errMsg := ""
+		if attempt.Error != nil {
+			errMsg = *attempt.Error
+		}
+		fmt.Printf(format, attempt.CreatedAt.AsTime().Local().Format(time.DateTime), state, attempt.Status, errMsg)
+		if h.Events {
+			fmt.Printf("  %s\n", attempt.Event)
+		}
+	}
+	return nil
+}
+
+type sendFSMEventCmd struct {
+	FSM      reflection.Ref `arg:"" required:"" help:"Full path of FSM."`
+	Instance string         `arg:"" required:"" help:"Key of the FSM instance."`
+	Event    reflection.Ref `arg:"" required:"" help:"Full path of the event type."`
+	Request  string         `arg:"" optional:"" help:"JSON5 event payload." default:"{}"`
+}
+
+func (s *sendFSMEventCmd) Run(ctx context.Context, client ftlv1connect.VerbServiceClient) error {
+	event := map[string]any{}
+	if err := json5.Unmarshal([]byte(s.Request), &event); err != nil {
+		return fmt.Errorf("invalid event: %w", err)
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("invalid event: %w", err)
+	}
+	_, err = client.SendFSMEvent(ctx, connect.NewRequest(&ftlv1.SendFSMEventRequest{
+		Fsm:      s.FSM.ToProto(),
+		Instance: s.Instance,
+		Event:    schema.TypeToProto(&schema.Ref{Module: s.Event.Module, Name: s.Event.Name}),
+		Body:     body,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to send fsm event: %w", err)
+	}
+	return nil
+}
+
+func fsmStatusString(status ftlv1.FSMStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "FSM_STATUS_"))
+}
+
+func refString(ref *schemapb.Ref) string {
+	if ref == nil {
+		return "-"
+	}
+	return ref.Module + "." + ref.Name
+}
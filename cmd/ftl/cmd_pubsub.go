@@ -2,4 +2,5 @@ package main
 
 type pubsubCmd struct {
 	Subscription subscriptionCmd `cmd:"" help:"Manage subscriptions."`
+	DeadLetter   deadLetterCmd   `cmd:"" name:"deadletter" help:"Manage events that subscriptions failed to consume."`
 }
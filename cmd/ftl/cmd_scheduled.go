@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+)
+
+type scheduledCmd struct {
+	List   listScheduledCallsCmd  `cmd:"" help:"List scheduled calls that have not yet completed."`
+	Cancel cancelScheduledCallCmd `cmd:"" help:"Cancel a scheduled call that is not yet executing."`
+}
+
+type listScheduledCallsCmd struct {
+	Verb  reflection.Ref `help:"Only list calls to this verb."`
+	Limit int32          `help:"Maximum number of calls to list." default:"100"`
+}
+
+func (l *listScheduledCallsCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	req := &ftlv1.ListScheduledCallsRequest{Limit: &l.Limit}
+	if l.Verb.Name != "" {
+		req.Verb = l.Verb.ToProto()
+	}
+	resp, err := client.ListScheduledCalls(ctx, connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("failed to list scheduled calls: %w", err)
+	}
+	format := "%-30s %-40s %-10s %-20s %-8s %s\n"
+	fmt.Printf(format, "VERB", "KEY", "STATE", "SCHEDULED", "RETRIES", "ERROR")
+	for _, call := range resp.Msg.Calls {
+		errMsg := ""
+		if call.Error != nil {
+			errMsg = *call.Error
+		}
+		fmt.Printf(format, refString(call.Verb), call.Key, call.State,
+			call.ScheduledAt.AsTime().Local().Format(time.DateTime), fmt.Sprint(call.RemainingAttempts), errMsg)
+	}
+	return nil
+}
+
+type cancelScheduledCallCmd struct {
+	Verb reflection.Ref `arg:"" required:"" help:"Full path of the verb the call is to."`
+	Key  string         `arg:"" required:"" help:"Key of the scheduled call."`
+}
+
+func (c *cancelScheduledCallCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	_, err := client.CancelScheduledCall(ctx, connect.NewRequest(&ftlv1.CancelScheduledCallRequest{
+		Verb: c.Verb.ToProto(),
+		Key:  c.Key,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to cancel scheduled call: %w", err)
+	}
+	return nil
+}
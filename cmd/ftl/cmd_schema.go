@@ -6,4 +6,6 @@ type schemaCmd struct {
 	Protobuf schemaProtobufCmd `cmd:"" help:"Generate protobuf schema mirroring the FTL schema structure."`
 	Generate schemaGenerateCmd `cmd:"" help:"Stream the schema from the cluster and generate files from the template."`
 	Import   schemaImportCmd   `cmd:"" help:"Import messages to the FTL schema."`
+	Graph    schemaGraphCmd    `cmd:"" help:"Render FSMs, verb calls and pub/sub flows as a DOT or Mermaid diagram."`
+	OpenAPI  schemaOpenAPICmd  `cmd:"" name:"openapi" help:"Generate an OpenAPI 3.1 document for all HTTP ingress routes."`
 }
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/backend/schema/diagram"
+)
+
+type schemaGraphCmd struct {
+	Format  diagram.Format `help:"Output format (dot, mermaid)." enum:"dot,mermaid" default:"dot"`
+	Kinds   []diagram.Kind `name:"kind" help:"Kinds of relationships to include (fsm, calls, pubsub)." enum:"fsm,calls,pubsub" default:"fsm,calls,pubsub"`
+	Modules []string       `help:"Modules to include. Defaults to all." type:"string" optional:""`
+}
+
+func (g *schemaGraphCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.PullSchema(ctx, connect.NewRequest(&ftlv1.PullSchemaRequest{}))
+	if err != nil {
+		return err
+	}
+	sch := &schema.Schema{}
+	for resp.Receive() {
+		msg := resp.Msg()
+		module, err := schema.ModuleFromProto(msg.Schema)
+		if err != nil {
+			return fmt.Errorf("invalid module schema: %w", err)
+		}
+		sch.Modules = append(sch.Modules, module)
+		if !msg.More {
+			break
+		}
+	}
+	if err := resp.Err(); err != nil {
+		return err
+	}
+	out, err := diagram.Build(sch, g.Kinds, g.Modules).Render(g.Format)
+	if err != nil {
+		return err
+	}
+	fmt.Print(out)
+	return nil
+}
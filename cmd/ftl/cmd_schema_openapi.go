@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"connectrpc.com/connect"
+
+	"github.com/TBD54566975/ftl/backend/controller/ingress"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+type schemaOpenAPICmd struct {
+	Title      string   `help:"Title of the API." default:"FTL"`
+	APIVersion string   `help:"Version of the API." default:"1.0.0"`
+	Servers    []string `name:"server" help:"Base URL the ingress routes are served from. May be repeated." placeholder:"URL"`
+}
+
+func (s *schemaOpenAPICmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.PullSchema(ctx, connect.NewRequest(&ftlv1.PullSchemaRequest{}))
+	if err != nil {
+		return err
+	}
+	sch := &schema.Schema{}
+	for resp.Receive() {
+		msg := resp.Msg()
+		module, err := schema.ModuleFromProto(msg.Schema)
+		if err != nil {
+			return fmt.Errorf("invalid module schema: %w", err)
+		}
+		sch.Modules = append(sch.Modules, module)
+		if !msg.More {
+			break
+		}
+	}
+	if err := resp.Err(); err != nil {
+		return err
+	}
+	doc, err := ingress.GenerateOpenAPI(sch, ingress.OpenAPIOptions{
+		Title:   s.Title,
+		Version: s.APIVersion,
+		Servers: s.Servers,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to generate OpenAPI document: %w", err)
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
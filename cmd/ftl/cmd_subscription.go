@@ -2,4 +2,5 @@ package main
 
 type subscriptionCmd struct {
 	Reset resetSubscriptionCmd `cmd:"" help:"Reset the subscription to the head of its topic."`
+	Seek  seekSubscriptionCmd  `cmd:"" help:"Move the subscription to an event or point in time."`
 }
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+)
+
+type seekSubscriptionCmd struct {
+	Subscription reflection.Ref `arg:"" required:"" help:"Full path of subscription to seek."`
+	Event        string         `help:"Consume from this event onwards." xor:"target"`
+	Time         string         `help:"Consume events published at or after this time (RFC3339)." xor:"target"`
+	Since        time.Duration  `help:"Consume events published within this duration of now, eg. 6h." xor:"target"`
+	DryRun       bool           `help:"Report how many events would be redelivered or skipped without moving the subscription."`
+}
+
+func (s *seekSubscriptionCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	req := &ftlv1.SeekSubscriptionRequest{
+		Subscription: s.Subscription.ToProto(),
+		DryRun:       s.DryRun,
+	}
+	switch {
+	case s.Event != "":
+		req.Target = &ftlv1.SeekSubscriptionRequest_EventKey{EventKey: s.Event}
+
+	case s.Time != "":
+		t, err := time.Parse(time.RFC3339, s.Time)
+		if err != nil {
+			return fmt.Errorf("invalid time %q: %w", s.Time, err)
+		}
+		req.Target = &ftlv1.SeekSubscriptionRequest_Time{Time: timestamppb.New(t)}
+
+	case s.Since != 0:
+		req.Target = &ftlv1.SeekSubscriptionRequest_Time{Time: timestamppb.New(time.Now().Add(-s.Since))}
+
+	default:
+		return errors.New("one of --event, --time or --since is required")
+	}
+	resp, err := client.SeekSubscription(ctx, connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("failed to seek subscription: %w", err)
+	}
+	verb := "Moved"
+	if s.DryRun {
+		verb = "Would move"
+	}
+	fmt.Printf("%s %s: %d event(s) redelivered, %d event(s) skipped\n", verb, s.Subscription, resp.Msg.Redelivered, resp.Msg.Skipped)
+	return nil
+}
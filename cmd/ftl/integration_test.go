@@ -195,9 +195,10 @@ func TestResetSubscription(t *testing.T) {
 				FROM modules
 				WHERE name = '%s'
 			)
-			SELECT cursor
-			FROM topic_subscriptions
-			WHERE name = '%s' AND module_id = (SELECT id FROM module)
+			SELECT cursors.cursor
+			FROM topic_subscription_cursors cursors
+			INNER JOIN topic_subscriptions subs ON cursors.subscription_id = subs.id
+			WHERE subs.name = '%s' AND subs.module_id = (SELECT id FROM module) AND cursors.partition = 0
 		`, module, subscription), cursor)
 	}
 
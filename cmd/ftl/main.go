@@ -30,26 +30,29 @@ type CLI struct {
 	Authenticators map[string]string `help:"Authenticators to use for FTL endpoints." mapsep:"," env:"FTL_AUTHENTICATORS" placeholder:"HOST=EXE,…"`
 	Insecure       bool              `help:"Skip TLS certificate verification. Caution: susceptible to machine-in-the-middle attacks."`
 
-	Ping     pingCmd     `cmd:"" help:"Ping the FTL cluster."`
-	Status   statusCmd   `cmd:"" help:"Show FTL status."`
-	Init     initCmd     `cmd:"" help:"Initialize a new FTL project."`
-	New      newCmd      `cmd:"" help:"Create a new FTL module."`
-	Dev      devCmd      `cmd:"" help:"Develop FTL modules. Will start the FTL cluster, build and deploy all modules found in the specified directories, and watch for changes."`
-	PS       psCmd       `cmd:"" help:"List deployments."`
-	Serve    serveCmd    `cmd:"" help:"Start the FTL server."`
-	Call     callCmd     `cmd:"" help:"Call an FTL function."`
-	Update   updateCmd   `cmd:"" help:"Update a deployment."`
-	Kill     killCmd     `cmd:"" help:"Kill a deployment."`
-	Schema   schemaCmd   `cmd:"" help:"FTL schema commands."`
-	Build    buildCmd    `cmd:"" help:"Build all modules found in the specified directories."`
-	Box      boxCmd      `cmd:"" help:"Build a self-contained Docker container for running a set of module."`
-	BoxRun   boxRunCmd   `cmd:"" hidden:"" help:"Run FTL inside an ftl-in-a-box container"`
-	Deploy   deployCmd   `cmd:"" help:"Build and deploy all modules found in the specified directories."`
-	Migrate  migrateCmd  `cmd:"" help:"Run a database migration, if required, based on the migration table."`
-	Download downloadCmd `cmd:"" help:"Download a deployment."`
-	Secret   secretCmd   `cmd:"" help:"Manage secrets."`
-	Config   configCmd   `cmd:"" help:"Manage configuration."`
-	Pubsub   pubsubCmd   `cmd:"" help:"Manage pub/sub."`
+	Ping      pingCmd      `cmd:"" help:"Ping the FTL cluster."`
+	Status    statusCmd    `cmd:"" help:"Show FTL status."`
+	Init      initCmd      `cmd:"" help:"Initialize a new FTL project."`
+	New       newCmd       `cmd:"" help:"Create a new FTL module."`
+	Dev       devCmd       `cmd:"" help:"Develop FTL modules. Will start the FTL cluster, build and deploy all modules found in the specified directories, and watch for changes."`
+	PS        psCmd        `cmd:"" help:"List deployments."`
+	Serve     serveCmd     `cmd:"" help:"Start the FTL server."`
+	Call      callCmd      `cmd:"" help:"Call an FTL function."`
+	Update    updateCmd    `cmd:"" help:"Update a deployment."`
+	Kill      killCmd      `cmd:"" help:"Kill a deployment."`
+	Schema    schemaCmd    `cmd:"" help:"FTL schema commands."`
+	Build     buildCmd     `cmd:"" help:"Build all modules found in the specified directories."`
+	Box       boxCmd       `cmd:"" help:"Build a self-contained Docker container for running a set of module."`
+	BoxRun    boxRunCmd    `cmd:"" hidden:"" help:"Run FTL inside an ftl-in-a-box container"`
+	Deploy    deployCmd    `cmd:"" help:"Build and deploy all modules found in the specified directories."`
+	Migrate   migrateCmd   `cmd:"" help:"Run a database migration, if required, based on the migration table."`
+	Download  downloadCmd  `cmd:"" help:"Download a deployment."`
+	Secret    secretCmd    `cmd:"" help:"Manage secrets."`
+	Config    configCmd    `cmd:"" help:"Manage configuration."`
+	Pubsub    pubsubCmd    `cmd:"" help:"Manage pub/sub."`
+	FSM       fsmCmd       `cmd:"" name:"fsm" help:"Inspect and manage FSM instances."`
+	Scheduled scheduledCmd `cmd:"" help:"Inspect and cancel scheduled calls."`
+	Cron      cronCmd      `cmd:"" help:"Inspect and manage cron jobs."`
 
 	// Specify the 1Password vault to access secrets from.
 	Vault string `name:"opvault" help:"1Password vault to be used for secrets. The name of the 1Password item will be the <ref> and the secret will be stored in the password field." placeholder:"VAULT"`
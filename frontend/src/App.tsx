@@ -1,6 +1,7 @@
 import { Navigate, Route, Routes } from 'react-router-dom'
 import { DeploymentPage } from './features/deployments/DeploymentPage.tsx'
 import { DeploymentsPage } from './features/deployments/DeploymentsPage.tsx'
+import { FSMPage } from './features/fsm/FSMPage.tsx'
 import { TimelinePage } from './features/timeline/TimelinePage.tsx'
 import { VerbPage } from './features/verbs/VerbPage.tsx'
 import { Layout } from './layout/Layout.tsx'
@@ -18,6 +19,7 @@ export const App = () => {
         <Route path='deployments/:deploymentKey' element={<DeploymentPage />} />
         <Route path='deployments/:deploymentKey/verbs/:verbName' element={<VerbPage />} />
         <Route path='console' element={<ConsolePage />} />
+        <Route path='fsms' element={<FSMPage />} />
       </Route>
       <Route path='*' element={<NotFoundPage />} />
     </Routes>
@@ -0,0 +1,72 @@
+import { useContext, useEffect, useState } from 'react'
+import { CloseButton } from '../../components/CloseButton'
+import { CodeBlock } from '../../components/CodeBlock'
+import { useClient } from '../../hooks/use-client'
+import { ControllerService } from '../../protos/xyz/block/ftl/v1/ftl_connect'
+import { FSMInstance, FSMTransitionAttempt } from '../../protos/xyz/block/ftl/v1/ftl_pb'
+import { SidePanelContext } from '../../providers/side-panel-provider'
+import { formatTimestamp } from '../../utils/date.utils'
+import { fsmStatusString, refString } from './fsm.utils'
+
+export const FSMInstanceDetails = ({ instance }: { instance: FSMInstance }) => {
+  const client = useClient(ControllerService)
+  const { closePanel } = useContext(SidePanelContext)
+  const [history, setHistory] = useState<FSMTransitionAttempt[]>([])
+
+  useEffect(() => {
+    const abortController = new AbortController()
+    const fetchHistory = async () => {
+      const response = await client.getFSMInstance(
+        { fsm: instance.fsm, instance: instance.key },
+        { signal: abortController.signal },
+      )
+      setHistory(response.history)
+    }
+
+    fetchHistory()
+
+    return () => {
+      abortController.abort()
+    }
+  }, [client, instance])
+
+  return (
+    <div className='p-4'>
+      <div className='flex items-center justify-between'>
+        <div className='flex items-center space-x-2'>
+          <div
+            className={`inline-block rounded-md bg-indigo-200 dark:bg-indigo-700 px-2 py-1 mr-1 text-sm font-medium text-gray-700 dark:text-gray-100`}
+          >
+            {refString(instance.fsm)}
+          </div>
+          <span className='font-mono text-sm'>{instance.key}</span>
+          <span className='text-sm text-gray-500 dark:text-gray-400'>{fsmStatusString(instance.status)}</span>
+        </div>
+        <CloseButton onClick={closePanel} />
+      </div>
+
+      <div className='text-sm pt-4'>History</div>
+      <ol className='pt-2 space-y-4'>
+        {history.map((attempt, index) => (
+          <li key={index} className='text-sm'>
+            <div className='flex items-center space-x-2'>
+              <span className='font-medium'>
+                {refString(attempt.state)}
+                {attempt.catching && ' (catch)'}
+              </span>
+              <span className={attempt.status === 'error' ? 'text-red-500' : 'text-gray-500 dark:text-gray-400'}>
+                {attempt.status}
+              </span>
+              <span className='font-mono text-gray-500 dark:text-gray-400'>{formatTimestamp(attempt.createdAt)}</span>
+            </div>
+            <CodeBlock
+              code={JSON.stringify(JSON.parse(new TextDecoder().decode(attempt.event)), null, 2)}
+              language='json'
+            />
+            {attempt.error && <CodeBlock code={attempt.error} language='text' />}
+          </li>
+        ))}
+      </ol>
+    </div>
+  )
+}
@@ -0,0 +1,127 @@
+import { ArrowPathIcon } from '@heroicons/react/24/outline'
+import { useContext, useEffect, useMemo, useState } from 'react'
+import { useClient } from '../../hooks/use-client'
+import { useSchema } from '../../hooks/use-schema'
+import { Page } from '../../layout'
+import { ControllerService } from '../../protos/xyz/block/ftl/v1/ftl_connect'
+import { FSMInstance } from '../../protos/xyz/block/ftl/v1/ftl_pb'
+import { Ref } from '../../protos/xyz/block/ftl/v1/schema/schema_pb'
+import { SidePanelContext } from '../../providers/side-panel-provider'
+import { formatTimestamp } from '../../utils/date.utils'
+import { FSMInstanceDetails } from './FSMInstanceDetails'
+import { fsmStatusString, isFailedFSMStatus, refString } from './fsm.utils'
+
+export const FSMPage = () => {
+  const client = useClient(ControllerService)
+  const schema = useSchema()
+  const { openPanel } = useContext(SidePanelContext)
+  const [selectedFSM, setSelectedFSM] = useState<string>('')
+  const [instances, setInstances] = useState<FSMInstance[]>([])
+
+  const fsms = useMemo(
+    () =>
+      schema.flatMap((module) =>
+        (module.schema?.decls ?? [])
+          .filter((decl) => decl.value.case === 'fsm')
+          .map((decl) => `${module.moduleName}.${decl.value.value?.name}`),
+      ),
+    [schema],
+  )
+
+  useEffect(() => {
+    if (selectedFSM === '' && fsms.length > 0) {
+      setSelectedFSM(fsms[0])
+    }
+  }, [fsms, selectedFSM])
+
+  useEffect(() => {
+    const abortController = new AbortController()
+    const fetchInstances = async () => {
+      if (selectedFSM === '') {
+        return
+      }
+      const [module, name] = selectedFSM.split('.')
+      const response = await client.listFSMInstances(
+        { fsm: new Ref({ module, name }) },
+        { signal: abortController.signal },
+      )
+      setInstances(response.instances)
+    }
+
+    fetchInstances()
+
+    return () => {
+      abortController.abort()
+    }
+  }, [client, selectedFSM])
+
+  const handleClick = (instance: FSMInstance) => {
+    openPanel(<FSMInstanceDetails instance={instance} />)
+  }
+
+  return (
+    <Page>
+      <Page.Header icon={<ArrowPathIcon />} title='FSMs'>
+        <select
+          value={selectedFSM}
+          onChange={(e) => setSelectedFSM(e.target.value)}
+          className='rounded-md border-0 py-1 pl-2 pr-8 text-sm bg-white dark:bg-gray-800 ring-1 ring-inset ring-gray-300 dark:ring-gray-600'
+        >
+          {fsms.map((fsm) => (
+            <option key={fsm} value={fsm}>
+              {fsm}
+            </option>
+          ))}
+        </select>
+      </Page.Header>
+      <Page.Body className='p-4 overflow-y-scroll'>
+        <table className='w-full text-left'>
+          <thead className='border-b border-white/10 text-sm leading-6 dark:text-white'>
+            <tr>
+              <th scope='col' className='py-2 pr-8 font-semibold'>
+                Instance
+              </th>
+              <th scope='col' className='py-2 pr-8 font-semibold'>
+                Status
+              </th>
+              <th scope='col' className='py-2 pr-8 font-semibold'>
+                State
+              </th>
+              <th scope='col' className='py-2 pr-8 font-semibold'>
+                Destination
+              </th>
+              <th scope='col' className='py-2 pr-8 font-semibold'>
+                Queued
+              </th>
+              <th scope='col' className='py-2 font-semibold'>
+                Updated
+              </th>
+            </tr>
+          </thead>
+          <tbody className='divide-y divide-black/5 dark:divide-white/5'>
+            {instances.map((instance) => (
+              <tr
+                key={instance.key}
+                onClick={() => handleClick(instance)}
+                className='cursor-pointer hover:bg-gray-100 dark:hover:bg-gray-800 text-sm leading-6'
+              >
+                <td className='py-2 pr-8 font-mono'>{instance.key}</td>
+                <td
+                  className={`py-2 pr-8 ${isFailedFSMStatus(instance.status) ? 'text-red-500' : ''}`}
+                >
+                  {fsmStatusString(instance.status)}
+                </td>
+                <td className='py-2 pr-8'>{refString(instance.currentState)}</td>
+                <td className='py-2 pr-8'>{refString(instance.destinationState)}</td>
+                <td className='py-2 pr-8'>{instance.queuedEvents.toString()}</td>
+                <td className='py-2 font-mono text-gray-500 dark:text-gray-400'>
+                  {formatTimestamp(instance.updatedAt)}
+                </td>
+              </tr>
+            ))}
+          </tbody>
+        </table>
+      </Page.Body>
+    </Page>
+  )
+}
@@ -0,0 +1,27 @@
+import { FSMStatus } from '../../protos/xyz/block/ftl/v1/ftl_pb'
+import { Ref } from '../../protos/xyz/block/ftl/v1/schema/schema_pb'
+
+export const refString = (ref?: Ref): string => {
+  if (!ref) return '-'
+  return `${ref.module}.${ref.name}`
+}
+
+export const fsmStatusString = (status: FSMStatus): string => {
+  switch (status) {
+    case FSMStatus.FSM_STATUS_COMPLETED:
+      return 'completed'
+    case FSMStatus.FSM_STATUS_FAILED:
+      return 'failed'
+    case FSMStatus.FSM_STATUS_COMPENSATING:
+      return 'compensating'
+    case FSMStatus.FSM_STATUS_COMPENSATED:
+      return 'compensated'
+    case FSMStatus.FSM_STATUS_COMPENSATION_FAILED:
+      return 'compensation failed'
+    default:
+      return 'running'
+  }
+}
+
+export const isFailedFSMStatus = (status: FSMStatus): boolean =>
+  status === FSMStatus.FSM_STATUS_FAILED || status === FSMStatus.FSM_STATUS_COMPENSATION_FAILED
@@ -1,7 +1,8 @@
-import { CubeTransparentIcon, ListBulletIcon, Square3Stack3DIcon } from '@heroicons/react/24/outline'
+import { ArrowPathIcon, CubeTransparentIcon, ListBulletIcon, Square3Stack3DIcon } from '@heroicons/react/24/outline'
 
 export const navigation = [
   { name: 'Events', href: '/events', icon: ListBulletIcon },
   { name: 'Console', href: '/console', icon: CubeTransparentIcon },
   { name: 'Deployments', href: '/deployments', icon: Square3Stack3DIcon },
+  { name: 'FSMs', href: '/fsms', icon: ArrowPathIcon },
 ]
@@ -5,7 +5,7 @@
 
 import { PingRequest, PingResponse } from "../ftl_pb.js";
 import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
-import { EventsQuery, GetEventsResponse, GetModulesRequest, GetModulesResponse, StreamEventsRequest, StreamEventsResponse } from "./console_pb.js";
+import { EventsQuery, GetEventsResponse, GetModulesRequest, GetModulesResponse, GetSchemaGraphRequest, GetSchemaGraphResponse, StreamEventsRequest, StreamEventsResponse } from "./console_pb.js";
 
 /**
  * @generated from service xyz.block.ftl.v1.console.ConsoleService
@@ -52,6 +52,17 @@ export const ConsoleService = {
       O: GetEventsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Render FSMs, verb calls and pub/sub flows as a DOT or Mermaid diagram.
+     *
+     * @generated from rpc xyz.block.ftl.v1.console.ConsoleService.GetSchemaGraph
+     */
+    getSchemaGraph: {
+      name: "GetSchemaGraph",
+      I: GetSchemaGraphRequest,
+      O: GetSchemaGraphResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -6,6 +6,7 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Duration, Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
 import { Config as Config$1, Data as Data$1, Ref, Secret as Secret$1, Verb as Verb$1 } from "../schema/schema_pb.js";
+import { CircuitBreakerState } from "../ftl_pb.js";
 
 /**
  * @generated from enum xyz.block.ftl.v1.console.EventType
@@ -89,6 +90,58 @@ proto3.util.setEnumType(LogLevel, "xyz.block.ftl.v1.console.LogLevel", [
   { no: 17, name: "LOG_LEVEL_ERROR" },
 ]);
 
+/**
+ * @generated from enum xyz.block.ftl.v1.console.GraphFormat
+ */
+export enum GraphFormat {
+  /**
+   * @generated from enum value: GRAPH_FORMAT_DOT = 0;
+   */
+  DOT = 0,
+
+  /**
+   * @generated from enum value: GRAPH_FORMAT_MERMAID = 1;
+   */
+  MERMAID = 1,
+}
+// Retrieve enum metadata with: proto3.getEnumType(GraphFormat)
+proto3.util.setEnumType(GraphFormat, "xyz.block.ftl.v1.console.GraphFormat", [
+  { no: 0, name: "GRAPH_FORMAT_DOT" },
+  { no: 1, name: "GRAPH_FORMAT_MERMAID" },
+]);
+
+/**
+ * @generated from enum xyz.block.ftl.v1.console.GraphKind
+ */
+export enum GraphKind {
+  /**
+   * @generated from enum value: GRAPH_KIND_UNKNOWN = 0;
+   */
+  UNKNOWN = 0,
+
+  /**
+   * @generated from enum value: GRAPH_KIND_FSM = 1;
+   */
+  FSM = 1,
+
+  /**
+   * @generated from enum value: GRAPH_KIND_CALLS = 2;
+   */
+  CALLS = 2,
+
+  /**
+   * @generated from enum value: GRAPH_KIND_PUBSUB = 3;
+   */
+  PUBSUB = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(GraphKind)
+proto3.util.setEnumType(GraphKind, "xyz.block.ftl.v1.console.GraphKind", [
+  { no: 0, name: "GRAPH_KIND_UNKNOWN" },
+  { no: 1, name: "GRAPH_KIND_FSM" },
+  { no: 2, name: "GRAPH_KIND_CALLS" },
+  { no: 3, name: "GRAPH_KIND_PUBSUB" },
+]);
+
 /**
  * @generated from message xyz.block.ftl.v1.console.LogEvent
  */
@@ -388,6 +441,11 @@ export class Verb extends Message<Verb> {
    */
   jsonRequestSchema = "";
 
+  /**
+   * @generated from field: xyz.block.ftl.v1.CircuitBreakerState circuit_breaker = 4;
+   */
+  circuitBreaker = CircuitBreakerState.CIRCUIT_BREAKER_CLOSED;
+
   constructor(data?: PartialMessage<Verb>) {
     super();
     proto3.util.initPartial(data, this);
@@ -399,6 +457,7 @@ export class Verb extends Message<Verb> {
     { no: 1, name: "verb", kind: "message", T: Verb$1 },
     { no: 2, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "json_request_schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "circuit_breaker", kind: "enum", T: proto3.getEnumType(CircuitBreakerState) },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Verb {
@@ -762,6 +821,96 @@ export class GetModulesResponse extends Message<GetModulesResponse> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.console.GetSchemaGraphRequest
+ */
+export class GetSchemaGraphRequest extends Message<GetSchemaGraphRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.console.GraphFormat format = 1;
+   */
+  format = GraphFormat.DOT;
+
+  /**
+   * Kinds of relationships to include. All are included if empty.
+   *
+   * @generated from field: repeated xyz.block.ftl.v1.console.GraphKind kinds = 2;
+   */
+  kinds: GraphKind[] = [];
+
+  /**
+   * Modules to include. All are included if empty.
+   *
+   * @generated from field: repeated string modules = 3;
+   */
+  modules: string[] = [];
+
+  constructor(data?: PartialMessage<GetSchemaGraphRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.console.GetSchemaGraphRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "format", kind: "enum", T: proto3.getEnumType(GraphFormat) },
+    { no: 2, name: "kinds", kind: "enum", T: proto3.getEnumType(GraphKind), repeated: true },
+    { no: 3, name: "modules", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSchemaGraphRequest {
+    return new GetSchemaGraphRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSchemaGraphRequest {
+    return new GetSchemaGraphRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSchemaGraphRequest {
+    return new GetSchemaGraphRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSchemaGraphRequest | PlainMessage<GetSchemaGraphRequest> | undefined, b: GetSchemaGraphRequest | PlainMessage<GetSchemaGraphRequest> | undefined): boolean {
+    return proto3.util.equals(GetSchemaGraphRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.console.GetSchemaGraphResponse
+ */
+export class GetSchemaGraphResponse extends Message<GetSchemaGraphResponse> {
+  /**
+   * @generated from field: string graph = 1;
+   */
+  graph = "";
+
+  constructor(data?: PartialMessage<GetSchemaGraphResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.console.GetSchemaGraphResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "graph", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSchemaGraphResponse {
+    return new GetSchemaGraphResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSchemaGraphResponse {
+    return new GetSchemaGraphResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSchemaGraphResponse {
+    return new GetSchemaGraphResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSchemaGraphResponse | PlainMessage<GetSchemaGraphResponse> | undefined, b: GetSchemaGraphResponse | PlainMessage<GetSchemaGraphResponse> | undefined): boolean {
+    return proto3.util.equals(GetSchemaGraphResponse, a, b);
+  }
+}
+
 /**
  * Query for events.
  *
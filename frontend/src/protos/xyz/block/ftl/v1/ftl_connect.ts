@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AcquireLeaseRequest, AcquireLeaseResponse, CallRequest, CallResponse, CreateDeploymentRequest, CreateDeploymentResponse, DeployRequest, DeployResponse, GetArtefactDiffsRequest, GetArtefactDiffsResponse, GetConfigRequest, GetConfigResponse, GetDeploymentArtefactsRequest, GetDeploymentArtefactsResponse, GetDeploymentRequest, GetDeploymentResponse, GetSchemaRequest, GetSchemaResponse, GetSecretRequest, GetSecretResponse, ListConfigRequest, ListConfigResponse, ListSecretsRequest, ListSecretsResponse, ModuleContextRequest, ModuleContextResponse, PingRequest, PingResponse, ProcessListRequest, ProcessListResponse, PublishEventRequest, PublishEventResponse, PullSchemaRequest, PullSchemaResponse, RegisterRunnerRequest, RegisterRunnerResponse, ReplaceDeployRequest, ReplaceDeployResponse, ReserveRequest, ReserveResponse, ResetSubscriptionRequest, ResetSubscriptionResponse, SendFSMEventRequest, SendFSMEventResponse, SetConfigRequest, SetConfigResponse, SetSecretRequest, SetSecretResponse, StatusRequest, StatusResponse, StreamDeploymentLogsRequest, StreamDeploymentLogsResponse, TerminateRequest, UnsetConfigRequest, UnsetConfigResponse, UnsetSecretRequest, UnsetSecretResponse, UpdateDeployRequest, UpdateDeployResponse, UploadArtefactRequest, UploadArtefactResponse } from "./ftl_pb.js";
+import { AcquireLeaseRequest, AcquireLeaseResponse, CallRequest, CallResponse, CancelScheduledCallRequest, CancelScheduledCallResponse, CreateDeploymentRequest, CreateDeploymentResponse, DeployRequest, DeployResponse, GetArtefactDiffsRequest, GetArtefactDiffsResponse, GetConfigRequest, GetConfigResponse, GetDeploymentArtefactsRequest, GetDeploymentArtefactsResponse, GetDeploymentRequest, GetDeploymentResponse, GetFSMInstanceRequest, GetFSMInstanceResponse, GetSchemaRequest, GetSchemaResponse, GetSecretRequest, GetSecretResponse, ListConfigRequest, ListConfigResponse, ListCronJobsRequest, ListCronJobsResponse, ListDeadLettersRequest, ListDeadLettersResponse, ListFSMInstancesRequest, ListFSMInstancesResponse, ListScheduledCallsRequest, ListScheduledCallsResponse, ListSecretsRequest, ListSecretsResponse, ModuleContextRequest, ModuleContextResponse, PauseCronJobRequest, PauseCronJobResponse, PingRequest, PingResponse, ProcessListRequest, ProcessListResponse, PublishEventRequest, PublishEventResponse, PullSchemaRequest, PullSchemaResponse, PurgeDeadLettersRequest, PurgeDeadLettersResponse, RegisterRunnerRequest, RegisterRunnerResponse, ReplaceDeployRequest, ReplaceDeployResponse, ReplayDeadLettersRequest, ReplayDeadLettersResponse, ReserveRequest, ReserveResponse, ResetSubscriptionRequest, ResetSubscriptionResponse, ResumeCronJobRequest, ResumeCronJobResponse, ScheduleCallRequest, ScheduleCallResponse, SeekSubscriptionRequest, SeekSubscriptionResponse, SendFSMEventRequest, SendFSMEventResponse, SendWebSocketMessageRequest, SendWebSocketMessageResponse, SetConfigRequest, SetConfigResponse, SetNextFSMEventRequest, SetNextFSMEventResponse, SetSecretRequest, SetSecretResponse, StatusRequest, StatusResponse, StreamDeploymentLogsRequest, StreamDeploymentLogsResponse, TerminateRequest, TriggerCronJobRequest, TriggerCronJobResponse, UnsetConfigRequest, UnsetConfigResponse, UnsetSecretRequest, UnsetSecretResponse, UpdateDeployRequest, UpdateDeployResponse, UploadArtefactRequest, UploadArtefactResponse } from "./ftl_pb.js";
 import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -61,6 +61,18 @@ export const VerbService = {
       O: SendFSMEventResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Set the event that an FSM instance will be sent when the transition it is
+     * executing completes.
+     *
+     * @generated from rpc xyz.block.ftl.v1.VerbService.SetNextFSMEvent
+     */
+    setNextFSMEvent: {
+      name: "SetNextFSMEvent",
+      I: SetNextFSMEventRequest,
+      O: SetNextFSMEventResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Publish an event to a topic.
      *
@@ -72,6 +84,30 @@ export const VerbService = {
       O: PublishEventResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Durably schedule an asynchronous call to a Verb.
+     *
+     * @generated from rpc xyz.block.ftl.v1.VerbService.ScheduleCall
+     */
+    scheduleCall: {
+      name: "ScheduleCall",
+      I: ScheduleCallRequest,
+      O: ScheduleCallResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Send a message to a client connected to a WebSocket ingress verb.
+     *
+     * The connection may be held by any controller in the cluster.
+     *
+     * @generated from rpc xyz.block.ftl.v1.VerbService.SendWebSocketMessage
+     */
+    sendWebSocketMessage: {
+      name: "SendWebSocketMessage",
+      I: SendWebSocketMessageRequest,
+      O: SendWebSocketMessageResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Issue a synchronous call to a Verb.
      *
@@ -83,6 +119,20 @@ export const VerbService = {
       O: CallResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Issue a call to a streaming Verb.
+     *
+     * Each response carries a single message from the stream. If the Verb
+     * fails, the final response is an error.
+     *
+     * @generated from rpc xyz.block.ftl.v1.VerbService.CallStream
+     */
+    callStream: {
+      name: "CallStream",
+      I: CallRequest,
+      O: CallResponse,
+      kind: MethodKind.ServerStreaming,
+    },
   }
 } as const;
 
@@ -268,6 +318,143 @@ export const ControllerService = {
       O: ResetSubscriptionResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Move the cursor for a subscription to an event or point in time.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.SeekSubscription
+     */
+    seekSubscription: {
+      name: "SeekSubscription",
+      I: SeekSubscriptionRequest,
+      O: SeekSubscriptionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List events that a subscription permanently failed to consume.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.ListDeadLetters
+     */
+    listDeadLetters: {
+      name: "ListDeadLetters",
+      I: ListDeadLettersRequest,
+      O: ListDeadLettersResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Schedule dead lettered events to be consumed by their subscription again.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.ReplayDeadLetters
+     */
+    replayDeadLetters: {
+      name: "ReplayDeadLetters",
+      I: ReplayDeadLettersRequest,
+      O: ReplayDeadLettersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Discard dead lettered events.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.PurgeDeadLetters
+     */
+    purgeDeadLetters: {
+      name: "PurgeDeadLetters",
+      I: PurgeDeadLettersRequest,
+      O: PurgeDeadLettersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List instances of an FSM.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.ListFSMInstances
+     */
+    listFSMInstances: {
+      name: "ListFSMInstances",
+      I: ListFSMInstancesRequest,
+      O: ListFSMInstancesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Get an FSM instance and its transition history.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.GetFSMInstance
+     */
+    getFSMInstance: {
+      name: "GetFSMInstance",
+      I: GetFSMInstanceRequest,
+      O: GetFSMInstanceResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * List scheduled calls that have not yet completed.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.ListScheduledCalls
+     */
+    listScheduledCalls: {
+      name: "ListScheduledCalls",
+      I: ListScheduledCallsRequest,
+      O: ListScheduledCallsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Cancel a scheduled call that is not yet executing.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.CancelScheduledCall
+     */
+    cancelScheduledCall: {
+      name: "CancelScheduledCall",
+      I: CancelScheduledCallRequest,
+      O: CancelScheduledCallResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List the cron jobs of active deployments.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.ListCronJobs
+     */
+    listCronJobs: {
+      name: "ListCronJobs",
+      I: ListCronJobsRequest,
+      O: ListCronJobsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Execute a cron job immediately, even if it is paused.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.TriggerCronJob
+     */
+    triggerCronJob: {
+      name: "TriggerCronJob",
+      I: TriggerCronJobRequest,
+      O: TriggerCronJobResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Stop a cron job from being scheduled, including in future deployments of its module.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.PauseCronJob
+     */
+    pauseCronJob: {
+      name: "PauseCronJob",
+      I: PauseCronJobRequest,
+      O: PauseCronJobResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Allow a paused cron job to be scheduled again.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.ResumeCronJob
+     */
+    resumeCronJob: {
+      name: "ResumeCronJob",
+      I: ResumeCronJobRequest,
+      O: ResumeCronJobResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Duration, Message, proto3, Struct, Timestamp } from "@bufbuild/protobuf";
+import { Duration, Message, proto3, protoInt64, Struct, Timestamp } from "@bufbuild/protobuf";
 import { Module, Ref, Schema, Type } from "./schema/schema_pb.js";
 
 /**
@@ -73,6 +73,82 @@ proto3.util.setEnumType(RunnerState, "xyz.block.ftl.v1.RunnerState", [
   { no: 3, name: "RUNNER_DEAD" },
 ]);
 
+/**
+ * @generated from enum xyz.block.ftl.v1.CircuitBreakerState
+ */
+export enum CircuitBreakerState {
+  /**
+   * Calls are allowed.
+   *
+   * @generated from enum value: CIRCUIT_BREAKER_CLOSED = 0;
+   */
+  CIRCUIT_BREAKER_CLOSED = 0,
+
+  /**
+   * Calls are rejected.
+   *
+   * @generated from enum value: CIRCUIT_BREAKER_OPEN = 1;
+   */
+  CIRCUIT_BREAKER_OPEN = 1,
+
+  /**
+   * A single trial call is allowed to determine whether the breaker closes.
+   *
+   * @generated from enum value: CIRCUIT_BREAKER_HALF_OPEN = 2;
+   */
+  CIRCUIT_BREAKER_HALF_OPEN = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(CircuitBreakerState)
+proto3.util.setEnumType(CircuitBreakerState, "xyz.block.ftl.v1.CircuitBreakerState", [
+  { no: 0, name: "CIRCUIT_BREAKER_CLOSED" },
+  { no: 1, name: "CIRCUIT_BREAKER_OPEN" },
+  { no: 2, name: "CIRCUIT_BREAKER_HALF_OPEN" },
+]);
+
+/**
+ * @generated from enum xyz.block.ftl.v1.FSMStatus
+ */
+export enum FSMStatus {
+  /**
+   * @generated from enum value: FSM_STATUS_RUNNING = 0;
+   */
+  FSM_STATUS_RUNNING = 0,
+
+  /**
+   * @generated from enum value: FSM_STATUS_COMPLETED = 1;
+   */
+  FSM_STATUS_COMPLETED = 1,
+
+  /**
+   * @generated from enum value: FSM_STATUS_FAILED = 2;
+   */
+  FSM_STATUS_FAILED = 2,
+
+  /**
+   * @generated from enum value: FSM_STATUS_COMPENSATING = 3;
+   */
+  FSM_STATUS_COMPENSATING = 3,
+
+  /**
+   * @generated from enum value: FSM_STATUS_COMPENSATED = 4;
+   */
+  FSM_STATUS_COMPENSATED = 4,
+
+  /**
+   * @generated from enum value: FSM_STATUS_COMPENSATION_FAILED = 5;
+   */
+  FSM_STATUS_COMPENSATION_FAILED = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(FSMStatus)
+proto3.util.setEnumType(FSMStatus, "xyz.block.ftl.v1.FSMStatus", [
+  { no: 0, name: "FSM_STATUS_RUNNING" },
+  { no: 1, name: "FSM_STATUS_COMPLETED" },
+  { no: 2, name: "FSM_STATUS_FAILED" },
+  { no: 3, name: "FSM_STATUS_COMPENSATING" },
+  { no: 4, name: "FSM_STATUS_COMPENSATED" },
+  { no: 5, name: "FSM_STATUS_COMPENSATION_FAILED" },
+]);
+
 /**
  * @generated from enum xyz.block.ftl.v1.ConfigProvider
  */
@@ -507,6 +583,9 @@ export class Metadata_Pair extends Message<Metadata_Pair> {
  */
 export class CallRequest extends Message<CallRequest> {
   /**
+   * When executing a transition of an FSM instance, the "fsm" and
+   * "fsmInstance" keys identify the instance.
+   *
    * @generated from field: xyz.block.ftl.v1.Metadata metadata = 1;
    */
   metadata?: Metadata;
@@ -521,6 +600,14 @@ export class CallRequest extends Message<CallRequest> {
    */
   body = new Uint8Array(0);
 
+  /**
+   * Duplicate calls to the same verb with the same key receive the response
+   * of the first call rather than executing the verb again.
+   *
+   * @generated from field: optional string idempotency_key = 4;
+   */
+  idempotencyKey?: string;
+
   constructor(data?: PartialMessage<CallRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -532,6 +619,7 @@ export class CallRequest extends Message<CallRequest> {
     { no: 1, name: "metadata", kind: "message", T: Metadata },
     { no: 2, name: "verb", kind: "message", T: Ref },
     { no: 3, name: "body", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 4, name: "idempotency_key", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CallRequest {
@@ -812,6 +900,92 @@ export class SendFSMEventResponse extends Message<SendFSMEventResponse> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.SetNextFSMEventRequest
+ */
+export class SetNextFSMEventRequest extends Message<SetNextFSMEventRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref fsm = 1;
+   */
+  fsm?: Ref;
+
+  /**
+   * @generated from field: string instance = 2;
+   */
+  instance = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Type event = 3;
+   */
+  event?: Type;
+
+  /**
+   * @generated from field: bytes body = 4;
+   */
+  body = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<SetNextFSMEventRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.SetNextFSMEventRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "fsm", kind: "message", T: Ref },
+    { no: 2, name: "instance", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "event", kind: "message", T: Type },
+    { no: 4, name: "body", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetNextFSMEventRequest {
+    return new SetNextFSMEventRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetNextFSMEventRequest {
+    return new SetNextFSMEventRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetNextFSMEventRequest {
+    return new SetNextFSMEventRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetNextFSMEventRequest | PlainMessage<SetNextFSMEventRequest> | undefined, b: SetNextFSMEventRequest | PlainMessage<SetNextFSMEventRequest> | undefined): boolean {
+    return proto3.util.equals(SetNextFSMEventRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.SetNextFSMEventResponse
+ */
+export class SetNextFSMEventResponse extends Message<SetNextFSMEventResponse> {
+  constructor(data?: PartialMessage<SetNextFSMEventResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.SetNextFSMEventResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetNextFSMEventResponse {
+    return new SetNextFSMEventResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetNextFSMEventResponse {
+    return new SetNextFSMEventResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetNextFSMEventResponse {
+    return new SetNextFSMEventResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetNextFSMEventResponse | PlainMessage<SetNextFSMEventResponse> | undefined, b: SetNextFSMEventResponse | PlainMessage<SetNextFSMEventResponse> | undefined): boolean {
+    return proto3.util.equals(SetNextFSMEventResponse, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.PublishEventRequest
  */
@@ -833,6 +1007,13 @@ export class PublishEventRequest extends Message<PublishEventRequest> {
    */
   caller = "";
 
+  /**
+   * Events with the same partition key are consumed in the order they were published.
+   *
+   * @generated from field: optional string partition_key = 4;
+   */
+  partitionKey?: string;
+
   constructor(data?: PartialMessage<PublishEventRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -844,6 +1025,7 @@ export class PublishEventRequest extends Message<PublishEventRequest> {
     { no: 1, name: "topic", kind: "message", T: Ref },
     { no: 2, name: "body", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
     { no: 3, name: "caller", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "partition_key", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PublishEventRequest {
@@ -895,215 +1077,460 @@ export class PublishEventResponse extends Message<PublishEventResponse> {
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.GetSchemaRequest
+ * @generated from message xyz.block.ftl.v1.RetryPolicy
  */
-export class GetSchemaRequest extends Message<GetSchemaRequest> {
-  constructor(data?: PartialMessage<GetSchemaRequest>) {
+export class RetryPolicy extends Message<RetryPolicy> {
+  /**
+   * @generated from field: int32 count = 1;
+   */
+  count = 0;
+
+  /**
+   * @generated from field: google.protobuf.Duration min_backoff = 2;
+   */
+  minBackoff?: Duration;
+
+  /**
+   * @generated from field: google.protobuf.Duration max_backoff = 3;
+   */
+  maxBackoff?: Duration;
+
+  constructor(data?: PartialMessage<RetryPolicy>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.GetSchemaRequest";
+  static readonly typeName = "xyz.block.ftl.v1.RetryPolicy";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "min_backoff", kind: "message", T: Duration },
+    { no: 3, name: "max_backoff", kind: "message", T: Duration },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSchemaRequest {
-    return new GetSchemaRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetryPolicy {
+    return new RetryPolicy().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSchemaRequest {
-    return new GetSchemaRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetryPolicy {
+    return new RetryPolicy().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSchemaRequest {
-    return new GetSchemaRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetryPolicy {
+    return new RetryPolicy().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSchemaRequest | PlainMessage<GetSchemaRequest> | undefined, b: GetSchemaRequest | PlainMessage<GetSchemaRequest> | undefined): boolean {
-    return proto3.util.equals(GetSchemaRequest, a, b);
+  static equals(a: RetryPolicy | PlainMessage<RetryPolicy> | undefined, b: RetryPolicy | PlainMessage<RetryPolicy> | undefined): boolean {
+    return proto3.util.equals(RetryPolicy, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.GetSchemaResponse
+ * @generated from message xyz.block.ftl.v1.ScheduleCallRequest
  */
-export class GetSchemaResponse extends Message<GetSchemaResponse> {
+export class ScheduleCallRequest extends Message<ScheduleCallRequest> {
   /**
-   * @generated from field: xyz.block.ftl.v1.schema.Schema schema = 1;
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 1;
    */
-  schema?: Schema;
+  verb?: Ref;
 
-  constructor(data?: PartialMessage<GetSchemaResponse>) {
+  /**
+   * @generated from field: bytes body = 2;
+   */
+  body = new Uint8Array(0);
+
+  /**
+   * @generated from field: google.protobuf.Timestamp scheduled_at = 3;
+   */
+  scheduledAt?: Timestamp;
+
+  /**
+   * Overrides the retry policy of the verb.
+   *
+   * @generated from field: optional xyz.block.ftl.v1.RetryPolicy retry = 4;
+   */
+  retry?: RetryPolicy;
+
+  /**
+   * If a call to the same verb with the same key has not yet completed, no
+   * call is scheduled. A key is generated if not provided.
+   *
+   * @generated from field: optional string key = 5;
+   */
+  key?: string;
+
+  constructor(data?: PartialMessage<ScheduleCallRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.GetSchemaResponse";
+  static readonly typeName = "xyz.block.ftl.v1.ScheduleCallRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "schema", kind: "message", T: Schema },
+    { no: 1, name: "verb", kind: "message", T: Ref },
+    { no: 2, name: "body", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 3, name: "scheduled_at", kind: "message", T: Timestamp },
+    { no: 4, name: "retry", kind: "message", T: RetryPolicy, opt: true },
+    { no: 5, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSchemaResponse {
-    return new GetSchemaResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScheduleCallRequest {
+    return new ScheduleCallRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSchemaResponse {
-    return new GetSchemaResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScheduleCallRequest {
+    return new ScheduleCallRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSchemaResponse {
-    return new GetSchemaResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScheduleCallRequest {
+    return new ScheduleCallRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSchemaResponse | PlainMessage<GetSchemaResponse> | undefined, b: GetSchemaResponse | PlainMessage<GetSchemaResponse> | undefined): boolean {
-    return proto3.util.equals(GetSchemaResponse, a, b);
+  static equals(a: ScheduleCallRequest | PlainMessage<ScheduleCallRequest> | undefined, b: ScheduleCallRequest | PlainMessage<ScheduleCallRequest> | undefined): boolean {
+    return proto3.util.equals(ScheduleCallRequest, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.PullSchemaRequest
+ * @generated from message xyz.block.ftl.v1.ScheduleCallResponse
  */
-export class PullSchemaRequest extends Message<PullSchemaRequest> {
-  constructor(data?: PartialMessage<PullSchemaRequest>) {
+export class ScheduleCallResponse extends Message<ScheduleCallResponse> {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key = "";
+
+  /**
+   * False if a call with the same key had already been scheduled.
+   *
+   * @generated from field: bool scheduled = 2;
+   */
+  scheduled = false;
+
+  constructor(data?: PartialMessage<ScheduleCallResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.PullSchemaRequest";
+  static readonly typeName = "xyz.block.ftl.v1.ScheduleCallResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "scheduled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PullSchemaRequest {
-    return new PullSchemaRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScheduleCallResponse {
+    return new ScheduleCallResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PullSchemaRequest {
-    return new PullSchemaRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScheduleCallResponse {
+    return new ScheduleCallResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PullSchemaRequest {
-    return new PullSchemaRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScheduleCallResponse {
+    return new ScheduleCallResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PullSchemaRequest | PlainMessage<PullSchemaRequest> | undefined, b: PullSchemaRequest | PlainMessage<PullSchemaRequest> | undefined): boolean {
-    return proto3.util.equals(PullSchemaRequest, a, b);
+  static equals(a: ScheduleCallResponse | PlainMessage<ScheduleCallResponse> | undefined, b: ScheduleCallResponse | PlainMessage<ScheduleCallResponse> | undefined): boolean {
+    return proto3.util.equals(ScheduleCallResponse, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.PullSchemaResponse
+ * @generated from message xyz.block.ftl.v1.SendWebSocketMessageRequest
  */
-export class PullSchemaResponse extends Message<PullSchemaResponse> {
-  /**
-   * @generated from field: string deployment_key = 1;
-   */
-  deploymentKey = "";
-
+export class SendWebSocketMessageRequest extends Message<SendWebSocketMessageRequest> {
   /**
-   * @generated from field: string module_name = 2;
-   */
-  moduleName = "";
-
-  /**
-   * For deletes this will not be present.
+   * The connection to send the message to, as received by a WebSocket ingress
+   * verb in builtin.WebSocketMessage.
    *
-   * @generated from field: optional xyz.block.ftl.v1.schema.Module schema = 4;
+   * @generated from field: string connection_id = 1;
    */
-  schema?: Module;
+  connectionId = "";
 
   /**
-   * If true there are more schema changes immediately following this one as part of the initial batch.
-   * If false this is the last schema change in the initial batch, but others may follow later.
-   *
-   * @generated from field: bool more = 3;
+   * @generated from field: bytes body = 2;
    */
-  more = false;
+  body = new Uint8Array(0);
 
   /**
-   * @generated from field: xyz.block.ftl.v1.DeploymentChangeType change_type = 5;
+   * Send the body as a binary message rather than a text message.
+   *
+   * @generated from field: bool binary = 3;
    */
-  changeType = DeploymentChangeType.DEPLOYMENT_ADDED;
+  binary = false;
 
-  constructor(data?: PartialMessage<PullSchemaResponse>) {
+  constructor(data?: PartialMessage<SendWebSocketMessageRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.PullSchemaResponse";
+  static readonly typeName = "xyz.block.ftl.v1.SendWebSocketMessageRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "module_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "schema", kind: "message", T: Module, opt: true },
-    { no: 3, name: "more", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 5, name: "change_type", kind: "enum", T: proto3.getEnumType(DeploymentChangeType) },
+    { no: 1, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "body", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 3, name: "binary", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PullSchemaResponse {
-    return new PullSchemaResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SendWebSocketMessageRequest {
+    return new SendWebSocketMessageRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PullSchemaResponse {
-    return new PullSchemaResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SendWebSocketMessageRequest {
+    return new SendWebSocketMessageRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PullSchemaResponse {
-    return new PullSchemaResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SendWebSocketMessageRequest {
+    return new SendWebSocketMessageRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PullSchemaResponse | PlainMessage<PullSchemaResponse> | undefined, b: PullSchemaResponse | PlainMessage<PullSchemaResponse> | undefined): boolean {
-    return proto3.util.equals(PullSchemaResponse, a, b);
+  static equals(a: SendWebSocketMessageRequest | PlainMessage<SendWebSocketMessageRequest> | undefined, b: SendWebSocketMessageRequest | PlainMessage<SendWebSocketMessageRequest> | undefined): boolean {
+    return proto3.util.equals(SendWebSocketMessageRequest, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.GetArtefactDiffsRequest
+ * @generated from message xyz.block.ftl.v1.SendWebSocketMessageResponse
  */
-export class GetArtefactDiffsRequest extends Message<GetArtefactDiffsRequest> {
-  /**
-   * @generated from field: repeated string client_digests = 1;
-   */
-  clientDigests: string[] = [];
-
-  constructor(data?: PartialMessage<GetArtefactDiffsRequest>) {
+export class SendWebSocketMessageResponse extends Message<SendWebSocketMessageResponse> {
+  constructor(data?: PartialMessage<SendWebSocketMessageResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.GetArtefactDiffsRequest";
+  static readonly typeName = "xyz.block.ftl.v1.SendWebSocketMessageResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "client_digests", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetArtefactDiffsRequest {
-    return new GetArtefactDiffsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SendWebSocketMessageResponse {
+    return new SendWebSocketMessageResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetArtefactDiffsRequest {
-    return new GetArtefactDiffsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SendWebSocketMessageResponse {
+    return new SendWebSocketMessageResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetArtefactDiffsRequest {
-    return new GetArtefactDiffsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SendWebSocketMessageResponse {
+    return new SendWebSocketMessageResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetArtefactDiffsRequest | PlainMessage<GetArtefactDiffsRequest> | undefined, b: GetArtefactDiffsRequest | PlainMessage<GetArtefactDiffsRequest> | undefined): boolean {
-    return proto3.util.equals(GetArtefactDiffsRequest, a, b);
+  static equals(a: SendWebSocketMessageResponse | PlainMessage<SendWebSocketMessageResponse> | undefined, b: SendWebSocketMessageResponse | PlainMessage<SendWebSocketMessageResponse> | undefined): boolean {
+    return proto3.util.equals(SendWebSocketMessageResponse, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.GetArtefactDiffsResponse
+ * @generated from message xyz.block.ftl.v1.GetSchemaRequest
  */
-export class GetArtefactDiffsResponse extends Message<GetArtefactDiffsResponse> {
-  /**
-   * @generated from field: repeated string missing_digests = 1;
-   */
-  missingDigests: string[] = [];
+export class GetSchemaRequest extends Message<GetSchemaRequest> {
+  constructor(data?: PartialMessage<GetSchemaRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetSchemaRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSchemaRequest {
+    return new GetSchemaRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSchemaRequest {
+    return new GetSchemaRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSchemaRequest {
+    return new GetSchemaRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSchemaRequest | PlainMessage<GetSchemaRequest> | undefined, b: GetSchemaRequest | PlainMessage<GetSchemaRequest> | undefined): boolean {
+    return proto3.util.equals(GetSchemaRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetSchemaResponse
+ */
+export class GetSchemaResponse extends Message<GetSchemaResponse> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Schema schema = 1;
+   */
+  schema?: Schema;
+
+  constructor(data?: PartialMessage<GetSchemaResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetSchemaResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema", kind: "message", T: Schema },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSchemaResponse {
+    return new GetSchemaResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSchemaResponse {
+    return new GetSchemaResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSchemaResponse {
+    return new GetSchemaResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSchemaResponse | PlainMessage<GetSchemaResponse> | undefined, b: GetSchemaResponse | PlainMessage<GetSchemaResponse> | undefined): boolean {
+    return proto3.util.equals(GetSchemaResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.PullSchemaRequest
+ */
+export class PullSchemaRequest extends Message<PullSchemaRequest> {
+  constructor(data?: PartialMessage<PullSchemaRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.PullSchemaRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PullSchemaRequest {
+    return new PullSchemaRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PullSchemaRequest {
+    return new PullSchemaRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PullSchemaRequest {
+    return new PullSchemaRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PullSchemaRequest | PlainMessage<PullSchemaRequest> | undefined, b: PullSchemaRequest | PlainMessage<PullSchemaRequest> | undefined): boolean {
+    return proto3.util.equals(PullSchemaRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.PullSchemaResponse
+ */
+export class PullSchemaResponse extends Message<PullSchemaResponse> {
+  /**
+   * @generated from field: string deployment_key = 1;
+   */
+  deploymentKey = "";
+
+  /**
+   * @generated from field: string module_name = 2;
+   */
+  moduleName = "";
+
+  /**
+   * For deletes this will not be present.
+   *
+   * @generated from field: optional xyz.block.ftl.v1.schema.Module schema = 4;
+   */
+  schema?: Module;
+
+  /**
+   * If true there are more schema changes immediately following this one as part of the initial batch.
+   * If false this is the last schema change in the initial batch, but others may follow later.
+   *
+   * @generated from field: bool more = 3;
+   */
+  more = false;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.DeploymentChangeType change_type = 5;
+   */
+  changeType = DeploymentChangeType.DEPLOYMENT_ADDED;
+
+  constructor(data?: PartialMessage<PullSchemaResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.PullSchemaResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "module_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "schema", kind: "message", T: Module, opt: true },
+    { no: 3, name: "more", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "change_type", kind: "enum", T: proto3.getEnumType(DeploymentChangeType) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PullSchemaResponse {
+    return new PullSchemaResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PullSchemaResponse {
+    return new PullSchemaResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PullSchemaResponse {
+    return new PullSchemaResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PullSchemaResponse | PlainMessage<PullSchemaResponse> | undefined, b: PullSchemaResponse | PlainMessage<PullSchemaResponse> | undefined): boolean {
+    return proto3.util.equals(PullSchemaResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetArtefactDiffsRequest
+ */
+export class GetArtefactDiffsRequest extends Message<GetArtefactDiffsRequest> {
+  /**
+   * @generated from field: repeated string client_digests = 1;
+   */
+  clientDigests: string[] = [];
+
+  constructor(data?: PartialMessage<GetArtefactDiffsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetArtefactDiffsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "client_digests", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetArtefactDiffsRequest {
+    return new GetArtefactDiffsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetArtefactDiffsRequest {
+    return new GetArtefactDiffsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetArtefactDiffsRequest {
+    return new GetArtefactDiffsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetArtefactDiffsRequest | PlainMessage<GetArtefactDiffsRequest> | undefined, b: GetArtefactDiffsRequest | PlainMessage<GetArtefactDiffsRequest> | undefined): boolean {
+    return proto3.util.equals(GetArtefactDiffsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetArtefactDiffsResponse
+ */
+export class GetArtefactDiffsResponse extends Message<GetArtefactDiffsResponse> {
+  /**
+   * @generated from field: repeated string missing_digests = 1;
+   */
+  missingDigests: string[] = [];
 
   /**
    * Artefacts that the client already has, and their path+executable status.
@@ -1938,6 +2365,11 @@ export class StatusResponse extends Message<StatusResponse> {
    */
   routes: StatusResponse_Route[] = [];
 
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.StatusResponse.CircuitBreaker circuit_breakers = 6;
+   */
+  circuitBreakers: StatusResponse_CircuitBreaker[] = [];
+
   constructor(data?: PartialMessage<StatusResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1951,6 +2383,7 @@ export class StatusResponse extends Message<StatusResponse> {
     { no: 3, name: "deployments", kind: "message", T: StatusResponse_Deployment, repeated: true },
     { no: 4, name: "ingress_routes", kind: "message", T: StatusResponse_IngressRoute, repeated: true },
     { no: 5, name: "routes", kind: "message", T: StatusResponse_Route, repeated: true },
+    { no: 6, name: "circuit_breakers", kind: "message", T: StatusResponse_CircuitBreaker, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusResponse {
@@ -2269,6 +2702,55 @@ export class StatusResponse_Route extends Message<StatusResponse_Route> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.StatusResponse.CircuitBreaker
+ */
+export class StatusResponse_CircuitBreaker extends Message<StatusResponse_CircuitBreaker> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 1;
+   */
+  verb?: Ref;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.CircuitBreakerState state = 2;
+   */
+  state = CircuitBreakerState.CIRCUIT_BREAKER_CLOSED;
+
+  /**
+   * @generated from field: int32 consecutive_failures = 3;
+   */
+  consecutiveFailures = 0;
+
+  constructor(data?: PartialMessage<StatusResponse_CircuitBreaker>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.StatusResponse.CircuitBreaker";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "verb", kind: "message", T: Ref },
+    { no: 2, name: "state", kind: "enum", T: proto3.getEnumType(CircuitBreakerState) },
+    { no: 3, name: "consecutive_failures", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusResponse_CircuitBreaker {
+    return new StatusResponse_CircuitBreaker().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusResponse_CircuitBreaker {
+    return new StatusResponse_CircuitBreaker().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusResponse_CircuitBreaker {
+    return new StatusResponse_CircuitBreaker().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StatusResponse_CircuitBreaker | PlainMessage<StatusResponse_CircuitBreaker> | undefined, b: StatusResponse_CircuitBreaker | PlainMessage<StatusResponse_CircuitBreaker> | undefined): boolean {
+    return proto3.util.equals(StatusResponse_CircuitBreaker, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.ProcessListRequest
  */
@@ -2509,6 +2991,1398 @@ export class ResetSubscriptionResponse extends Message<ResetSubscriptionResponse
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.SeekSubscriptionRequest
+ */
+export class SeekSubscriptionRequest extends Message<SeekSubscriptionRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref subscription = 1;
+   */
+  subscription?: Ref;
+
+  /**
+   * @generated from oneof xyz.block.ftl.v1.SeekSubscriptionRequest.target
+   */
+  target: {
+    /**
+     * Consume from this event onwards.
+     *
+     * @generated from field: string event_key = 2;
+     */
+    value: string;
+    case: "eventKey";
+  } | {
+    /**
+     * Consume events published at or after this time.
+     *
+     * @generated from field: google.protobuf.Timestamp time = 3;
+     */
+    value: Timestamp;
+    case: "time";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * Report the effect of the seek without moving the cursor.
+   *
+   * @generated from field: bool dry_run = 4;
+   */
+  dryRun = false;
+
+  constructor(data?: PartialMessage<SeekSubscriptionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.SeekSubscriptionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "subscription", kind: "message", T: Ref },
+    { no: 2, name: "event_key", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "target" },
+    { no: 3, name: "time", kind: "message", T: Timestamp, oneof: "target" },
+    { no: 4, name: "dry_run", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SeekSubscriptionRequest {
+    return new SeekSubscriptionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SeekSubscriptionRequest {
+    return new SeekSubscriptionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SeekSubscriptionRequest {
+    return new SeekSubscriptionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SeekSubscriptionRequest | PlainMessage<SeekSubscriptionRequest> | undefined, b: SeekSubscriptionRequest | PlainMessage<SeekSubscriptionRequest> | undefined): boolean {
+    return proto3.util.equals(SeekSubscriptionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.SeekSubscriptionResponse
+ */
+export class SeekSubscriptionResponse extends Message<SeekSubscriptionResponse> {
+  /**
+   * Number of previously consumed events that will be consumed again.
+   *
+   * @generated from field: int64 redelivered = 1;
+   */
+  redelivered = protoInt64.zero;
+
+  /**
+   * Number of unconsumed events that will be skipped.
+   *
+   * @generated from field: int64 skipped = 2;
+   */
+  skipped = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SeekSubscriptionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.SeekSubscriptionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "redelivered", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "skipped", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SeekSubscriptionResponse {
+    return new SeekSubscriptionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SeekSubscriptionResponse {
+    return new SeekSubscriptionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SeekSubscriptionResponse {
+    return new SeekSubscriptionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SeekSubscriptionResponse | PlainMessage<SeekSubscriptionResponse> | undefined, b: SeekSubscriptionResponse | PlainMessage<SeekSubscriptionResponse> | undefined): boolean {
+    return proto3.util.equals(SeekSubscriptionResponse, a, b);
+  }
+}
+
+/**
+ * An event that a subscription permanently failed to consume.
+ *
+ * @generated from message xyz.block.ftl.v1.DeadLetter
+ */
+export class DeadLetter extends Message<DeadLetter> {
+  /**
+   * @generated from field: string event_key = 1;
+   */
+  eventKey = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref subscription = 2;
+   */
+  subscription?: Ref;
+
+  /**
+   * The dead letter topic the event was published to.
+   *
+   * @generated from field: xyz.block.ftl.v1.schema.Ref topic = 3;
+   */
+  topic?: Ref;
+
+  /**
+   * @generated from field: string error = 4;
+   */
+  error = "";
+
+  /**
+   * @generated from field: int32 attempts = 5;
+   */
+  attempts = 0;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * JSON encoded event.
+   *
+   * @generated from field: bytes payload = 7;
+   */
+  payload = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<DeadLetter>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.DeadLetter";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "event_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "subscription", kind: "message", T: Ref },
+    { no: 3, name: "topic", kind: "message", T: Ref },
+    { no: 4, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "attempts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "created_at", kind: "message", T: Timestamp },
+    { no: 7, name: "payload", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeadLetter {
+    return new DeadLetter().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeadLetter {
+    return new DeadLetter().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeadLetter {
+    return new DeadLetter().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeadLetter | PlainMessage<DeadLetter> | undefined, b: DeadLetter | PlainMessage<DeadLetter> | undefined): boolean {
+    return proto3.util.equals(DeadLetter, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListDeadLettersRequest
+ */
+export class ListDeadLettersRequest extends Message<ListDeadLettersRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref subscription = 1;
+   */
+  subscription?: Ref;
+
+  /**
+   * Only return this event.
+   *
+   * @generated from field: optional string event_key = 2;
+   */
+  eventKey?: string;
+
+  /**
+   * @generated from field: optional int32 limit = 3;
+   */
+  limit?: number;
+
+  constructor(data?: PartialMessage<ListDeadLettersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListDeadLettersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "subscription", kind: "message", T: Ref },
+    { no: 2, name: "event_key", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeadLettersRequest {
+    return new ListDeadLettersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeadLettersRequest {
+    return new ListDeadLettersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeadLettersRequest {
+    return new ListDeadLettersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDeadLettersRequest | PlainMessage<ListDeadLettersRequest> | undefined, b: ListDeadLettersRequest | PlainMessage<ListDeadLettersRequest> | undefined): boolean {
+    return proto3.util.equals(ListDeadLettersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListDeadLettersResponse
+ */
+export class ListDeadLettersResponse extends Message<ListDeadLettersResponse> {
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.DeadLetter dead_letters = 1;
+   */
+  deadLetters: DeadLetter[] = [];
+
+  constructor(data?: PartialMessage<ListDeadLettersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListDeadLettersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "dead_letters", kind: "message", T: DeadLetter, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeadLettersResponse {
+    return new ListDeadLettersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeadLettersResponse {
+    return new ListDeadLettersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeadLettersResponse {
+    return new ListDeadLettersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDeadLettersResponse | PlainMessage<ListDeadLettersResponse> | undefined, b: ListDeadLettersResponse | PlainMessage<ListDeadLettersResponse> | undefined): boolean {
+    return proto3.util.equals(ListDeadLettersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ReplayDeadLettersRequest
+ */
+export class ReplayDeadLettersRequest extends Message<ReplayDeadLettersRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref subscription = 1;
+   */
+  subscription?: Ref;
+
+  /**
+   * Only replay this event.
+   *
+   * @generated from field: optional string event_key = 2;
+   */
+  eventKey?: string;
+
+  constructor(data?: PartialMessage<ReplayDeadLettersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ReplayDeadLettersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "subscription", kind: "message", T: Ref },
+    { no: 2, name: "event_key", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReplayDeadLettersRequest {
+    return new ReplayDeadLettersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReplayDeadLettersRequest {
+    return new ReplayDeadLettersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReplayDeadLettersRequest {
+    return new ReplayDeadLettersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReplayDeadLettersRequest | PlainMessage<ReplayDeadLettersRequest> | undefined, b: ReplayDeadLettersRequest | PlainMessage<ReplayDeadLettersRequest> | undefined): boolean {
+    return proto3.util.equals(ReplayDeadLettersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ReplayDeadLettersResponse
+ */
+export class ReplayDeadLettersResponse extends Message<ReplayDeadLettersResponse> {
+  /**
+   * @generated from field: int32 count = 1;
+   */
+  count = 0;
+
+  constructor(data?: PartialMessage<ReplayDeadLettersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ReplayDeadLettersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReplayDeadLettersResponse {
+    return new ReplayDeadLettersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReplayDeadLettersResponse {
+    return new ReplayDeadLettersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReplayDeadLettersResponse {
+    return new ReplayDeadLettersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReplayDeadLettersResponse | PlainMessage<ReplayDeadLettersResponse> | undefined, b: ReplayDeadLettersResponse | PlainMessage<ReplayDeadLettersResponse> | undefined): boolean {
+    return proto3.util.equals(ReplayDeadLettersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.PurgeDeadLettersRequest
+ */
+export class PurgeDeadLettersRequest extends Message<PurgeDeadLettersRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref subscription = 1;
+   */
+  subscription?: Ref;
+
+  /**
+   * Only purge this event.
+   *
+   * @generated from field: optional string event_key = 2;
+   */
+  eventKey?: string;
+
+  constructor(data?: PartialMessage<PurgeDeadLettersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.PurgeDeadLettersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "subscription", kind: "message", T: Ref },
+    { no: 2, name: "event_key", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PurgeDeadLettersRequest {
+    return new PurgeDeadLettersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PurgeDeadLettersRequest {
+    return new PurgeDeadLettersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PurgeDeadLettersRequest {
+    return new PurgeDeadLettersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PurgeDeadLettersRequest | PlainMessage<PurgeDeadLettersRequest> | undefined, b: PurgeDeadLettersRequest | PlainMessage<PurgeDeadLettersRequest> | undefined): boolean {
+    return proto3.util.equals(PurgeDeadLettersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.PurgeDeadLettersResponse
+ */
+export class PurgeDeadLettersResponse extends Message<PurgeDeadLettersResponse> {
+  /**
+   * @generated from field: int32 count = 1;
+   */
+  count = 0;
+
+  constructor(data?: PartialMessage<PurgeDeadLettersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.PurgeDeadLettersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PurgeDeadLettersResponse {
+    return new PurgeDeadLettersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PurgeDeadLettersResponse {
+    return new PurgeDeadLettersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PurgeDeadLettersResponse {
+    return new PurgeDeadLettersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PurgeDeadLettersResponse | PlainMessage<PurgeDeadLettersResponse> | undefined, b: PurgeDeadLettersResponse | PlainMessage<PurgeDeadLettersResponse> | undefined): boolean {
+    return proto3.util.equals(PurgeDeadLettersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.FSMInstance
+ */
+export class FSMInstance extends Message<FSMInstance> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref fsm = 1;
+   */
+  fsm?: Ref;
+
+  /**
+   * @generated from field: string key = 2;
+   */
+  key = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.FSMStatus status = 3;
+   */
+  status = FSMStatus.FSM_STATUS_RUNNING;
+
+  /**
+   * Unset until the instance has completed its first transition.
+   *
+   * @generated from field: optional xyz.block.ftl.v1.schema.Ref current_state = 4;
+   */
+  currentState?: Ref;
+
+  /**
+   * Set while a transition is executing.
+   *
+   * @generated from field: optional xyz.block.ftl.v1.schema.Ref destination_state = 5;
+   */
+  destinationState?: Ref;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 7;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * Number of events waiting for the executing transition to complete.
+   *
+   * @generated from field: int64 queued_events = 8;
+   */
+  queuedEvents = protoInt64.zero;
+
+  constructor(data?: PartialMessage<FSMInstance>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.FSMInstance";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "fsm", kind: "message", T: Ref },
+    { no: 2, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status", kind: "enum", T: proto3.getEnumType(FSMStatus) },
+    { no: 4, name: "current_state", kind: "message", T: Ref, opt: true },
+    { no: 5, name: "destination_state", kind: "message", T: Ref, opt: true },
+    { no: 6, name: "created_at", kind: "message", T: Timestamp },
+    { no: 7, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 8, name: "queued_events", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FSMInstance {
+    return new FSMInstance().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FSMInstance {
+    return new FSMInstance().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FSMInstance {
+    return new FSMInstance().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FSMInstance | PlainMessage<FSMInstance> | undefined, b: FSMInstance | PlainMessage<FSMInstance> | undefined): boolean {
+    return proto3.util.equals(FSMInstance, a, b);
+  }
+}
+
+/**
+ * An attempt to execute a transition of an FSM instance.
+ *
+ * @generated from message xyz.block.ftl.v1.FSMTransitionAttempt
+ */
+export class FSMTransitionAttempt extends Message<FSMTransitionAttempt> {
+  /**
+   * The destination state of the transition.
+   *
+   * @generated from field: xyz.block.ftl.v1.schema.Ref state = 1;
+   */
+  state?: Ref;
+
+  /**
+   * One of "pending", "executing", "success" or "error".
+   *
+   * @generated from field: string status = 2;
+   */
+  status = "";
+
+  /**
+   * @generated from field: optional string error = 3;
+   */
+  error?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * JSON encoded event.
+   *
+   * @generated from field: bytes event = 5;
+   */
+  event = new Uint8Array(0);
+
+  /**
+   * True if this attempt is executing the catch verb of the transition.
+   *
+   * @generated from field: bool catching = 6;
+   */
+  catching = false;
+
+  constructor(data?: PartialMessage<FSMTransitionAttempt>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.FSMTransitionAttempt";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "state", kind: "message", T: Ref },
+    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "created_at", kind: "message", T: Timestamp },
+    { no: 5, name: "event", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 6, name: "catching", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FSMTransitionAttempt {
+    return new FSMTransitionAttempt().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FSMTransitionAttempt {
+    return new FSMTransitionAttempt().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FSMTransitionAttempt {
+    return new FSMTransitionAttempt().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FSMTransitionAttempt | PlainMessage<FSMTransitionAttempt> | undefined, b: FSMTransitionAttempt | PlainMessage<FSMTransitionAttempt> | undefined): boolean {
+    return proto3.util.equals(FSMTransitionAttempt, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListFSMInstancesRequest
+ */
+export class ListFSMInstancesRequest extends Message<ListFSMInstancesRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref fsm = 1;
+   */
+  fsm?: Ref;
+
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.FSMStatus status = 2;
+   */
+  status?: FSMStatus;
+
+  /**
+   * Only return instances in this state.
+   *
+   * @generated from field: optional xyz.block.ftl.v1.schema.Ref state = 3;
+   */
+  state?: Ref;
+
+  /**
+   * @generated from field: optional int32 limit = 4;
+   */
+  limit?: number;
+
+  constructor(data?: PartialMessage<ListFSMInstancesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListFSMInstancesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "fsm", kind: "message", T: Ref },
+    { no: 2, name: "status", kind: "enum", T: proto3.getEnumType(FSMStatus), opt: true },
+    { no: 3, name: "state", kind: "message", T: Ref, opt: true },
+    { no: 4, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFSMInstancesRequest {
+    return new ListFSMInstancesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFSMInstancesRequest {
+    return new ListFSMInstancesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFSMInstancesRequest {
+    return new ListFSMInstancesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListFSMInstancesRequest | PlainMessage<ListFSMInstancesRequest> | undefined, b: ListFSMInstancesRequest | PlainMessage<ListFSMInstancesRequest> | undefined): boolean {
+    return proto3.util.equals(ListFSMInstancesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListFSMInstancesResponse
+ */
+export class ListFSMInstancesResponse extends Message<ListFSMInstancesResponse> {
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.FSMInstance instances = 1;
+   */
+  instances: FSMInstance[] = [];
+
+  constructor(data?: PartialMessage<ListFSMInstancesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListFSMInstancesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instances", kind: "message", T: FSMInstance, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFSMInstancesResponse {
+    return new ListFSMInstancesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFSMInstancesResponse {
+    return new ListFSMInstancesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFSMInstancesResponse {
+    return new ListFSMInstancesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListFSMInstancesResponse | PlainMessage<ListFSMInstancesResponse> | undefined, b: ListFSMInstancesResponse | PlainMessage<ListFSMInstancesResponse> | undefined): boolean {
+    return proto3.util.equals(ListFSMInstancesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetFSMInstanceRequest
+ */
+export class GetFSMInstanceRequest extends Message<GetFSMInstanceRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref fsm = 1;
+   */
+  fsm?: Ref;
+
+  /**
+   * @generated from field: string instance = 2;
+   */
+  instance = "";
+
+  constructor(data?: PartialMessage<GetFSMInstanceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetFSMInstanceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "fsm", kind: "message", T: Ref },
+    { no: 2, name: "instance", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetFSMInstanceRequest {
+    return new GetFSMInstanceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetFSMInstanceRequest {
+    return new GetFSMInstanceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetFSMInstanceRequest {
+    return new GetFSMInstanceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetFSMInstanceRequest | PlainMessage<GetFSMInstanceRequest> | undefined, b: GetFSMInstanceRequest | PlainMessage<GetFSMInstanceRequest> | undefined): boolean {
+    return proto3.util.equals(GetFSMInstanceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetFSMInstanceResponse
+ */
+export class GetFSMInstanceResponse extends Message<GetFSMInstanceResponse> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.FSMInstance instance = 1;
+   */
+  instance?: FSMInstance;
+
+  /**
+   * Every transition attempt of the instance, oldest first.
+   *
+   * @generated from field: repeated xyz.block.ftl.v1.FSMTransitionAttempt history = 2;
+   */
+  history: FSMTransitionAttempt[] = [];
+
+  constructor(data?: PartialMessage<GetFSMInstanceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetFSMInstanceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance", kind: "message", T: FSMInstance },
+    { no: 2, name: "history", kind: "message", T: FSMTransitionAttempt, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetFSMInstanceResponse {
+    return new GetFSMInstanceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetFSMInstanceResponse {
+    return new GetFSMInstanceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetFSMInstanceResponse {
+    return new GetFSMInstanceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetFSMInstanceResponse | PlainMessage<GetFSMInstanceResponse> | undefined, b: GetFSMInstanceResponse | PlainMessage<GetFSMInstanceResponse> | undefined): boolean {
+    return proto3.util.equals(GetFSMInstanceResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ScheduledCall
+ */
+export class ScheduledCall extends Message<ScheduledCall> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 1;
+   */
+  verb?: Ref;
+
+  /**
+   * @generated from field: string key = 2;
+   */
+  key = "";
+
+  /**
+   * "pending" or "executing".
+   *
+   * @generated from field: string state = 3;
+   */
+  state = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp scheduled_at = 4;
+   */
+  scheduledAt?: Timestamp;
+
+  /**
+   * @generated from field: int32 remaining_attempts = 5;
+   */
+  remainingAttempts = 0;
+
+  /**
+   * The error of the previous attempt, if it failed.
+   *
+   * @generated from field: optional string error = 6;
+   */
+  error?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<ScheduledCall>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ScheduledCall";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "verb", kind: "message", T: Ref },
+    { no: 2, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "scheduled_at", kind: "message", T: Timestamp },
+    { no: 5, name: "remaining_attempts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "created_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScheduledCall {
+    return new ScheduledCall().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScheduledCall {
+    return new ScheduledCall().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScheduledCall {
+    return new ScheduledCall().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ScheduledCall | PlainMessage<ScheduledCall> | undefined, b: ScheduledCall | PlainMessage<ScheduledCall> | undefined): boolean {
+    return proto3.util.equals(ScheduledCall, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListScheduledCallsRequest
+ */
+export class ListScheduledCallsRequest extends Message<ListScheduledCallsRequest> {
+  /**
+   * Only list calls to this verb.
+   *
+   * @generated from field: optional xyz.block.ftl.v1.schema.Ref verb = 1;
+   */
+  verb?: Ref;
+
+  /**
+   * @generated from field: optional int32 limit = 2;
+   */
+  limit?: number;
+
+  constructor(data?: PartialMessage<ListScheduledCallsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListScheduledCallsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "verb", kind: "message", T: Ref, opt: true },
+    { no: 2, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListScheduledCallsRequest {
+    return new ListScheduledCallsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListScheduledCallsRequest {
+    return new ListScheduledCallsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListScheduledCallsRequest {
+    return new ListScheduledCallsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListScheduledCallsRequest | PlainMessage<ListScheduledCallsRequest> | undefined, b: ListScheduledCallsRequest | PlainMessage<ListScheduledCallsRequest> | undefined): boolean {
+    return proto3.util.equals(ListScheduledCallsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListScheduledCallsResponse
+ */
+export class ListScheduledCallsResponse extends Message<ListScheduledCallsResponse> {
+  /**
+   * Soonest first.
+   *
+   * @generated from field: repeated xyz.block.ftl.v1.ScheduledCall calls = 1;
+   */
+  calls: ScheduledCall[] = [];
+
+  constructor(data?: PartialMessage<ListScheduledCallsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListScheduledCallsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "calls", kind: "message", T: ScheduledCall, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListScheduledCallsResponse {
+    return new ListScheduledCallsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListScheduledCallsResponse {
+    return new ListScheduledCallsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListScheduledCallsResponse {
+    return new ListScheduledCallsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListScheduledCallsResponse | PlainMessage<ListScheduledCallsResponse> | undefined, b: ListScheduledCallsResponse | PlainMessage<ListScheduledCallsResponse> | undefined): boolean {
+    return proto3.util.equals(ListScheduledCallsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.CancelScheduledCallRequest
+ */
+export class CancelScheduledCallRequest extends Message<CancelScheduledCallRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 1;
+   */
+  verb?: Ref;
+
+  /**
+   * @generated from field: string key = 2;
+   */
+  key = "";
+
+  constructor(data?: PartialMessage<CancelScheduledCallRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.CancelScheduledCallRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "verb", kind: "message", T: Ref },
+    { no: 2, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelScheduledCallRequest {
+    return new CancelScheduledCallRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelScheduledCallRequest {
+    return new CancelScheduledCallRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelScheduledCallRequest {
+    return new CancelScheduledCallRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelScheduledCallRequest | PlainMessage<CancelScheduledCallRequest> | undefined, b: CancelScheduledCallRequest | PlainMessage<CancelScheduledCallRequest> | undefined): boolean {
+    return proto3.util.equals(CancelScheduledCallRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.CancelScheduledCallResponse
+ */
+export class CancelScheduledCallResponse extends Message<CancelScheduledCallResponse> {
+  constructor(data?: PartialMessage<CancelScheduledCallResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.CancelScheduledCallResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelScheduledCallResponse {
+    return new CancelScheduledCallResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelScheduledCallResponse {
+    return new CancelScheduledCallResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelScheduledCallResponse {
+    return new CancelScheduledCallResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelScheduledCallResponse | PlainMessage<CancelScheduledCallResponse> | undefined, b: CancelScheduledCallResponse | PlainMessage<CancelScheduledCallResponse> | undefined): boolean {
+    return proto3.util.equals(CancelScheduledCallResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.CronJob
+ */
+export class CronJob extends Message<CronJob> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 1;
+   */
+  verb?: Ref;
+
+  /**
+   * @generated from field: string deployment_key = 2;
+   */
+  deploymentKey = "";
+
+  /**
+   * @generated from field: string schedule = 3;
+   */
+  schedule = "";
+
+  /**
+   * "idle" or "executing".
+   *
+   * @generated from field: string state = 4;
+   */
+  state = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp next_execution = 5;
+   */
+  nextExecution?: Timestamp;
+
+  /**
+   * Unset if the job has not executed in the current deployment.
+   *
+   * @generated from field: optional google.protobuf.Timestamp last_execution = 6;
+   */
+  lastExecution?: Timestamp;
+
+  /**
+   * Set if the job has been paused.
+   *
+   * @generated from field: optional google.protobuf.Timestamp paused_at = 7;
+   */
+  pausedAt?: Timestamp;
+
+  /**
+   * The IANA timezone the schedule is evaluated in.
+   *
+   * @generated from field: string timezone = 8;
+   */
+  timezone = "";
+
+  /**
+   * What happens to missed executions: "skip", "once" or "all".
+   *
+   * @generated from field: string missed_runs = 9;
+   */
+  missedRuns = "";
+
+  constructor(data?: PartialMessage<CronJob>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.CronJob";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "verb", kind: "message", T: Ref },
+    { no: 2, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "schedule", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "next_execution", kind: "message", T: Timestamp },
+    { no: 6, name: "last_execution", kind: "message", T: Timestamp, opt: true },
+    { no: 7, name: "paused_at", kind: "message", T: Timestamp, opt: true },
+    { no: 8, name: "timezone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "missed_runs", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CronJob {
+    return new CronJob().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CronJob {
+    return new CronJob().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CronJob {
+    return new CronJob().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CronJob | PlainMessage<CronJob> | undefined, b: CronJob | PlainMessage<CronJob> | undefined): boolean {
+    return proto3.util.equals(CronJob, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListCronJobsRequest
+ */
+export class ListCronJobsRequest extends Message<ListCronJobsRequest> {
+  constructor(data?: PartialMessage<ListCronJobsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListCronJobsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCronJobsRequest {
+    return new ListCronJobsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCronJobsRequest {
+    return new ListCronJobsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCronJobsRequest {
+    return new ListCronJobsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListCronJobsRequest | PlainMessage<ListCronJobsRequest> | undefined, b: ListCronJobsRequest | PlainMessage<ListCronJobsRequest> | undefined): boolean {
+    return proto3.util.equals(ListCronJobsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListCronJobsResponse
+ */
+export class ListCronJobsResponse extends Message<ListCronJobsResponse> {
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.CronJob jobs = 1;
+   */
+  jobs: CronJob[] = [];
+
+  constructor(data?: PartialMessage<ListCronJobsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListCronJobsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "jobs", kind: "message", T: CronJob, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCronJobsResponse {
+    return new ListCronJobsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCronJobsResponse {
+    return new ListCronJobsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCronJobsResponse {
+    return new ListCronJobsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListCronJobsResponse | PlainMessage<ListCronJobsResponse> | undefined, b: ListCronJobsResponse | PlainMessage<ListCronJobsResponse> | undefined): boolean {
+    return proto3.util.equals(ListCronJobsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.TriggerCronJobRequest
+ */
+export class TriggerCronJobRequest extends Message<TriggerCronJobRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 1;
+   */
+  verb?: Ref;
+
+  constructor(data?: PartialMessage<TriggerCronJobRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.TriggerCronJobRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "verb", kind: "message", T: Ref },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerCronJobRequest {
+    return new TriggerCronJobRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerCronJobRequest {
+    return new TriggerCronJobRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerCronJobRequest {
+    return new TriggerCronJobRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TriggerCronJobRequest | PlainMessage<TriggerCronJobRequest> | undefined, b: TriggerCronJobRequest | PlainMessage<TriggerCronJobRequest> | undefined): boolean {
+    return proto3.util.equals(TriggerCronJobRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.TriggerCronJobResponse
+ */
+export class TriggerCronJobResponse extends Message<TriggerCronJobResponse> {
+  constructor(data?: PartialMessage<TriggerCronJobResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.TriggerCronJobResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerCronJobResponse {
+    return new TriggerCronJobResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerCronJobResponse {
+    return new TriggerCronJobResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerCronJobResponse {
+    return new TriggerCronJobResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TriggerCronJobResponse | PlainMessage<TriggerCronJobResponse> | undefined, b: TriggerCronJobResponse | PlainMessage<TriggerCronJobResponse> | undefined): boolean {
+    return proto3.util.equals(TriggerCronJobResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.PauseCronJobRequest
+ */
+export class PauseCronJobRequest extends Message<PauseCronJobRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 1;
+   */
+  verb?: Ref;
+
+  constructor(data?: PartialMessage<PauseCronJobRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.PauseCronJobRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "verb", kind: "message", T: Ref },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PauseCronJobRequest {
+    return new PauseCronJobRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PauseCronJobRequest {
+    return new PauseCronJobRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PauseCronJobRequest {
+    return new PauseCronJobRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PauseCronJobRequest | PlainMessage<PauseCronJobRequest> | undefined, b: PauseCronJobRequest | PlainMessage<PauseCronJobRequest> | undefined): boolean {
+    return proto3.util.equals(PauseCronJobRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.PauseCronJobResponse
+ */
+export class PauseCronJobResponse extends Message<PauseCronJobResponse> {
+  constructor(data?: PartialMessage<PauseCronJobResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.PauseCronJobResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PauseCronJobResponse {
+    return new PauseCronJobResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PauseCronJobResponse {
+    return new PauseCronJobResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PauseCronJobResponse {
+    return new PauseCronJobResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PauseCronJobResponse | PlainMessage<PauseCronJobResponse> | undefined, b: PauseCronJobResponse | PlainMessage<PauseCronJobResponse> | undefined): boolean {
+    return proto3.util.equals(PauseCronJobResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ResumeCronJobRequest
+ */
+export class ResumeCronJobRequest extends Message<ResumeCronJobRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 1;
+   */
+  verb?: Ref;
+
+  constructor(data?: PartialMessage<ResumeCronJobRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ResumeCronJobRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "verb", kind: "message", T: Ref },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResumeCronJobRequest {
+    return new ResumeCronJobRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResumeCronJobRequest {
+    return new ResumeCronJobRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResumeCronJobRequest {
+    return new ResumeCronJobRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResumeCronJobRequest | PlainMessage<ResumeCronJobRequest> | undefined, b: ResumeCronJobRequest | PlainMessage<ResumeCronJobRequest> | undefined): boolean {
+    return proto3.util.equals(ResumeCronJobRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ResumeCronJobResponse
+ */
+export class ResumeCronJobResponse extends Message<ResumeCronJobResponse> {
+  constructor(data?: PartialMessage<ResumeCronJobResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ResumeCronJobResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResumeCronJobResponse {
+    return new ResumeCronJobResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResumeCronJobResponse {
+    return new ResumeCronJobResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResumeCronJobResponse {
+    return new ResumeCronJobResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResumeCronJobResponse | PlainMessage<ResumeCronJobResponse> | undefined, b: ResumeCronJobResponse | PlainMessage<ResumeCronJobResponse> | undefined): boolean {
+    return proto3.util.equals(ResumeCronJobResponse, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.DeployRequest
  */
@@ -783,6 +783,11 @@ export class FSMTransition extends Message<FSMTransition> {
    */
   to?: Ref;
 
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.schema.Metadata metadata = 5;
+   */
+  metadata: Metadata[] = [];
+
   constructor(data?: PartialMessage<FSMTransition>) {
     super();
     proto3.util.initPartial(data, this);
@@ -795,6 +800,7 @@ export class FSMTransition extends Message<FSMTransition> {
     { no: 2, name: "comments", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 3, name: "from", kind: "message", T: Ref, opt: true },
     { no: 4, name: "to", kind: "message", T: Ref },
+    { no: 5, name: "metadata", kind: "message", T: Metadata, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FSMTransition {
@@ -1232,6 +1238,54 @@ export class Metadata extends Message<Metadata> {
      */
     value: MetadataTypeMap;
     case: "typeMap";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataTimeout timeout = 9;
+     */
+    value: MetadataTimeout;
+    case: "timeout";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataRateLimit rateLimit = 10;
+     */
+    value: MetadataRateLimit;
+    case: "rateLimit";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataConcurrency concurrency = 11;
+     */
+    value: MetadataConcurrency;
+    case: "concurrency";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataQueue queue = 12;
+     */
+    value: MetadataQueue;
+    case: "queue";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataMigrate migrate = 13;
+     */
+    value: MetadataMigrate;
+    case: "migrate";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataCompensate compensate = 14;
+     */
+    value: MetadataCompensate;
+    case: "compensate";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataAuth auth = 15;
+     */
+    value: MetadataAuth;
+    case: "auth";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataRecursive recursive = 16;
+     */
+    value: MetadataRecursive;
+    case: "recursive";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<Metadata>) {
@@ -1250,6 +1304,14 @@ export class Metadata extends Message<Metadata> {
     { no: 6, name: "retry", kind: "message", T: MetadataRetry, oneof: "value" },
     { no: 7, name: "subscriber", kind: "message", T: MetadataSubscriber, oneof: "value" },
     { no: 8, name: "typeMap", kind: "message", T: MetadataTypeMap, oneof: "value" },
+    { no: 9, name: "timeout", kind: "message", T: MetadataTimeout, oneof: "value" },
+    { no: 10, name: "rateLimit", kind: "message", T: MetadataRateLimit, oneof: "value" },
+    { no: 11, name: "concurrency", kind: "message", T: MetadataConcurrency, oneof: "value" },
+    { no: 12, name: "queue", kind: "message", T: MetadataQueue, oneof: "value" },
+    { no: 13, name: "migrate", kind: "message", T: MetadataMigrate, oneof: "value" },
+    { no: 14, name: "compensate", kind: "message", T: MetadataCompensate, oneof: "value" },
+    { no: 15, name: "auth", kind: "message", T: MetadataAuth, oneof: "value" },
+    { no: 16, name: "recursive", kind: "message", T: MetadataRecursive, oneof: "value" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Metadata {
@@ -1318,6 +1380,79 @@ export class MetadataAlias extends Message<MetadataAlias> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataAuth
+ */
+export class MetadataAuth extends Message<MetadataAuth> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: string scheme = 2;
+   */
+  scheme = "";
+
+  /**
+   * @generated from field: string issuer = 3;
+   */
+  issuer = "";
+
+  /**
+   * @generated from field: string audience = 4;
+   */
+  audience = "";
+
+  /**
+   * @generated from field: string jwks = 5;
+   */
+  jwks = "";
+
+  /**
+   * @generated from field: string header = 6;
+   */
+  header = "";
+
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Ref secret = 7;
+   */
+  secret?: Ref;
+
+  constructor(data?: PartialMessage<MetadataAuth>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataAuth";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "scheme", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "issuer", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "audience", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "jwks", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "header", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "secret", kind: "message", T: Ref, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataAuth {
+    return new MetadataAuth().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataAuth {
+    return new MetadataAuth().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataAuth {
+    return new MetadataAuth().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataAuth | PlainMessage<MetadataAuth> | undefined, b: MetadataAuth | PlainMessage<MetadataAuth> | undefined): boolean {
+    return proto3.util.equals(MetadataAuth, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.MetadataCalls
  */
@@ -1361,6 +1496,98 @@ export class MetadataCalls extends Message<MetadataCalls> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataCompensate
+ */
+export class MetadataCompensate extends Message<MetadataCompensate> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref state = 2;
+   */
+  state?: Ref;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 3;
+   */
+  verb?: Ref;
+
+  constructor(data?: PartialMessage<MetadataCompensate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataCompensate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "state", kind: "message", T: Ref },
+    { no: 3, name: "verb", kind: "message", T: Ref },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataCompensate {
+    return new MetadataCompensate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataCompensate {
+    return new MetadataCompensate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataCompensate {
+    return new MetadataCompensate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataCompensate | PlainMessage<MetadataCompensate> | undefined, b: MetadataCompensate | PlainMessage<MetadataCompensate> | undefined): boolean {
+    return proto3.util.equals(MetadataCompensate, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataConcurrency
+ */
+export class MetadataConcurrency extends Message<MetadataConcurrency> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: int64 limit = 2;
+   */
+  limit = protoInt64.zero;
+
+  constructor(data?: PartialMessage<MetadataConcurrency>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataConcurrency";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "limit", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataConcurrency {
+    return new MetadataConcurrency().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataConcurrency {
+    return new MetadataConcurrency().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataConcurrency {
+    return new MetadataConcurrency().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataConcurrency | PlainMessage<MetadataConcurrency> | undefined, b: MetadataConcurrency | PlainMessage<MetadataConcurrency> | undefined): boolean {
+    return proto3.util.equals(MetadataConcurrency, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.MetadataCronJob
  */
@@ -1375,6 +1602,16 @@ export class MetadataCronJob extends Message<MetadataCronJob> {
    */
   cron = "";
 
+  /**
+   * @generated from field: string timezone = 3;
+   */
+  timezone = "";
+
+  /**
+   * @generated from field: string missed = 4;
+   */
+  missed = "";
+
   constructor(data?: PartialMessage<MetadataCronJob>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1385,6 +1622,8 @@ export class MetadataCronJob extends Message<MetadataCronJob> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "pos", kind: "message", T: Position, opt: true },
     { no: 2, name: "cron", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "timezone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "missed", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataCronJob {
@@ -1502,6 +1741,190 @@ export class MetadataIngress extends Message<MetadataIngress> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataMigrate
+ */
+export class MetadataMigrate extends Message<MetadataMigrate> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref from = 2;
+   */
+  from?: Ref;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref to = 3;
+   */
+  to?: Ref;
+
+  constructor(data?: PartialMessage<MetadataMigrate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataMigrate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "from", kind: "message", T: Ref },
+    { no: 3, name: "to", kind: "message", T: Ref },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataMigrate {
+    return new MetadataMigrate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataMigrate {
+    return new MetadataMigrate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataMigrate {
+    return new MetadataMigrate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataMigrate | PlainMessage<MetadataMigrate> | undefined, b: MetadataMigrate | PlainMessage<MetadataMigrate> | undefined): boolean {
+    return proto3.util.equals(MetadataMigrate, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataQueue
+ */
+export class MetadataQueue extends Message<MetadataQueue> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: int64 depth = 2;
+   */
+  depth = protoInt64.zero;
+
+  /**
+   * @generated from field: bool dedupe = 3;
+   */
+  dedupe = false;
+
+  constructor(data?: PartialMessage<MetadataQueue>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataQueue";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "depth", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "dedupe", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataQueue {
+    return new MetadataQueue().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataQueue {
+    return new MetadataQueue().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataQueue {
+    return new MetadataQueue().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataQueue | PlainMessage<MetadataQueue> | undefined, b: MetadataQueue | PlainMessage<MetadataQueue> | undefined): boolean {
+    return proto3.util.equals(MetadataQueue, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataRateLimit
+ */
+export class MetadataRateLimit extends Message<MetadataRateLimit> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: int64 limit = 2;
+   */
+  limit = protoInt64.zero;
+
+  /**
+   * @generated from field: string period = 3;
+   */
+  period = "";
+
+  constructor(data?: PartialMessage<MetadataRateLimit>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataRateLimit";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "limit", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "period", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataRateLimit {
+    return new MetadataRateLimit().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataRateLimit {
+    return new MetadataRateLimit().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataRateLimit {
+    return new MetadataRateLimit().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataRateLimit | PlainMessage<MetadataRateLimit> | undefined, b: MetadataRateLimit | PlainMessage<MetadataRateLimit> | undefined): boolean {
+    return proto3.util.equals(MetadataRateLimit, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataRecursive
+ */
+export class MetadataRecursive extends Message<MetadataRecursive> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  constructor(data?: PartialMessage<MetadataRecursive>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataRecursive";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataRecursive {
+    return new MetadataRecursive().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataRecursive {
+    return new MetadataRecursive().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataRecursive {
+    return new MetadataRecursive().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataRecursive | PlainMessage<MetadataRecursive> | undefined, b: MetadataRecursive | PlainMessage<MetadataRecursive> | undefined): boolean {
+    return proto3.util.equals(MetadataRecursive, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.MetadataRetry
  */
@@ -1577,6 +2000,16 @@ export class MetadataSubscriber extends Message<MetadataSubscriber> {
    */
   name = "";
 
+  /**
+   * @generated from field: optional int64 batchSize = 3;
+   */
+  batchSize?: bigint;
+
+  /**
+   * @generated from field: string batchWait = 4;
+   */
+  batchWait = "";
+
   constructor(data?: PartialMessage<MetadataSubscriber>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1587,6 +2020,8 @@ export class MetadataSubscriber extends Message<MetadataSubscriber> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "pos", kind: "message", T: Position, opt: true },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "batchSize", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 4, name: "batchWait", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataSubscriber {
@@ -1606,6 +2041,49 @@ export class MetadataSubscriber extends Message<MetadataSubscriber> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataTimeout
+ */
+export class MetadataTimeout extends Message<MetadataTimeout> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: string duration = 2;
+   */
+  duration = "";
+
+  constructor(data?: PartialMessage<MetadataTimeout>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataTimeout";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "duration", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataTimeout {
+    return new MetadataTimeout().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataTimeout {
+    return new MetadataTimeout().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataTimeout {
+    return new MetadataTimeout().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataTimeout | PlainMessage<MetadataTimeout> | undefined, b: MetadataTimeout | PlainMessage<MetadataTimeout> | undefined): boolean {
+    return proto3.util.equals(MetadataTimeout, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.MetadataTypeMap
  */
@@ -2071,6 +2549,11 @@ export class Subscription extends Message<Subscription> {
    */
   topic?: Ref;
 
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Ref deadLetter = 5;
+   */
+  deadLetter?: Ref;
+
   constructor(data?: PartialMessage<Subscription>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2083,6 +2566,7 @@ export class Subscription extends Message<Subscription> {
     { no: 2, name: "comments", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "topic", kind: "message", T: Ref },
+    { no: 5, name: "deadLetter", kind: "message", T: Ref, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Subscription {
@@ -2168,6 +2652,11 @@ export class Topic extends Message<Topic> {
    */
   event?: Type;
 
+  /**
+   * @generated from field: int64 partitions = 6;
+   */
+  partitions = protoInt64.zero;
+
   constructor(data?: PartialMessage<Topic>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2181,6 +2670,7 @@ export class Topic extends Message<Topic> {
     { no: 3, name: "export", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 4, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "event", kind: "message", T: Type },
+    { no: 6, name: "partitions", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Topic {
@@ -2611,6 +3101,11 @@ export class Verb extends Message<Verb> {
    */
   metadata: Metadata[] = [];
 
+  /**
+   * @generated from field: bool stream = 8;
+   */
+  stream = false;
+
   constructor(data?: PartialMessage<Verb>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2627,6 +3122,7 @@ export class Verb extends Message<Verb> {
     { no: 5, name: "request", kind: "message", T: Type },
     { no: 6, name: "response", kind: "message", T: Type },
     { no: 7, name: "metadata", kind: "message", T: Metadata, repeated: true },
+    { no: 8, name: "stream", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Verb {
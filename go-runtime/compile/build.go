@@ -54,6 +54,7 @@ type goVerb struct {
 	MustImport  string
 	HasRequest  bool
 	HasResponse bool
+	IsStream    bool
 }
 
 type mainModuleContext struct {
@@ -203,6 +204,7 @@ func Build(ctx context.Context, projectRootDir, moduleDir string, sch *schema.Sc
 		if _, ok := verb.Response.(*schema.Unit); !ok {
 			goverb.HasResponse = true
 		}
+		goverb.IsStream = verb.Stream
 		goVerbs = append(goVerbs, goverb)
 	}
 	localExternalTypes, err := getLocalExternalTypes(result.Module)
@@ -420,6 +422,11 @@ var scaffoldFuncs = scaffolder.FuncMap{
 					imports["github.com/TBD54566975/ftl/go-runtime/ftl"] = ""
 				}
 
+			case *schema.Verb:
+				if n.IsExported() && n.Stream {
+					imports["github.com/TBD54566975/ftl/go-runtime/ftl"] = ""
+				}
+
 			case *schema.TypeAlias:
 				if n.IsExported() {
 					if im, _ := getGoExternalTypeForWidenedType(n); im != "" {
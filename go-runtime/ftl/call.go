@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"connectrpc.com/connect"
 
@@ -87,6 +88,30 @@ func CallEmpty(ctx context.Context, empty Empty) error {
 	return err
 }
 
+// Deadline returns the deadline of the current call chain, if any.
+//
+// Deadlines are set by ingress or by a verb's //ftl:timeout directive and are
+// propagated to every downstream call. The context passed to a verb is
+// cancelled once the deadline passes.
+func Deadline(ctx context.Context) Option[time.Time] {
+	if deadline, ok := ctx.Deadline(); ok {
+		return Some(deadline)
+	}
+	return None[time.Time]()
+}
+
+// IdempotencyKey returns the idempotency key the current verb was called with, if any.
+//
+// Keys are provided via the Idempotency-Key header on ingress requests. A
+// duplicate call with the same key receives the response of the first call
+// without the verb being executed again.
+func IdempotencyKey(ctx context.Context) Option[string] {
+	if key, ok := rpc.IdempotencyKeyFromContext(ctx).Get(); ok {
+		return Some(key)
+	}
+	return None[string]()
+}
+
 func widenVerb[Req, Resp any](verb Verb[Req, Resp]) Verb[any, any] {
 	return func(ctx context.Context, uncheckedReq any) (any, error) {
 		req, ok := uncheckedReq.(Req)
@@ -3,6 +3,7 @@ package ftl
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
 	"github.com/TBD54566975/ftl/go-runtime/internal"
@@ -13,10 +14,12 @@ type FSMHandle struct {
 }
 
 type FSMTransition struct {
-	fromFunc reflect.Value
-	from     reflection.Ref
-	toFunc   reflect.Value
-	to       reflection.Ref
+	fromFunc     reflect.Value
+	from         reflection.Ref
+	toFunc       reflect.Value
+	to           reflection.Ref
+	timeout      time.Duration
+	compensation bool
 }
 
 // Start specifies a start state in an FSM.
@@ -39,11 +42,48 @@ func Transition[FromIn, ToIn any](from Sink[FromIn], to Sink[ToIn]) FSMTransitio
 	}
 }
 
+// Timeout specifies a transition in an FSM that is taken when an instance has
+// been in the "from" state for the given duration.
+//
+// The event that moved the instance into the "from" state is passed to the "to"
+// state. The timeout is cancelled if any other transition out of the "from"
+// state starts first. The duration must be a constant whole number of seconds.
+func Timeout[In any](from Sink[In], to Sink[In], after time.Duration) FSMTransition {
+	return FSMTransition{
+		fromFunc: reflect.ValueOf(from),
+		from:     reflection.FuncRef(from),
+		toFunc:   reflect.ValueOf(to),
+		to:       reflection.FuncRef(to),
+		timeout:  after,
+	}
+}
+
+// Compensate specifies the verb that undoes the work of a state in an FSM.
+//
+// If a state verb of an instance fails after exhausting its retries, the
+// compensations of the states the instance completed are called in reverse
+// order, each with the event that moved the instance into the state.
+// Compensations are retried with the retry policy of the FSM.
+func Compensate[In any](state Sink[In], compensation Sink[In]) FSMTransition {
+	return FSMTransition{
+		fromFunc:     reflect.ValueOf(state),
+		from:         reflection.FuncRef(state),
+		toFunc:       reflect.ValueOf(compensation),
+		to:           reflection.FuncRef(compensation),
+		compensation: true,
+	}
+}
+
 // FSM creates a new finite-state machine.
 func FSM(name string, transitions ...FSMTransition) *FSMHandle {
 	rtransitions := make([]reflection.Transition, len(transitions))
 	for i, transition := range transitions {
-		rtransitions[i] = reflection.Transition{From: transition.fromFunc, To: transition.toFunc}
+		rtransitions[i] = reflection.Transition{
+			From:         transition.fromFunc,
+			To:           transition.toFunc,
+			Timeout:      transition.timeout,
+			Compensation: transition.compensation,
+		}
 	}
 	reflection.Register(reflection.FSM(name, rtransitions...))
 	return &FSMHandle{name: name}
@@ -55,7 +95,19 @@ func FSM(name string, transitions ...FSMTransition) *FSMHandle {
 // be valid for the current state of the FSM instance.
 //
 // If the FSM instance is not executing, a new one will be started. If the event
-// is not valid for the current state, an error will be returned.
+// is not valid for the current state, an error will be returned. If the FSM
+// instance is executing a transition, the event is queued until the transition
+// completes.
 func (f *FSMHandle) Send(ctx context.Context, instance string, event any) error {
 	return internal.FromContext(ctx).FSMSend(ctx, f.name, instance, event)
 }
+
+// FSMNext sets the next event of the FSM instance whose transition is executing.
+//
+// It must be called from a state verb of the FSM. The event is sent to the
+// instance as part of completing the transition, ahead of any queued events,
+// and is dropped if the state verb fails. The event must be valid for the state
+// being transitioned to.
+func FSMNext(ctx context.Context, event any) error {
+	return internal.FromContext(ctx).FSMNext(ctx, event)
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/alecthomas/types/optional"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/TBD54566975/ftl/common/configuration"
 	"github.com/TBD54566975/ftl/go-runtime/ftl"
 	"github.com/TBD54566975/ftl/go-runtime/internal"
+	"github.com/TBD54566975/ftl/internal/model"
 )
 
 // pubSubEvent is a sum type for all events that can be published to the pubsub system.
@@ -26,8 +29,9 @@ type pubSubEvent interface {
 
 // publishEvent holds an event to be published to a topic
 type publishEvent struct {
-	topic   *schema.Ref
-	content any
+	topic        *schema.Ref
+	partitionKey optional.Option[string]
+	content      any
 }
 
 func (publishEvent) pubSubEvent() {}
@@ -41,14 +45,20 @@ type subscriptionDidConsumeEvent struct {
 func (subscriptionDidConsumeEvent) pubSubEvent() {}
 
 type subscription struct {
-	name        string
-	topic       *schema.Ref
-	cursor      optional.Option[int]
+	name  string
+	topic *schema.Ref
+	// batchSize is the maximum number of events delivered to each call of a
+	// subscriber, or 0 if subscribers consume one event at a time.
+	batchSize int
+	// cursor is the index of the last event delivered to a subscriber.
+	cursor optional.Option[int]
+	// batchStart is the index of the first event delivered with the cursor.
+	batchStart  int
 	isExecuting bool
 	errors      map[int]error
 }
 
-type subscriber func(context.Context, any) error
+type subscriber func(ctx context.Context, events []any) error
 
 type fakeFTL struct {
 	fsm *fakeFSMManager
@@ -58,6 +68,10 @@ type fakeFTL struct {
 	configValues  map[string][]byte
 	secretValues  map[string][]byte
 	pubSub        *fakePubSub
+
+	webSocketLock sync.Mutex
+	// Messages sent to each WebSocket connection, in the order they were sent.
+	webSocketMessages map[string][]any
 }
 
 // mapImpl is a function that takes an object and returns an object of a potentially different
@@ -71,6 +85,8 @@ func contextWithFakeFTL(ctx context.Context) context.Context {
 		allowMapCalls: false,
 		configValues:  map[string][]byte{},
 		secretValues:  map[string][]byte{},
+
+		webSocketMessages: map[string][]any{},
 	}
 	ctx = internal.WithContext(ctx, fake)
 	fake.pubSub = newFakePubSub(ctx)
@@ -117,6 +133,27 @@ func (f *fakeFTL) FSMSend(ctx context.Context, fsm string, instance string, even
 	return f.fsm.SendEvent(ctx, fsm, instance, event)
 }
 
+func (f *fakeFTL) FSMNext(ctx context.Context, event any) error {
+	return f.fsm.SetNextEvent(ctx, event)
+}
+
+// SendWebSocketMessage records a message sent to a WebSocket connection.
+func (f *fakeFTL) SendWebSocketMessage(ctx context.Context, connectionID string, message any) error {
+	f.webSocketLock.Lock()
+	defer f.webSocketLock.Unlock()
+	f.webSocketMessages[connectionID] = append(f.webSocketMessages[connectionID], message)
+	return nil
+}
+
+// ScheduleCall calls the verb immediately rather than at the scheduled time.
+func (f *fakeFTL) ScheduleCall(ctx context.Context, verb *schema.Ref, req any, at time.Time, options internal.ScheduleCallOptions, call func(context.Context) error) (string, error) {
+	key := options.Key.Default(model.NewScheduledCallKey(verb.Module, verb.Name).String())
+	if err := call(ctx); err != nil {
+		return "", fmt.Errorf("scheduled call to %s failed: %w", verb, err)
+	}
+	return key, nil
+}
+
 // addMapMock saves a new mock of ftl.Map to the internal map in fakeFTL.
 //
 // mockMap provides the whole mock implemention, so it gets called in place of both `fn`
@@ -164,6 +201,11 @@ func actuallyCallMap(ctx context.Context, impl mapImpl) any {
 	return out
 }
 
-func (f *fakeFTL) PublishEvent(ctx context.Context, topic *schema.Ref, event any) error {
-	return f.pubSub.publishEvent(topic, event)
+// PublishEvent publishes an event to a topic.
+//
+// The fake consumes each subscription's events one at a time in publish order,
+// which preserves the ordering of events with the same partition key. The
+// partition key of each event is available from PublishedEventsForTopic.
+func (f *fakeFTL) PublishEvent(ctx context.Context, topic *schema.Ref, partitionKey optional.Option[string], event any) error {
+	return f.pubSub.publishEvent(topic, partitionKey, event)
 }
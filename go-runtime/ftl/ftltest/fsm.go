@@ -2,16 +2,32 @@ package ftltest
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 
+	"github.com/alecthomas/types/optional"
+
 	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+	"github.com/TBD54566975/ftl/go-runtime/internal"
 )
 
 type fakeFSMInstance struct {
 	name       string
 	terminated bool
 	state      reflect.Value
+	// The state being transitioned to, while its verb is executing.
+	executing reflect.Value
+	// The event set by the executing state verb with FSMNext.
+	next optional.Option[any]
+	// States completed by the instance, oldest first.
+	completed []fakeCompletedState
+}
+
+type fakeCompletedState struct {
+	state reflect.Value
+	// The event that moved the instance into the state.
+	event any
 }
 
 func newFakeFSMManager() *fakeFSMManager {
@@ -53,8 +69,9 @@ func (f *fakeFSMManager) SendEvent(ctx context.Context, fsm string, instance str
 	var transition reflection.Transition
 
 	// Find the transition that matches the current state and the event type.
+	// Timeout transitions are never triggered by events.
 	for _, t := range rfsm.Transitions {
-		if fsmInstance.state == t.From && reflect.TypeOf(event).AssignableTo(t.To.Type().In(1)) {
+		if t.Timeout == 0 && !t.Compensation && fsmInstance.state == t.From && reflect.TypeOf(event).AssignableTo(t.To.Type().In(1)) {
 			transition = t
 			break
 		}
@@ -65,15 +82,23 @@ func (f *fakeFSMManager) SendEvent(ctx context.Context, fsm string, instance str
 		return fmt.Errorf("no transition found for event %T", event)
 	}
 
-	out := transition.To.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(event)})
-	var err error
-	erri := out[0]
-	if erri.IsNil() {
-		fsmInstance.state = transition.To
-	} else {
-		err = erri.Interface().(error) //nolint:forcetypeassert
-		fsmInstance.state = reflect.Value{}
+	fsmInstance.executing = transition.To
+	fsmInstance.next = optional.None[any]()
+	stateCtx := internal.ContextWithFSMInstance(ctx, internal.FSMInstance{FSM: fsm, Instance: instance})
+	out := transition.To.Call([]reflect.Value{reflect.ValueOf(stateCtx), reflect.ValueOf(event)})
+	fsmInstance.executing = reflect.Value{}
+	if erri := out[0]; !erri.IsNil() {
+		// A failed instance can not transition any further.
+		err := erri.Interface().(error) //nolint:forcetypeassert
+		fsmInstance.terminated = true
+		fsmInstance.next = optional.None[any]()
+		if cerr := f.compensate(ctx, rfsm, fsmInstance); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+		return err
 	}
+	fsmInstance.state = transition.To
+	fsmInstance.completed = append(fsmInstance.completed, fakeCompletedState{state: transition.To, event: event})
 	currentStateRef := reflection.FuncRef(fsmInstance.state.Interface()).ToSchema()
 
 	// Flag the FSM instance as terminated if the current state is a terminal state.
@@ -83,5 +108,55 @@ func (f *fakeFSMManager) SendEvent(ctx context.Context, fsm string, instance str
 			break
 		}
 	}
-	return err
+
+	// Apply the event set by the state verb with FSMNext.
+	if next, ok := fsmInstance.next.Get(); ok {
+		fsmInstance.next = optional.None[any]()
+		return f.SendEvent(ctx, fsm, instance, next)
+	}
+	return nil
+}
+
+// compensate calls the compensations of the states completed by a failed FSM
+// instance, most recent first, stopping at the first compensation to fail.
+func (f *fakeFSMManager) compensate(ctx context.Context, rfsm reflection.ReflectedFSM, fsmInstance *fakeFSMInstance) error {
+	completed := fsmInstance.completed
+	fsmInstance.completed = nil
+	for i := len(completed) - 1; i >= 0; i-- {
+		for _, t := range rfsm.Transitions {
+			if !t.Compensation || t.From != completed[i].state {
+				continue
+			}
+			out := t.To.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(completed[i].event)})
+			if erri := out[0]; !erri.IsNil() {
+				return fmt.Errorf("compensation of %s failed: %w", reflection.FuncRef(completed[i].state.Interface()), erri.Interface().(error)) //nolint:forcetypeassert
+			}
+		}
+	}
+	return nil
+}
+
+func (f *fakeFSMManager) SetNextEvent(ctx context.Context, event any) error {
+	current, ok := internal.FSMInstanceFromContext(ctx).Get()
+	if !ok {
+		return fmt.Errorf("FSMNext can only be called from an FSM state verb")
+	}
+	fsmInstance, ok := f.instances[fsmInstanceKey{current.FSM, current.Instance}]
+	if !ok || !fsmInstance.executing.IsValid() {
+		return fmt.Errorf("fsm %q instance %q is not executing a transition", current.FSM, current.Instance)
+	}
+	if fsmInstance.next.Ok() {
+		return fmt.Errorf("fsm %q instance %q already has a next event", current.FSM, current.Instance)
+	}
+	rfsm, ok := reflection.GetFSM(current.FSM).Get()
+	if !ok {
+		return fmt.Errorf("fsm %q not found", current.FSM)
+	}
+	for _, t := range rfsm.Transitions {
+		if t.Timeout == 0 && !t.Compensation && fsmInstance.executing == t.From && reflect.TypeOf(event).AssignableTo(t.To.Type().In(1)) {
+			fsmInstance.next = optional.Some(event)
+			return nil
+		}
+	}
+	return fmt.Errorf("no transition found for next event %T", event)
 }
@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"os"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 
@@ -443,12 +444,65 @@ func WithSubscriber[E any](subscription ftl.SubscriptionHandle[E], sink ftl.Sink
 	}
 }
 
+// WithBatchSubscriber adds a subscriber that consumes batches of events during a test
+//
+// Like WithSubscriber(…), but for sinks that consume batches. Each batch contains the events
+// that have been published but not yet consumed, up to batchSize events. batchSize should
+// match the batch size in the sink's "ftl:subscribe" directive.
+//
+// To be used when setting up a context for a test:
+//
+//	ctx := ftltest.Context(
+//		ftltest.WithBatchSubscriber(paymentAnalytics, AnalysePayments, 100),
+//		// ... other options
+//	)
+func WithBatchSubscriber[E any](subscription ftl.SubscriptionHandle[E], sink ftl.Sink[[]E], batchSize int) Option {
+	return Option{
+		rank: other,
+		apply: func(ctx context.Context, state *OptionsState) error {
+			if batchSize <= 0 {
+				return fmt.Errorf("batch size for subscription %s must be positive", subscription.Name)
+			}
+			fftl := internal.FromContext(ctx).(*fakeFTL) //nolint:forcetypeassert
+			addBatchSubscriber(fftl.pubSub, subscription, sink, batchSize)
+			return nil
+		},
+	}
+}
+
 // EventsForTopic returns all published events for a topic
 func EventsForTopic[E any](ctx context.Context, topic ftl.TopicHandle[E]) []E {
+	published := PublishedEventsForTopic(ctx, topic)
+	events := make([]E, len(published))
+	for i, e := range published {
+		events[i] = e.Event
+	}
+	return events
+}
+
+// PublishedEvent is an event published to a topic.
+type PublishedEvent[E any] struct {
+	Event E
+	// PartitionKey is the key the event was published with, if any.
+	PartitionKey ftl.Option[string]
+}
+
+// PublishedEventsForTopic returns all published events for a topic, in the
+// order they were published, along with their partition keys
+func PublishedEventsForTopic[E any](ctx context.Context, topic ftl.TopicHandle[E]) []PublishedEvent[E] {
 	fftl := internal.FromContext(ctx).(*fakeFTL) //nolint:forcetypeassert
 	return eventsForTopic(ctx, fftl.pubSub, topic)
 }
 
+// WebSocketMessages returns the messages sent to a WebSocket connection with
+// ftl.SendWebSocketMessage, in the order they were sent.
+func WebSocketMessages(ctx context.Context, connectionID string) []any {
+	fftl := internal.FromContext(ctx).(*fakeFTL) //nolint:forcetypeassert
+	fftl.webSocketLock.Lock()
+	defer fftl.webSocketLock.Unlock()
+	return slices.Clone(fftl.webSocketMessages[connectionID])
+}
+
 type SubscriptionResult[E any] struct {
 	Event E
 	Error ftl.Option[error]
@@ -25,15 +25,21 @@ type fakePubSub struct {
 
 	// pubSubLock required to access [topics, subscriptions, subscribers]
 	pubSubLock    sync.Mutex
-	topics        map[schema.RefKey][]any
+	topics        map[schema.RefKey][]topicEvent
 	subscriptions map[string]*subscription
 	subscribers   map[string][]subscriber
 }
 
+// topicEvent is an event published to a topic
+type topicEvent struct {
+	partitionKey optional.Option[string]
+	content      any
+}
+
 func newFakePubSub(ctx context.Context) *fakePubSub {
 	f := &fakePubSub{
 		globalTopic:   pubsub.New[pubSubEvent](),
-		topics:        map[schema.RefKey][]any{},
+		topics:        map[schema.RefKey][]topicEvent{},
 		subscriptions: map[string]*subscription{},
 		subscribers:   map[string][]subscriber{},
 	}
@@ -41,34 +47,54 @@ func newFakePubSub(ctx context.Context) *fakePubSub {
 	return f
 }
 
-func (f *fakePubSub) publishEvent(topic *schema.Ref, event any) error {
+func (f *fakePubSub) publishEvent(topic *schema.Ref, partitionKey optional.Option[string], event any) error {
 	f.publishWaitGroup.Add(1)
-	return f.globalTopic.PublishSync(publishEvent{topic: topic, content: event})
+	return f.globalTopic.PublishSync(publishEvent{topic: topic, partitionKey: partitionKey, content: event})
 }
 
 // addSubscriber adds a subscriber to the fake FTL instance. Each subscriber included in the test must be manually added
 func addSubscriber[E any](f *fakePubSub, sub ftl.SubscriptionHandle[E], sink ftl.Sink[E]) {
+	f.addSubscriber(sub.Name, sub.Topic, 0, func(ctx context.Context, events []any) error {
+		if event, ok := events[0].(E); ok {
+			return sink(ctx, event)
+		}
+		return fmt.Errorf("unexpected event type %T for subscription %s", events[0], sub.Name)
+	})
+}
+
+// addBatchSubscriber adds a subscriber that consumes batches of up to batchSize events to the fake FTL instance.
+func addBatchSubscriber[E any](f *fakePubSub, sub ftl.SubscriptionHandle[E], sink ftl.Sink[[]E], batchSize int) {
+	f.addSubscriber(sub.Name, sub.Topic, batchSize, func(ctx context.Context, events []any) error {
+		batch := make([]E, 0, len(events))
+		for _, e := range events {
+			event, ok := e.(E)
+			if !ok {
+				return fmt.Errorf("unexpected event type %T for subscription %s", e, sub.Name)
+			}
+			batch = append(batch, event)
+		}
+		return sink(ctx, batch)
+	})
+}
+
+func (f *fakePubSub) addSubscriber(name string, topic *schema.Ref, batchSize int, sink subscriber) {
 	f.pubSubLock.Lock()
 	defer f.pubSubLock.Unlock()
 
-	if _, ok := f.subscriptions[sub.Name]; !ok {
-		f.subscriptions[sub.Name] = &subscription{
-			name:   sub.Name,
-			topic:  sub.Topic,
-			errors: map[int]error{},
+	if _, ok := f.subscriptions[name]; !ok {
+		f.subscriptions[name] = &subscription{
+			name:      name,
+			topic:     topic,
+			batchSize: batchSize,
+			errors:    map[int]error{},
 		}
 	}
 
-	f.subscribers[sub.Name] = append(f.subscribers[sub.Name], func(ctx context.Context, event any) error {
-		if event, ok := event.(E); ok {
-			return sink(ctx, event)
-		}
-		return fmt.Errorf("unexpected event type %T for subscription %s", event, sub.Name)
-	})
+	f.subscribers[name] = append(f.subscribers[name], sink)
 }
 
 // eventsForTopic returns all events published to a topic
-func eventsForTopic[E any](ctx context.Context, f *fakePubSub, topic ftl.TopicHandle[E]) []E {
+func eventsForTopic[E any](ctx context.Context, f *fakePubSub, topic ftl.TopicHandle[E]) []PublishedEvent[E] {
 	// Make sure all published events make it into our pubsub state
 	f.publishWaitGroup.Wait()
 
@@ -76,16 +102,20 @@ func eventsForTopic[E any](ctx context.Context, f *fakePubSub, topic ftl.TopicHa
 	defer f.pubSubLock.Unlock()
 
 	logger := log.FromContext(ctx).Scope("pubsub")
-	var events = []E{}
+	var events = []PublishedEvent[E]{}
 	raw, ok := f.topics[topic.Ref.ToRefKey()]
 	if !ok {
 		return events
 	}
 	for _, e := range raw {
-		if event, ok := e.(E); ok {
-			events = append(events, event)
+		if event, ok := e.content.(E); ok {
+			published := PublishedEvent[E]{Event: event, PartitionKey: ftl.None[string]()}
+			if key, ok := e.partitionKey.Get(); ok {
+				published.PartitionKey = ftl.Some(key)
+			}
+			events = append(events, published)
 		} else {
-			logger.Warnf("unexpected event type %T for topic %s", e, topic.Ref)
+			logger.Warnf("unexpected event type %T for topic %s", e.content, topic.Ref)
 		}
 	}
 	return events
@@ -108,12 +138,12 @@ func resultsForSubscription[E any](ctx context.Context, f *fakePubSub, handle ft
 		return results
 	}
 
-	count := subscription.cursor.Default(-1)
-	if !subscription.isExecuting {
-		count++
+	count := subscription.cursor.Default(-1) + 1
+	if subscription.isExecuting {
+		count = subscription.batchStart
 	}
 	for i := range count {
-		e := topic[i]
+		e := topic[i].content
 		if event, ok := e.(E); ok {
 			result := SubscriptionResult[E]{
 				Event: event,
@@ -155,11 +185,8 @@ func (f *fakePubSub) handlePubSubEvent(ctx context.Context, e pubSubEvent) {
 	switch event := e.(type) {
 	case publishEvent:
 		logger.Debugf("publishing to %s: %v", event.topic.Name, event.content)
-		if _, ok := f.topics[event.topic.ToRefKey()]; !ok {
-			f.topics[event.topic.ToRefKey()] = []any{event.content}
-		} else {
-			f.topics[event.topic.ToRefKey()] = append(f.topics[event.topic.ToRefKey()], event.content)
-		}
+		published := topicEvent{partitionKey: event.partitionKey, content: event.content}
+		f.topics[event.topic.ToRefKey()] = append(f.topics[event.topic.ToRefKey()], published)
 		f.publishWaitGroup.Done()
 	case subscriptionDidConsumeEvent:
 		sub, ok := f.subscriptions[event.subscription]
@@ -167,7 +194,10 @@ func (f *fakePubSub) handlePubSubEvent(ctx context.Context, e pubSubEvent) {
 			panic(fmt.Sprintf("subscription %q not found", event.subscription))
 		}
 		if event.err != nil {
-			sub.errors[sub.cursor.MustGet()] = event.err
+			// Every event in a batch fails with the batch.
+			for i := sub.batchStart; i <= sub.cursor.MustGet(); i++ {
+				sub.errors[i] = event.err
+			}
 		}
 		sub.isExecuting = false
 	}
@@ -194,13 +224,16 @@ func (f *fakePubSub) handlePubSubEvent(ctx context.Context, e pubSubEvent) {
 		}
 		chosenSubscriber := subscribers[rand.Intn(len(subscribers))] //nolint:gosec
 
-		sub.cursor = optional.Some(cursor + 1)
+		// Batches include the events that have already been published, up to the batch size.
+		end := min(len(topicEvents), cursor+1+max(sub.batchSize, 1))
+		sub.batchStart = cursor + 1
+		sub.cursor = optional.Some(end - 1)
 		sub.isExecuting = true
 
-		go func(sub string, chosenSubscriber subscriber, event any) {
-			err := chosenSubscriber(ctx, event)
+		go func(sub string, chosenSubscriber subscriber, events []any) {
+			err := chosenSubscriber(ctx, events)
 			f.globalTopic.Publish(subscriptionDidConsumeEvent{subscription: sub, err: err})
-		}(sub.name, chosenSubscriber, topicEvents[sub.cursor.MustGet()])
+		}(sub.name, chosenSubscriber, slices.Map(topicEvents[cursor+1:end], func(e topicEvent) any { return e.content }))
 	}
 }
 
@@ -28,3 +28,11 @@ func ErrorsAfterASecond(ctx context.Context, event Event) error {
 	time.Sleep(1 * time.Second)
 	return fmt.Errorf("SubscriberThatFails always fails")
 }
+
+var batchSubscription = ftl.Subscription(Topic, "batchSubscription")
+
+//ftl:subscribe batchSubscription batch 3 1s
+func ErrorsForBatch(ctx context.Context, events []Event) error {
+	time.Sleep(500 * time.Millisecond)
+	return fmt.Errorf("failed to consume batch of %d events", len(events))
+}
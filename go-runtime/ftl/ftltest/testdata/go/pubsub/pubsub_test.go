@@ -3,6 +3,7 @@ package pubsub
 import (
 	"context"
 	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/alecthomas/atomic"
@@ -53,3 +54,45 @@ func TestMultipleMultipleFakeSubscribers(t *testing.T) {
 	assert.Equal(t, count, len(ftltest.EventsForTopic(ctx, Topic)))
 	assert.Equal(t, count, counter.Load())
 }
+
+func TestBatchSubscriber(t *testing.T) {
+	// Test that events are consumed in batches, and that each event in a failed batch is tracked as an error
+	count := 7
+	var lock sync.Mutex
+	batchSizes := []int{}
+
+	ctx := ftltest.Context(
+		ftltest.WithBatchSubscriber(batchSubscription, func(ctx context.Context, events []Event) error {
+			lock.Lock()
+			batchSizes = append(batchSizes, len(events))
+			lock.Unlock()
+			return ErrorsForBatch(ctx, events)
+		}, 3),
+	)
+	for i := 0; i < count; i++ {
+		assert.NoError(t, PublishToTopicOne(ctx, Event{Value: strconv.Itoa(i)}))
+	}
+	ftltest.WaitForSubscriptionsToComplete(ctx)
+	assert.Equal(t, count, len(ftltest.ErrorsForSubscription(ctx, batchSubscription)))
+
+	lock.Lock()
+	defer lock.Unlock()
+	total := 0
+	for _, size := range batchSizes {
+		assert.True(t, size >= 1 && size <= 3, "batch size %d is out of bounds", size)
+		total += size
+	}
+	assert.Equal(t, count, total)
+	assert.True(t, len(batchSizes) < count, "expected events to be batched")
+}
+
+func TestPartitionKeys(t *testing.T) {
+	// Test that the partition key of each published event is tracked
+	ctx := ftltest.Context()
+	assert.NoError(t, Topic.PublishWithKey(ctx, "a", Event{Value: "1"}))
+	assert.NoError(t, Topic.Publish(ctx, Event{Value: "2"}))
+	assert.Equal(t, []ftltest.PublishedEvent[Event]{
+		{Event: Event{Value: "1"}, PartitionKey: ftl.Some("a")},
+		{Event: Event{Value: "2"}, PartitionKey: ftl.None[string]()},
+	}, ftltest.PublishedEventsForTopic(ctx, Topic))
+}
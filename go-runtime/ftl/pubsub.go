@@ -3,6 +3,8 @@ package ftl
 import (
 	"context"
 
+	"github.com/alecthomas/types/optional"
+
 	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
 	"github.com/TBD54566975/ftl/go-runtime/internal"
@@ -11,30 +13,83 @@ import (
 // Topic declares a topic
 //
 // Topics publish events, and subscriptions can listen to them.
-func Topic[E any](name string) TopicHandle[E] {
-	return TopicHandle[E]{Ref: &schema.Ref{
-		Name:   name,
-		Module: reflection.Module(),
-	}}
+func Topic[E any](name string, options ...TopicOption) TopicHandle[E] {
+	opts := topicOptions{}
+	for _, option := range options {
+		option(&opts)
+	}
+	return TopicHandle[E]{
+		Ref: &schema.Ref{
+			Name:   name,
+			Module: reflection.Module(),
+		},
+		Partitions: opts.partitions,
+	}
+}
+
+// TopicOption configures a topic.
+type TopicOption func(*topicOptions)
+
+type topicOptions struct {
+	partitions int
+}
+
+// Partitions distributes the events published to a topic across n partitions.
+//
+// Events in different partitions are consumed concurrently by subscriptions,
+// while events with the same key (see [TopicHandle.PublishWithKey]) are
+// always published to the same partition and consumed in order.
+func Partitions(n int) TopicOption {
+	return func(o *topicOptions) { o.partitions = n }
 }
 
 type TopicHandle[E any] struct {
-	Ref *schema.Ref
+	Ref        *schema.Ref
+	Partitions int
 }
 
 // Publish publishes an event to a topic
 func (t TopicHandle[E]) Publish(ctx context.Context, event E) error {
-	return internal.FromContext(ctx).PublishEvent(ctx, t.Ref, event)
+	return internal.FromContext(ctx).PublishEvent(ctx, t.Ref, optional.None[string](), event)
+}
+
+// PublishWithKey publishes an event to a topic with a partition key.
+//
+// Events published with the same key are consumed by each subscription in the
+// order they were published.
+func (t TopicHandle[E]) PublishWithKey(ctx context.Context, key string, event E) error {
+	return internal.FromContext(ctx).PublishEvent(ctx, t.Ref, optional.Some(key), event)
 }
 
 type SubscriptionHandle[E any] struct {
-	Topic *schema.Ref
-	Name  string
+	Topic      *schema.Ref
+	Name       string
+	DeadLetter *schema.Ref
+}
+
+// SubscriptionOption configures a subscription.
+type SubscriptionOption func(*subscriptionOptions)
+
+type subscriptionOptions struct {
+	deadLetter *schema.Ref
+}
+
+// DeadLetter publishes events that permanently fail to be consumed by the
+// subscription to the given topic.
+//
+// The topic's event type must be builtin.FailedEvent[E], where E is the event
+// type of the subscribed topic.
+func DeadLetter[E any](topic TopicHandle[E]) SubscriptionOption {
+	return func(o *subscriptionOptions) { o.deadLetter = topic.Ref }
 }
 
 // Subscription declares a subscription to a topic
 //
 // Sinks can consume events from the subscription by including a "ftl:subscibe <subscription_name>" directive
-func Subscription[E any](topic TopicHandle[E], name string) SubscriptionHandle[E] {
-	return SubscriptionHandle[E]{Name: name, Topic: topic.Ref}
+func Subscription[E any](topic TopicHandle[E], name string, options ...SubscriptionOption) SubscriptionHandle[E] {
+	opts := subscriptionOptions{}
+	for _, option := range options {
+		option(&opts)
+	}
+	return SubscriptionHandle[E]{Name: name, Topic: topic.Ref, DeadLetter: opts.deadLetter}
 }
@@ -2,6 +2,7 @@ package reflection
 
 import (
 	"reflect"
+	"time"
 
 	"github.com/alecthomas/types/optional"
 
@@ -43,6 +44,11 @@ func SumType[Discriminator any](variants ...Discriminator) Registree {
 type Transition struct {
 	From reflect.Value
 	To   reflect.Value
+	// Non-zero if this is a timeout transition.
+	Timeout time.Duration
+	// True if this is not a transition, but declares To as the compensation
+	// of the From state.
+	Compensation bool
 }
 
 type ReflectedFSM struct {
@@ -96,7 +102,12 @@ func (t *TypeRegistry) registerSumType(discriminator reflect.Type, variants map[
 func (t *TypeRegistry) registerFSM(name string, transitions []Transition) {
 	fsm := &schema.FSM{Name: name}
 	for _, transition := range transitions {
-		if !transition.From.IsValid() {
+		if transition.Compensation {
+			fsm.Metadata = append(fsm.Metadata, &schema.MetadataCompensate{
+				State: FuncRef(transition.From.Interface()).ToSchema(),
+				Verb:  FuncRef(transition.To.Interface()).ToSchema(),
+			})
+		} else if !transition.From.IsValid() {
 			fsm.Start = append(fsm.Start, FuncRef(transition.To.Interface()).ToSchema())
 		} else {
 			fsm.Transitions = append(fsm.Transitions, &schema.FSMTransition{
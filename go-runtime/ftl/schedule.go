@@ -0,0 +1,54 @@
+package ftl
+
+import (
+	"context"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+	"github.com/TBD54566975/ftl/go-runtime/internal"
+)
+
+// CallOption configures a call scheduled with [CallAt] or [CallAfter].
+type CallOption func(*internal.ScheduleCallOptions)
+
+// WithCallKey sets the key of a scheduled call.
+//
+// If a call to the same verb with the same key has not yet completed, no
+// call is scheduled. Keys are generated if not set.
+func WithCallKey(key string) CallOption {
+	return func(o *internal.ScheduleCallOptions) { o.Key = optional.Some(key) }
+}
+
+// WithCallRetry overrides the retry policy of the verb for a scheduled call.
+func WithCallRetry(count int, minBackoff, maxBackoff time.Duration) CallOption {
+	return func(o *internal.ScheduleCallOptions) {
+		o.Retry = optional.Some(internal.RetryPolicy{Count: count, MinBackoff: minBackoff, MaxBackoff: maxBackoff})
+	}
+}
+
+// CallAt durably schedules a call to a Sink at the given time, returning the
+// key of the call.
+//
+// The call is executed by the FTL controller, and is retried according to the
+// retry policy of the sink. Scheduled calls can be listed and cancelled with
+// "ftl scheduled".
+func CallAt[Req any](ctx context.Context, sink Sink[Req], req Req, at time.Time, options ...CallOption) (string, error) {
+	opts := internal.ScheduleCallOptions{}
+	for _, option := range options {
+		option(&opts)
+	}
+	ref := reflection.FuncRef(sink)
+	return internal.FromContext(ctx).ScheduleCall(ctx, ref.ToSchema(), req, at, opts, func(ctx context.Context) error {
+		return CallSink(ctx, sink, req)
+	})
+}
+
+// CallAfter durably schedules a call to a Sink after the given delay,
+// returning the key of the call.
+//
+// See [CallAt] for details.
+func CallAfter[Req any](ctx context.Context, sink Sink[Req], req Req, delay time.Duration, options ...CallOption) (string, error) {
+	return CallAt(ctx, sink, req, time.Now().Add(delay), options...)
+}
@@ -26,3 +26,15 @@ type Source[Resp any] func(context.Context) (Resp, error)
 
 // An Empty is a function that does not accept input or return output.
 type Empty func(context.Context) error
+
+// A Stream is a function that accepts input and writes a stream of output.
+type Stream[Req, Resp any] func(context.Context, Req, StreamWriter[Resp]) error
+
+// StreamWriter writes the output of a Stream to its caller.
+type StreamWriter[Resp any] interface {
+	// Send a single output to the caller.
+	//
+	// Returns an error if the caller has gone away, in which case the Stream
+	// should stop.
+	Send(ctx context.Context, resp Resp) error
+}
@@ -0,0 +1,20 @@
+package ftl
+
+import (
+	"context"
+
+	"github.com/TBD54566975/ftl/go-runtime/internal"
+)
+
+// SendWebSocketMessage sends a message to a client connected to a WebSocket
+// ingress verb.
+//
+// The connection is identified by the ConnectionId of a
+// builtin.WebSocketMessage received by the verb, and may be held by any
+// controller in the cluster. Strings are sent as text messages, byte slices as
+// binary messages, and anything else as JSON encoded text messages.
+//
+// Messages sent to connections that have closed are dropped.
+func SendWebSocketMessage(ctx context.Context, connectionID string, message any) error {
+	return internal.FromContext(ctx).SendWebSocketMessage(ctx, connectionID, message)
+}
@@ -2,6 +2,9 @@ package internal
 
 import (
 	"context"
+	"time"
+
+	"github.com/alecthomas/types/optional"
 
 	"github.com/TBD54566975/ftl/backend/schema"
 )
@@ -17,8 +20,25 @@ type FTL interface {
 	// FSMSend sends an event to an instance of an FSM.
 	FSMSend(ctx context.Context, fsm, instance string, data any) error
 
+	// FSMNext sets the next event of the FSM instance whose transition is
+	// executing in ctx.
+	FSMNext(ctx context.Context, event any) error
+
 	// PublishEvent sends an event to a pubsub topic.
-	PublishEvent(ctx context.Context, topic *schema.Ref, event any) error
+	//
+	// Events published with the same partition key are consumed in order.
+	PublishEvent(ctx context.Context, topic *schema.Ref, partitionKey optional.Option[string], event any) error
+
+	// SendWebSocketMessage sends a message to a client connected to a
+	// WebSocket ingress verb.
+	SendWebSocketMessage(ctx context.Context, connectionID string, message any) error
+
+	// ScheduleCall durably schedules a call to a verb, returning the key of
+	// the call.
+	//
+	// "call" calls the verb, for implementations that don't schedule calls
+	// durably.
+	ScheduleCall(ctx context.Context, verb *schema.Ref, req any, at time.Time, options ScheduleCallOptions, call func(context.Context) error) (string, error)
 
 	// CallMap calls Get on an instance of an ftl.Map.
 	//
@@ -34,6 +54,22 @@ type FTL interface {
 	GetSecret(ctx context.Context, name string, dest any) error
 }
 
+// ScheduleCallOptions configures a call scheduled with [FTL.ScheduleCall].
+type ScheduleCallOptions struct {
+	// Identifies the call. No call is scheduled if a call to the same verb
+	// with the same key has not yet completed.
+	Key optional.Option[string]
+	// Overrides the retry policy of the verb.
+	Retry optional.Option[RetryPolicy]
+}
+
+// RetryPolicy is the retry policy of an asynchronous call.
+type RetryPolicy struct {
+	Count      int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
 type ftlContextKey struct{}
 
 // WithContext returns a new context with the FTL instance.
@@ -49,3 +85,24 @@ func FromContext(ctx context.Context) FTL {
 	}
 	return ftl
 }
+
+// FSMInstance identifies an instance of an FSM in the current module.
+type FSMInstance struct {
+	FSM      string
+	Instance string
+}
+
+type fsmInstanceContextKey struct{}
+
+// ContextWithFSMInstance returns a new context for executing a transition of
+// an FSM instance.
+func ContextWithFSMInstance(ctx context.Context, instance FSMInstance) context.Context {
+	return context.WithValue(ctx, fsmInstanceContextKey{}, instance)
+}
+
+// FSMInstanceFromContext returns the FSM instance whose transition is executing
+// in ctx, if any.
+func FSMInstanceFromContext(ctx context.Context) optional.Option[FSMInstance] {
+	instance, ok := ctx.Value(fsmInstanceContextKey{}).(FSMInstance)
+	return optional.From(instance, ok)
+}
@@ -6,9 +6,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
 	"github.com/puzpuzpuz/xsync/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
@@ -68,7 +72,29 @@ func (r *RealFTL) FSMSend(ctx context.Context, fsm, instance string, event any)
 	return nil
 }
 
-func (r *RealFTL) PublishEvent(ctx context.Context, topic *schema.Ref, event any) error {
+func (r *RealFTL) FSMNext(ctx context.Context, event any) error {
+	instance, ok := FSMInstanceFromContext(ctx).Get()
+	if !ok {
+		return fmt.Errorf("FSMNext can only be called from an FSM state verb")
+	}
+	client := rpc.ClientFromContext[ftlv1connect.VerbServiceClient](ctx)
+	body, err := encoding.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	_, err = client.SetNextFSMEvent(ctx, connect.NewRequest(&ftlv1.SetNextFSMEventRequest{
+		Fsm:      &schemapb.Ref{Module: reflection.Module(), Name: instance.FSM},
+		Instance: instance.Instance,
+		Event:    schema.TypeToProto(reflection.ReflectTypeToSchemaType(reflect.TypeOf(event))),
+		Body:     body,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to set next event: %w", err)
+	}
+	return nil
+}
+
+func (r *RealFTL) PublishEvent(ctx context.Context, topic *schema.Ref, partitionKey optional.Option[string], event any) error {
 	caller := reflection.CallingVerb()
 	if topic.Module != caller.Module {
 		return fmt.Errorf("can not publish to another module's topic: %s", topic)
@@ -79,9 +105,10 @@ func (r *RealFTL) PublishEvent(ctx context.Context, topic *schema.Ref, event any
 		return fmt.Errorf("failed to marshal event: %w", err)
 	}
 	_, err = client.PublishEvent(ctx, connect.NewRequest(&ftlv1.PublishEventRequest{
-		Topic:  topic.ToProto().(*schemapb.Ref), //nolint: forcetypeassert
-		Caller: caller.Name,
-		Body:   body,
+		Topic:        topic.ToProto().(*schemapb.Ref), //nolint: forcetypeassert
+		Caller:       caller.Name,
+		Body:         body,
+		PartitionKey: partitionKey.Ptr(),
 	}))
 	if err != nil {
 		return fmt.Errorf("failed to publish event: %w", err)
@@ -89,6 +116,55 @@ func (r *RealFTL) PublishEvent(ctx context.Context, topic *schema.Ref, event any
 	return nil
 }
 
+func (r *RealFTL) SendWebSocketMessage(ctx context.Context, connectionID string, message any) error {
+	client := rpc.ClientFromContext[ftlv1connect.VerbServiceClient](ctx)
+	req := &ftlv1.SendWebSocketMessageRequest{ConnectionId: connectionID}
+	switch message := message.(type) {
+	case string:
+		req.Body = []byte(message)
+	case []byte:
+		req.Body = message
+		req.Binary = true
+	default:
+		body, err := encoding.Marshal(message)
+		if err != nil {
+			return fmt.Errorf("failed to marshal WebSocket message: %w", err)
+		}
+		req.Body = body
+	}
+	_, err := client.SendWebSocketMessage(ctx, connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("failed to send WebSocket message: %w", err)
+	}
+	return nil
+}
+
+func (r *RealFTL) ScheduleCall(ctx context.Context, verb *schema.Ref, req any, at time.Time, options ScheduleCallOptions, _ func(context.Context) error) (string, error) {
+	client := rpc.ClientFromContext[ftlv1connect.VerbServiceClient](ctx)
+	body, err := encoding.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+	request := &ftlv1.ScheduleCallRequest{
+		Verb:        verb.ToProto().(*schemapb.Ref), //nolint: forcetypeassert
+		Body:        body,
+		ScheduledAt: timestamppb.New(at),
+		Key:         options.Key.Ptr(),
+	}
+	if retry, ok := options.Retry.Get(); ok {
+		request.Retry = &ftlv1.RetryPolicy{
+			Count:      int32(retry.Count),
+			MinBackoff: durationpb.New(retry.MinBackoff),
+			MaxBackoff: durationpb.New(retry.MaxBackoff),
+		}
+	}
+	resp, err := client.ScheduleCall(ctx, connect.NewRequest(request))
+	if err != nil {
+		return "", fmt.Errorf("failed to schedule call to %s: %w", verb, err)
+	}
+	return resp.Msg.Key, nil
+}
+
 func (r *RealFTL) CallMap(ctx context.Context, mapper any, value any, mapImpl func(context.Context) (any, error)) any {
 	// Compute checksum of the input.
 	inputData, err := json.Marshal(value)
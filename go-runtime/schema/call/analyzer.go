@@ -16,6 +16,8 @@ import (
 
 const (
 	ftlCallFuncPath        = "github.com/TBD54566975/ftl/go-runtime/ftl.Call"
+	ftlCallAtFuncPath      = "github.com/TBD54566975/ftl/go-runtime/ftl.CallAt"
+	ftlCallAfterFuncPath   = "github.com/TBD54566975/ftl/go-runtime/ftl.CallAfter"
 	ftlPkgPath             = "github.com/TBD54566975/ftl/go-runtime/ftl"
 	ftlTopicHandleTypeName = "TopicHandle"
 )
@@ -50,7 +52,20 @@ func Extract(pass *analysis.Pass) (interface{}, error) {
 			if fn == nil {
 				return
 			}
-			if fn.FullName() == ftlCallFuncPath {
+			switch fn.FullName() {
+			case ftlCallFuncPath:
+				if len(node.Args) != 3 {
+					common.Errorf(pass, node, "call must have exactly three arguments")
+					return
+				}
+				extractVerbCall(pass, parentFuncObj, node)
+				return
+			case ftlCallAtFuncPath, ftlCallAfterFuncPath:
+				// Scheduled calls have a time or delay and options after the request.
+				if len(node.Args) < 4 {
+					common.Errorf(pass, node, "scheduled call must have at least four arguments")
+					return
+				}
 				extractVerbCall(pass, parentFuncObj, node)
 				return
 			}
@@ -61,10 +76,6 @@ func Extract(pass *analysis.Pass) (interface{}, error) {
 }
 
 func extractVerbCall(pass *analysis.Pass, parentFuncObj types.Object, node *ast.CallExpr) {
-	if len(node.Args) != 3 {
-		common.Errorf(pass, node, "call must have exactly three arguments")
-		return
-	}
 	ref := parseVerbRef(pass, node.Args[1])
 	if ref == nil {
 		if sel, ok := node.Args[1].(*ast.SelectorExpr); ok {
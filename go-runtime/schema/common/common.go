@@ -24,6 +24,8 @@ var (
 	FtlUnitTypePath = "github.com/TBD54566975/ftl/go-runtime/ftl.Unit"
 	// FtlOptionTypePath is the path to the FTL option type.
 	FtlOptionTypePath = "github.com/TBD54566975/ftl/go-runtime/ftl.Option"
+	// FtlStreamWriterTypePath is the path to the FTL stream writer type.
+	FtlStreamWriterTypePath = "github.com/TBD54566975/ftl/go-runtime/ftl.StreamWriter"
 
 	extractorRegistery = xsync.NewMapOf[reflect.Type, ExtractDeclFunc[schema.Decl, ast.Node]]()
 )
@@ -769,6 +771,27 @@ func ExtractStringLiteralArg(pass *analysis.Pass, node *ast.CallExpr, argIndex i
 	return s
 }
 
+// ExtractIntLiteralArg extracts an integer literal argument from a call expression at the given index.
+func ExtractIntLiteralArg(pass *analysis.Pass, node *ast.CallExpr, argIndex int) int {
+	if argIndex >= len(node.Args) {
+		Errorf(pass, node, "expected integer argument at index %d", argIndex)
+		return 0
+	}
+
+	literal, ok := node.Args[argIndex].(*ast.BasicLit)
+	if !ok || literal.Kind != token.INT {
+		Errorf(pass, node, "expected integer literal for argument at index %d", argIndex)
+		return 0
+	}
+
+	i, err := strconv.ParseInt(literal.Value, 0, 0)
+	if err != nil {
+		Wrapf(pass, node, err, "")
+		return 0
+	}
+	return int(i)
+}
+
 func isLocalRef(pass *analysis.Pass, ref *schema.Ref) bool {
 	moduleName, err := FtlModuleFromGoPackage(pass.Pkg.Path())
 	if err != nil {
@@ -148,15 +148,19 @@ func (*DirectiveTypeAlias) MustAnnotate() []ast.Node {
 type DirectiveIngress struct {
 	Pos token.Pos
 
-	Type   string                        `parser:"'ingress' @('http')?"`
-	Method string                        `parser:"@('GET' | 'POST' | 'PUT' | 'DELETE')"`
+	Type   string                        `parser:"'ingress' @('http' | 'ws')?"`
+	Method string                        `parser:"@('GET' | 'POST' | 'PUT' | 'DELETE')?"`
 	Path   []schema.IngressPathComponent `parser:"('/' @@)+"`
 }
 
 func (*DirectiveIngress) directive() {}
 func (d *DirectiveIngress) String() string {
 	w := &strings.Builder{}
-	fmt.Fprintf(w, "ftl:ingress %s", d.Method)
+	if d.Type == "ws" {
+		fmt.Fprintf(w, "ftl:ingress ws ")
+	} else {
+		fmt.Fprintf(w, "ftl:ingress %s ", d.Method)
+	}
 	for _, p := range d.Path {
 		fmt.Fprintf(w, "/%s", p)
 	}
@@ -179,13 +183,22 @@ func (*DirectiveIngress) MustAnnotate() []ast.Node {
 type DirectiveCronJob struct {
 	Pos token.Pos
 
-	Cron cron.Pattern `parser:"'cron' @@"`
+	Cron     cron.Pattern `parser:"'cron' @@"`
+	Timezone string       `parser:"('tz' @(Ident ((?! Whitespace) ('/' | '-' | '+' | Ident | Number))*))?"`
+	Missed   string       `parser:"('missed' @('skip' | 'once' | 'all'))?"`
 }
 
 func (*DirectiveCronJob) directive() {}
 
 func (d *DirectiveCronJob) String() string {
-	return fmt.Sprintf("cron %s", d.Cron)
+	out := fmt.Sprintf("cron %s", d.Cron)
+	if d.Timezone != "" {
+		out += " tz " + d.Timezone
+	}
+	if d.Missed != "" {
+		out += " missed " + d.Missed
+	}
+	return out
 }
 func (d *DirectiveCronJob) IsExported() bool {
 	return false
@@ -252,17 +265,27 @@ func (d *DirectiveRetry) Catch() optional.Option[schema.Ref] {
 	})
 }
 
-// DirectiveSubscriber is used to subscribe a sink to a subscription
+// DirectiveSubscriber is used to subscribe a sink to a subscription, eg.
+// //ftl:subscribe payments or //ftl:subscribe payments batch 100 5s
 type DirectiveSubscriber struct {
 	Pos token.Pos
 
-	Name string `parser:"'subscribe' @Ident"`
+	Name      string `parser:"'subscribe' @Ident"`
+	BatchSize *int   `parser:"('batch' @Number"`
+	BatchWait string `parser:"@(Number (?! Whitespace) Ident)?)?"`
 }
 
 func (*DirectiveSubscriber) directive() {}
 
 func (d *DirectiveSubscriber) String() string {
-	return fmt.Sprintf("subscribe %s", d.Name)
+	out := fmt.Sprintf("subscribe %s", d.Name)
+	if d.BatchSize != nil {
+		out += fmt.Sprintf(" batch %d", *d.BatchSize)
+		if d.BatchWait != "" {
+			out += " " + d.BatchWait
+		}
+	}
+	return out
 }
 func (*DirectiveSubscriber) GetTypeName() string { return "subscribe" }
 func (d *DirectiveSubscriber) SetPosition(pos token.Pos) {
@@ -275,6 +298,210 @@ func (*DirectiveSubscriber) MustAnnotate() []ast.Node {
 	return []ast.Node{&ast.FuncDecl{}}
 }
 
+// DirectiveTimeout sets the maximum duration of a call to a verb.
+type DirectiveTimeout struct {
+	Pos token.Pos
+
+	Duration string `parser:"'timeout' @(Number (?! Whitespace) Ident)"`
+}
+
+func (*DirectiveTimeout) directive() {}
+
+func (d *DirectiveTimeout) String() string {
+	return fmt.Sprintf("timeout %s", d.Duration)
+}
+func (*DirectiveTimeout) GetTypeName() string { return "timeout" }
+func (d *DirectiveTimeout) SetPosition(pos token.Pos) {
+	d.Pos = pos
+}
+func (d *DirectiveTimeout) GetPosition() token.Pos {
+	return d.Pos
+}
+func (*DirectiveTimeout) MustAnnotate() []ast.Node {
+	return []ast.Node{&ast.FuncDecl{}}
+}
+
+// DirectiveRateLimit limits the rate of calls to a verb, eg. //ftl:ratelimit 100/1m
+type DirectiveRateLimit struct {
+	Pos token.Pos
+
+	Limit  int    `parser:"'ratelimit' @Number '/'"`
+	Period string `parser:"@(Number (?! Whitespace) Ident)"`
+}
+
+func (*DirectiveRateLimit) directive() {}
+
+func (d *DirectiveRateLimit) String() string {
+	return fmt.Sprintf("ratelimit %d/%s", d.Limit, d.Period)
+}
+func (*DirectiveRateLimit) GetTypeName() string { return "ratelimit" }
+func (d *DirectiveRateLimit) SetPosition(pos token.Pos) {
+	d.Pos = pos
+}
+func (d *DirectiveRateLimit) GetPosition() token.Pos {
+	return d.Pos
+}
+func (*DirectiveRateLimit) MustAnnotate() []ast.Node {
+	return []ast.Node{&ast.FuncDecl{}}
+}
+
+// DirectiveConcurrency limits the number of concurrent calls to a verb.
+type DirectiveConcurrency struct {
+	Pos token.Pos
+
+	Limit int `parser:"'concurrency' @Number"`
+}
+
+func (*DirectiveConcurrency) directive() {}
+
+func (d *DirectiveConcurrency) String() string {
+	return fmt.Sprintf("concurrency %d", d.Limit)
+}
+func (*DirectiveConcurrency) GetTypeName() string { return "concurrency" }
+func (d *DirectiveConcurrency) SetPosition(pos token.Pos) {
+	d.Pos = pos
+}
+func (d *DirectiveConcurrency) GetPosition() token.Pos {
+	return d.Pos
+}
+func (*DirectiveConcurrency) MustAnnotate() []ast.Node {
+	return []ast.Node{&ast.FuncDecl{}}
+}
+
+// DirectiveRecursive allows a verb to take part in call cycles.
+type DirectiveRecursive struct {
+	Pos token.Pos
+
+	Recursive bool `parser:"@'recursive'"`
+}
+
+func (*DirectiveRecursive) directive() {}
+
+func (d *DirectiveRecursive) String() string {
+	return "recursive"
+}
+func (*DirectiveRecursive) GetTypeName() string { return "recursive" }
+func (d *DirectiveRecursive) SetPosition(pos token.Pos) {
+	d.Pos = pos
+}
+func (d *DirectiveRecursive) GetPosition() token.Pos {
+	return d.Pos
+}
+func (*DirectiveRecursive) MustAnnotate() []ast.Node {
+	return []ast.Node{&ast.FuncDecl{}}
+}
+
+// DirectiveAuth requires HTTP ingress requests to a verb to be authenticated, eg.
+// //ftl:auth jwt issuer "https://auth.example.com" jwks "https://auth.example.com/.well-known/jwks.json"
+// //ftl:auth apikey secret apiKeys
+type DirectiveAuth struct {
+	Pos token.Pos
+
+	Scheme       string  `parser:"'auth' @('jwt' | 'apikey' | 'mtls')"`
+	Issuer       string  `parser:"('issuer' @String)?"`
+	Audience     string  `parser:"('audience' @String)?"`
+	JWKS         string  `parser:"('jwks' @String)?"`
+	Header       string  `parser:"('header' @String)?"`
+	SecretModule *string `parser:"('secret' (@Ident '.')?"`
+	Secret       string  `parser:"@Ident)?"`
+}
+
+func (*DirectiveAuth) directive() {}
+
+func (d *DirectiveAuth) String() string {
+	md := &schema.MetadataAuth{Scheme: d.Scheme, Issuer: d.Issuer, Audience: d.Audience, JWKS: d.JWKS, Header: d.Header, Secret: d.SecretRef()}
+	return strings.TrimPrefix(md.String(), "+")
+}
+func (*DirectiveAuth) GetTypeName() string { return "auth" }
+func (d *DirectiveAuth) SetPosition(pos token.Pos) {
+	d.Pos = pos
+}
+func (d *DirectiveAuth) GetPosition() token.Pos {
+	return d.Pos
+}
+func (*DirectiveAuth) MustAnnotate() []ast.Node {
+	return []ast.Node{&ast.FuncDecl{}}
+}
+
+// SecretRef returns the secret holding the keys, or nil if not specified.
+func (d *DirectiveAuth) SecretRef() *schema.Ref {
+	if d.Secret == "" {
+		return nil
+	}
+	return &schema.Ref{Module: optionalString(d.SecretModule), Name: d.Secret}
+}
+
+// DirectiveQueue configures the event queue of an FSM, eg. //ftl:queue 10 dedupe
+type DirectiveQueue struct {
+	Pos token.Pos
+
+	Depth  int  `parser:"'queue' @Number"`
+	Dedupe bool `parser:"@'dedupe'?"`
+}
+
+func (*DirectiveQueue) directive() {}
+
+func (d *DirectiveQueue) String() string {
+	if d.Dedupe {
+		return fmt.Sprintf("queue %d dedupe", d.Depth)
+	}
+	return fmt.Sprintf("queue %d", d.Depth)
+}
+func (*DirectiveQueue) GetTypeName() string { return "queue" }
+func (d *DirectiveQueue) SetPosition(pos token.Pos) {
+	d.Pos = pos
+}
+func (d *DirectiveQueue) GetPosition() token.Pos {
+	return d.Pos
+}
+func (*DirectiveQueue) MustAnnotate() []ast.Node {
+	return []ast.Node{&ast.GenDecl{}}
+}
+
+// DirectiveMigrate maps a state removed from an FSM to an existing state, eg.
+// //ftl:migrate created to invoiced
+type DirectiveMigrate struct {
+	Pos token.Pos
+
+	FromModule *string `parser:"'migrate' (@Ident '.')?"`
+	FromVerb   string  `parser:"@Ident"`
+	ToModule   *string `parser:"'to' (@Ident '.')?"`
+	ToVerb     string  `parser:"@Ident"`
+}
+
+func (*DirectiveMigrate) directive() {}
+
+func (d *DirectiveMigrate) String() string {
+	return fmt.Sprintf("migrate %s to %s", d.From(), d.To())
+}
+func (*DirectiveMigrate) GetTypeName() string { return "migrate" }
+func (d *DirectiveMigrate) SetPosition(pos token.Pos) {
+	d.Pos = pos
+}
+func (d *DirectiveMigrate) GetPosition() token.Pos {
+	return d.Pos
+}
+func (*DirectiveMigrate) MustAnnotate() []ast.Node {
+	return []ast.Node{&ast.GenDecl{}}
+}
+
+// From returns the removed state. The module is empty if it was not specified.
+func (d *DirectiveMigrate) From() *schema.Ref {
+	return &schema.Ref{Module: optionalString(d.FromModule), Name: d.FromVerb}
+}
+
+// To returns the state to migrate to. The module is empty if it was not specified.
+func (d *DirectiveMigrate) To() *schema.Ref {
+	return &schema.Ref{Module: optionalString(d.ToModule), Name: d.ToVerb}
+}
+
+func optionalString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 // DirectiveExport is used on declarations that don't include export in other directives.
 type DirectiveExport struct {
 	Pos token.Pos
@@ -330,7 +557,8 @@ var DirectiveParser = participle.MustBuild[directiveWrapper](
 	participle.UseLookahead(2),
 	participle.Union[Directive](&DirectiveVerb{}, &DirectiveData{}, &DirectiveEnum{}, &DirectiveTypeAlias{},
 		&DirectiveIngress{}, &DirectiveCronJob{}, &DirectiveRetry{}, &DirectiveSubscriber{}, &DirectiveExport{},
-		&DirectiveTypeMap{}),
+		&DirectiveTypeMap{}, &DirectiveTimeout{}, &DirectiveRateLimit{}, &DirectiveConcurrency{}, &DirectiveQueue{},
+		&DirectiveMigrate{}, &DirectiveAuth{}, &DirectiveRecursive{}),
 	participle.Union[schema.IngressPathComponent](&schema.IngressPathLiteral{}, &schema.IngressPathParameter{}),
 )
 
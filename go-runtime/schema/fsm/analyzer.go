@@ -2,7 +2,9 @@ package fsm
 
 import (
 	"go/ast"
+	"go/constant"
 	"go/types"
+	"time"
 
 	"github.com/TBD54566975/golang-tools/go/analysis"
 	"github.com/alecthomas/types/optional"
@@ -10,12 +12,15 @@ import (
 	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/backend/schema/strcase"
 	"github.com/TBD54566975/ftl/go-runtime/schema/common"
+	"github.com/TBD54566975/ftl/internal/duration"
 )
 
 const (
 	ftlFSMFuncPath        = "github.com/TBD54566975/ftl/go-runtime/ftl.FSM"
 	ftlTransitionFuncPath = "github.com/TBD54566975/ftl/go-runtime/ftl.Transition"
 	ftlStartFuncPath      = "github.com/TBD54566975/ftl/go-runtime/ftl.Start"
+	ftlTimeoutFuncPath    = "github.com/TBD54566975/ftl/go-runtime/ftl.Timeout"
+	ftlCompensateFuncPath = "github.com/TBD54566975/ftl/go-runtime/ftl.Compensate"
 )
 
 // Extractor extracts FSMs.
@@ -36,12 +41,12 @@ func Extract(pass *analysis.Pass, obj types.Object, node *ast.GenDecl, callExpr
 	for _, arg := range callExpr.Args[1:] {
 		call, ok := arg.(*ast.CallExpr)
 		if !ok {
-			common.Errorf(pass, arg, "expected call to Start or Transition")
+			common.Errorf(pass, arg, "expected call to Start, Transition, Timeout or Compensate")
 			continue
 		}
 		_, fn := common.Deref[*types.Func](pass, call.Fun)
 		if fn == nil {
-			common.Errorf(pass, call, "expected call to Start or Transition")
+			common.Errorf(pass, call, "expected call to Start, Transition, Timeout or Compensate")
 			continue
 		}
 		parseFSMTransition(pass, call, fn, fsm)
@@ -49,20 +54,26 @@ func Extract(pass *analysis.Pass, obj types.Object, node *ast.GenDecl, callExpr
 
 	if md, ok := common.GetFactForObject[*common.ExtractedMetadata](pass, obj).Get(); ok {
 		for _, m := range md.Metadata {
-			if _, ok := m.(*schema.MetadataRetry); !ok {
+			switch m.(type) {
+			case *schema.MetadataRetry, *schema.MetadataQueue, *schema.MetadataMigrate:
+			default:
 				common.Errorf(pass, callExpr, "unexpected metadata %q attached for FSM", m)
 			}
 		}
 		fsm.Comments = md.Comments
-		fsm.Metadata = md.Metadata
+		fsm.Metadata = append(fsm.Metadata, md.Metadata...)
 	}
 	return optional.Some(fsm)
 }
 
-// Parse a Start or Transition call in an FSM declaration and add it to the FSM.
+// Parse a Start, Transition, Timeout or Compensate call in an FSM declaration and add it to the FSM.
 func parseFSMTransition(pass *analysis.Pass, node *ast.CallExpr, fn *types.Func, fsm *schema.FSM) {
-	refs := make([]*schema.Ref, len(node.Args))
-	for i, arg := range node.Args {
+	args := node.Args
+	if fn.FullName() == ftlTimeoutFuncPath && len(args) == 3 {
+		args = args[:2]
+	}
+	refs := make([]*schema.Ref, len(args))
+	for i, arg := range args {
 		ref := parseVerbRef(pass, arg)
 		if ref == nil {
 			common.Errorf(pass, arg, "expected a reference to a sink")
@@ -89,9 +100,55 @@ func parseFSMTransition(pass *analysis.Pass, node *ast.CallExpr, fn *types.Func,
 			To:   refs[1],
 		})
 
+	case ftlTimeoutFuncPath:
+		if len(refs) != 2 {
+			common.Errorf(pass, node, "expected two references to sinks and a duration")
+			return
+		}
+		timeout, ok := parseTimeout(pass, node.Args[2])
+		if !ok {
+			return
+		}
+		fsm.Transitions = append(fsm.Transitions, &schema.FSMTransition{
+			Pos:  common.GoPosToSchemaPos(pass.Fset, node.Pos()),
+			From: refs[0],
+			To:   refs[1],
+			Metadata: []schema.Metadata{&schema.MetadataTimeout{
+				Pos:      common.GoPosToSchemaPos(pass.Fset, node.Args[2].Pos()),
+				Duration: timeout,
+			}},
+		})
+
+	case ftlCompensateFuncPath:
+		if len(refs) != 2 {
+			common.Errorf(pass, node, "expected two references to sinks")
+			return
+		}
+		fsm.Metadata = append(fsm.Metadata, &schema.MetadataCompensate{
+			Pos:   common.GoPosToSchemaPos(pass.Fset, node.Pos()),
+			State: refs[0],
+			Verb:  refs[1],
+		})
+
 	default:
-		common.Errorf(pass, node, "expected call to Start or Transition")
+		common.Errorf(pass, node, "expected call to Start, Transition, Timeout or Compensate")
+	}
+}
+
+// Parse a constant time.Duration expression into a schema duration, eg. "1h30m".
+func parseTimeout(pass *analysis.Pass, node ast.Expr) (string, bool) {
+	tv, ok := pass.TypesInfo.Types[node]
+	if !ok || tv.Value == nil {
+		common.Errorf(pass, node, "timeout must be a constant duration")
+		return "", false
+	}
+	nanos, exact := constant.Int64Val(tv.Value)
+	timeout := time.Duration(nanos)
+	if !exact || timeout <= 0 || timeout%time.Second != 0 {
+		common.Errorf(pass, node, "timeout must be a positive whole number of seconds")
+		return "", false
 	}
+	return duration.Format(timeout), true
 }
 
 func parseVerbRef(pass *analysis.Pass, node ast.Expr) *schema.Ref {
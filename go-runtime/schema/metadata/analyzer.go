@@ -112,8 +112,10 @@ func extractMetadata(pass *analysis.Pass, node ast.Node, doc *ast.CommentGroup)
 				continue
 			}
 			metadata = append(metadata, &schema.MetadataCronJob{
-				Pos:  common.GoPosToSchemaPos(pass.Fset, dt.Pos),
-				Cron: dt.Cron.String(),
+				Pos:      common.GoPosToSchemaPos(pass.Fset, dt.Pos),
+				Cron:     dt.Cron.String(),
+				Timezone: dt.Timezone,
+				Missed:   dt.Missed,
 			})
 		case *common.DirectiveRetry:
 			metadata = append(metadata, &schema.MetadataRetry{
@@ -126,8 +128,57 @@ func extractMetadata(pass *analysis.Pass, node ast.Node, doc *ast.CommentGroup)
 		case *common.DirectiveSubscriber:
 			newSchType = &schema.Verb{}
 			metadata = append(metadata, &schema.MetadataSubscriber{
+				Pos:       common.GoPosToSchemaPos(pass.Fset, dt.Pos),
+				Name:      dt.Name,
+				BatchSize: dt.BatchSize,
+				BatchWait: dt.BatchWait,
+			})
+		case *common.DirectiveTimeout:
+			newSchType = &schema.Verb{}
+			metadata = append(metadata, &schema.MetadataTimeout{
+				Pos:      common.GoPosToSchemaPos(pass.Fset, dt.Pos),
+				Duration: dt.Duration,
+			})
+		case *common.DirectiveRateLimit:
+			newSchType = &schema.Verb{}
+			metadata = append(metadata, &schema.MetadataRateLimit{
+				Pos:    common.GoPosToSchemaPos(pass.Fset, dt.Pos),
+				Limit:  dt.Limit,
+				Period: dt.Period,
+			})
+		case *common.DirectiveConcurrency:
+			newSchType = &schema.Verb{}
+			metadata = append(metadata, &schema.MetadataConcurrency{
+				Pos:   common.GoPosToSchemaPos(pass.Fset, dt.Pos),
+				Limit: dt.Limit,
+			})
+		case *common.DirectiveRecursive:
+			newSchType = &schema.Verb{}
+			metadata = append(metadata, &schema.MetadataRecursive{
+				Pos: common.GoPosToSchemaPos(pass.Fset, dt.Pos),
+			})
+		case *common.DirectiveAuth:
+			newSchType = &schema.Verb{}
+			metadata = append(metadata, &schema.MetadataAuth{
+				Pos:      common.GoPosToSchemaPos(pass.Fset, dt.Pos),
+				Scheme:   dt.Scheme,
+				Issuer:   dt.Issuer,
+				Audience: dt.Audience,
+				JWKS:     dt.JWKS,
+				Header:   dt.Header,
+				Secret:   dt.SecretRef(),
+			})
+		case *common.DirectiveQueue:
+			metadata = append(metadata, &schema.MetadataQueue{
+				Pos:    common.GoPosToSchemaPos(pass.Fset, dt.Pos),
+				Depth:  dt.Depth,
+				Dedupe: dt.Dedupe,
+			})
+		case *common.DirectiveMigrate:
+			metadata = append(metadata, &schema.MetadataMigrate{
 				Pos:  common.GoPosToSchemaPos(pass.Fset, dt.Pos),
-				Name: dt.Name,
+				From: dt.From(),
+				To:   dt.To(),
 			})
 		case *common.DirectiveTypeMap:
 			newSchType = &schema.TypeAlias{}
@@ -199,8 +250,12 @@ func isAnnotatingValidGoNode(dir common.Directive, node ast.Node) bool {
 }
 
 func canRepeatDirective(dir common.Directive) bool {
-	_, ok := dir.(*common.DirectiveTypeMap)
-	return ok
+	switch dir.(type) {
+	case *common.DirectiveTypeMap, *common.DirectiveAuth:
+		return true
+	default:
+		return false
+	}
 }
 
 // TODO: fix - this doesn't work for member functions.
@@ -267,8 +267,13 @@ func TestExtractModuleSchemaTwo(t *testing.T) {
 			export verb callsTwoAndThree(two.Payload<String>) two.Payload<String>
 				+calls two.three, two.two
 
+			export verb remind(two.Payload<String>) Unit
+
 			export verb returnsUser(Unit) two.UserResponse
 
+			export verb schedulesRemind(two.Payload<String>) Unit
+				+calls two.remind
+
 			export verb three(two.Payload<String>) two.Payload<String>
 
 			export verb two(two.Payload<String>) two.Payload<String>
@@ -288,12 +293,14 @@ func TestExtractModuleSchemaFSM(t *testing.T) {
 	expected := `module fsm {
 		fsm payment
 			+retry 10 5s 10m
+			+queue 50 dedupe
 		{
 			start fsm.created
 			start fsm.paid
 			transition fsm.created to fsm.paid
 			transition fsm.created to fsm.failed
 			transition fsm.paid to fsm.completed
+			transition fsm.created to fsm.expired +timeout 30m
 		}
 
 		// The message to be sent when the payment is completed.
@@ -317,6 +324,8 @@ func TestExtractModuleSchemaFSM(t *testing.T) {
 		verb created(fsm.OnlinePaymentCreated) Unit
 			+retry 5 1m30s 7m
 
+		verb expired(fsm.OnlinePaymentCreated) Unit
+
 		verb failed(fsm.OnlinePaymentFailed) Unit
 			+retry 5 1h 1d
 
@@ -433,17 +442,23 @@ func TestExtractModulePubSub(t *testing.T) {
 	assert.Equal(t, nil, r.Errors, "expected no schema errors")
 	actual := schema.Normalise(r.Module)
 	expected := `module pubsub {
-		topic payins pubsub.PayinEvent
+		// failedPayins receives payin events that could not be processed.
+		topic failedPayins builtin.FailedEvent<pubsub.PayinEvent>
+		topic payins pubsub.PayinEvent partitions 4
 		// publicBroadcast is a topic that broadcasts payin events to the public.
 		// out of order with subscription registration to test ordering doesn't matter.
 		export topic publicBroadcast pubsub.PayinEvent
 		subscription broadcastSubscription pubsub.publicBroadcast
-		subscription paymentProcessing pubsub.payins
+		subscription payinAnalytics pubsub.payins
+		subscription paymentProcessing pubsub.payins deadletter pubsub.failedPayins
 
         export data PayinEvent {
         	name String
         }
 
+        verb analysePayins([pubsub.PayinEvent]) Unit
+        	+subscribe payinAnalytics batch 100 5s
+
 		export verb broadcast(Unit) Unit
 
         verb payin(Unit) Unit
@@ -14,78 +14,97 @@ import (
 
 const (
 	ftlSubscriptionFuncPath = "github.com/TBD54566975/ftl/go-runtime/ftl.Subscription"
+	ftlDeadLetterFuncPath   = "github.com/TBD54566975/ftl/go-runtime/ftl.DeadLetter"
 )
 
 // Extractor extracts subscriptions.
 var Extractor = common.NewCallDeclExtractor[*schema.Subscription]("subscription", Extract, ftlSubscriptionFuncPath)
 
-// expects: var _ = ftl.Subscription(topicHandle, "name_literal")
+// expects: var _ = ftl.Subscription(topicHandle, "name_literal", ...options)
 func Extract(pass *analysis.Pass, obj types.Object, node *ast.GenDecl, callExpr *ast.CallExpr,
 	callPath string) optional.Option[*schema.Subscription] {
-	var topicRef *schema.Ref
-	if len(callExpr.Args) != 2 {
-		common.Errorf(pass, callExpr, "subscription registration must have exactly two arguments")
+	if len(callExpr.Args) < 2 {
+		common.Errorf(pass, callExpr, "subscription registration must have at least two arguments")
 		return optional.None[*schema.Subscription]()
 	}
-	if topicIdent, ok := callExpr.Args[0].(*ast.Ident); ok {
+	topicRef, ok := extractTopicRef(pass, callExpr, callExpr.Args[0]).Get()
+	if !ok {
+		return optional.None[*schema.Subscription]()
+	}
+
+	var deadLetterRef *schema.Ref
+	for _, arg := range callExpr.Args[2:] {
+		optionExpr, ok := arg.(*ast.CallExpr)
+		if !ok || !common.FuncPathEquals(pass, optionExpr, ftlDeadLetterFuncPath) || len(optionExpr.Args) != 1 {
+			common.Errorf(pass, arg, "subscription options must be calls to ftl.DeadLetter(topic)")
+			return optional.None[*schema.Subscription]()
+		}
+		deadLetterRef, ok = extractTopicRef(pass, optionExpr, optionExpr.Args[0]).Get()
+		if !ok {
+			return optional.None[*schema.Subscription]()
+		}
+	}
+
+	subName := common.ExtractStringLiteralArg(pass, callExpr, 1)
+	expSubName := strcase.ToLowerCamel(subName)
+	if subName != expSubName {
+		common.Errorf(pass, node, "unsupported subscription name %q, did you mean to use %q?", subName, expSubName)
+		return optional.None[*schema.Subscription]()
+	}
+	subscription := &schema.Subscription{
+		Pos:        common.GoPosToSchemaPos(pass.Fset, callExpr.Pos()),
+		Name:       subName,
+		Topic:      topicRef,
+		DeadLetter: deadLetterRef,
+	}
+	common.ApplyMetadata[*schema.Subscription](pass, obj, func(md *common.ExtractedMetadata) {
+		subscription.Comments = md.Comments
+	})
+	return optional.Some(subscription)
+}
+
+// extractTopicRef resolves a topic handle expression to a reference to the topic.
+func extractTopicRef(pass *analysis.Pass, callExpr *ast.CallExpr, expr ast.Expr) optional.Option[*schema.Ref] {
+	if topicIdent, ok := expr.(*ast.Ident); ok {
 		// Topic is within module
-		// we will find the subscription name from the string literal parameter
 		object := pass.TypesInfo.ObjectOf(topicIdent)
 		fact, ok := common.GetFactForObject[*common.ExtractedDecl](pass, object).Get()
 		if !ok || fact.Decl == nil {
 			common.Errorf(pass, callExpr, "could not find topic declaration for topic variable")
-			return optional.None[*schema.Subscription]()
+			return optional.None[*schema.Ref]()
 		}
 		topic, ok := fact.Decl.(*schema.Topic)
 		if !ok {
 			common.Errorf(pass, callExpr, "could not find topic declaration for topic variable")
-			return optional.None[*schema.Subscription]()
+			return optional.None[*schema.Ref]()
 		}
 
 		moduleName, err := common.FtlModuleFromGoPackage(pass.Pkg.Path())
 		if err != nil {
-			return optional.None[*schema.Subscription]()
+			return optional.None[*schema.Ref]()
 		}
-		topicRef = &schema.Ref{
+		return optional.Some(&schema.Ref{
 			Module: moduleName,
 			Name:   topic.Name,
-		}
-	} else if topicSelExp, ok := callExpr.Args[0].(*ast.SelectorExpr); ok {
+		})
+	} else if topicSelExp, ok := expr.(*ast.SelectorExpr); ok {
 		// External topic
-		// we will derive subscription name from generated variable name
+		// we will derive the topic name from generated variable name
 		moduleIdent, moduleOk := topicSelExp.X.(*ast.Ident)
 		if !moduleOk {
 			common.Errorf(pass, callExpr, "subscription registration must have a topic")
-			return optional.None[*schema.Subscription]()
+			return optional.None[*schema.Ref]()
 		}
 		varName := topicSelExp.Sel.Name
 		if varName == "" {
 			common.Errorf(pass, callExpr, "subscription registration must have a topic")
-			return optional.None[*schema.Subscription]()
+			return optional.None[*schema.Ref]()
 		}
-		name := strcase.ToLowerCamel(varName)
-		topicRef = &schema.Ref{
+		return optional.Some(&schema.Ref{
 			Module: moduleIdent.Name,
-			Name:   name,
-		}
-	} else {
-		common.Errorf(pass, callExpr, "subscription registration must have a topic")
-		return optional.None[*schema.Subscription]()
-	}
-
-	subName := common.ExtractStringLiteralArg(pass, callExpr, 1)
-	expSubName := strcase.ToLowerCamel(subName)
-	if subName != expSubName {
-		common.Errorf(pass, node, "unsupported subscription name %q, did you mean to use %q?", subName, expSubName)
-		return optional.None[*schema.Subscription]()
+			Name:   strcase.ToLowerCamel(varName),
+		})
 	}
-	subscription := &schema.Subscription{
-		Pos:   common.GoPosToSchemaPos(pass.Fset, callExpr.Pos()),
-		Name:  subName,
-		Topic: topicRef,
-	}
-	common.ApplyMetadata[*schema.Subscription](pass, obj, func(md *common.ExtractedMetadata) {
-		subscription.Comments = md.Comments
-	})
-	return optional.Some(subscription)
+	common.Errorf(pass, callExpr, "subscription registration must have a topic")
+	return optional.None[*schema.Ref]()
 }
@@ -2,6 +2,7 @@ package fsm
 
 import (
 	"context"
+	"time"
 
 	"github.com/TBD54566975/ftl/go-runtime/ftl"
 )
@@ -9,12 +10,14 @@ import (
 // The payment FSM.
 //
 //ftl:retry 10 5s 10m
+//ftl:queue 50 dedupe
 var paymentFSM = ftl.FSM("payment",
 	ftl.Start(Created),
 	ftl.Start(Paid),
 	ftl.Transition(Created, Paid),
 	ftl.Transition(Created, Failed),
 	ftl.Transition(Paid, Completed),
+	ftl.Timeout(Created, Expired, 30*time.Minute),
 )
 
 // The message to be sent when the payment is completed.
@@ -37,6 +40,11 @@ func Created(ctx context.Context, in OnlinePaymentCreated) error {
 	return nil
 }
 
+//ftl:verb
+func Expired(ctx context.Context, in OnlinePaymentCreated) error {
+	return nil
+}
+
 //ftl:verb
 //ftl:retry 5 1h 1d
 func Failed(ctx context.Context, in OnlinePaymentFailed) error {
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"ftl/builtin"
+
 	"github.com/TBD54566975/ftl/go-runtime/ftl" // Import the FTL SDK.
 )
 
@@ -26,9 +28,21 @@ func ProcessPayin(ctx context.Context, event PayinEvent) error {
 	return nil
 }
 
-var _ = ftl.Subscription(Payins, "paymentProcessing")
+var _ = ftl.Subscription(Payins, "paymentProcessing", ftl.DeadLetter(FailedPayins))
+
+var Payins = ftl.Topic[PayinEvent]("payins", ftl.Partitions(4))
+
+var _ = ftl.Subscription(Payins, "payinAnalytics")
+
+//ftl:subscribe payinAnalytics batch 100 5s
+func AnalysePayins(ctx context.Context, events []PayinEvent) error {
+	logger := ftl.LoggerFromContext(ctx)
+	logger.Infof("Received %d PubSub events", len(events))
+	return nil
+}
 
-var Payins = ftl.Topic[PayinEvent]("payins")
+// failedPayins receives payin events that could not be processed.
+var FailedPayins = ftl.Topic[builtin.FailedEvent[PayinEvent]]("failedPayins")
 
 var _ = ftl.Subscription(PublicBroadcast, "broadcastSubscription")
 
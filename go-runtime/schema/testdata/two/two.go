@@ -2,6 +2,7 @@ package two
 
 import (
 	"context"
+	"time"
 
 	"github.com/TBD54566975/ftl/go-runtime/ftl"
 	lib "github.com/TBD54566975/ftl/go-runtime/schema/testdata"
@@ -70,6 +71,17 @@ func CallsTwoAndThree(ctx context.Context, req Payload[string]) (Payload[string]
 	return Payload[string]{}, err
 }
 
+//ftl:verb export
+func Remind(ctx context.Context, req Payload[string]) error {
+	return nil
+}
+
+//ftl:verb export
+func SchedulesRemind(ctx context.Context, req Payload[string]) error {
+	_, err := ftl.CallAfter(ctx, Remind, req, time.Hour, ftl.WithCallKey(req.Body))
+	return err
+}
+
 //ftl:verb export
 func ReturnsUser(ctx context.Context) (UserResponse, error) {
 	return UserResponse{
@@ -13,13 +13,14 @@ import (
 )
 
 const (
-	ftlTopicFuncPath = "github.com/TBD54566975/ftl/go-runtime/ftl.Topic"
+	ftlTopicFuncPath      = "github.com/TBD54566975/ftl/go-runtime/ftl.Topic"
+	ftlPartitionsFuncPath = "github.com/TBD54566975/ftl/go-runtime/ftl.Partitions"
 )
 
 // Extractor extracts topics.
 var Extractor = common.NewCallDeclExtractor[*schema.Topic]("topic", Extract, ftlTopicFuncPath)
 
-// Extract expects: var NameLiteral = ftl.Topic[EventType]("nameLiteral")
+// Extract expects: var NameLiteral = ftl.Topic[EventType]("nameLiteral", ...options)
 func Extract(pass *analysis.Pass, obj types.Object, node *ast.GenDecl, callExpr *ast.CallExpr,
 	callPath string) optional.Option[*schema.Topic] {
 	indexExpr, ok := callExpr.Fun.(*ast.IndexExpr)
@@ -51,10 +52,25 @@ func Extract(pass *analysis.Pass, obj types.Object, node *ast.GenDecl, callExpr
 		}
 	}
 
+	var partitions int
+	for _, arg := range callExpr.Args[1:] {
+		optionExpr, ok := arg.(*ast.CallExpr)
+		if !ok || !common.FuncPathEquals(pass, optionExpr, ftlPartitionsFuncPath) {
+			common.Errorf(pass, arg, "topic options must be calls to ftl.Partitions(n)")
+			return optional.None[*schema.Topic]()
+		}
+		partitions = common.ExtractIntLiteralArg(pass, optionExpr, 0)
+		if partitions < 1 {
+			common.Errorf(pass, arg, "topic must have at least one partition")
+			return optional.None[*schema.Topic]()
+		}
+	}
+
 	topic := &schema.Topic{
-		Pos:   common.GoPosToSchemaPos(pass.Fset, node.Pos()),
-		Name:  topicName,
-		Event: typeParamType,
+		Pos:        common.GoPosToSchemaPos(pass.Fset, node.Pos()),
+		Name:       topicName,
+		Event:      typeParamType,
+		Partitions: partitions,
 	}
 	common.ApplyMetadata[*schema.Subscription](pass, obj, func(md *common.ExtractedMetadata) {
 		topic.Comments = md.Comments
@@ -37,45 +37,65 @@ func Extract(pass *analysis.Pass, node *ast.FuncDecl, obj types.Object) optional
 		return optional.None[*schema.Verb]()
 	}
 
-	reqt, respt := checkSignature(pass, node, sig)
+	reqt, respt, stream := checkSignature(pass, node, sig)
 	req := optional.Some[schema.Type](&schema.Unit{})
 	if reqt.Ok() {
 		req = common.ExtractType(pass, node.Type.Params.List[1])
 	}
+	params := sig.Params()
+	results := sig.Results()
 	resp := optional.Some[schema.Type](&schema.Unit{})
-	if respt.Ok() {
-		resp = common.ExtractType(pass, node.Type.Results.List[0])
+	var respField *ast.Field
+	var respType types.Type
+	switch {
+	case stream:
+		// The response type is the type parameter of ftl.StreamWriter[T].
+		respField = node.Type.Params.List[2]
+		respType = params.At(2).Type()
+		if index, ok := respField.Type.(*ast.IndexExpr); ok {
+			resp = common.ExtractType(pass, index.Index)
+		} else {
+			resp = optional.None[schema.Type]()
+		}
+	case respt.Ok():
+		respField = node.Type.Results.List[0]
+		respType = results.At(0).Type()
+		resp = common.ExtractType(pass, respField)
 	}
 
-	params := sig.Params()
-	results := sig.Results()
 	reqV, ok := req.Get()
 	if !ok {
 		common.Errorf(pass, node.Type.Params.List[1], "unsupported request type %q", params.At(1).Type())
 	}
 	resV, ok := resp.Get()
 	if !ok {
-		common.Errorf(pass, node.Type.Results.List[0], "unsupported response type %q", results.At(0).Type())
+		common.Errorf(pass, respField, "unsupported response type %q", respType)
 	}
 	verb.Request = reqV
 	verb.Response = resV
+	verb.Stream = stream
 
 	return optional.Some(verb)
 }
 
-func checkSignature(pass *analysis.Pass, node *ast.FuncDecl, sig *types.Signature) (req, resp optional.Option[*types.Var]) {
+func checkSignature(pass *analysis.Pass, node *ast.FuncDecl, sig *types.Signature) (req, resp optional.Option[*types.Var], stream bool) {
 	if node.Name.Name == "" {
 		common.Errorf(pass, node, "verb function must be named")
-		return optional.None[*types.Var](), optional.None[*types.Var]()
+		return optional.None[*types.Var](), optional.None[*types.Var](), false
 	}
 	if !unicode.IsUpper(rune(node.Name.Name[0])) {
 		common.Errorf(pass, node, "verb name must be exported")
-		return optional.None[*types.Var](), optional.None[*types.Var]()
+		return optional.None[*types.Var](), optional.None[*types.Var](), false
 	}
 
 	params := sig.Params()
 	results := sig.Results()
-	if params.Len() > 2 {
+	if params.Len() == 3 && isStreamWriter(params.At(2).Type()) {
+		stream = true
+		if results.Len() != 1 {
+			common.Errorf(pass, node, "streaming verb must only return an error")
+		}
+	} else if params.Len() > 2 {
 		common.Errorf(pass, node, "must have at most two parameters (context.Context, struct)")
 	}
 
@@ -86,7 +106,7 @@ func checkSignature(pass *analysis.Pass, node *ast.FuncDecl, sig *types.Signatur
 		common.TokenErrorf(pass, params.At(0).Pos(), params.At(0).Name(), "first parameter must be of type context.Context but is %s", params.At(0).Type())
 	}
 
-	if params.Len() == 2 {
+	if params.Len() >= 2 {
 		if params.At(1).Type().String() == common.FtlUnitTypePath {
 			common.TokenErrorf(pass, params.At(1).Pos(), params.At(1).Name(), "second parameter must not be ftl.Unit")
 		}
@@ -108,5 +128,14 @@ func checkSignature(pass *analysis.Pass, node *ast.FuncDecl, sig *types.Signatur
 		}
 		resp = optional.Some(results.At(0))
 	}
-	return req, resp
+	return req, resp, stream
+}
+
+// isStreamWriter returns true if t is an ftl.StreamWriter[T].
+func isStreamWriter(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	return named.Obj().Pkg().Path()+"."+named.Obj().Name() == common.FtlStreamWriterTypePath
 }
@@ -7,9 +7,11 @@ import (
 	"runtime/debug"
 
 	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
 
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/common/plugin"
 	"github.com/TBD54566975/ftl/go-runtime/encoding"
 	"github.com/TBD54566975/ftl/go-runtime/ftl"
@@ -58,6 +60,8 @@ func NewUserVerbServer(projectName string, moduleName string, handlers ...Handle
 type Handler struct {
 	ref reflection.Ref
 	fn  func(ctx context.Context, req []byte) ([]byte, error)
+	// stream is set instead of fn for streaming Verbs.
+	stream func(ctx context.Context, req []byte, send func([]byte) error) error
 }
 
 func handler[Req, Resp any](ref reflection.Ref, verb func(ctx context.Context, req Req) (Resp, error)) Handler {
@@ -115,6 +119,67 @@ func HandleEmpty(empty func(ctx context.Context) error) Handler {
 	})
 }
 
+// HandleStream creates a Handler from a streaming Verb.
+func HandleStream[Req, Resp any](verb func(ctx context.Context, req Req, stream ftl.StreamWriter[Resp]) error) Handler {
+	ref := reflection.FuncRef(verb)
+	return Handler{
+		ref: ref,
+		stream: func(ctx context.Context, reqdata []byte, send func([]byte) error) error {
+			var req Req
+			err := encoding.Unmarshal(reqdata, &req)
+			if err != nil {
+				return fmt.Errorf("invalid request to verb %s: %w", ref, err)
+			}
+			err = verb(ctx, req, streamWriter[Resp]{ref: ref, send: send})
+			if err != nil {
+				return fmt.Errorf("call to verb %s failed: %w", ref, err)
+			}
+			return nil
+		},
+	}
+}
+
+type streamWriter[Resp any] struct {
+	ref  reflection.Ref
+	send func([]byte) error
+}
+
+func (s streamWriter[Resp]) Send(ctx context.Context, resp Resp) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("stream from verb %s closed: %w", s.ref, err)
+	}
+	respdata, err := encoding.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	if err := s.send(respdata); err != nil {
+		return fmt.Errorf("stream from verb %s closed: %w", s.ref, err)
+	}
+	return nil
+}
+
+// fsmInstanceFromMetadata returns the FSM instance a call is executing a
+// transition for, if any.
+func fsmInstanceFromMetadata(metadata *ftlv1.Metadata) optional.Option[internal.FSMInstance] {
+	var fsm, instance string
+	for _, pair := range metadata.GetValues() {
+		switch pair.Key {
+		case "fsm":
+			ref, err := schema.ParseRef(pair.Value)
+			if err != nil {
+				return optional.None[internal.FSMInstance]()
+			}
+			fsm = ref.Name
+		case "fsmInstance":
+			instance = pair.Value
+		}
+	}
+	if fsm == "" || instance == "" {
+		return optional.None[internal.FSMInstance]()
+	}
+	return optional.Some(internal.FSMInstance{FSM: fsm, Instance: instance})
+}
+
 var _ ftlv1connect.VerbServiceHandler = (*moduleServer)(nil)
 
 // This is the server that is compiled into the same binary as user-defined Verbs.
@@ -145,7 +210,11 @@ func (m *moduleServer) Call(ctx context.Context, req *connect.Request[ftlv1.Call
 	if !ok {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("verb %s.%s not found", req.Msg.Verb.Module, req.Msg.Verb.Name))
 	}
+	if handler.fn == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("verb %s.%s is a streaming verb", req.Msg.Verb.Module, req.Msg.Verb.Name))
+	}
 
+	ctx = contextForCall(ctx, req.Msg)
 	respdata, err := handler.fn(ctx, req.Msg.Body)
 	if err != nil {
 		// This makes me slightly ill.
@@ -159,6 +228,56 @@ func (m *moduleServer) Call(ctx context.Context, req *connect.Request[ftlv1.Call
 	}), nil
 }
 
+func (m *moduleServer) CallStream(ctx context.Context, req *connect.Request[ftlv1.CallRequest], stream *connect.ServerStream[ftlv1.CallResponse]) (err error) {
+	logger := log.FromContext(ctx)
+	// Recover from panics and terminate the stream with an error ftlv1.CallResponse.
+	defer func() {
+		if r := recover(); r != nil {
+			var rerr error
+			if e, ok := r.(error); ok {
+				rerr = e
+			} else {
+				rerr = fmt.Errorf("%v", r)
+			}
+			stack := string(debug.Stack())
+			logger.Errorf(rerr, "panic in verb %s.%s", req.Msg.Verb.Module, req.Msg.Verb.Name)
+			err = stream.Send(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Error_{Error: &ftlv1.CallResponse_Error{
+				Message: rerr.Error(),
+				Stack:   &stack,
+			}}})
+		}
+	}()
+	handler, ok := m.handlers[reflection.RefFromProto(req.Msg.Verb)]
+	if !ok {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("verb %s.%s not found", req.Msg.Verb.Module, req.Msg.Verb.Name))
+	}
+	if handler.stream == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("verb %s.%s is not a streaming verb", req.Msg.Verb.Module, req.Msg.Verb.Name))
+	}
+
+	ctx = contextForCall(ctx, req.Msg)
+	err = handler.stream(ctx, req.Msg.Body, func(data []byte) error {
+		return stream.Send(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: data}})
+	})
+	if err != nil {
+		return stream.Send(&ftlv1.CallResponse{
+			Response: &ftlv1.CallResponse_Error_{Error: &ftlv1.CallResponse_Error{Message: err.Error()}},
+		})
+	}
+	return nil
+}
+
+// contextForCall applies the metadata of a call request to the context passed to the Verb.
+func contextForCall(ctx context.Context, req *ftlv1.CallRequest) context.Context {
+	if key := req.GetIdempotencyKey(); key != "" {
+		ctx = rpc.WithIdempotencyKey(ctx, key)
+	}
+	if instance, ok := fsmInstanceFromMetadata(req.Metadata).Get(); ok {
+		ctx = internal.ContextWithFSMInstance(ctx, instance)
+	}
+	return ctx
+}
+
 func (m *moduleServer) GetModuleContext(_ context.Context, _ *connect.Request[ftlv1.ModuleContextRequest], _ *connect.ServerStream[ftlv1.ModuleContextResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, fmt.Errorf("GetModuleContext not implemented"))
 }
@@ -175,6 +294,18 @@ func (m *moduleServer) SendFSMEvent(context.Context, *connect.Request[ftlv1.Send
 	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("SendFSMEvent not implemented"))
 }
 
+func (m *moduleServer) SetNextFSMEvent(context.Context, *connect.Request[ftlv1.SetNextFSMEventRequest]) (*connect.Response[ftlv1.SetNextFSMEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("SetNextFSMEvent not implemented"))
+}
+
 func (m *moduleServer) PublishEvent(context.Context, *connect.Request[ftlv1.PublishEventRequest]) (*connect.Response[ftlv1.PublishEventResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("PublishEvent not implemented"))
 }
+
+func (m *moduleServer) SendWebSocketMessage(context.Context, *connect.Request[ftlv1.SendWebSocketMessageRequest]) (*connect.Response[ftlv1.SendWebSocketMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("SendWebSocketMessage not implemented"))
+}
+
+func (m *moduleServer) ScheduleCall(context.Context, *connect.Request[ftlv1.ScheduleCallRequest]) (*connect.Response[ftlv1.ScheduleCallResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("ScheduleCall not implemented"))
+}
@@ -3,6 +3,8 @@ package cron
 import (
 	"fmt"
 	"time"
+	// Timezone data is embedded so that schedules can be evaluated on hosts without it.
+	_ "time/tzdata"
 )
 
 /*
@@ -19,6 +21,10 @@ It supports the following features:
 - ranges with - (eg 1-5)
 - steps with / (eg 1-5/2)
 - lists with , (eg 1,2,3)
+
+Patterns are evaluated in UTC unless parsed with ParseInTimezone. Across daylight saving transitions:
+- times that are skipped (eg 02:30 when clocks go forward from 02:00 to 03:00) match once, at the same offset after the transition (eg 03:30)
+- times that occur twice (eg 02:30 when clocks go back from 03:00 to 02:00) match once, at their first occurrence
 */
 
 // Next calculates the next time that matches the pattern after the current time
@@ -29,7 +35,7 @@ func Next(pattern Pattern, allowCurrentTime bool) (time.Time, error) {
 
 // NextAfter calculates the next time that matches the pattern after the origin time
 // If inclusive is true, the origin time is considered a valid match
-// All calculations are done in UTC, and the result is returned in UTC
+// Calculations are done in the pattern's timezone, and the result is returned in UTC
 func NextAfter(pattern Pattern, origin time.Time, inclusive bool) (time.Time, error) {
 	// set original to the first acceptable time, regardless of pattern
 	origin = origin.UTC()
@@ -37,9 +43,48 @@ func NextAfter(pattern Pattern, origin time.Time, inclusive bool) (time.Time, er
 		origin = origin.Add(-time.Second)
 	}
 
-	next := pattern.expression.Next(origin)
-	if next.IsZero() {
-		return next, fmt.Errorf("unable to find next timeout for %s", pattern)
+	location := pattern.Location()
+	if location == time.UTC {
+		next := pattern.expression.Next(origin)
+		if next.IsZero() {
+			return next, fmt.Errorf("unable to find next timeout for %s", pattern)
+		}
+		return next, nil
+	}
+
+	// Evaluate the pattern against wall clock times, represented as UTC so that there are no gaps or
+	// repeats, then convert each candidate to the pattern's timezone.
+	wallClock := wallClockTime(origin.In(location))
+	for {
+		wallClock = pattern.expression.Next(wallClock)
+		if wallClock.IsZero() {
+			return wallClock, fmt.Errorf("unable to find next timeout for %s", pattern)
+		}
+		// A candidate can resolve to a time before the origin if the origin is within a repeated hour.
+		if next := resolveWallClockTime(wallClock, location); next.After(origin) {
+			return next, nil
+		}
+	}
+}
+
+// wallClockTime returns the wall clock time of t, represented in UTC.
+func wallClockTime(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// resolveWallClockTime returns the first time in the location with the given wall clock time.
+//
+// If the wall clock time is skipped by a transition, the offset before the transition is used.
+func resolveWallClockTime(wallClock time.Time, location *time.Location) time.Time {
+	// Offsets only change at transitions, which are assumed to be at least a couple of days apart.
+	_, offsetBefore := wallClock.Add(-48 * time.Hour).In(location).Zone()
+	_, offsetAfter := wallClock.Add(48 * time.Hour).In(location).Zone()
+	before := wallClock.Add(-time.Duration(offsetBefore) * time.Second).UTC()
+	after := wallClock.Add(-time.Duration(offsetAfter) * time.Second).UTC()
+	matchesBefore := wallClockTime(before.In(location)).Equal(wallClock)
+	matchesAfter := wallClockTime(after.In(location)).Equal(wallClock)
+	if matchesAfter && (!matchesBefore || after.Before(before)) {
+		return after
 	}
-	return next, nil
+	return before
 }
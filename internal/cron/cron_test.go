@@ -9,8 +9,87 @@ import (
 )
 
 func TestNonUTC(t *testing.T) {
-	// This cron package only works with UTC times.
-	// Passing in non-UTC times works fine, but the results will be in UTC.
+	// Patterns without a timezone are evaluated in UTC, regardless of the origin's timezone.
+	pattern, err := Parse("0 9 * * *")
+	assert.NoError(t, err)
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+	next, err := NextAfter(pattern, time.Date(2024, 1, 10, 9, 30, 0, 0, berlin), false)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC), next)
+}
+
+func TestTimezone(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+	for _, tt := range []struct {
+		name     string
+		str      string
+		origin   time.Time
+		expected []time.Time
+	}{
+		{"StandardTime", "0 9 * * *", time.Date(2024, 1, 10, 0, 0, 0, 0, berlin), []time.Time{
+			time.Date(2024, 1, 10, 8, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 11, 8, 0, 0, 0, time.UTC),
+		}},
+		{"DaylightSavingTime", "0 9 * * *", time.Date(2024, 7, 10, 0, 0, 0, 0, berlin), []time.Time{
+			time.Date(2024, 7, 10, 7, 0, 0, 0, time.UTC),
+			time.Date(2024, 7, 11, 7, 0, 0, 0, time.UTC),
+		}},
+		{"AcrossClocksGoingForward", "0 9 * * *", time.Date(2024, 3, 30, 12, 0, 0, 0, berlin), []time.Time{
+			time.Date(2024, 3, 31, 7, 0, 0, 0, time.UTC),
+			time.Date(2024, 4, 1, 7, 0, 0, 0, time.UTC),
+		}},
+		{"SkippedTime", "30 2 * * *", time.Date(2024, 3, 30, 12, 0, 0, 0, berlin), []time.Time{
+			time.Date(2024, 3, 31, 1, 30, 0, 0, time.UTC), // 03:30 CEST
+			time.Date(2024, 4, 1, 0, 30, 0, 0, time.UTC),
+		}},
+		{"RepeatedTime", "30 2 * * *", time.Date(2024, 10, 26, 12, 0, 0, 0, berlin), []time.Time{
+			time.Date(2024, 10, 27, 0, 30, 0, 0, time.UTC), // 02:30 CEST, but not 02:30 CET
+			time.Date(2024, 10, 28, 1, 30, 0, 0, time.UTC),
+		}},
+		{"HourlyAcrossClocksGoingForward", "30 * * * *", time.Date(2024, 3, 31, 1, 0, 0, 0, berlin), []time.Time{
+			time.Date(2024, 3, 31, 0, 30, 0, 0, time.UTC), // 01:30 CET
+			time.Date(2024, 3, 31, 1, 30, 0, 0, time.UTC), // 03:30 CEST
+			time.Date(2024, 3, 31, 2, 30, 0, 0, time.UTC), // 04:30 CEST
+		}},
+		{"OriginWithinRepeatedHour", "0 * * * *", time.Date(2024, 10, 27, 1, 10, 0, 0, time.UTC), []time.Time{ // 02:10 CET
+			time.Date(2024, 10, 27, 2, 0, 0, 0, time.UTC), // 03:00 CET
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			pattern, err := ParseInTimezone(tt.str, "Europe/Berlin")
+			assert.NoError(t, err)
+			origin := tt.origin
+			for _, expected := range tt.expected {
+				next, err := NextAfter(pattern, origin, false)
+				assert.NoError(t, err)
+				assert.Equal(t, expected, next)
+				origin = next
+			}
+		})
+	}
+}
+
+func TestTimezoneErrors(t *testing.T) {
+	_, err := ParseInTimezone("* * * * *", "Mars/Olympus_Mons")
+	assert.EqualError(t, err, `invalid timezone "Mars/Olympus_Mons": unknown time zone Mars/Olympus_Mons`)
+	_, err = ParseInTimezone("* * * * *", "Local")
+	assert.EqualError(t, err, `invalid timezone "Local": timezone must be an IANA timezone name`)
+	pattern, err := ParseInTimezone("* * * * *", "")
+	assert.NoError(t, err)
+	assert.Equal(t, time.UTC, pattern.Location())
+}
+
+func TestParseMissedRunPolicy(t *testing.T) {
+	policy, err := ParseMissedRunPolicy("")
+	assert.NoError(t, err)
+	assert.Equal(t, MissedRunsOnce, policy)
+	policy, err = ParseMissedRunPolicy("all")
+	assert.NoError(t, err)
+	assert.Equal(t, MissedRunsAll, policy)
+	_, err = ParseMissedRunPolicy("some")
+	assert.EqualError(t, err, `invalid missed run policy "some": expected one of "skip", "once" or "all"`)
 }
 
 func TestParsingAndValidationErrors(t *testing.T) {
@@ -40,7 +119,6 @@ func TestParsingAndValidationErrors(t *testing.T) {
 }
 
 func TestNext(t *testing.T) {
-	//TODO: test inputting non UTC...
 	for _, tt := range []struct {
 		str              string
 		inputsAndOutputs [][]time.Time
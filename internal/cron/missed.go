@@ -0,0 +1,30 @@
+package cron
+
+import "fmt"
+
+// MissedRunPolicy determines what happens to executions of a job that were missed,
+// eg. because no controller was running when they were due.
+type MissedRunPolicy string
+
+const (
+	// MissedRunsSkip skips missed executions, and waits for the next scheduled execution.
+	MissedRunsSkip MissedRunPolicy = "skip"
+	// MissedRunsOnce executes the job once, however many executions were missed.
+	MissedRunsOnce MissedRunPolicy = "once"
+	// MissedRunsAll executes the job once for each missed execution, in order.
+	MissedRunsAll MissedRunPolicy = "all"
+)
+
+// ParseMissedRunPolicy parses a missed run policy.
+//
+// An empty policy is equivalent to MissedRunsOnce.
+func ParseMissedRunPolicy(policy string) (MissedRunPolicy, error) {
+	switch MissedRunPolicy(policy) {
+	case "":
+		return MissedRunsOnce, nil
+	case MissedRunsSkip, MissedRunsOnce, MissedRunsAll:
+		return MissedRunPolicy(policy), nil
+	default:
+		return "", fmt.Errorf("invalid missed run policy %q: expected one of %q, %q or %q", policy, MissedRunsSkip, MissedRunsOnce, MissedRunsAll)
+	}
+}
@@ -43,6 +43,15 @@ type Pattern struct {
 	DayOfWeek  *DayOfWeek  `parser:"| @('Mon' | 'Tue' | 'Wed' | 'Thu' | 'Fri' | 'Sat' | 'Sun')"`
 	Components []Component `parser:"| @@+"`
 	expression *cronexpr.Expression
+	location   *time.Location
+}
+
+// Location returns the timezone the pattern is evaluated in.
+func (p Pattern) Location() *time.Location {
+	if p.location == nil {
+		return time.UTC
+	}
+	return p.location
 }
 
 func (p Pattern) String() string {
@@ -247,6 +256,40 @@ func Parse(text string) (Pattern, error) {
 	return *pattern, nil
 }
 
+// ParseInTimezone parses a pattern that is evaluated in an IANA timezone, eg. "Europe/Berlin".
+//
+// An empty timezone is equivalent to UTC.
+func ParseInTimezone(text string, timezone string) (Pattern, error) {
+	location, err := LoadTimezone(timezone)
+	if err != nil {
+		return Pattern{}, err
+	}
+	pattern, err := Parse(text)
+	if err != nil {
+		return Pattern{}, err
+	}
+	pattern.location = location
+	return pattern, nil
+}
+
+// LoadTimezone loads an IANA timezone, eg. "Europe/Berlin".
+//
+// An empty timezone is equivalent to UTC. The local timezone of the host is not allowed,
+// as patterns must be evaluated consistently on every host.
+func LoadTimezone(timezone string) (*time.Location, error) {
+	switch timezone {
+	case "":
+		return time.UTC, nil
+	case "Local":
+		return nil, fmt.Errorf("invalid timezone %q: timezone must be an IANA timezone name", timezone)
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", timezone, err)
+	}
+	return location, nil
+}
+
 // A helper struct to build up a cron pattern with a short syntax.
 type shortState struct {
 	seenNonZero bool
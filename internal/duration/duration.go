@@ -30,6 +30,31 @@ func Parse(str string) (time.Duration, error) {
 	return components.Duration(), nil
 }
 
+// Format formats a duration in the form accepted by Parse, eg. "1d2h30m".
+//
+// Any fraction of a second is truncated.
+func Format(d time.Duration) string {
+	if d < time.Second {
+		return "0s"
+	}
+	out := ""
+	for _, unit := range []struct {
+		suffix   string
+		duration time.Duration
+	}{
+		{"d", time.Hour * 24},
+		{"h", time.Hour},
+		{"m", time.Minute},
+		{"s", time.Second},
+	} {
+		if n := d / unit.duration; n > 0 {
+			out += strconv.Itoa(int(n)) + unit.suffix
+			d -= n * unit.duration
+		}
+	}
+	return out
+}
+
 func ParseComponents(str string) (*Components, error) {
 	// regex is more lenient than what is valid to allow for better error messages.
 	re := regexp.MustCompile(`^(\d+)([a-zA-Z]+)`)
@@ -43,3 +43,25 @@ func TestBadDurations(t *testing.T) {
 		assert.Zero(t, duration)
 	}
 }
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		input    time.Duration
+		expected string
+	}{
+		{0, "0s"},
+		{time.Second, "1s"},
+		{time.Minute * 30, "30m"},
+		{time.Hour*24 + time.Minute*90, "1d1h30m"},
+		{time.Hour*48 + time.Second*5, "2d5s"},
+		{time.Second*3 + time.Millisecond*500, "3s"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, Format(test.input))
+		if test.input%time.Second == 0 {
+			duration, err := Parse(Format(test.input))
+			assert.NoError(t, err)
+			assert.Equal(t, test.input, duration)
+		}
+	}
+}
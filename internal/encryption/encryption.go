@@ -19,8 +19,9 @@ import (
 type SubKey string
 
 const (
-	TimelineSubKey SubKey = "timeline"
-	AsyncSubKey    SubKey = "async"
+	TimelineSubKey    SubKey = "timeline"
+	AsyncSubKey       SubKey = "async"
+	IdempotencySubKey SubKey = "idempotency"
 )
 
 type DataEncryptor interface {
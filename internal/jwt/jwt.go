@@ -0,0 +1,143 @@
+// Package jwt verifies JSON Web Tokens (RFC 7519) signed with JWS (RFC 7515).
+//
+// Supported algorithms are HS256/384/512, RS256/384/512, PS256/384/512,
+// ES256/384/512 and EdDSA. Unsigned tokens ("alg": "none") are always rejected.
+package jwt
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	josejwt "github.com/go-jose/go-jose/v4/jwt"
+)
+
+// ErrUnknownKey is returned by Verify when none of the keys can verify a token.
+var ErrUnknownKey = errors.New("no key matching token")
+
+var algorithms = []jose.SignatureAlgorithm{
+	jose.HS256, jose.HS384, jose.HS512,
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// Claims of a token.
+type Claims map[string]any
+
+// Subject returns the "sub" claim.
+func (c Claims) Subject() string {
+	sub, _ := c["sub"].(string)
+	return sub
+}
+
+// Issuer returns the "iss" claim.
+func (c Claims) Issuer() string {
+	iss, _ := c["iss"].(string)
+	return iss
+}
+
+// Audience returns the "aud" claim, which may be either a string or a list of strings.
+func (c Claims) Audience() []string {
+	switch aud := c["aud"].(type) {
+	case string:
+		return []string{aud}
+	case []any:
+		out := make([]string, 0, len(aud))
+		for _, a := range aud {
+			if s, ok := a.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+// Validation describes the claims a token must have to be valid.
+type Validation struct {
+	// Issuer must match the "iss" claim, if set.
+	Issuer string
+	// Audience must be one of the "aud" claims, if set.
+	Audience string
+	// Now is the time used to check the "exp", "nbf" and "iat" claims, defaults to time.Now().
+	Now time.Time
+	// Leeway allowed for clock skew when checking "exp", "nbf" and "iat".
+	Leeway time.Duration
+}
+
+// Verify the signature and claims of a compact serialised token.
+//
+// Keys are tried in order, skipping those whose ID or algorithm doesn't match
+// the token.
+func Verify(token string, keys []Key, validation Validation) (Claims, error) {
+	// Reject anything other than three segments before parsing, as go-jose
+	// splits on every separator.
+	if strings.Count(token, ".") != 2 {
+		return nil, fmt.Errorf("malformed token")
+	}
+	parsed, err := josejwt.ParseSigned(token, algorithms)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	hdr := parsed.Headers[0]
+
+	var verifiedBy any
+	for _, key := range keys {
+		if (key.ID != "" && hdr.KeyID != "" && key.ID != hdr.KeyID) || (key.Algorithm != "" && key.Algorithm != hdr.Algorithm) {
+			continue
+		}
+		if err := parsed.Claims(key.Key); err == nil {
+			verifiedBy = key.Key
+			break
+		}
+	}
+	if verifiedBy == nil {
+		return nil, ErrUnknownKey
+	}
+
+	var claims Claims
+	var registered josejwt.Claims
+	if err := parsed.Claims(verifiedBy, &claims, &registered); err != nil {
+		return nil, fmt.Errorf("invalid token claims: %w", err)
+	}
+	// go-jose treats null dates as absent, which would make "exp": null a
+	// token that never expires.
+	for _, name := range []string{"exp", "nbf"} {
+		if value, ok := claims[name]; ok && value == nil {
+			return nil, fmt.Errorf("invalid %q claim: %w", name, josejwt.ErrUnmarshalNumericDate)
+		}
+	}
+	expected := josejwt.Expected{Issuer: validation.Issuer, Time: validation.Now}
+	if validation.Audience != "" {
+		expected.AnyAudience = josejwt.Audience{validation.Audience}
+	}
+	if err := registered.ValidateWithLeeway(expected, validation.Leeway); err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	return claims, nil
+}
+
+// Sign claims with the given algorithm and private key, returning a compact serialised token.
+//
+// key must be an *rsa.PrivateKey, *ecdsa.PrivateKey, ed25519.PrivateKey or
+// []byte HMAC secret, as appropriate for the algorithm.
+func Sign(claims Claims, algorithm string, keyID string, key any) (string, error) {
+	options := (&jose.SignerOptions{}).WithType("JWT")
+	if keyID != "" {
+		options = options.WithHeader("kid", keyID)
+	}
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.SignatureAlgorithm(algorithm), Key: key}, options)
+	if err != nil {
+		return "", fmt.Errorf("failed to create signer: %w", err)
+	}
+	token, err := josejwt.Signed(signer).Claims(map[string]any(claims)).Serialize()
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+	return token, nil
+}
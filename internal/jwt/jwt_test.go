@@ -0,0 +1,243 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	josejwt "github.com/go-jose/go-jose/v4/jwt"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	p256Key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	p521Key, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	assert.NoError(t, err)
+	edPub, edKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	secret := hmacSecret("secret")
+
+	for _, test := range []struct {
+		alg    string
+		sign   any
+		verify any
+	}{
+		{"HS256", secret, secret},
+		{"HS512", secret, secret},
+		{"RS256", rsaKey, &rsaKey.PublicKey},
+		{"RS384", rsaKey, &rsaKey.PublicKey},
+		{"PS256", rsaKey, &rsaKey.PublicKey},
+		{"ES256", p256Key, &p256Key.PublicKey},
+		{"ES512", p521Key, &p521Key.PublicKey},
+		{"EdDSA", edKey, edPub},
+	} {
+		t.Run(test.alg, func(t *testing.T) {
+			token, err := Sign(Claims{"sub": "alice"}, test.alg, "", test.sign)
+			assert.NoError(t, err)
+			claims, err := Verify(token, []Key{{Key: test.verify}}, Validation{})
+			assert.NoError(t, err)
+			assert.Equal(t, "alice", claims.Subject())
+		})
+	}
+
+	t.Run("WrongKeyType", func(t *testing.T) {
+		token, err := Sign(Claims{}, "RS256", "", rsaKey)
+		assert.NoError(t, err)
+		_, err = Verify(token, []Key{{Key: secret}, {Key: &p256Key.PublicKey}}, Validation{})
+		assert.IsError(t, err, ErrUnknownKey)
+	})
+
+	t.Run("TamperedClaims", func(t *testing.T) {
+		token, err := Sign(Claims{"sub": "alice"}, "HS256", "", secret)
+		assert.NoError(t, err)
+		other, err := Sign(Claims{"sub": "mallory"}, "HS256", "", hmacSecret("other"))
+		assert.NoError(t, err)
+		parts, otherParts := strings.Split(token, "."), strings.Split(other, ".")
+		tampered := parts[0] + "." + otherParts[1] + "." + parts[2]
+		_, err = Verify(tampered, []Key{{Key: secret}}, Validation{})
+		assert.IsError(t, err, ErrUnknownKey)
+	})
+
+	t.Run("KeyID", func(t *testing.T) {
+		token, err := Sign(Claims{}, "HS256", "b", hmacSecret("b"))
+		assert.NoError(t, err)
+		_, err = Verify(token, []Key{{ID: "a", Key: hmacSecret("b")}}, Validation{})
+		assert.IsError(t, err, ErrUnknownKey)
+		_, err = Verify(token, []Key{{ID: "a", Key: hmacSecret("a")}, {ID: "b", Key: hmacSecret("b")}}, Validation{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("AlgorithmNone", func(t *testing.T) {
+		token := encode(t, map[string]string{"alg": "none"}) + "." + encode(t, Claims{"sub": "alice"}) + "."
+		_, err := Verify(token, []Key{{Key: secret}}, Validation{})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `unexpected signature algorithm "none"`)
+	})
+
+	t.Run("ShortHMACSecret", func(t *testing.T) {
+		token, err := Sign(Claims{}, "HS256", "", secret)
+		assert.NoError(t, err)
+		_, err = Verify(token, []Key{{Key: secret[:16]}}, Validation{})
+		assert.IsError(t, err, ErrUnknownKey)
+	})
+}
+
+func TestValidation(t *testing.T) {
+	secret := hmacSecret("secret")
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		name       string
+		claims     Claims
+		validation Validation
+		err        error
+	}{
+		{name: "Valid",
+			claims:     Claims{"iss": "issuer", "aud": "api", "exp": float64(now.Add(time.Minute).Unix())},
+			validation: Validation{Issuer: "issuer", Audience: "api"}},
+		{name: "AudienceList",
+			claims:     Claims{"aud": []any{"other", "api"}},
+			validation: Validation{Audience: "api"}},
+		{name: "Expired",
+			claims: Claims{"exp": float64(now.Add(-time.Minute).Unix())},
+			err:    josejwt.ErrExpired},
+		{name: "ExpiredWithinLeeway",
+			claims:     Claims{"exp": float64(now.Add(-time.Minute).Unix())},
+			validation: Validation{Leeway: 2 * time.Minute}},
+		{name: "NotYetValid",
+			claims: Claims{"nbf": float64(now.Add(time.Minute).Unix())},
+			err:    josejwt.ErrNotValidYet},
+		{name: "NonNumericExpiry",
+			claims: Claims{"exp": "2024-09-01T11:59:00Z"},
+			err:    josejwt.ErrUnmarshalNumericDate},
+		{name: "NonNumericNotBefore",
+			claims: Claims{"nbf": nil},
+			err:    josejwt.ErrUnmarshalNumericDate},
+		{name: "WrongIssuer",
+			claims:     Claims{"iss": "other"},
+			validation: Validation{Issuer: "issuer"},
+			err:        josejwt.ErrInvalidIssuer},
+		{name: "MissingAudience",
+			claims:     Claims{"aud": []any{"other"}},
+			validation: Validation{Audience: "api"},
+			err:        josejwt.ErrInvalidAudience},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			token, err := Sign(test.claims, "HS256", "", secret)
+			assert.NoError(t, err)
+			test.validation.Now = now
+			_, err = Verify(token, []Key{{Key: secret}}, test.validation)
+			if test.err != nil {
+				assert.IsError(t, err, test.err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseKeys(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	assert.NoError(t, err)
+	edPub, edKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	t.Run("JWKS", func(t *testing.T) {
+		jwks := fmt.Sprintf(`{"keys": [
+			{"kty": "RSA", "kid": "rsa", "alg": "RS256", "n": %q, "e": %q},
+			{"kty": "EC", "kid": "ec", "crv": "P-384", "x": %q, "y": %q},
+			{"kty": "OKP", "kid": "ed", "crv": "Ed25519", "x": %q},
+			{"kty": "RSA", "kid": "enc", "use": "enc", "n": "AQAB", "e": "AQAB"}
+		]}`,
+			b64(rsaKey.N.Bytes()), b64([]byte{1, 0, 1}),
+			b64(ecKey.X.FillBytes(make([]byte, 48))), b64(ecKey.Y.FillBytes(make([]byte, 48))),
+			b64(edPub))
+		keys, err := ParseKeys([]byte(jwks))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(keys))
+		assert.Equal(t, []string{"rsa", "ec", "ed"}, []string{keys[0].ID, keys[1].ID, keys[2].ID})
+		assert.Equal(t, "RS256", keys[0].Algorithm)
+
+		for _, test := range []struct {
+			alg string
+			kid string
+			key any
+		}{{"RS256", "rsa", rsaKey}, {"ES384", "ec", ecKey}, {"EdDSA", "ed", edKey}} {
+			token, err := Sign(Claims{}, test.alg, test.kid, test.key)
+			assert.NoError(t, err)
+			_, err = Verify(token, keys, Validation{})
+			assert.NoError(t, err)
+		}
+
+		// The RSA key is restricted to RS256.
+		token, err := Sign(Claims{}, "RS512", "rsa", rsaKey)
+		assert.NoError(t, err)
+		_, err = Verify(token, keys, Validation{})
+		assert.IsError(t, err, ErrUnknownKey)
+	})
+
+	t.Run("InvalidJWKS", func(t *testing.T) {
+		_, err := ParseKeys([]byte(`{"keys": [{"kty": "EC", "crv": "P-256", "x": "AAAA", "y": "AAAA"}]}`))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid JWKS key 0: ")
+	})
+
+	t.Run("PEM", func(t *testing.T) {
+		der, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+		assert.NoError(t, err)
+		keys, err := ParseKeys(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+		assert.NoError(t, err)
+		token, err := Sign(Claims{}, "RS256", "", rsaKey)
+		assert.NoError(t, err)
+		_, err = Verify(token, keys, Validation{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("MalformedPEM", func(t *testing.T) {
+		_, err := ParseKeys([]byte("-----BEGIN PUBLIC KEY-----\n!!!\n-----END PUBLIC KEY-----\n"))
+		assert.EqualError(t, err, "invalid PEM encoded key")
+	})
+
+	t.Run("HMAC", func(t *testing.T) {
+		jwks := []byte(fmt.Sprintf(`{"keys": [{"kty": "oct", "alg": "HS256", "k": %q}]}`, b64(hmacSecret("secret"))))
+		keys, err := ParseKeys(jwks)
+		assert.NoError(t, err)
+		assert.Equal(t, []Key{{Algorithm: "HS256", Key: hmacSecret("secret")}}, keys)
+
+		// Remote key sets must not supply HMAC secrets.
+		keys, err = ParseJWKS(jwks)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(keys))
+	})
+
+	t.Run("UnrecognisedKey", func(t *testing.T) {
+		_, err := ParseKeys([]byte("secret"))
+		assert.EqualError(t, err, "unrecognised key, expected a JWKS document or PEM encoded public key")
+	})
+}
+
+// hmacSecret returns a secret long enough for any HMAC algorithm.
+func hmacSecret(seed string) []byte { return []byte(strings.Repeat(seed, 64/len(seed)+1)[:64]) }
+
+func b64(data []byte) string { return base64.RawURLEncoding.EncodeToString(data) }
+
+func encode(t *testing.T, value any) string {
+	t.Helper()
+	data, err := json.Marshal(value)
+	assert.NoError(t, err)
+	return b64(data)
+}
@@ -0,0 +1,156 @@
+package jwt
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/go-jose/go-jose/v4"
+)
+
+// Key used to verify token signatures.
+type Key struct {
+	// ID matches the "kid" header of tokens signed with this key, if set.
+	ID string
+	// Algorithm restricts the key to a single signing algorithm, if set.
+	Algorithm string
+	// Key is an *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey or []byte HMAC secret.
+	Key any
+}
+
+// ParseKeys parses verification keys from a JWKS document (RFC 7517) or
+// PEM-encoded public keys and certificates.
+//
+// HMAC secrets must be given explicitly as "oct" keys in a JWKS document, so
+// that malformed public keys are never used as HMAC secrets. Unlike ParseJWKS,
+// "oct" keys are accepted, so this must only be used for trusted keys.
+func ParseKeys(data []byte) ([]Key, error) {
+	trimmed := bytes.TrimSpace(data)
+	switch {
+	case len(trimmed) == 0:
+		return nil, fmt.Errorf("empty key")
+
+	case trimmed[0] == '{':
+		return parseJWKS(trimmed, true)
+
+	case bytes.Contains(trimmed, []byte("-----BEGIN")):
+		var keys []Key
+		rest := trimmed
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			key, err := parsePEMBlock(block)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, Key{Key: key})
+		}
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("invalid PEM encoded key")
+		}
+		return keys, nil
+
+	default:
+		return nil, fmt.Errorf("unrecognised key, expected a JWKS document or PEM encoded public key")
+	}
+}
+
+func parsePEMBlock(block *pem.Block) (any, error) {
+	switch block.Type {
+	case "PUBLIC KEY":
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key: %w", err)
+		}
+		return checkPublicKey(key)
+
+	case "RSA PUBLIC KEY":
+		key, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RSA public key: %w", err)
+		}
+		return key, nil
+
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate: %w", err)
+		}
+		return checkPublicKey(cert.PublicKey)
+
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q, expected a public key or certificate", block.Type)
+	}
+}
+
+func checkPublicKey(key any) (any, error) {
+	switch key.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", key)
+	}
+}
+
+// ParseJWKS parses the asymmetric signing keys of a JSON Web Key Set (RFC 7517).
+//
+// Symmetric ("oct") keys are ignored, as a remote key set must never supply
+// HMAC secrets. Encryption keys and keys of unsupported types are also ignored.
+func ParseJWKS(data []byte) ([]Key, error) {
+	return parseJWKS(data, false)
+}
+
+func parseJWKS(data []byte, allowSymmetric bool) ([]Key, error) {
+	var set struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("invalid JWKS: %w", err)
+	}
+	keys := make([]Key, 0, len(set.Keys))
+	for i, raw := range set.Keys {
+		var kind struct {
+			Kty string `json:"kty"`
+			Use string `json:"use"`
+		}
+		if err := json.Unmarshal(raw, &kind); err != nil {
+			return nil, fmt.Errorf("invalid JWKS key %d: %w", i, err)
+		}
+		switch {
+		case kind.Use == "enc":
+			continue
+		case kind.Kty == "oct" && !allowSymmetric:
+			continue
+		case kind.Kty != "RSA" && kind.Kty != "EC" && kind.Kty != "OKP" && kind.Kty != "oct":
+			continue
+		}
+		var jwk jose.JSONWebKey
+		if err := jwk.UnmarshalJSON(raw); err != nil {
+			return nil, fmt.Errorf("invalid JWKS key %d: %w", i, err)
+		}
+		var key any
+		if secret, ok := jwk.Key.([]byte); ok {
+			if len(secret) == 0 {
+				return nil, fmt.Errorf("invalid JWKS key %d: empty symmetric key", i)
+			}
+			key = secret
+		} else {
+			// Only keep the public half of any private keys.
+			public := jwk.Public()
+			if !public.Valid() {
+				return nil, fmt.Errorf("invalid JWKS key %d", i)
+			}
+			key = public.Key
+		}
+		keys = append(keys, Key{ID: jwk.KeyID, Algorithm: jwk.Algorithm, Key: key})
+	}
+	return keys, nil
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/cron"
 )
 
 type CronJobState string
@@ -18,7 +19,15 @@ type CronJob struct {
 	DeploymentKey DeploymentKey
 	Verb          schema.Ref
 	Schedule      string
+	// Timezone is the IANA timezone the schedule is evaluated in.
+	Timezone      string
+	MissedRuns    cron.MissedRunPolicy
 	StartTime     time.Time
 	NextExecution time.Time
 	State         CronJobState
 }
+
+// Pattern parses the job's schedule in its timezone.
+func (c CronJob) Pattern() (cron.Pattern, error) {
+	return cron.ParseInTimezone(c.Schedule, c.Timezone)
+}
@@ -5,8 +5,9 @@ import (
 )
 
 type Subscription struct {
-	Name   string
-	Key    SubscriptionKey
-	Topic  TopicKey
-	Cursor optional.Option[TopicEventKey]
+	Name      string
+	Key       SubscriptionKey
+	Topic     TopicKey
+	Partition int
+	Cursor    optional.Option[TopicEventKey]
 }
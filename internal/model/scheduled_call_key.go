@@ -0,0 +1,35 @@
+package model
+
+import (
+	"errors"
+)
+
+// ScheduledCallKey identifies a call scheduled without a key of its own.
+type ScheduledCallKey = KeyType[ScheduledCallPayload, *ScheduledCallPayload]
+
+func NewScheduledCallKey(module, verb string) ScheduledCallKey {
+	return newKey[ScheduledCallPayload](module, verb)
+}
+
+func ParseScheduledCallKey(key string) (ScheduledCallKey, error) {
+	return parseKey[ScheduledCallPayload](key)
+}
+
+type ScheduledCallPayload struct {
+	Module string
+	Verb   string
+}
+
+var _ KeyPayload = (*ScheduledCallPayload)(nil)
+
+func (d *ScheduledCallPayload) Kind() string   { return "scl" }
+func (d *ScheduledCallPayload) String() string { return d.Module + "-" + d.Verb }
+func (d *ScheduledCallPayload) Parse(parts []string) error {
+	if len(parts) != 2 {
+		return errors.New("expected <module>-<verb> but got empty string")
+	}
+	d.Module = parts[0]
+	d.Verb = parts[1]
+	return nil
+}
+func (d *ScheduledCallPayload) RandomBytes() int { return 10 }
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"errors"
+)
+
+// WebSocketConnectionKey identifies a WebSocket connection to an ingress verb.
+type WebSocketConnectionKey = KeyType[WebSocketConnectionPayload, *WebSocketConnectionPayload]
+
+func NewWebSocketConnectionKey(module, verb string) WebSocketConnectionKey {
+	return newKey[WebSocketConnectionPayload](module, verb)
+}
+
+func ParseWebSocketConnectionKey(key string) (WebSocketConnectionKey, error) {
+	return parseKey[WebSocketConnectionPayload](key)
+}
+
+type WebSocketConnectionPayload struct {
+	Module string
+	Verb   string
+}
+
+var _ KeyPayload = (*WebSocketConnectionPayload)(nil)
+
+func (d *WebSocketConnectionPayload) Kind() string   { return "wsc" }
+func (d *WebSocketConnectionPayload) String() string { return d.Module + "-" + d.Verb }
+func (d *WebSocketConnectionPayload) Parse(parts []string) error {
+	if len(parts) != 2 {
+		return errors.New("expected <module>-<verb> but got empty string")
+	}
+	d.Module = parts[0]
+	d.Verb = parts[1]
+	return nil
+}
+func (d *WebSocketConnectionPayload) RandomBytes() int { return 10 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"runtime/debug"
+	"time"
 
 	"connectrpc.com/connect"
 	"connectrpc.com/otelconnect"
@@ -22,6 +23,7 @@ type ftlDirectRoutingKey struct{}
 type ftlVerbKey struct{}
 type requestIDKey struct{}
 type parentRequestIDKey struct{}
+type idempotencyKey struct{}
 
 // WithDirectRouting ensures any hops in Verb routing do not redirect.
 //
@@ -81,6 +83,17 @@ func WithParentRequestKey(ctx context.Context, key model.RequestKey) context.Con
 	return context.WithValue(ctx, parentRequestIDKey{}, key.String())
 }
 
+// WithIdempotencyKey adds the idempotency key of the current call to the context.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key of the current call, if any.
+func IdempotencyKeyFromContext(ctx context.Context) optional.Option[string] {
+	key, _ := ctx.Value(idempotencyKey{}).(string)
+	return optional.Zero(key)
+}
+
 func requestKeyFromContextValue(value any) (optional.Option[model.RequestKey], error) {
 	keyStr, ok := value.(string)
 	if !ok {
@@ -205,6 +218,11 @@ func (m *metadataInterceptor) WrapStreamingHandler(req connect.StreamingHandlerF
 		if err != nil {
 			return err
 		}
+		ctx, cancel, err := withHeaderDeadline(ctx, s.RequestHeader())
+		if err != nil {
+			return err
+		}
+		defer cancel()
 		err = req(ctx, s)
 		if err != nil {
 			if connect.CodeOf(err) == connect.CodeCanceled {
@@ -225,6 +243,14 @@ func (m *metadataInterceptor) WrapUnary(uf connect.UnaryFunc) connect.UnaryFunc
 		if err != nil {
 			return nil, err
 		}
+		if !req.Spec().IsClient {
+			var cancel context.CancelFunc
+			ctx, cancel, err = withHeaderDeadline(ctx, req.Header())
+			if err != nil {
+				return nil, err
+			}
+			defer cancel()
+		}
 		resp, err := uf(ctx, req)
 		if err != nil {
 			logger.Logf(m.errorLevel, "Unary RPC failed: %s: %s", err, req.Spec().Procedure)
@@ -272,6 +298,9 @@ func propagateHeaders(ctx context.Context, isClient bool, header http.Header) (c
 		} else if key, ok := key.Get(); ok {
 			headers.SetParentRequestKey(header, key)
 		}
+		if deadline, ok := ctx.Deadline(); ok {
+			headers.SetTimeout(header, time.Until(deadline))
+		}
 	} else {
 		if headers.IsDirectRouted(header) {
 			ctx = WithDirectRouting(ctx)
@@ -295,6 +324,20 @@ func propagateHeaders(ctx context.Context, isClient bool, header http.Header) (c
 	return ctx, nil
 }
 
+// withHeaderDeadline applies the call chain deadline from an incoming request
+// to the context, if present.
+func withHeaderDeadline(ctx context.Context, header http.Header) (context.Context, context.CancelFunc, error) {
+	timeout, err := headers.GetTimeout(header)
+	if err != nil {
+		return nil, nil, err
+	}
+	if remaining, ok := timeout.Get(); ok {
+		ctx, cancel := context.WithTimeout(ctx, remaining)
+		return ctx, cancel, nil
+	}
+	return ctx, func() {}, nil
+}
+
 // versionInterceptor reports a warning to the client if the client is older than the server.
 type versionInterceptor struct{}
 
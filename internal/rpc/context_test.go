@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	"github.com/alecthomas/assert/v2"
@@ -19,3 +20,25 @@ func TestRPCContext(t *testing.T) {
 	assert.Equal(t, verbClient, ClientFromContext[ftlv1connect.VerbServiceClient](ctx))
 	assert.Equal(t, controllerClient, ClientFromContext[ftlv1connect.ControllerServiceClient](ctx))
 }
+
+func TestDeadlinePropagation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	header := http.Header{}
+	_, err := propagateHeaders(ctx, true, header)
+	assert.NoError(t, err)
+	assert.NotEqual(t, "", header.Get("Ftl-Timeout"))
+
+	serverCtx, serverCancel, err := withHeaderDeadline(context.Background(), header)
+	assert.NoError(t, err)
+	defer serverCancel()
+	deadline, ok := serverCtx.Deadline()
+	assert.True(t, ok)
+	assert.True(t, time.Until(deadline) <= time.Minute && time.Until(deadline) > 50*time.Second)
+
+	header.Set("Ftl-Timeout", "-1s")
+	expiredCtx, expiredCancel, err := withHeaderDeadline(context.Background(), header)
+	assert.NoError(t, err)
+	defer expiredCancel()
+	assert.Equal(t, context.DeadlineExceeded, expiredCtx.Err())
+}
@@ -3,6 +3,7 @@ package headers
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/alecthomas/types/optional"
 
@@ -22,6 +23,11 @@ const (
 	// ParentRequestIDHeader is the header used to pass the parent request ID,
 	// i.e. the publisher or fsm call that initiated this call.
 	ParentRequestIDHeader = "Ftl-Parent-Request-Id"
+	// TimeoutHeader is the header used to pass the time remaining before the
+	// deadline of the current call chain, as a Go duration string.
+	//
+	// It is recomputed on each hop, so it is unaffected by clock skew.
+	TimeoutHeader = "Ftl-Timeout"
 )
 
 func IsDirectRouted(header http.Header) bool {
@@ -65,6 +71,26 @@ func getRequestKeyFromKeyStr(keyStr string) (model.RequestKey, bool, error) {
 	return key, true, nil
 }
 
+// SetTimeout sets the time remaining before the call chain's deadline.
+func SetTimeout(header http.Header, remaining time.Duration) {
+	header.Set(TimeoutHeader, remaining.String())
+}
+
+// GetTimeout returns the time remaining before the call chain's deadline, if any.
+//
+// The returned duration may be zero or negative if the deadline has passed.
+func GetTimeout(header http.Header) (optional.Option[time.Duration], error) {
+	value := header.Get(TimeoutHeader)
+	if value == "" {
+		return optional.None[time.Duration](), nil
+	}
+	remaining, err := time.ParseDuration(value)
+	if err != nil {
+		return optional.None[time.Duration](), fmt.Errorf("invalid %s header %q: %w", TimeoutHeader, value, err)
+	}
+	return optional.Some(remaining), nil
+}
+
 // GetCallers history from an incoming request.
 func GetCallers(header http.Header) ([]*schema.Ref, error) {
 	headers := header.Values(VerbHeader)
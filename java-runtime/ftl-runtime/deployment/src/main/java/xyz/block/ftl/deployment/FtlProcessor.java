@@ -382,9 +382,15 @@ class FtlProcessor {
             var method = cron.target().asMethod();
             String className = method.declaringClass().name().toString();
             beans.addBeanClass(className);
+            var cronJob = MetadataCronJob.newBuilder().setCron(cron.value().asString());
+            if (cron.value("timezone") != null) {
+                cronJob.setTimezone(cron.value("timezone").asString());
+            }
+            if (cron.value("missed") != null) {
+                cronJob.setMissed(cron.value("missed").asString());
+            }
             handleVerbMethod(extractionContext, method, className, false, BodyType.DISALLOWED, (builder -> {
-                builder.addMetadata(Metadata.newBuilder()
-                        .setCronJob(MetadataCronJob.newBuilder().setCron(cron.value().asString())).build());
+                builder.addMetadata(Metadata.newBuilder().setCronJob(cronJob).build());
             }));
         }
     }
@@ -11,4 +11,14 @@ public @interface Cron {
 
     String value();
 
+    /**
+     * The IANA timezone the cron expression is evaluated in, eg. "Europe/Berlin". Defaults to UTC.
+     */
+    String timezone() default "";
+
+    /**
+     * What to do with executions that were missed: "skip" (the default), "once" or "all".
+     */
+    String missed() default "";
+
 }
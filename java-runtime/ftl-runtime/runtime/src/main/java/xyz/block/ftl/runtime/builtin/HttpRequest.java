@@ -15,6 +15,7 @@ public class HttpRequest {
     private Map<String, List<String>> query;
     private Map<String, List<String>> headers;
     private JsonNode body;
+    private Principal principal;
 
     public String getMethod() {
         return method;
@@ -63,4 +64,12 @@ public class HttpRequest {
     public void setBody(JsonNode body) {
         this.body = body;
     }
+
+    public Principal getPrincipal() {
+        return principal;
+    }
+
+    public void setPrincipal(Principal principal) {
+        this.principal = principal;
+    }
 }
@@ -0,0 +1,36 @@
+package xyz.block.ftl.runtime.builtin;
+
+import java.util.Map;
+
+/**
+ * The authenticated caller of an HTTP ingress verb.
+ */
+public class Principal {
+    private String scheme;
+    private String subject;
+    private Map<String, Object> claims;
+
+    public String getScheme() {
+        return scheme;
+    }
+
+    public void setScheme(String scheme) {
+        this.scheme = scheme;
+    }
+
+    public String getSubject() {
+        return subject;
+    }
+
+    public void setSubject(String subject) {
+        this.subject = subject;
+    }
+
+    public Map<String, Object> getClaims() {
+        return claims;
+    }
+
+    public void setClaims(Map<String, Object> claims) {
+        this.claims = claims;
+    }
+}
@@ -25,6 +25,9 @@ var typeAliasCompletionDocs string
 //go:embed markdown/completion/ingress.md
 var ingressCompletionDocs string
 
+//go:embed markdown/completion/ingressWebSocket.md
+var ingressWebSocketCompletionDocs string
+
 //go:embed markdown/completion/cron.md
 var cronCompletionDocs string
 
@@ -34,6 +37,18 @@ var cronExpressionCompletionDocs string
 //go:embed markdown/completion/retry.md
 var retryCompletionDocs string
 
+//go:embed markdown/completion/timeout.md
+var timeoutCompletionDocs string
+
+//go:embed markdown/completion/ratelimit.md
+var rateLimitCompletionDocs string
+
+//go:embed markdown/completion/concurrency.md
+var concurrencyCompletionDocs string
+
+//go:embed markdown/completion/auth.md
+var authCompletionDocs string
+
 //go:embed markdown/completion/configDeclare.md
 var declareConfigCompletionDocs string
 
@@ -59,9 +74,14 @@ var completionItems = []protocol.CompletionItem{
 	completionItem("ftl:enum (value)", "FTL Enum (value type)", enumValueCompletionDocs),
 	completionItem("ftl:typealias", "FTL Type Alias", typeAliasCompletionDocs),
 	completionItem("ftl:ingress", "FTL Ingress", ingressCompletionDocs),
+	completionItem("ftl:ingress:ws", "FTL WebSocket Ingress", ingressWebSocketCompletionDocs),
 	completionItem("ftl:cron", "FTL Cron", cronCompletionDocs),
 	completionItem("ftl:cron:expression", "FTL Cron with expression", cronExpressionCompletionDocs),
 	completionItem("ftl:retry", "FTL Retry", retryCompletionDocs),
+	completionItem("ftl:timeout", "FTL Timeout", timeoutCompletionDocs),
+	completionItem("ftl:ratelimit", "FTL Rate Limit", rateLimitCompletionDocs),
+	completionItem("ftl:concurrency", "FTL Concurrency Limit", concurrencyCompletionDocs),
+	completionItem("ftl:auth", "FTL Auth", authCompletionDocs),
 	completionItem("ftl:config:declare", "Declare config", declareConfigCompletionDocs),
 	completionItem("ftl:secret:declare", "Declare secret", declareSecretCompletionDocs),
 	completionItem("ftl:pubsub:topic", "Declare PubSub topic", declarePubSubTopicCompletionDocs),
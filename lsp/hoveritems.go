@@ -2,11 +2,15 @@
 package lsp
 
 var hoverMap = map[string]string{
-	"//ftl:cron": "## Cron\n\nA cron job is an Empty verb that will be called on a schedule. The syntax is described [here](https://pubs.opengroup.org/onlinepubs/9699919799.2018edition/utilities/crontab.html).\n\nYou can also use a shorthand syntax for the cron job, supporting seconds (`s`), minutes (`m`), hours (`h`), and specific days of the week (e.g. `Mon`).\n\n### Examples\n\nThe following function will be called hourly:\n\n```go\n//ftl:cron 0 * * * *\nfunc Hourly(ctx context.Context) error {\n  // ...\n}\n```\n\nEvery 12 hours, starting at UTC midnight:\n\n```go\n//ftl:cron 12h\nfunc TwiceADay(ctx context.Context) error {\n  // ...\n}\n```\n\nEvery Monday at UTC midnight:\n\n```go\n//ftl:cron Mon\nfunc Mondays(ctx context.Context) error {\n  // ...\n}\n```\n\n",
+	"//ftl:auth": "## Authentication\n\nIngress verbs can require requests to be authenticated before they are called with the `//ftl:auth` directive. Requests that fail authentication receive a `401 Unauthorized` response and the verb is not called.\n\nJWT bearer tokens can be verified against a JWKS endpoint, or against keys stored in an FTL secret. The secret may hold PEM encoded public keys or certificates, or a JWKS document. HMAC secrets must be stored as `oct` keys in a JWKS document, and must be at least as long as the hash they are used with (32 bytes for `HS256`). `oct` keys served by a JWKS endpoint are ignored. `issuer` and `audience` are optional, and are checked against the token's `iss` and `aud` claims if present. Tokens with non-numeric `exp` or `nbf` claims are rejected:\n\n```go\n//ftl:ingress GET /orders\n//ftl:auth jwt issuer \"https://auth.example.com\" audience \"orders\" jwks \"https://auth.example.com/.well-known/jwks.json\"\nfunc ListOrders(ctx context.Context, req builtin.HttpRequest[ftl.Unit]) (builtin.HttpResponse[[]Order, string], error) {\n  // ...\n}\n```\n\nAPI keys are read from the `X-API-Key` header by default, and checked against a secret. The secret may be a single key (`string`), a list of keys (`[]string`), or a map of keys to the name of their owner (`map[string]string`):\n\n```go\nvar apiKeys = ftl.Secret[map[string]string](\"apiKeys\")\n\n//ftl:ingress POST /orders\n//ftl:auth apikey header \"X-Token\" secret apiKeys\nfunc CreateOrder(ctx context.Context, req builtin.HttpRequest[Order]) (builtin.HttpResponse[Order, string], error) {\n  // ...\n}\n```\n\nClient certificates can be required with `//ftl:auth mtls`. As ingress doesn't terminate TLS itself, the verified client certificate must be forwarded by the TLS terminating proxy in the header given by the controller's `--ingress-client-cert-header` flag, as a URL encoded PEM certificate.\n\nIf a verb declares more than one `//ftl:auth` directive, a request satisfying any one of them is accepted. The authenticated caller is available to the verb as `req.Principal`:\n\n```go\nprincipal, _ := req.Principal.Get()\nfmt.Println(principal.Scheme, principal.Subject, principal.Claims[\"email\"])\n```\n\n`Subject` is the token's `sub` claim, the owner of the API key (or the key's index in a list), or the client certificate's subject. `Claims` holds the token's claims, or the client certificate's details.\n",
+	"//ftl:concurrency": "## Limits\n\nThe rate of calls to a verb can be limited with the `//ftl:ratelimit <count>/<period>` directive, and the number of concurrent calls with `//ftl:concurrency <count>`:\n\n```go\n//ftl:verb\n//ftl:ratelimit 100/1m\n//ftl:concurrency 10\nfunc Charge(ctx context.Context, in ChargeRequest) (ChargeResponse, error) {\n  // ...\n}\n```\n\nLimits are enforced by the controllers and apply across the whole cluster, regardless of which controller or runner serves a call. Calls that exceed a limit fail with a `ResourceExhausted` error, or a `429 Too Many Requests` response for HTTP ingress requests.\n",
+	"//ftl:cron": "## Cron\n\nA cron job is an Empty verb that will be called on a schedule. The syntax is described [here](https://pubs.opengroup.org/onlinepubs/9699919799.2018edition/utilities/crontab.html).\n\nYou can also use a shorthand syntax for the cron job, supporting seconds (`s`), minutes (`m`), hours (`h`), and specific days of the week (e.g. `Mon`).\n\n### Examples\n\nThe following function will be called hourly:\n\n```go\n//ftl:cron 0 * * * *\nfunc Hourly(ctx context.Context) error {\n  // ...\n}\n```\n\nEvery 12 hours, starting at UTC midnight:\n\n```go\n//ftl:cron 12h\nfunc TwiceADay(ctx context.Context) error {\n  // ...\n}\n```\n\nEvery Monday at UTC midnight:\n\n```go\n//ftl:cron Mon\nfunc Mondays(ctx context.Context) error {\n  // ...\n}\n```\n\n### Timezones\n\nCron jobs are scheduled in UTC by default. To schedule a job in a different timezone, add `tz` followed by an [IANA timezone](https://en.wikipedia.org/wiki/List_of_tz_database_time_zones) name. For example, every weekday at 9am in Berlin, whether or not daylight saving time is in effect:\n\n```go\n//ftl:cron 0 9 * * 1-5 tz Europe/Berlin\nfunc StartOfDay(ctx context.Context) error {\n  // ...\n}\n```\n\nWhen clocks go forward, a job scheduled within the skipped hour runs once, an hour later than scheduled. When clocks go back, a job scheduled within the repeated hour runs once, at the first occurrence of that time.\n\n### Missed executions\n\nIf an execution of a job is missed, for example because no controllers were running when it was due, the job's `missed` policy determines what happens once it can be scheduled again:\n\n- `skip` skips missed executions and waits for the next scheduled execution.\n- `once` (the default) executes the job once, however many executions were missed.\n- `all` executes the job once for each missed execution, in order, catching up on at most the last 100 missed executions.\n\nAn execution that starts up to a minute after it was due is not considered missed.\n\n```go\n//ftl:cron 0 * * * * missed all\nfunc Hourly(ctx context.Context) error {\n  // ...\n}\n```\n\n### Managing cron jobs\n\nThe `ftl cron` commands allow operators to inspect and control cron jobs without redeploying their module:\n\n```sh\n# List cron jobs with their state and their next and last executions\nftl cron list\n\n# Execute a job immediately\nftl cron trigger mymodule.hourly\n\n# Stop a job from being scheduled, and allow it to be scheduled again\nftl cron pause mymodule.hourly\nftl cron resume mymodule.hourly\n```\n\nA paused job stays paused when its module is redeployed, but can still be triggered manually. Executions that were due while a job was paused are skipped when it is resumed.\n",
 	"//ftl:enum": "## Type enums (sum types)\n\n[Sum types](https://en.wikipedia.org/wiki/Tagged_union) are supported by FTL's type system, but aren't directly supported by Go. However they can be approximated with the use of [sealed interfaces](https://blog.chewxy.com/2018/03/18/golang-interfaces/). To declare a sum type in FTL use the comment directive `//ftl:enum`:\n\n```go\n//ftl:enum\ntype Animal interface { animal() }\n\ntype Cat struct {}\nfunc (Cat) animal() {}\n\ntype Dog struct {}\nfunc (Dog) animal() {}\n```\n## Value enums\n\nA value enum is an enumerated set of string or integer values.\n\n```go\n//ftl:enum\ntype Colour string\n\nconst (\n  Red   Colour = \"red\"\n  Green Colour = \"green\"\n  Blue  Colour = \"blue\"\n)\n```\n",
-	"//ftl:ingress": "## HTTP Ingress\n\nVerbs annotated with `ftl:ingress` will be exposed via HTTP (`http` is the default ingress type). These endpoints will then be available on one of our default `ingress` ports (local development defaults to `http://localhost:8891`).\n\nThe following will be available at `http://localhost:8891/http/users/123/posts?postId=456`.\n\n```go\ntype GetRequest struct {\n\tUserID string `json:\"userId\"`\n\tPostID string `json:\"postId\"`\n}\n\ntype GetResponse struct {\n\tMessage string `json:\"msg\"`\n}\n\n//ftl:ingress GET /http/users/{userId}/posts\nfunc Get(ctx context.Context, req builtin.HttpRequest[GetRequest]) (builtin.HttpResponse[GetResponse, ErrorResponse], error) {\n  // ...\n}\n```\n\n> **NOTE!**\n> The `req` and `resp` types of HTTP `ingress` [verbs](../verbs) must be `builtin.HttpRequest` and `builtin.HttpResponse` respectively. These types provide the necessary fields for HTTP `ingress` (`headers`, `statusCode`, etc.)\n>\n> You will need to import `ftl/builtin`.\n\nKey points:\n\n- `ingress` verbs will be automatically exported by default.\n\n## Field mapping\n\nGiven the following request verb:\n\n```go\ntype GetRequest struct {\n\tUserID string             `json:\"userId\"`\n\tTag    ftl.Option[string] `json:\"tag\"`\n\tPostID string             `json:\"postId\"`\n}\n\ntype GetResponse struct {\n\tMessage string `json:\"msg\"`\n}\n\n//ftl:ingress http GET /users/{userId}/posts/{postId}\nfunc Get(ctx context.Context, req builtin.HttpRequest[GetRequest]) (builtin.HttpResponse[GetResponse, string], error) {\n\treturn builtin.HttpResponse[GetResponse, string]{\n\t\tHeaders: map[string][]string{\"Get\": {\"Header from FTL\"}},\n\t\tBody: ftl.Some(GetResponse{\n\t\t\tMessage: fmt.Sprintf(\"UserID: %s, PostID: %s, Tag: %s\", req.Body.UserID, req.Body.PostID, req.Body.Tag.Default(\"none\")),\n\t\t}),\n\t}, nil\n}\n```\n\n`path`, `query`, and `body` parameters are automatically mapped to the `req` structure.\n\nFor example, this curl request will map `userId` to `req.Body.UserID` and `postId` to `req.Body.PostID`, and `tag` to `req.Body.Tag`:\n\n```sh\ncurl -i http://localhost:8891/users/123/posts/456?tag=ftl\n```\n\nThe response here will be:\n\n```json\n{\n  \"msg\": \"UserID: 123, PostID: 456, Tag: ftl\"\n}\n```\n\n#### Optional fields\n\nOptional fields are represented by the `ftl.Option` type. The `Option` type is a wrapper around the actual type and can be `Some` or `None`. In the example above, the `Tag` field is optional.\n\n```sh\ncurl -i http://localhost:8891/users/123/posts/456\n```\n\nBecause the `tag` query parameter is not provided, the response will be:\n\n```json\n{\n  \"msg\": \"UserID: 123, PostID: 456, Tag: none\"\n}\n```\n\n#### Casing\n\nField names use lowerCamelCase by default. You can override this by using the `json` tag.\n\n## SumTypes\n\nGiven the following request verb:\n\n```go\n//ftl:enum export\ntype SumType interface {\n\ttag()\n}\n\ntype A string\n\nfunc (A) tag() {}\n\ntype B []string\n\nfunc (B) tag() {}\n\n//ftl:ingress http GET /typeenum\nfunc TypeEnum(ctx context.Context, req builtin.HttpRequest[SumType]) (builtin.HttpResponse[SumType, string], error) {\n\treturn builtin.HttpResponse[SumType, string]{Body: ftl.Some(req.Body)}, nil\n}\n```\n\nThe following curl request will map the `SumType` name and value to the `req.Body`:\n\n```sh\ncurl -X GET \"http://localhost:8891/typeenum\" \\\n     -H \"Content-Type: application/json\" \\\n     --data '{\"name\": \"A\", \"value\": \"sample\"}'\n```\n\nThe response will be:\n\n```json\n{\n  \"name\": \"A\",\n  \"value\": \"sample\"\n}\n```\n\n## Encoding query params as JSON\n\nComplex query params can also be encoded as JSON using the `@json` query parameter. For example:\n\n> `{\"tag\":\"ftl\"}` url-encoded is `%7B%22tag%22%3A%22ftl%22%7D`\n\n```bash\ncurl -i http://localhost:8891/users/123/posts/456?@json=%7B%22tag%22%3A%22ftl%22%7D\n```\n",
-	"//ftl:retry": "## Retries\n\nAny verb called asynchronously (specifically, PubSub subscribers and FSM states), may optionally specify a basic exponential backoff retry policy via a Go comment directive. The directive has the following syntax:\n\n```go\n//ftl:retry [<attempts=10>] <min-backoff> [<max-backoff=1hr>] [catch <catchVerb>]\n```\n\nFor example, the following function will retry up to 10 times, with a delay of 5s, 10s, 20s, 40s, 60s, 60s, etc.\n\n```go\n//ftl:retry 10 5s 1m\nfunc Invoiced(ctx context.Context, in Invoice) error {\n  // ...\n}\n```\n\n## Catching\nAfter all retries have failed, a catch verb can be used to safely recover.\n\nThese catch verbs have a request type of `builtin.CatchRequest<Req>` and no response type. If a catch verb returns an error, it will be retried until it succeeds so it is important to handle errors carefully.\n\n```go\n//ftl retry 5 1s catch recoverPaymentProcessing\nfunc ProcessPayment(ctx context.Context, payment Payment) error {\n    ...\n}\n\n//ftl:verb\nfunc RecoverPaymentProcessing(ctx context.Context, request builtin.CatchRequest[Payment]) error {\n    // safely handle final failure of the payment\n}\n```\n\nFor FSMs, after a catch verb has been successfully called the FSM will moved to the failed state.",
-	"//ftl:subscribe": "## PubSub\n\nFTL has first-class support for PubSub, modelled on the concepts of topics (where events are sent), subscriptions (a cursor over the topic), and subscribers (functions events are delivered to). Subscribers are, as you would expect, sinks. Each subscription is a cursor over the topic it is associated with. Each topic may have multiple subscriptions. Each subscription may have multiple subscribers, in which case events will be distributed among them.\n\nFirst, declare a new topic:\n\n```go\nvar Invoices = ftl.Topic[Invoice](\"invoices\")\n```\n\nThen declare each subscription on the topic:\n\n```go\nvar _ = ftl.Subscription(Invoices, \"emailInvoices\")\n```\n\nAnd finally define a Sink to consume from the subscription:\n\n```go\n//ftl:subscribe emailInvoices\nfunc SendInvoiceEmail(ctx context.Context, in Invoice) error {\n  // ...\n}\n```\n\nEvents can be published to a topic like so:\n\n```go\nInvoices.Publish(ctx, Invoice{...})\n```\n\n> **NOTE!**\n> PubSub topics cannot be published to from outside the module that declared them, they can only be subscribed to. That is, if a topic is declared in module `A`, module `B` cannot publish to it.\n",
+	"//ftl:ingress": "## HTTP Ingress\n\nVerbs annotated with `ftl:ingress` will be exposed via HTTP (`http` is the default ingress type). These endpoints will then be available on one of our default `ingress` ports (local development defaults to `http://localhost:8891`).\n\nThe following will be available at `http://localhost:8891/http/users/123/posts?postId=456`.\n\n```go\ntype GetRequest struct {\n\tUserID string `json:\"userId\"`\n\tPostID string `json:\"postId\"`\n}\n\ntype GetResponse struct {\n\tMessage string `json:\"msg\"`\n}\n\n//ftl:ingress GET /http/users/{userId}/posts\nfunc Get(ctx context.Context, req builtin.HttpRequest[GetRequest]) (builtin.HttpResponse[GetResponse, ErrorResponse], error) {\n  // ...\n}\n```\n\n> **NOTE!**\n> The `req` and `resp` types of HTTP `ingress` [verbs](../verbs) must be `builtin.HttpRequest` and `builtin.HttpResponse` respectively. These types provide the necessary fields for HTTP `ingress` (`headers`, `statusCode`, etc.)\n>\n> You will need to import `ftl/builtin`.\n\nKey points:\n\n- `ingress` verbs will be automatically exported by default.\n\n## Field mapping\n\nGiven the following request verb:\n\n```go\ntype GetRequest struct {\n\tUserID string             `json:\"userId\"`\n\tTag    ftl.Option[string] `json:\"tag\"`\n\tPostID string             `json:\"postId\"`\n}\n\ntype GetResponse struct {\n\tMessage string `json:\"msg\"`\n}\n\n//ftl:ingress http GET /users/{userId}/posts/{postId}\nfunc Get(ctx context.Context, req builtin.HttpRequest[GetRequest]) (builtin.HttpResponse[GetResponse, string], error) {\n\treturn builtin.HttpResponse[GetResponse, string]{\n\t\tHeaders: map[string][]string{\"Get\": {\"Header from FTL\"}},\n\t\tBody: ftl.Some(GetResponse{\n\t\t\tMessage: fmt.Sprintf(\"UserID: %s, PostID: %s, Tag: %s\", req.Body.UserID, req.Body.PostID, req.Body.Tag.Default(\"none\")),\n\t\t}),\n\t}, nil\n}\n```\n\n`path`, `query`, and `body` parameters are automatically mapped to the `req` structure.\n\nFor example, this curl request will map `userId` to `req.Body.UserID` and `postId` to `req.Body.PostID`, and `tag` to `req.Body.Tag`:\n\n```sh\ncurl -i http://localhost:8891/users/123/posts/456?tag=ftl\n```\n\nThe response here will be:\n\n```json\n{\n  \"msg\": \"UserID: 123, PostID: 456, Tag: ftl\"\n}\n```\n\n#### Optional fields\n\nOptional fields are represented by the `ftl.Option` type. The `Option` type is a wrapper around the actual type and can be `Some` or `None`. In the example above, the `Tag` field is optional.\n\n```sh\ncurl -i http://localhost:8891/users/123/posts/456\n```\n\nBecause the `tag` query parameter is not provided, the response will be:\n\n```json\n{\n  \"msg\": \"UserID: 123, PostID: 456, Tag: none\"\n}\n```\n\n#### Casing\n\nField names use lowerCamelCase by default. You can override this by using the `json` tag.\n\n## SumTypes\n\nGiven the following request verb:\n\n```go\n//ftl:enum export\ntype SumType interface {\n\ttag()\n}\n\ntype A string\n\nfunc (A) tag() {}\n\ntype B []string\n\nfunc (B) tag() {}\n\n//ftl:ingress http GET /typeenum\nfunc TypeEnum(ctx context.Context, req builtin.HttpRequest[SumType]) (builtin.HttpResponse[SumType, string], error) {\n\treturn builtin.HttpResponse[SumType, string]{Body: ftl.Some(req.Body)}, nil\n}\n```\n\nThe following curl request will map the `SumType` name and value to the `req.Body`:\n\n```sh\ncurl -X GET \"http://localhost:8891/typeenum\" \\\n     -H \"Content-Type: application/json\" \\\n     --data '{\"name\": \"A\", \"value\": \"sample\"}'\n```\n\nThe response will be:\n\n```json\n{\n  \"name\": \"A\",\n  \"value\": \"sample\"\n}\n```\n\n## Forms and file uploads\n\n`POST` and `PUT` request bodies are decoded as JSON, unless their `Content-Type` is `application/x-www-form-urlencoded` or `multipart/form-data`, in which case form fields are mapped to the fields of the body by name. Form values are converted to the type of the field, so HTML forms can be posted directly to an ingress verb. Files in a multipart form are mapped to `builtin.File` fields, which hold the file's name, content type and content:\n\n```go\ntype UploadRequest struct {\n\tTitle  string                   `json:\"title\"`\n\tPublic bool                     `json:\"public\"`\n\tPhotos []builtin.File           `json:\"photos\"`\n\tCover  ftl.Option[builtin.File] `json:\"cover\"`\n}\n\n//ftl:ingress http POST /albums\nfunc Upload(ctx context.Context, req builtin.HttpRequest[UploadRequest]) (builtin.HttpResponse[ftl.Unit, string], error) {\n\tfor _, photo := range req.Body.Photos {\n\t\t// photo.Name, photo.ContentType, photo.Content\n\t}\n\t// ...\n}\n```\n\n```sh\ncurl -i http://localhost:8891/albums -F title=Holiday -F public=true -F photos=@beach.jpg -F photos=@sunset.jpg\n```\n\nForm values that don't match a field are ignored. Empty values for optional fields other than strings are treated as absent. A file can also be mapped to a `Bytes` field, in which case only its content is kept. If the body of a request is itself a `builtin.File`, the raw request body is mapped to it, with the name taken from the `Content-Disposition` header.\n\nRequest bodies that can't be decoded are rejected with a `400 Bad Request`. Bodies larger than the controller's `--ingress-max-body` (32MiB by default) are rejected with a `413 Request Entity Too Large`. Up to `--ingress-form-memory` (32MiB by default) of a multipart form is buffered in memory while it is parsed, and larger uploaded files are written to temporary files. Uploaded files are passed to the verb in full though, so it is `--ingress-max-body` that bounds the memory used, and multipart forms are rejected if it is `0`.\n\n## Encoding query params as JSON\n\nComplex query params can also be encoded as JSON using the `@json` query parameter. For example:\n\n> `{\"tag\":\"ftl\"}` url-encoded is `%7B%22tag%22%3A%22ftl%22%7D`\n\n```bash\ncurl -i http://localhost:8891/users/123/posts/456?@json=%7B%22tag%22%3A%22ftl%22%7D\n```\n\n## Idempotency keys\n\nRequests that must not be executed twice, such as payments, can include an `Idempotency-Key` header:\n\n```bash\ncurl -i http://localhost:8891/payments -d '{\"amount\":100}' -H \"Idempotency-Key: 5f3c1e2a\"\n```\n\nThe first request with a given key calls the verb as usual, and its response is stored. Subsequent requests to the same verb with the same key receive the stored response without the verb being called again. If the first request is still in flight, duplicates receive a `409 Conflict`. Responses are kept for 24 hours by default, configurable with the controller's `--idempotency-key-ttl` flag.\n\nVerbs can read the key with `ftl.IdempotencyKey(ctx)`, and `ftl call` accepts an `--idempotency-key` flag.\n\n## OpenAPI\n\nFTL can generate an [OpenAPI 3.1](https://spec.openapis.org/oas/v3.1.0) document describing every HTTP ingress route in the cluster:\n\n```bash\nftl schema openapi --title \"My API\" --server https://api.example.com > openapi.json\n```\n\nThe same document is served by the controller at `/openapi.json` (eg. `http://localhost:8892/openapi.json`).\n\nThe document is derived from the same rules used to decode requests:\n\n- Path parameters take the type of the matching field of the request body.\n- For `GET` and `DELETE` routes, the remaining fields of a data request body are query parameters. If any field can't be represented as a plain query parameter, the request is described as a single `@json` parameter instead.\n- For `POST` and `PUT` routes, data request bodies are JSON.\n- The `HttpResponse` body type is the successful response, and the error type is the default response.\n- [Authentication](#authentication) requirements are described as security schemes.\n- [Streaming](#streaming) verbs are described by the content type of their elements, and `text/event-stream`.\n\nField names use their JSON aliases, and doc comments on verbs, data types and fields are included as summaries and descriptions.\n\n## Authentication\n\nIngress verbs can require requests to be authenticated before they are called with the `//ftl:auth` directive. Requests that fail authentication receive a `401 Unauthorized` response and the verb is not called.\n\nJWT bearer tokens can be verified against a JWKS endpoint, or against keys stored in an FTL secret. The secret may hold PEM encoded public keys or certificates, or a JWKS document. HMAC secrets must be stored as `oct` keys in a JWKS document, and must be at least as long as the hash they are used with (32 bytes for `HS256`). `oct` keys served by a JWKS endpoint are ignored. `issuer` and `audience` are optional, and are checked against the token's `iss` and `aud` claims if present. Tokens with non-numeric `exp` or `nbf` claims are rejected:\n\n```go\n//ftl:ingress GET /orders\n//ftl:auth jwt issuer \"https://auth.example.com\" audience \"orders\" jwks \"https://auth.example.com/.well-known/jwks.json\"\nfunc ListOrders(ctx context.Context, req builtin.HttpRequest[ftl.Unit]) (builtin.HttpResponse[[]Order, string], error) {\n  // ...\n}\n```\n\nAPI keys are read from the `X-API-Key` header by default, and checked against a secret. The secret may be a single key (`string`), a list of keys (`[]string`), or a map of keys to the name of their owner (`map[string]string`):\n\n```go\nvar apiKeys = ftl.Secret[map[string]string](\"apiKeys\")\n\n//ftl:ingress POST /orders\n//ftl:auth apikey header \"X-Token\" secret apiKeys\nfunc CreateOrder(ctx context.Context, req builtin.HttpRequest[Order]) (builtin.HttpResponse[Order, string], error) {\n  // ...\n}\n```\n\nClient certificates can be required with `//ftl:auth mtls`. As ingress doesn't terminate TLS itself, the verified client certificate must be forwarded by the TLS terminating proxy in the header given by the controller's `--ingress-client-cert-header` flag, as a URL encoded PEM certificate.\n\nIf a verb declares more than one `//ftl:auth` directive, a request satisfying any one of them is accepted. The authenticated caller is available to the verb as `req.Principal`:\n\n```go\nprincipal, _ := req.Principal.Get()\nfmt.Println(principal.Scheme, principal.Subject, principal.Claims[\"email\"])\n```\n\n`Subject` is the token's `sub` claim, the owner of the API key (or the key's index in a list), or the client certificate's subject. `Claims` holds the token's claims, or the client certificate's details.\n\n## Streaming\n\nRather than buffering a single response, an ingress verb can stream its response to the client as it is produced by accepting an `ftl.StreamWriter` in place of returning a response. This is useful for large exports, or for pushing progress updates to a browser:\n\n```go\ntype Progress struct {\n  Percent int `json:\"percent\"`\n}\n\n//ftl:ingress GET /export\nfunc Export(ctx context.Context, req builtin.HttpRequest[ftl.Unit], stream ftl.StreamWriter[Progress]) error {\n  for i := 1; i <= 10; i++ {\n    if err := stream.Send(ctx, Progress{Percent: i * 10}); err != nil {\n      return err // The client has gone away.\n    }\n  }\n  return nil\n}\n```\n\nEach value sent is written to the client immediately:\n\n- If the request's `Accept` header includes `text/event-stream`, each value is a [server-sent event](https://html.spec.whatwg.org/multipage/server-sent-events.html), eg. `new EventSource(\"/export\")` in a browser.\n- Otherwise the response uses chunked transfer encoding. `string` values are written as-is with the content type `text/plain`, `[]byte` values as `application/octet-stream`, and all other values as newline delimited JSON (`application/x-ndjson`).\n\nIf the verb returns an error before sending anything, the client receives a `500 Internal Server Error`. Once the stream has started, an `error` event is sent to event stream clients, and chunked responses are aborted so that the client sees an incomplete response. Streaming verbs can't be called with `ftl.Call()`, and don't support idempotency keys. The controller's `--ingress-timeout` applies to the whole stream.\n\n## WebSockets\n\nVerbs can also handle messages from WebSocket clients with the `ws` ingress type. The controller accepts the connection, and calls the verb once for each message received. Each message is tagged with the ID of its connection:\n\n```go\ntype ChatMessage struct {\n  Text string `json:\"text\"`\n}\n\n//ftl:ingress ws /chat\nfunc Chat(ctx context.Context, req builtin.WebSocketMessage[ChatMessage]) error {\n  return ftl.SendWebSocketMessage(ctx, req.ConnectionId, ChatMessage{Text: \"echo: \" + req.Body.Text})\n}\n```\n\nMessages are decoded the same way as HTTP request bodies, so `Body` can be any type. Messages from a connection are passed to the verb one at a time, in the order they are received. A message that can't be decoded closes the connection with status `1007`. A message larger than the controller's `--ingress-max-body` closes the connection with status `1009`. WebSocket ingress can't have a method, path parameters or a response type.\n\nAny verb can send a message to a connection with `ftl.SendWebSocketMessage()`, as long as it knows the connection's ID. `string` messages are sent as text, `[]byte` messages as binary, and anything else as JSON text. The connection may be held by a different controller, in which case the message is delivered by way of the database. Messages for connections that have been closed are dropped.\n\nThe controller's `--ingress-timeout` doesn't apply to WebSocket connections. Connections are accepted from pages served from the ingress' own host, and from origins passed to `--allow-origins`.\n",
+	"//ftl:ratelimit": "## Limits\n\nThe rate of calls to a verb can be limited with the `//ftl:ratelimit <count>/<period>` directive, and the number of concurrent calls with `//ftl:concurrency <count>`:\n\n```go\n//ftl:verb\n//ftl:ratelimit 100/1m\n//ftl:concurrency 10\nfunc Charge(ctx context.Context, in ChargeRequest) (ChargeResponse, error) {\n  // ...\n}\n```\n\nLimits are enforced by the controllers and apply across the whole cluster, regardless of which controller or runner serves a call. Calls that exceed a limit fail with a `ResourceExhausted` error, or a `429 Too Many Requests` response for HTTP ingress requests.\n",
+	"//ftl:retry": "## Retries\n\nAny verb called asynchronously (specifically, PubSub subscribers, FSM states and [scheduled calls](../scheduled)), may optionally specify a basic exponential backoff retry policy via a Go comment directive. The directive has the following syntax:\n\n```go\n//ftl:retry [<attempts=10>] <min-backoff> [<max-backoff=1hr>] [catch <catchVerb>]\n```\n\nFor example, the following function will retry up to 10 times, with a delay of 5s, 10s, 20s, 40s, 60s, 60s, etc.\n\n```go\n//ftl:retry 10 5s 1m\nfunc Invoiced(ctx context.Context, in Invoice) error {\n  // ...\n}\n```\n\n## Catching\nAfter all retries have failed, a catch verb can be used to safely recover.\n\nThese catch verbs have a request type of `builtin.CatchRequest<Req>` and no response type. If a catch verb returns an error, it will be retried until it succeeds so it is important to handle errors carefully. The exception is a subscriber whose subscription has a [dead letter topic](../pubsub#dead-letter-topics): if its catch verb fails, the event is published to the dead letter topic instead of the catch verb being retried.\n\n```go\n//ftl retry 5 1s catch recoverPaymentProcessing\nfunc ProcessPayment(ctx context.Context, payment Payment) error {\n    ...\n}\n\n//ftl:verb\nfunc RecoverPaymentProcessing(ctx context.Context, request builtin.CatchRequest[Payment]) error {\n    // safely handle final failure of the payment\n}\n```\n\nFor FSMs, after a catch verb has been successfully called the FSM will moved to the failed state.",
+	"//ftl:subscribe": "## PubSub\n\nFTL has first-class support for PubSub, modelled on the concepts of topics (where events are sent), subscriptions (a cursor over the topic), and subscribers (functions events are delivered to). Subscribers are, as you would expect, sinks. Each subscription is a cursor over the topic it is associated with. Each topic may have multiple subscriptions. Each subscription may have multiple subscribers, in which case events will be distributed among them.\n\nFirst, declare a new topic:\n\n```go\nvar Invoices = ftl.Topic[Invoice](\"invoices\")\n```\n\nThen declare each subscription on the topic:\n\n```go\nvar _ = ftl.Subscription(Invoices, \"emailInvoices\")\n```\n\nAnd finally define a Sink to consume from the subscription:\n\n```go\n//ftl:subscribe emailInvoices\nfunc SendInvoiceEmail(ctx context.Context, in Invoice) error {\n  // ...\n}\n```\n\nEvents can be published to a topic like so:\n\n```go\nInvoices.Publish(ctx, Invoice{...})\n```\n\n> **NOTE!**\n> PubSub topics cannot be published to from outside the module that declared them, they can only be subscribed to. That is, if a topic is declared in module `A`, module `B` cannot publish to it.\n\n## Batches\n\nSinks that benefit from processing many events at once, such as analytics or bulk writes, can consume a subscription in batches by declaring a maximum batch size and, optionally, how long to wait for a batch to fill (one second by default):\n\n```go\nvar _ = ftl.Subscription(Invoices, \"invoiceAnalytics\")\n\n//ftl:subscribe invoiceAnalytics batch 100 5s\nfunc RecordInvoices(ctx context.Context, in []Invoice) error {\n  // ...\n}\n```\n\nA batch is delivered as soon as it is full, or once its oldest event has waited for the maximum wait. Retries and catch verbs apply to the whole batch, and if the batch fails permanently every event in it is published to the subscription's dead letter topic.\n\nIn unit tests, use `ftltest.WithBatchSubscriber(subscription, sink, batchSize)` instead of `ftltest.WithSubscriber(…)`.\n\n## Partitions\n\nBy default each subscription consumes the events in a topic one at a time, in the order they were published. To consume events concurrently, a topic can be split into partitions:\n\n```go\nvar Invoices = ftl.Topic[Invoice](\"invoices\", ftl.Partitions(8))\n```\n\nEach partition of a subscription is consumed independently. Events published with a key are always published to the same partition, so events with the same key are still consumed in order:\n\n```go\nInvoices.PublishWithKey(ctx, invoice.CustomerID, invoice)\n```\n\nEvents published without a key are distributed randomly across partitions. Increasing the number of partitions of an existing topic only affects where new events are published.\n\n## Replaying events\n\nA subscription can be moved to an earlier or later point in its topic, without affecting other subscriptions to the same topic. This is useful for reprocessing events after fixing a bug in a subscriber:\n\n```sh\n# Reprocess the last six hours of events\nftl pubsub subscription seek echo.emailInvoices --since=6h\n# Consume from a specific event or time onwards\nftl pubsub subscription seek echo.emailInvoices --event=<event>\nftl pubsub subscription seek echo.emailInvoices --time=2024-08-16T09:00:00Z\n```\n\nPass `--dry-run` to report how many events would be redelivered or skipped without moving the subscription.\n\n## Dead letter topics\n\nBy default, an event that a subscriber fails to consume after exhausting its [retries](../retries) is skipped. To keep these events, declare a dead letter topic for the subscription. The dead letter topic must have the same event type as the topic being subscribed to:\n\n```go\nvar FailedInvoices = ftl.Topic[Invoice](\"failedInvoices\")\n\nvar _ = ftl.Subscription(Invoices, \"emailInvoices\", ftl.DeadLetter(FailedInvoices))\n```\n\nEvents that permanently fail are published to the dead letter topic, which can itself be subscribed to like any other topic. The error and number of attempts are recorded alongside each event, and can be managed with `ftl pubsub deadletter`:\n\n```sh\n# List failed events\nftl pubsub deadletter list echo.emailInvoices\n# Show the error and payload of a failed event\nftl pubsub deadletter inspect echo.emailInvoices <event>\n# Deliver failed events to the subscription again\nftl pubsub deadletter replay echo.emailInvoices [--event=<event>]\n# Discard failed events\nftl pubsub deadletter purge echo.emailInvoices [--event=<event>]\n```\n\nReplayed events that fail again are returned to the dead letters. Purging does not remove events from the dead letter topic itself.\n\n## Diagrams\n\n`ftl schema graph --kind=pubsub` renders topics, their subscriptions and the verbs subscribed to them as a DOT or Mermaid diagram. Use `--modules` to limit the diagram to specific modules.\n",
+	"//ftl:timeout": "## Timeouts\n\nA verb may declare a maximum duration for calls to it, including any verbs it calls in turn, with the `//ftl:timeout` directive:\n\n```go\n//ftl:verb\n//ftl:timeout 30s\nfunc Checkout(ctx context.Context, in CheckoutRequest) (CheckoutResponse, error) {\n  // ...\n}\n```\n\nThe deadline is propagated to every downstream call, which can only shorten it. Once the deadline passes the `ctx` passed to the verb is cancelled and pending calls fail with a deadline exceeded error. The current deadline is available via `ftl.Deadline(ctx)`.\n\nRequests from ingress can be given a default deadline with the controller's `--ingress-timeout` flag.\n",
 	"//ftl:typealias": "## Type aliases\n\nA type alias is an alternate name for an existing type. It can be declared like so:\n\n```go\n//ftl:typealias\ntype Alias Target\n```\nor\n```go\n//ftl:typealias\ntype Alias = Target\n```\n\neg.\n\n```go\n//ftl:typealias\ntype UserID string\n\n//ftl:typealias\ntype UserToken = string\n```\n",
-	"//ftl:verb": "## Verbs\n\n## Defining Verbs\n\nTo declare a Verb, write a normal Go function with the following signature, annotated with the Go [comment directive](https://tip.golang.org/doc/comment#syntax) `//ftl:verb`:\n\n```go\n//ftl:verb\nfunc F(context.Context, In) (Out, error) { }\n```\n\neg.\n\n```go\ntype EchoRequest struct {}\n\ntype EchoResponse struct {}\n\n//ftl:verb\nfunc Echo(ctx context.Context, in EchoRequest) (EchoResponse, error) {\n  // ...\n}\n```\n\nBy default verbs are only [visible](../visibility) to other verbs in the same module.\n\n## Calling Verbs\n\nTo call a verb use `ftl.Call()`. eg.\n\n```go\nout, err := ftl.Call(ctx, echo.Echo, echo.EchoRequest{})\n```\n",
+	"//ftl:verb": "## Verbs\n\n## Defining Verbs\n\nTo declare a Verb, write a normal Go function with the following signature, annotated with the Go [comment directive](https://tip.golang.org/doc/comment#syntax) `//ftl:verb`:\n\n```go\n//ftl:verb\nfunc F(context.Context, In) (Out, error) { }\n```\n\neg.\n\n```go\ntype EchoRequest struct {}\n\ntype EchoResponse struct {}\n\n//ftl:verb\nfunc Echo(ctx context.Context, in EchoRequest) (EchoResponse, error) {\n  // ...\n}\n```\n\nBy default verbs are only [visible](../visibility) to other verbs in the same module.\n\nVerbs that stream their response to HTTP clients take an `ftl.StreamWriter[Out]` instead of returning `Out`. See [Streaming](../ingress#streaming).\n\n## Calling Verbs\n\nTo call a verb use `ftl.Call()`. eg.\n\n```go\nout, err := ftl.Call(ctx, echo.Echo, echo.EchoRequest{})\n```\n\nCalls that would form a cycle, eg. `a -> b -> a`, are rejected unless every verb in the cycle is annotated with `//ftl:recursive`:\n\n```go\n//ftl:verb\n//ftl:recursive\nfunc Walk(ctx context.Context, in Node) (Tree, error) {\n  // ...\n}\n```\n\nChains of calls deeper than the controller's `--max-call-depth` (64 by default) are also rejected. In both cases the call fails with a `FailedPrecondition` error describing the offending chain. Call cycles that are not fully `//ftl:recursive` are reported as warnings when a module is deployed.\n\n\n## Timeouts\n\nA verb may declare a maximum duration for calls to it, including any verbs it calls in turn, with the `//ftl:timeout` directive:\n\n```go\n//ftl:verb\n//ftl:timeout 30s\nfunc Checkout(ctx context.Context, in CheckoutRequest) (CheckoutResponse, error) {\n  // ...\n}\n```\n\nThe deadline is propagated to every downstream call, which can only shorten it. Once the deadline passes the `ctx` passed to the verb is cancelled and pending calls fail with a deadline exceeded error. The current deadline is available via `ftl.Deadline(ctx)`.\n\nRequests from ingress can be given a default deadline with the controller's `--ingress-timeout` flag.\n\n## Limits\n\nThe rate of calls to a verb can be limited with the `//ftl:ratelimit <count>/<period>` directive, and the number of concurrent calls with `//ftl:concurrency <count>`:\n\n```go\n//ftl:verb\n//ftl:ratelimit 100/1m\n//ftl:concurrency 10\nfunc Charge(ctx context.Context, in ChargeRequest) (ChargeResponse, error) {\n  // ...\n}\n```\n\nLimits are enforced by the controllers and apply across the whole cluster, regardless of which controller or runner serves a call. Calls that exceed a limit fail with a `ResourceExhausted` error, or a `429 Too Many Requests` response for HTTP ingress requests.\n",
 }